@@ -206,7 +206,8 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) (res abci.ResponseDeliv
 // latest header and reset the deliver state. Also, if a non-zero halt height is
 // defined in config, Commit will execute a deferred function call to check
 // against that height and gracefully halt if it matches the latest committed
-// height.
+// height. Finally, a snapshot of the committed state is taken if the height
+// matches the configured snapshot interval.
 func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	header := app.deliverState.ctx.BlockHeader()
 
@@ -244,11 +245,42 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	// empty/reset the deliver state
 	app.deliverState = nil
 
+	app.snapshot(commitID.Version)
+
 	return abci.ResponseCommit{
 		Data: commitID.Hash,
 	}
 }
 
+// snapshot takes a snapshot of the state committed at the given height if a
+// snapshot store is set and the height is a multiple of the snapshot interval,
+// and prunes the old snapshots. Errors are logged but never halt the node.
+//
+// NOTE: The snapshot is taken synchronously and thus delays the next block by
+// the time required to write it.
+func (app *BaseApp) snapshot(height int64) {
+	if app.snapshotManager == nil || app.snapshotInterval == 0 || uint64(height)%app.snapshotInterval != 0 {
+		return
+	}
+
+	app.logger.Info("creating state snapshot", "height", height)
+	snapshot, err := app.snapshotManager.Create(height)
+	if err != nil {
+		app.logger.Error("failed to create state snapshot", "height", height, "err", err)
+		return
+	}
+	app.logger.Info("completed state snapshot", "height", height, "chunks", snapshot.Chunks, "hash", fmt.Sprintf("%X", snapshot.Hash))
+
+	if app.snapshotKeepRecent > 0 {
+		pruned, err := app.snapshotManager.Prune(app.snapshotKeepRecent)
+		if err != nil {
+			app.logger.Error("failed to prune state snapshots", "err", err)
+			return
+		}
+		app.logger.Debug("pruned state snapshots", "pruned", pruned)
+	}
+}
+
 // halt attempts to gracefully shutdown the node via SIGINT and SIGTERM falling
 // back on os.Exit if both fail.
 func (app *BaseApp) halt() {
@@ -17,6 +17,8 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/cosmos/cosmos-sdk/store/snapshots"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -105,6 +107,13 @@ type BaseApp struct {
 	// minimum block time (in Unix seconds) at which to halt the chain and gracefully shutdown
 	haltTime uint64
 
+	// snapshots of the committed state, taken every snapshotInterval blocks
+	// while keeping the snapshotKeepRecent most recent ones (0 keeps them all)
+	snapshotStore      *snapshots.Store
+	snapshotManager    *snapshots.Manager
+	snapshotInterval   uint64
+	snapshotKeepRecent uint32
+
 	// application's version string
 	appVersion string
 }
@@ -137,6 +146,14 @@ func NewBaseApp(
 		app.cms.SetInterBlockCache(app.interBlockCache)
 	}
 
+	if rs, ok := app.cms.(*rootmulti.Store); ok {
+		rs.SetLogger(logger.With("module", "store"))
+	}
+
+	if app.snapshotStore != nil {
+		app.snapshotManager = snapshots.NewManager(app.snapshotStore, app.cms)
+	}
+
 	return app
 }
 
@@ -246,8 +263,8 @@ func StoreLoaderWithUpgrade(upgrades *storetypes.StoreUpgrades) StoreLoader {
 // and not re-applied on next restart
 //
 // This is useful for in place migrations when a store key is renamed between
-// two versions of the software. (TODO: this code will move to x/upgrades
-// when PR #4233 is merged, here mainly to help test the design)
+// two versions of the software. Chains coordinating upgrades on-chain should
+// prefer the height-aware loader provided by x/upgrade (UpgradeStoreLoader).
 func UpgradeableStoreLoader(upgradeInfoPath string) StoreLoader {
 	return func(ms sdk.CommitMultiStore) error {
 		_, err := os.Stat(upgradeInfoPath)
@@ -355,6 +372,24 @@ func (app *BaseApp) setInterBlockCache(cache sdk.MultiStorePersistentCache) {
 	app.interBlockCache = cache
 }
 
+func (app *BaseApp) setSnapshotStore(store *snapshots.Store) {
+	app.snapshotStore = store
+}
+
+func (app *BaseApp) setSnapshotInterval(interval uint64) {
+	app.snapshotInterval = interval
+}
+
+func (app *BaseApp) setSnapshotKeepRecent(keepRecent uint32) {
+	app.snapshotKeepRecent = keepRecent
+}
+
+// SnapshotManager returns the snapshot manager of the BaseApp, which is nil if
+// no snapshot store was set.
+func (app *BaseApp) SnapshotManager() *snapshots.Manager {
+	return app.snapshotManager
+}
+
 // Router returns the router of the BaseApp.
 func (app *BaseApp) Router() sdk.Router {
 	if app.sealed {
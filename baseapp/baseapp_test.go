@@ -17,6 +17,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/cosmos/cosmos-sdk/store/snapshots"
 	store "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -323,6 +324,45 @@ func TestLoadVersionInvalid(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestSnapshots(t *testing.T) {
+	logger := log.NewNopLogger()
+	dir, err := ioutil.TempDir("", "snapshots")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	snapshotStore, err := snapshots.NewStore(dir)
+	require.NoError(t, err)
+
+	capKey := sdk.NewKVStoreKey(MainStoreKey)
+	app := NewBaseApp(t.Name(), logger, dbm.NewMemDB(), nil,
+		SetSnapshotStore(snapshotStore), SetSnapshotInterval(2), SetSnapshotKeepRecent(2))
+	app.MountStores(capKey)
+	require.NoError(t, app.LoadLatestVersion(capKey))
+
+	var commitID6 sdk.CommitID
+	for height := int64(1); height <= 7; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: height}})
+		app.deliverState.ctx.KVStore(capKey).Set([]byte("height"), []byte(fmt.Sprintf("%d", height)))
+		res := app.Commit()
+		if height == 6 {
+			commitID6 = sdk.CommitID{Version: height, Hash: res.Data}
+		}
+	}
+
+	list, err := snapshotStore.List()
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	require.Equal(t, int64(6), list[0].Height)
+	require.Equal(t, int64(4), list[1].Height)
+
+	// restore a new app from the latest snapshot
+	restored := NewBaseApp(t.Name(), logger, dbm.NewMemDB(), nil, SetSnapshotStore(snapshotStore))
+	restored.MountStores(capKey)
+	require.NoError(t, restored.SnapshotManager().Restore(6))
+	require.NoError(t, restored.LoadLatestVersion(capKey))
+	testLoadVersionHelper(t, restored, int64(6), commitID6)
+}
+
 func testLoadVersionHelper(t *testing.T, app *BaseApp, expectedHeight int64, expectedID sdk.CommitID) {
 	lastHeight := app.LastBlockHeight()
 	lastID := app.LastCommitID()
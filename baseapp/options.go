@@ -8,6 +8,7 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/snapshots"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -45,6 +46,24 @@ func SetInterBlockCache(cache sdk.MultiStorePersistentCache) func(*BaseApp) {
 	return func(app *BaseApp) { app.setInterBlockCache(cache) }
 }
 
+// SetSnapshotStore returns a BaseApp option function that sets the store in
+// which the snapshots of the committed state are saved.
+func SetSnapshotStore(snapshotStore *snapshots.Store) func(*BaseApp) {
+	return func(app *BaseApp) { app.setSnapshotStore(snapshotStore) }
+}
+
+// SetSnapshotInterval returns a BaseApp option function that sets the block
+// interval at which snapshots are taken. An interval of 0 disables snapshots.
+func SetSnapshotInterval(interval uint64) func(*BaseApp) {
+	return func(app *BaseApp) { app.setSnapshotInterval(interval) }
+}
+
+// SetSnapshotKeepRecent returns a BaseApp option function that sets the number
+// of recent snapshots to keep. A value of 0 keeps all the snapshots.
+func SetSnapshotKeepRecent(keepRecent uint32) func(*BaseApp) {
+	return func(app *BaseApp) { app.setSnapshotKeepRecent(keepRecent) }
+}
+
 func (app *BaseApp) SetName(name string) {
 	if app.sealed {
 		panic("SetName() on sealed BaseApp")
@@ -52,6 +52,8 @@ const (
 	FlagRPCWriteTimeout    = flags.FlagRPCWriteTimeout
 	FlagOutputDocument     = flags.FlagOutputDocument
 	FlagSkipConfirmation   = flags.FlagSkipConfirmation
+	FlagKeyringBackend     = flags.FlagKeyringBackend
+	DefaultKeyringBackend  = flags.DefaultKeyringBackend
 	DefaultKeyPass         = keys.DefaultKeyPass
 	FlagAddress            = keys.FlagAddress
 	FlagPublicKey          = keys.FlagPublicKey
@@ -73,6 +75,7 @@ var (
 	PostCommands                       = flags.PostCommands
 	RegisterRestServerFlags            = flags.RegisterRestServerFlags
 	ParseGas                           = flags.ParseGas
+	KeyringBackendUsage                = flags.KeyringBackendUsage
 	NewCompletionCmd                   = flags.NewCompletionCmd
 	MarshalJSON                        = keys.MarshalJSON
 	UnmarshalJSON                      = keys.UnmarshalJSON
@@ -86,6 +89,7 @@ var (
 	ReadPassphraseFromStdin            = keys.ReadPassphraseFromStdin
 	NewKeyBaseFromHomeFlag             = keys.NewKeyBaseFromHomeFlag
 	NewKeyBaseFromDir                  = keys.NewKeyBaseFromDir
+	NewLegacyKeyBaseFromDir            = keys.NewLegacyKeyBaseFromDir
 	NewInMemoryKeyBase                 = keys.NewInMemoryKeyBase
 	NewRestServer                      = lcd.NewRestServer
 	ServeCommand                       = lcd.ServeCommand
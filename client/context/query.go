@@ -51,7 +51,8 @@ func (ctx CLIContext) QueryStore(key cmn.HexBytes, storeName string) ([]byte, in
 
 // QuerySubspace performs a query to a Tendermint node with the provided
 // store name and subspace. It returns key value pair and height of the query
-// upon success or an error if the query fails.
+// upon success or an error if the query fails. Unless TrustNode is enabled, the
+// returned pairs are verified to be all the pairs of the subspace.
 func (ctx CLIContext) QuerySubspace(subspace []byte, storeName string) (res []sdk.KVPair, height int64, err error) {
 	resRaw, height, err := ctx.queryStore(subspace, storeName, "subspace")
 	if err != nil {
@@ -98,7 +99,7 @@ func (ctx CLIContext) query(path string, key cmn.HexBytes) (res []byte, height i
 		return res, resp.Height, errors.New(resp.Log)
 	}
 
-	// data from trusted node or subspace query doesn't need verification
+	// data from trusted node or non-store query doesn't need verification
 	if ctx.TrustNode || !isQueryStoreWithProof(path) {
 		return resp.Value, resp.Height, nil
 	}
@@ -140,7 +141,7 @@ func (ctx CLIContext) verifyProof(queryPath string, resp abci.ResponseQuery) err
 	prt := rootmulti.DefaultProofRuntime()
 
 	// TODO: Better convention for path?
-	storeName, err := parseQueryStorePath(queryPath)
+	storeName, subpath, err := parseQueryStorePath(queryPath)
 	if err != nil {
 		return err
 	}
@@ -149,7 +150,9 @@ func (ctx CLIContext) verifyProof(queryPath string, resp abci.ResponseQuery) err
 	kp = kp.AppendKey([]byte(storeName), merkle.KeyEncodingURL)
 	kp = kp.AppendKey(resp.Key, merkle.KeyEncodingURL)
 
-	if resp.Value == nil {
+	// the value of a subspace query is the list of its key value pairs, which
+	// is proven by a range proof even if it is empty
+	if resp.Value == nil && subpath == "key" {
 		err = prt.VerifyAbsence(resp.Proof, commit.Header.AppHash, kp.String())
 		if err != nil {
 			return errors.Wrap(err, "failed to prove merkle proof")
@@ -173,7 +176,8 @@ func (ctx CLIContext) queryStore(key cmn.HexBytes, storeName, endPath string) ([
 }
 
 // isQueryStoreWithProof expects a format like /<queryType>/<storeName>/<subpath>
-// queryType must be "store" and subpath must be "key" to require a proof.
+// queryType must be "store" and subpath must be "key" or "subspace" to require
+// a proof.
 func isQueryStoreWithProof(path string) bool {
 	if !strings.HasPrefix(path, "/") {
 		return false
@@ -192,21 +196,22 @@ func isQueryStoreWithProof(path string) bool {
 	return false
 }
 
-// parseQueryStorePath expects a format like /store/<storeName>/key.
-func parseQueryStorePath(path string) (storeName string, err error) {
+// parseQueryStorePath expects a format like /store/<storeName>/key or
+// /store/<storeName>/subspace.
+func parseQueryStorePath(path string) (storeName, subpath string, err error) {
 	if !strings.HasPrefix(path, "/") {
-		return "", errors.New("expected path to start with /")
+		return "", "", errors.New("expected path to start with /")
 	}
 
 	paths := strings.SplitN(path[1:], "/", 3)
 	switch {
 	case len(paths) != 3:
-		return "", errors.New("expected format like /store/<storeName>/key")
+		return "", "", errors.New("expected format like /store/<storeName>/<key|subspace>")
 	case paths[0] != "store":
-		return "", errors.New("expected format like /store/<storeName>/key")
-	case paths[2] != "key":
-		return "", errors.New("expected format like /store/<storeName>/key")
+		return "", "", errors.New("expected format like /store/<storeName>/<key|subspace>")
+	case paths[2] != "key" && paths[2] != "subspace":
+		return "", "", errors.New("expected format like /store/<storeName>/<key|subspace>")
 	}
 
-	return paths[1], nil
+	return paths[1], paths[2], nil
 }
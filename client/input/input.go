@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"sync"
 
 	"github.com/bgentry/speakeasy"
 	isatty "github.com/mattn/go-isatty"
@@ -14,6 +15,24 @@ import (
 // MinPassLength is the minimum acceptable password length
 const MinPassLength = 8
 
+var (
+	stdinMtx  sync.Mutex
+	stdinFile *os.File
+	stdinBuf  *bufio.Reader
+)
+
+// Stdin returns a buffered reader of STDIN shared by all the prompts of a
+// command, so that piped input buffered by one prompt is not lost to the next.
+func Stdin() *bufio.Reader {
+	stdinMtx.Lock()
+	defer stdinMtx.Unlock()
+
+	if stdinBuf == nil || stdinFile != os.Stdin {
+		stdinFile, stdinBuf = os.Stdin, bufio.NewReader(os.Stdin)
+	}
+	return stdinBuf
+}
+
 // GetPassword will prompt for a password one-time (to sign a tx)
 // It enforces the password length
 func GetPassword(prompt string, buf *bufio.Reader) (pass string, err error) {
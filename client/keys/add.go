@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"strings"
 
 	bip39 "github.com/bartekn/go-bip39"
 
@@ -31,6 +32,9 @@ const (
 	flagIndex       = "index"
 	flagMultisig    = "multisig"
 	flagNoSort      = "nosort"
+	flagRemote      = "remote"
+	flagRemoteKey   = "remote-key"
+	flagKeyAlgo     = "algo"
 
 	// DefaultKeyPass contains the default key password for genesis transactions
 	DefaultKeyPass = "12345678"
@@ -56,6 +60,16 @@ You can add a multisig key by passing the list of key names you want the public
 key to be composed of to the --multisig flag and the minimum number of signatures
 required through --multisig-threshold. The keys are sorted by address, unless
 the flag --nosort is set.
+
+Use the --remote flag to add a reference to a key held by a signing daemon,
+which signs on behalf of the key. The key is looked up by its name on the
+daemon, which is the name of the key unless --remote-key is set.
+
+The --algo flag selects the signing algorithm of the derived key. Ed25519 keys
+are derived following SLIP-0010, which only defines hardened derivation, so
+every level of their HD path is hardened. Sr25519 keys are derived like ed25519
+keys, from their own master key. Secp256r1 and sr25519 keys can not be part of
+a multisig key.
 `,
 		Args: cobra.ExactArgs(1),
 		RunE: runAddCmd,
@@ -66,11 +80,14 @@ the flag --nosort is set.
 	cmd.Flags().String(FlagPublicKey, "", "Parse a public key in bech32 format and save it to disk")
 	cmd.Flags().BoolP(flagInteractive, "i", false, "Interactively prompt user for BIP39 passphrase and mnemonic")
 	cmd.Flags().Bool(flags.FlagUseLedger, false, "Store a local reference to a private key on a Ledger device")
+	cmd.Flags().String(flagRemote, "", "Store a local reference to a key held by the signing daemon serving on the endpoint (unix://<path>|http(s)://<host>:<port>)")
+	cmd.Flags().String(flagRemoteKey, "", "Name of the key on the signing daemon, if different from the key name (for use in conjunction with --remote)")
 	cmd.Flags().Bool(flagRecover, false, "Provide seed phrase to recover existing key instead of creating")
 	cmd.Flags().Bool(flagNoBackup, false, "Don't print out seed phrase (if others are watching the terminal)")
 	cmd.Flags().Bool(flagDryRun, false, "Perform action, but don't add key to local keystore")
 	cmd.Flags().Uint32(flagAccount, 0, "Account number for HD derivation")
 	cmd.Flags().Uint32(flagIndex, 0, "Address index number for HD derivation")
+	cmd.Flags().String(flagKeyAlgo, string(keys.Secp256k1), fmt.Sprintf("Signing algorithm of the key (%s)", signingAlgosUsage()))
 	cmd.Flags().Bool(flags.FlagIndentResponse, false, "Add indent to JSON response")
 	return cmd
 }
@@ -95,13 +112,21 @@ func runAddCmd(cmd *cobra.Command, args []string) error {
 	interactive := viper.GetBool(flagInteractive)
 	showMnemonic := !viper.GetBool(flagNoBackup)
 
+	algo := keys.SigningAlgo(viper.GetString(flagKeyAlgo))
+	if algo == "" {
+		algo = keys.Secp256k1
+	}
+	if !keys.IsSupportedAlgorithm(algo) {
+		return fmt.Errorf("unsupported signing algorithm %s, expected one of: %s", algo, signingAlgosUsage())
+	}
+
 	if viper.GetBool(flagDryRun) {
 		// we throw this away, so don't enforce args,
 		// we want to get a new random seed phrase quickly
 		kb = keys.NewInMemory()
 		encryptPassword = DefaultKeyPass
 	} else {
-		kb, err = NewKeyBaseFromHomeFlag()
+		kb, err = newKeyBaseFromHomeFlag(inBuf)
 		if err != nil {
 			return err
 		}
@@ -132,6 +157,9 @@ func runAddCmd(cmd *cobra.Command, args []string) error {
 				if err != nil {
 					return err
 				}
+				if err := validateMultisigPubKey(keyname, k.GetPubKey()); err != nil {
+					return err
+				}
 				pks = append(pks, k.GetPubKey())
 			}
 
@@ -151,8 +179,23 @@ func runAddCmd(cmd *cobra.Command, args []string) error {
 			return nil
 		}
 
-		// ask for a password when generating a local key
-		if viper.GetString(FlagPublicKey) == "" && !viper.GetBool(flags.FlagUseLedger) {
+		if endpoint := viper.GetString(flagRemote); endpoint != "" {
+			remoteKey := viper.GetString(flagRemoteKey)
+			if remoteKey == "" {
+				remoteKey = name
+			}
+
+			info, err := kb.CreateRemote(name, endpoint, remoteKey)
+			if err != nil {
+				return err
+			}
+
+			return printCreate(cmd, info, false, "")
+		}
+
+		// ask for a password when generating a local key, unless the keyring
+		// protects it
+		if viper.GetString(FlagPublicKey) == "" && !viper.GetBool(flags.FlagUseLedger) && usesKeyPassphrases() {
 			encryptPassword, err = input.GetCheckPassword(
 				"Enter a passphrase to encrypt your key to disk:",
 				"Repeat the passphrase:", inBuf)
@@ -180,7 +223,7 @@ func runAddCmd(cmd *cobra.Command, args []string) error {
 	// If we're using ledger, only thing we need is the path and the bech32 prefix.
 	if viper.GetBool(flags.FlagUseLedger) {
 		bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
-		info, err := kb.CreateLedger(name, keys.Secp256k1, bech32PrefixAccAddr, account, index)
+		info, err := kb.CreateLedger(name, algo, bech32PrefixAccAddr, account, index)
 		if err != nil {
 			return err
 		}
@@ -243,7 +286,7 @@ func runAddCmd(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	info, err := kb.CreateAccount(name, mnemonic, bip39Passphrase, encryptPassword, account, index)
+	info, err := kb.CreateAccount(name, mnemonic, bip39Passphrase, encryptPassword, account, index, algo)
 	if err != nil {
 		return err
 	}
@@ -258,6 +301,16 @@ func runAddCmd(cmd *cobra.Command, args []string) error {
 	return printCreate(cmd, info, showMnemonic, mnemonic)
 }
 
+// signingAlgosUsage returns the list of the supported signing algorithms
+func signingAlgosUsage() string {
+	algos := keys.SigningAlgos()
+	names := make([]string, len(algos))
+	for i, algo := range algos {
+		names[i] = string(algo)
+	}
+	return strings.Join(names, "|")
+}
+
 func printCreate(cmd *cobra.Command, info keys.Info, showMnemonic bool, mnemonic string) error {
 	output := viper.Get(cli.OutputFlag)
 
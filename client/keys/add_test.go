@@ -5,10 +5,15 @@ import (
 
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"github.com/tendermint/tendermint/crypto/ed25519"
 	"github.com/tendermint/tendermint/libs/cli"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/sr25519"
 	"github.com/cosmos/cosmos-sdk/tests"
 )
 
@@ -46,3 +51,84 @@ func Test_runAddCmdBasic(t *testing.T) {
 	err = runAddCmd(cmd, []string{"keyname2"})
 	assert.NoError(t, err)
 }
+
+func Test_runAddCmdKeyring(t *testing.T) {
+	cmd := addKeyCommand()
+	mockIn, _, _ := tests.ApplyMockIO(cmd)
+
+	kbHome, kbCleanUp := tests.NewTestCaseDir(t)
+	defer kbCleanUp()
+	viper.Set(flags.FlagHome, kbHome)
+	viper.Set(cli.OutputFlag, OutputFormatText)
+
+	viper.Set(flags.FlagKeyringBackend, keys.BackendTest)
+	defer viper.Set(flags.FlagKeyringBackend, flags.DefaultKeyringBackend)
+
+	// no passphrase is asked for
+	mockIn.Reset("")
+	require.NoError(t, runAddCmd(cmd, []string{"keyname1"}))
+
+	viper.Set(flagKeyAlgo, string(keys.Sr25519))
+	mockIn.Reset("test1234\ntest1234\n")
+	require.NoError(t, runAddCmd(cmd, []string{"keyname3"}))
+
+	kb, err := NewKeyBaseFromHomeFlag()
+	require.NoError(t, err)
+	info, err := kb.Get("keyname1")
+	require.NoError(t, err)
+	require.Equal(t, keys.TypeLocal, info.GetType())
+
+	// the key is not in the legacy keybase
+	legacyKb, err := NewLegacyKeyBaseFromDir(kbHome)
+	require.NoError(t, err)
+	_, err = legacyKb.Get("keyname1")
+	require.Error(t, err)
+}
+
+func Test_runAddCmdAlgo(t *testing.T) {
+	cmd := addKeyCommand()
+	mockIn, _, _ := tests.ApplyMockIO(cmd)
+
+	kbHome, kbCleanUp := tests.NewTestCaseDir(t)
+	defer kbCleanUp()
+	viper.Set(flags.FlagHome, kbHome)
+	viper.Set(cli.OutputFlag, OutputFormatText)
+	defer viper.Set(flagKeyAlgo, "")
+
+	viper.Set(flagKeyAlgo, "unknown")
+	mockIn.Reset("test1234\ntest1234\n")
+	require.Error(t, runAddCmd(cmd, []string{"keyname1"}))
+
+	viper.Set(flagKeyAlgo, string(keys.Ed25519))
+	mockIn.Reset("test1234\ntest1234\n")
+	require.NoError(t, runAddCmd(cmd, []string{"keyname1"}))
+
+	viper.Set(flagKeyAlgo, string(keys.Secp256r1))
+	mockIn.Reset("test1234\ntest1234\n")
+	require.NoError(t, runAddCmd(cmd, []string{"keyname2"}))
+
+	viper.Set(flagKeyAlgo, string(keys.Sr25519))
+	mockIn.Reset("test1234\ntest1234\n")
+	require.NoError(t, runAddCmd(cmd, []string{"keyname3"}))
+
+	kb, err := NewKeyBaseFromHomeFlag()
+	require.NoError(t, err)
+	info, err := kb.Get("keyname1")
+	require.NoError(t, err)
+	require.IsType(t, ed25519.PubKeyEd25519{}, info.GetPubKey())
+	info, err = kb.Get("keyname2")
+	require.NoError(t, err)
+	require.IsType(t, secp256r1.PubKeySecp256r1{}, info.GetPubKey())
+	info, err = kb.Get("keyname3")
+	require.NoError(t, err)
+	require.IsType(t, sr25519.PubKeySr25519{}, info.GetPubKey())
+
+	// secp256r1 keys can not be part of a multisig key
+	viper.Set(flagMultisig, []string{"keyname1", "keyname2"})
+	defer viper.Set(flagMultisig, nil)
+	require.Error(t, runAddCmd(cmd, []string{"multi"}))
+
+	// nor sr25519 keys
+	viper.Set(flagMultisig, []string{"keyname1", "keyname3"})
+	require.Error(t, runAddCmd(cmd, []string{"multi"}))
+}
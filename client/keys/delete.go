@@ -23,16 +23,17 @@ func deleteKeyCommand() *cobra.Command {
 		Short: "Delete the given key",
 		Long: `Delete a key from the store.
 
-Note that removing offline or ledger keys will remove
+Note that removing offline, ledger or remote keys will remove
 only the public key references stored locally, i.e.
-private keys stored in a ledger device cannot be deleted with the CLI.
+private keys stored in a ledger device or held by a signing
+daemon cannot be deleted with the CLI.
 `,
 		RunE: runDeleteCmd,
 		Args: cobra.ExactArgs(1),
 	}
 
 	cmd.Flags().BoolP(flagYes, "y", false,
-		"Skip confirmation prompt when deleting offline or ledger key references")
+		"Skip confirmation prompt when deleting offline, ledger or remote key references, or keyring keys")
 	cmd.Flags().BoolP(flagForce, "f", false,
 		"Remove the key unconditionally without asking for the passphrase")
 	return cmd
@@ -41,7 +42,8 @@ private keys stored in a ledger device cannot be deleted with the CLI.
 func runDeleteCmd(cmd *cobra.Command, args []string) error {
 	name := args[0]
 
-	kb, err := NewKeyBaseFromHomeFlag()
+	buf := bufio.NewReader(cmd.InOrStdin())
+	kb, err := newKeyBaseFromHomeFlag(buf)
 	if err != nil {
 		return err
 	}
@@ -51,8 +53,7 @@ func runDeleteCmd(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	buf := bufio.NewReader(cmd.InOrStdin())
-	if info.GetType() == keys.TypeLedger || info.GetType() == keys.TypeOffline {
+	if info.GetType() == keys.TypeLedger || info.GetType() == keys.TypeOffline || info.GetType() == keys.TypeRemote {
 		if !viper.GetBool(flagYes) {
 			if err := confirmDeletion(buf); err != nil {
 				return err
@@ -65,6 +66,20 @@ func runDeleteCmd(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
+	// keys stored in a keyring are not protected by their own passphrase
+	if !usesKeyPassphrases() {
+		if !viper.GetBool(flagYes) {
+			if err := confirmDeletion(buf); err != nil {
+				return err
+			}
+		}
+		if err := kb.Delete(name, "", true); err != nil {
+			return err
+		}
+		cmd.PrintErrln("Key deleted forever (uh oh!)")
+		return nil
+	}
+
 	// skip passphrase check if run with --force
 	skipPass := viper.GetBool(flagForce)
 	var oldpass string
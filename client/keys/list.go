@@ -1,6 +1,8 @@
 package keys
 
 import (
+	"bufio"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -19,7 +21,7 @@ along with their associated name and address.`,
 }
 
 func runListCmd(cmd *cobra.Command, args []string) error {
-	kb, err := NewKeyBaseFromHomeFlag()
+	kb, err := newKeyBaseFromHomeFlag(bufio.NewReader(cmd.InOrStdin()))
 	if err != nil {
 		return err
 	}
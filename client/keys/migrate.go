@@ -0,0 +1,112 @@
+package keys
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
+)
+
+// migratePassphrase is used to encrypt the private keys while they are moved
+// from the legacy keybase to the keyring.
+const migratePassphrase = "NOOP_PASSPHRASE"
+
+func migrateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Migrate the keys from the legacy LevelDB keybase to a keyring",
+		Long: `Migrate the keys stored in the legacy LevelDB keybase under --home to the
+keyring backend selected with --keyring-backend.
+
+The passphrase of each locally stored key is asked for to decrypt its private
+key. Keys whose name is already taken in the keyring are skipped. The legacy
+keybase is left unchanged, so it can be removed once the migration is checked.
+
+If run with --dry-run, the keys are migrated to an in-memory keyring, which
+checks the passphrases without storing anything.
+`,
+		Args: cobra.NoArgs,
+		RunE: runMigrateCmd,
+	}
+
+	cmd.Flags().Bool(flagDryRun, false, "Run the migration without storing the keys in the keyring")
+	return cmd
+}
+
+func runMigrateCmd(cmd *cobra.Command, args []string) error {
+	rootDir := viper.GetString(flags.FlagHome)
+
+	if usesKeyPassphrases() && !viper.GetBool(flagDryRun) {
+		return errors.New("select the keyring backend to migrate the keys to with --keyring-backend")
+	}
+
+	buf := bufio.NewReader(cmd.InOrStdin())
+	kb := keys.NewKeyringKeybase(keys.NewMemKeyring())
+	if !viper.GetBool(flagDryRun) {
+		var err error
+		if kb, err = newKeyBaseFromDir(rootDir, buf); err != nil {
+			return err
+		}
+	}
+
+	legacyKb, err := NewLegacyKeyBaseFromDir(rootDir)
+	if err != nil {
+		return err
+	}
+	defer legacyKb.CloseDB()
+
+	oldKeys, err := legacyKb.List()
+	if err != nil {
+		return err
+	}
+
+	var migrated int
+	for _, key := range oldKeys {
+		name := key.GetName()
+
+		if _, err := kb.Get(name); err == nil {
+			cmd.PrintErrf("Key %q already exists in the keyring, skipping\n", name)
+			continue
+		}
+
+		cmd.PrintErrf("Migrating key %q (%s)\n", name, key.GetType())
+		if err := migrateKey(legacyKb, kb, key, buf); err != nil {
+			return fmt.Errorf("failed to migrate key %q: %v", name, err)
+		}
+		migrated++
+	}
+
+	cmd.PrintErrf("%d of %d keys migrated.\n", migrated, len(oldKeys))
+	return nil
+}
+
+// migrateKey moves a key from the legacy keybase to the keyring, asking for
+// the passphrase of locally stored keys.
+func migrateKey(legacyKb, kb keys.Keybase, key keys.Info, buf *bufio.Reader) error {
+	name := key.GetName()
+
+	if key.GetType() != keys.TypeLocal {
+		armor, err := legacyKb.Export(name)
+		if err != nil {
+			return err
+		}
+		return kb.Import(name, armor)
+	}
+
+	passphrase, err := input.GetPassword(fmt.Sprintf("Enter the passphrase of key %q:", name), buf)
+	if err != nil {
+		return err
+	}
+
+	armor, err := legacyKb.ExportPrivKey(name, passphrase, migratePassphrase)
+	if err != nil {
+		return err
+	}
+	return kb.ImportPrivKey(name, armor, migratePassphrase)
+}
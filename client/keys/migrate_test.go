@@ -0,0 +1,95 @@
+package keys
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
+	"github.com/cosmos/cosmos-sdk/tests"
+)
+
+func Test_runMigrateCmd(t *testing.T) {
+	cmd := migrateCommand()
+	mockIn, _, _ := tests.ApplyMockIO(cmd)
+
+	kbHome, cleanUp := tests.NewTestCaseDir(t)
+	defer cleanUp()
+	viper.Set(flags.FlagHome, kbHome)
+	defer viper.Set(flags.FlagKeyringBackend, flags.DefaultKeyringBackend)
+
+	legacyKb, err := NewLegacyKeyBaseFromDir(kbHome)
+	require.NoError(t, err)
+	local, err := legacyKb.CreateAccount("local", tests.TestMnemonic, "", "pass1234", 0, 0, keys.Secp256k1)
+	require.NoError(t, err)
+	offline, err := legacyKb.CreateAccount("offline", tests.TestMnemonic, "", "", 0, 1, keys.Secp256k1)
+	require.NoError(t, err)
+
+	// a keyring backend must be selected
+	viper.Set(flags.FlagKeyringBackend, flags.DefaultKeyringBackend)
+	require.Error(t, runMigrateCmd(cmd, nil))
+
+	viper.Set(flags.FlagKeyringBackend, keys.BackendTest)
+
+	// a wrong passphrase fails the migration
+	mockIn.Reset("wrongpass1234\n")
+	require.Error(t, runMigrateCmd(cmd, nil))
+
+	mockIn.Reset("pass1234\n")
+	require.NoError(t, runMigrateCmd(cmd, nil))
+
+	kb, err := NewKeyBaseFromHomeFlag()
+	require.NoError(t, err)
+
+	info, err := kb.Get("local")
+	require.NoError(t, err)
+	require.Equal(t, keys.TypeLocal, info.GetType())
+	require.Equal(t, local.GetPubKey(), info.GetPubKey())
+	_, _, err = kb.Sign("local", "", []byte("to be signed"))
+	require.NoError(t, err)
+
+	info, err = kb.Get("offline")
+	require.NoError(t, err)
+	require.Equal(t, keys.TypeOffline, info.GetType())
+	require.Equal(t, offline.GetPubKey(), info.GetPubKey())
+
+	// migrated keys are skipped
+	mockIn.Reset("")
+	require.NoError(t, runMigrateCmd(cmd, nil))
+
+	// the legacy keybase is left unchanged
+	l, err := legacyKb.List()
+	require.NoError(t, err)
+	require.Len(t, l, 2)
+}
+
+func Test_runMigrateCmdEncryptedKeyring(t *testing.T) {
+	cmd := migrateCommand()
+	mockIn, _, _ := tests.ApplyMockIO(cmd)
+
+	kbHome, cleanUp := tests.NewTestCaseDir(t)
+	defer cleanUp()
+	viper.Set(flags.FlagHome, kbHome)
+	viper.Set(flags.FlagKeyringBackend, keys.BackendFile)
+	defer viper.Set(flags.FlagKeyringBackend, flags.DefaultKeyringBackend)
+
+	legacyKb, err := NewLegacyKeyBaseFromDir(kbHome)
+	require.NoError(t, err)
+	_, err = legacyKb.CreateAccount("local", tests.TestMnemonic, "", "pass1234", 0, 0, keys.Secp256k1)
+	require.NoError(t, err)
+	legacyKb.CloseDB()
+
+	// the passphrases of the key and of the new keyring are read from the command input
+	mockIn.Reset("pass1234\nkeyring1234\nkeyring1234\n")
+	require.NoError(t, runMigrateCmd(cmd, nil))
+
+	kb, err := newKeyBaseFromHomeFlag(bufio.NewReader(strings.NewReader("keyring1234\n")))
+	require.NoError(t, err)
+	info, err := kb.Get("local")
+	require.NoError(t, err)
+	require.Equal(t, keys.TypeLocal, info.GetType())
+}
@@ -0,0 +1,103 @@
+package keys
+
+import (
+	"bufio"
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/remote"
+)
+
+const (
+	flagListenAddr = "laddr"
+
+	// defaultRemoteSignerSocket is the socket the signing daemon listens on
+	// by default, in the client's home directory.
+	defaultRemoteSignerSocket = "remote-signer.sock"
+)
+
+func remoteSignerCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remote-signer <name>...",
+		Short: "Run a signing daemon holding the given local keys",
+		Long: `Run a signing daemon holding the given locally stored keys, so that clients
+holding a reference to them can sign transactions without access to the private
+keys. References are created with 'keys add <name> --remote <endpoint>'.
+
+The keys are decrypted when the daemon starts and kept in memory until it is
+stopped. The daemon listens on --laddr, which is either a Unix socket
+(unix://<path>, by default in the home directory) or an HTTP address
+(http://<host>:<port>). Clients are not authenticated: the daemon must only be
+reachable by trusted clients.
+`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: runRemoteSignerCmd,
+	}
+
+	cmd.Flags().String(flagListenAddr, "", "The endpoint to listen on (unix://<path>|http://<host>:<port>)")
+	return cmd
+}
+
+func runRemoteSignerCmd(cmd *cobra.Command, args []string) error {
+	buf := bufio.NewReader(cmd.InOrStdin())
+	kb, err := newKeyBaseFromHomeFlag(buf)
+	if err != nil {
+		return err
+	}
+
+	signer, err := newRemoteSigner(kb, args, buf)
+	if err != nil {
+		return err
+	}
+
+	endpoint := viper.GetString(flagListenAddr)
+	if endpoint == "" {
+		endpoint = "unix://" + filepath.Join(viper.GetString(flags.FlagHome), defaultRemoteSignerSocket)
+	}
+
+	listener, err := remote.Listen(endpoint)
+	if err != nil {
+		return err
+	}
+	defer listener.Close()
+
+	cmd.PrintErrf("Serving keys %v on %s\n", args, endpoint)
+	return remote.NewServer(signer).Serve(listener)
+}
+
+// newRemoteSigner unlocks the named local keys of a keybase and returns the
+// signer holding them.
+func newRemoteSigner(kb keys.Keybase, names []string, buf *bufio.Reader) (*remote.PrivKeySigner, error) {
+	privKeys := make(map[string]crypto.PrivKey, len(names))
+	for _, name := range names {
+		info, err := kb.Get(name)
+		if err != nil {
+			return nil, err
+		}
+		if info.GetType() != keys.TypeLocal {
+			return nil, fmt.Errorf("%s is not a locally stored key", name)
+		}
+
+		var passphrase string
+		if usesKeyPassphrases() {
+			passphrase, err = input.GetPassword(fmt.Sprintf("Password to unlock '%s':", name), buf)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		privKeys[name], err = kb.ExportPrivateKeyObject(name, passphrase)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return remote.NewPrivKeySigner(privKeys), nil
+}
@@ -0,0 +1,67 @@
+package keys
+
+import (
+	"bufio"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/remote"
+	"github.com/cosmos/cosmos-sdk/tests"
+)
+
+func Test_remoteSigner(t *testing.T) {
+	kbHome, cleanUp := tests.NewTestCaseDir(t)
+	defer cleanUp()
+	viper.Set(flags.FlagHome, kbHome)
+	viper.Set(cli.OutputFlag, OutputFormatText)
+
+	kb, err := NewKeyBaseFromHomeFlag()
+	require.NoError(t, err)
+	local, err := kb.CreateAccount("treasury", tests.TestMnemonic, "", "pass1234", 0, 0, keys.Secp256k1)
+	require.NoError(t, err)
+	_, err = kb.CreateAccount("offline", tests.TestMnemonic, "", "", 0, 1, keys.Secp256k1)
+	require.NoError(t, err)
+
+	// only local keys can be unlocked with their passphrase
+	_, err = newRemoteSigner(kb, []string{"offline"}, bufio.NewReader(strings.NewReader("")))
+	require.Error(t, err)
+	_, err = newRemoteSigner(kb, []string{"treasury"}, bufio.NewReader(strings.NewReader("wrong\n")))
+	require.Error(t, err)
+
+	signer, err := newRemoteSigner(kb, []string{"treasury"}, bufio.NewReader(strings.NewReader("pass1234\n")))
+	require.NoError(t, err)
+
+	daemon := httptest.NewServer(remote.NewServer(signer))
+	defer daemon.Close()
+
+	// add a reference to the key held by the daemon
+	cmd := addKeyCommand()
+	tests.ApplyMockIO(cmd)
+	viper.Set(flagRemote, daemon.URL)
+	viper.Set(flagRemoteKey, "treasury")
+	defer viper.Set(flagRemote, "")
+	defer viper.Set(flagRemoteKey, "")
+	require.NoError(t, runAddCmd(cmd, []string{"treasury-ref"}))
+
+	info, err := kb.Get("treasury-ref")
+	require.NoError(t, err)
+	require.Equal(t, keys.TypeRemote, info.GetType())
+	require.Equal(t, local.GetPubKey(), info.GetPubKey())
+
+	// no passphrase is needed to sign with the reference
+	passphrase, err := GetPassphrase("treasury-ref")
+	require.NoError(t, err)
+	require.Empty(t, passphrase)
+
+	msg := []byte("to be signed")
+	sig, pub, err := kb.Sign("treasury-ref", passphrase, msg)
+	require.NoError(t, err)
+	require.True(t, pub.VerifyBytes(msg, sig))
+}
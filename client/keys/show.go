@@ -14,6 +14,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/crypto"
 	"github.com/cosmos/cosmos-sdk/crypto/keys"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/sr25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -69,6 +71,9 @@ func runShowCmd(cmd *cobra.Command, args []string) (err error) {
 				return err
 			}
 
+			if err := validateMultisigPubKey(keyName, info.GetPubKey()); err != nil {
+				return err
+			}
 			pks[i] = info.GetPubKey()
 		}
 
@@ -137,6 +142,18 @@ func runShowCmd(cmd *cobra.Command, args []string) (err error) {
 	return nil
 }
 
+// validateMultisigPubKey returns an error if the key can not be part of a
+// multisig key.
+func validateMultisigPubKey(name string, pub tmcrypto.PubKey) error {
+	switch pub.(type) {
+	case secp256r1.PubKeySecp256r1:
+		return fmt.Errorf("%s is a secp256r1 key, which can not be part of a multisig key", name)
+	case sr25519.PubKeySr25519:
+		return fmt.Errorf("%s is a sr25519 key, which can not be part of a multisig key", name)
+	}
+	return nil
+}
+
 func validateMultisigThreshold(k, nKeys int) error {
 	if k <= 0 {
 		return fmt.Errorf("threshold must be a positive integer")
@@ -2,6 +2,7 @@ package keys
 
 import (
 	"bufio"
+	"fmt"
 
 	"github.com/spf13/cobra"
 
@@ -21,8 +22,12 @@ func updateKeyCommand() *cobra.Command {
 func runUpdateCmd(cmd *cobra.Command, args []string) error {
 	name := args[0]
 
+	if !usesKeyPassphrases() {
+		return fmt.Errorf("keys stored in the %s keyring have no passphrase", keyringBackend())
+	}
+
 	buf := bufio.NewReader(cmd.InOrStdin())
-	kb, err := NewKeyBaseFromHomeFlag()
+	kb, err := newKeyBaseFromHomeFlag(buf)
 	if err != nil {
 		return err
 	}
@@ -3,8 +3,8 @@ package keys
 import (
 	"bufio"
 	"fmt"
-	"os"
 	"path/filepath"
+	"sync"
 
 	"github.com/spf13/viper"
 	"github.com/tendermint/tendermint/libs/cli"
@@ -22,10 +22,23 @@ const (
 
 	// defaultKeyDBName is the client's subdirectory where keys are stored.
 	defaultKeyDBName = "keys"
+
+	// defaultKeyringDirName is the client's subdirectory where the keyring
+	// backends store the keys.
+	defaultKeyringDirName = "keyring"
 )
 
 type bechKeyOutFn func(keyInfo keys.Info) (keys.KeyOutput, error)
 
+var (
+	keybasesMtx sync.Mutex
+
+	// keybases memoizes the keybases opened by NewKeyBaseFromDir per directory
+	// and backend, so that a command opening the keybase several times asks
+	// for the passphrase of an encrypted keyring only once.
+	keybases = make(map[string]keys.Keybase)
+)
+
 // GetKeyInfo returns key info for a given name. An error is returned if the
 // keybase cannot be retrieved or getting the info fails.
 func GetKeyInfo(name string) (keys.Info, error) {
@@ -49,9 +62,10 @@ func GetPassphrase(name string) (string, error) {
 		return passphrase, err
 	}
 
-	// we only need a passphrase for locally stored keys
+	// we only need a passphrase for locally stored keys, unless they are
+	// protected by a keyring
 	// TODO: (ref: #864) address security concerns
-	if keyInfo.GetType() == keys.TypeLocal {
+	if keyInfo.GetType() == keys.TypeLocal && usesKeyPassphrases() {
 		passphrase, err = ReadPassphraseFromStdin(name)
 		if err != nil {
 			return passphrase, err
@@ -64,10 +78,9 @@ func GetPassphrase(name string) (string, error) {
 // ReadPassphraseFromStdin attempts to read a passphrase from STDIN return an
 // error upon failure.
 func ReadPassphraseFromStdin(name string) (string, error) {
-	buf := bufio.NewReader(os.Stdin)
 	prompt := fmt.Sprintf("Password to sign with '%s':", name)
 
-	passphrase, err := input.GetPassword(prompt, buf)
+	passphrase, err := input.GetPassword(prompt, input.Stdin())
 	if err != nil {
 		return passphrase, fmt.Errorf("error reading passphrase: %v", err)
 	}
@@ -81,8 +94,52 @@ func NewKeyBaseFromHomeFlag() (keys.Keybase, error) {
 	return NewKeyBaseFromDir(rootDir)
 }
 
-// NewKeyBaseFromDir initializes a keybase at a particular dir.
+// NewKeyBaseFromDir initializes a keybase at a particular dir, using the
+// backend selected with the keyring backend flag. The passphrase of an
+// encrypted keyring is read from STDIN. The keybase is opened once per dir and
+// backend, and returned again by subsequent calls.
 func NewKeyBaseFromDir(rootDir string) (keys.Keybase, error) {
+	keybasesMtx.Lock()
+	defer keybasesMtx.Unlock()
+
+	id := keyringBackend() + ":" + filepath.Clean(rootDir)
+	if kb, ok := keybases[id]; ok {
+		return kb, nil
+	}
+
+	kb, err := newKeyBaseFromDir(rootDir, input.Stdin())
+	if err != nil {
+		return nil, err
+	}
+	keybases[id] = kb
+	return kb, nil
+}
+
+// newKeyBaseFromHomeFlag initializes a Keybase based on the configuration,
+// reading the passphrase of an encrypted keyring from buf.
+func newKeyBaseFromHomeFlag(buf *bufio.Reader) (keys.Keybase, error) {
+	return newKeyBaseFromDir(viper.GetString(flags.FlagHome), buf)
+}
+
+// newKeyBaseFromDir initializes a keybase at a particular dir, reading the
+// passphrase of an encrypted keyring from buf. Commands pass the reader they
+// read their other inputs from, so that no input is lost in another buffer.
+func newKeyBaseFromDir(rootDir string, buf *bufio.Reader) (keys.Keybase, error) {
+	backend := keyringBackend()
+	if backend == keys.BackendLevelDB {
+		return getLazyKeyBaseFromDir(rootDir)
+	}
+
+	kr, err := keys.NewKeyring(backend, filepath.Join(rootDir, defaultKeyringDirName), keyringPassphrasePrompt(buf))
+	if err != nil {
+		return nil, err
+	}
+	return keys.NewKeyringKeybase(kr), nil
+}
+
+// NewLegacyKeyBaseFromDir initializes the legacy LevelDB keybase at a
+// particular dir, whatever the selected keyring backend.
+func NewLegacyKeyBaseFromDir(rootDir string) (keys.Keybase, error) {
 	return getLazyKeyBaseFromDir(rootDir)
 }
 
@@ -93,6 +150,35 @@ func getLazyKeyBaseFromDir(rootDir string) (keys.Keybase, error) {
 	return keys.New(defaultKeyDBName, filepath.Join(rootDir, "keys")), nil
 }
 
+// keyringBackend returns the backend selected with the keyring backend flag.
+func keyringBackend() string {
+	if backend := viper.GetString(flags.FlagKeyringBackend); backend != "" {
+		return backend
+	}
+	return flags.DefaultKeyringBackend
+}
+
+// usesKeyPassphrases returns true if the selected backend encrypts each
+// private key with its own passphrase.
+func usesKeyPassphrases() bool {
+	return keyringBackend() == keys.BackendLevelDB
+}
+
+// keyringPassphrasePrompt returns a prompt reading the passphrase of an
+// encrypted keyring from buf, asking for a confirmation when the keyring is
+// created.
+func keyringPassphrasePrompt(buf *bufio.Reader) func(create bool) (string, error) {
+	return func(create bool) (string, error) {
+		if create {
+			return input.GetCheckPassword(
+				"Enter a passphrase to encrypt the keyring:",
+				"Repeat the passphrase:", buf)
+		}
+
+		return input.GetPassword("Enter the passphrase of the keyring:", buf)
+	}
+}
+
 func printKeyInfo(keyInfo keys.Info, bechKeyOut bechKeyOutFn) {
 	ko, err := bechKeyOut(keyInfo)
 	if err != nil {
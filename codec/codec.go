@@ -8,6 +8,9 @@ import (
 	amino "github.com/tendermint/go-amino"
 	cryptoamino "github.com/tendermint/tendermint/crypto/encoding/amino"
 	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/sr25519"
 )
 
 // amino codec to marshal/unmarshal
@@ -20,6 +23,8 @@ func New() *Codec {
 // Register the go-crypto to the codec
 func RegisterCrypto(cdc *Codec) {
 	cryptoamino.RegisterAmino(cdc)
+	secp256r1.RegisterAmino(cdc)
+	sr25519.RegisterAmino(cdc)
 }
 
 // RegisterEvidences registers Tendermint evidence types with the provided codec.
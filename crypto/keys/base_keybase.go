@@ -0,0 +1,230 @@
+package keys
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/remote"
+	"github.com/cosmos/cosmos-sdk/types"
+
+	bip39 "github.com/cosmos/go-bip39"
+
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+)
+
+type (
+	// baseKeybase is an auxiliary type that groups the Keybase features
+	// which do not depend on how the keys are stored.
+	baseKeybase struct {
+		// ignorePassphrases is true if the storage does not encrypt the private
+		// keys with the passphrases of the keys, in which case a private key is
+		// stored even if no passphrase is given.
+		ignorePassphrases bool
+	}
+
+	// keyWriter defines the storage operations baseKeybase relies on.
+	keyWriter interface {
+		writeLocalKey(name string, priv tmcrypto.PrivKey, passphrase string) (Info, error)
+		writeInfo(name string, info Info) error
+	}
+)
+
+// CreateMnemonic generates a new key, persists it with the given writer and
+// returns the generated mnemonic and the key Info.
+func (kb baseKeybase) CreateMnemonic(kw keyWriter, name string, language Language, passwd string, algo SigningAlgo) (info Info, mnemonic string, err error) {
+	if language != English {
+		return nil, "", ErrUnsupportedLanguage
+	}
+	if !IsSupportedAlgorithm(algo) {
+		err = ErrUnknownSigningAlgo
+		return
+	}
+
+	// default number of words (24):
+	// this generates a mnemonic directly from the number of words by reading system entropy.
+	entropy, err := bip39.NewEntropy(defaultEntropySize)
+	if err != nil {
+		return
+	}
+	mnemonic, err = bip39.NewMnemonic(entropy)
+	if err != nil {
+		return
+	}
+
+	seed := bip39.NewSeed(mnemonic, DefaultBIP39Passphrase)
+	fullFundraiserPath := types.GetConfig().GetFullFundraiserPath()
+	info, err = kb.persistDerivedKey(kw, seed, passwd, name, fullFundraiserPath, algo)
+	return
+}
+
+// CreateAccount converts a mnemonic to a private key of the signing algorithm
+// and persists it with the given writer.
+func (kb baseKeybase) CreateAccount(kw keyWriter, name, mnemonic, bip39Passwd, encryptPasswd string, account uint32, index uint32, algo SigningAlgo) (Info, error) {
+	coinType := types.GetConfig().GetCoinType()
+	hdPath := hd.NewFundraiserParams(account, coinType, index)
+	return kb.Derive(kw, name, mnemonic, bip39Passwd, encryptPasswd, *hdPath, algo)
+}
+
+// Derive derives a private key of the signing algorithm from a mnemonic and
+// the BIP44 params and persists it with the given writer.
+func (kb baseKeybase) Derive(kw keyWriter, name, mnemonic, bip39Passphrase, encryptPasswd string, params hd.BIP44Params, algo SigningAlgo) (info Info, err error) {
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, bip39Passphrase)
+	if err != nil {
+		return
+	}
+
+	info, err = kb.persistDerivedKey(kw, seed, encryptPasswd, name, params.String(), algo)
+	return
+}
+
+// CreateLedger creates a new reference to a Ledger keypair and persists it
+// with the given writer. It returns an error if the Ledger could not be queried.
+func (kb baseKeybase) CreateLedger(kw keyWriter, name string, algo SigningAlgo, hrp string, account, index uint32) (Info, error) {
+	if algo != Secp256k1 {
+		return nil, ErrUnsupportedSigningAlgo
+	}
+
+	coinType := types.GetConfig().GetCoinType()
+	hdPath := hd.NewFundraiserParams(account, coinType, index)
+	priv, _, err := crypto.NewPrivKeyLedgerSecp256k1(*hdPath, hrp)
+	if err != nil {
+		return nil, err
+	}
+	pub := priv.PubKey()
+
+	// Note: Once Cosmos App v1.3.1 is compulsory, it could be possible to check that pubkey and addr match
+	return kb.writeLedgerKey(kw, name, pub, *hdPath)
+}
+
+// CreateRemote creates a new reference to a key held by a signing daemon and
+// persists it with the given writer. It returns an error if the daemon could
+// not be queried.
+func (kb baseKeybase) CreateRemote(kw keyWriter, name, endpoint, remoteName string) (Info, error) {
+	client, err := remote.NewClient(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	pub, err := client.PubKey(remoteName)
+	if err != nil {
+		return nil, err
+	}
+
+	info := newRemoteInfo(name, pub, endpoint)
+	if err := kw.writeInfo(name, info); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+func (kb baseKeybase) persistDerivedKey(kw keyWriter, seed []byte, passwd, name, fullHdPath string, algo SigningAlgo) (info Info, err error) {
+	// create master key and derive first key:
+	derivedPriv, err := deriveKey(algo, seed, fullHdPath)
+	if err != nil {
+		return
+	}
+
+	// if we have a password, use it to encrypt the private key and store it
+	// else store the public key only, unless the storage protects the key
+	if passwd != "" || kb.ignorePassphrases {
+		return kw.writeLocalKey(name, derivedPriv, passwd)
+	}
+
+	return kb.writeOfflineKey(kw, name, derivedPriv.PubKey())
+}
+
+func (kb baseKeybase) writeLedgerKey(kw keyWriter, name string, pub tmcrypto.PubKey, path hd.BIP44Params) (Info, error) {
+	info := newLedgerInfo(name, pub, path)
+	if err := kw.writeInfo(name, info); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+func (kb baseKeybase) writeOfflineKey(kw keyWriter, name string, pub tmcrypto.PubKey) (Info, error) {
+	info := newOfflineInfo(name, pub)
+	if err := kw.writeInfo(name, info); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+func (kb baseKeybase) writeMultisigKey(kw keyWriter, name string, pub tmcrypto.PubKey) (Info, error) {
+	info := NewMultiInfo(name, pub)
+	if err := kw.writeInfo(name, info); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// SignWithLedger signs a message with the Ledger device the key refers to.
+func (kb baseKeybase) SignWithLedger(info Info, msg []byte) (sig []byte, pub tmcrypto.PubKey, err error) {
+	i, ok := info.(ledgerInfo)
+	if !ok {
+		return nil, nil, errors.New("not a ledger object")
+	}
+
+	priv, err := crypto.NewPrivKeyLedgerSecp256k1Unsafe(i.Path)
+	if err != nil {
+		return
+	}
+
+	sig, err = priv.Sign(msg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return sig, priv.PubKey(), nil
+}
+
+// SignWithRemote signs a message with the signing daemon holding the key.
+func (kb baseKeybase) SignWithRemote(info Info, msg []byte) (sig []byte, pub tmcrypto.PubKey, err error) {
+	i, ok := info.(remoteInfo)
+	if !ok {
+		return nil, nil, errors.New("not a remote object")
+	}
+
+	client, err := remote.NewClient(i.Endpoint)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sig, err = client.Sign(i.PubKey, msg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return sig, i.PubKey, nil
+}
+
+// SignOffline prints the message to sign and reads its signature from the
+// standard input, for the keys whose private key is not available.
+func (kb baseKeybase) SignOffline(info Info, msg []byte) (sig []byte, pub tmcrypto.PubKey, err error) {
+	_, err = fmt.Fprintf(os.Stderr, "Message to sign:\n\n%s\n", msg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	buf := input.Stdin()
+	_, err = fmt.Fprintf(os.Stderr, "\nEnter Amino-encoded signature:\n")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Will block until user inputs the signature
+	signed, err := buf.ReadString('\n')
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := cdc.UnmarshalBinaryLengthPrefixed([]byte(signed), sig); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to decode signature")
+	}
+
+	return sig, info.GetPubKey(), nil
+}
@@ -173,8 +173,33 @@ func ComputeMastersFromSeed(seed []byte) (secret [32]byte, chainCode [32]byte) {
 // using the given chainCode.
 func DerivePrivateKeyForPath(privKeyBytes [32]byte, chainCode [32]byte, path string) ([32]byte, error) {
 	data := privKeyBytes
+	components, err := parsePath(path)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	for _, c := range components {
+		data, chainCode = derivePrivateKey(data, chainCode, c.index, c.harden)
+	}
+	var derivedKey [32]byte
+	n := copy(derivedKey[:], data[:])
+	if n != 32 || len(data) != 32 {
+		return [32]byte{}, fmt.Errorf("expected a (secp256k1) key of length 32, got length: %v", len(data))
+	}
+
+	return derivedKey, nil
+}
+
+// pathComponent is a level of a BIP 32 path
+type pathComponent struct {
+	index  uint32
+	harden bool
+}
+
+// parsePath returns the levels of a BIP 32 path.
+func parsePath(path string) ([]pathComponent, error) {
 	parts := strings.Split(path, "/")
-	for _, part := range parts {
+	components := make([]pathComponent, len(parts))
+	for i, part := range parts {
 		// do we have an apostrophe?
 		harden := part[len(part)-1:] == "'"
 		// harden == private derivation, else public derivation:
@@ -183,20 +208,14 @@ func DerivePrivateKeyForPath(privKeyBytes [32]byte, chainCode [32]byte, path str
 		}
 		idx, err := strconv.Atoi(part)
 		if err != nil {
-			return [32]byte{}, fmt.Errorf("invalid BIP 32 path: %s", err)
+			return nil, fmt.Errorf("invalid BIP 32 path: %s", err)
 		}
 		if idx < 0 {
-			return [32]byte{}, errors.New("invalid BIP 32 path: index negative ot too large")
+			return nil, errors.New("invalid BIP 32 path: index negative ot too large")
 		}
-		data, chainCode = derivePrivateKey(data, chainCode, uint32(idx), harden)
-	}
-	var derivedKey [32]byte
-	n := copy(derivedKey[:], data[:])
-	if n != 32 || len(data) != 32 {
-		return [32]byte{}, fmt.Errorf("expected a (secp256k1) key of length 32, got length: %v", len(data))
+		components[i] = pathComponent{uint32(idx), harden}
 	}
-
-	return derivedKey, nil
+	return components, nil
 }
 
 // derivePrivateKey derives the private key with index and chainCode.
@@ -0,0 +1,123 @@
+package hd
+
+import (
+	"crypto/elliptic"
+	"fmt"
+	"math/big"
+)
+
+// SLIP-0010 generalizes the BIP 32 derivation to other curves than secp256k1:
+//  https://github.com/satoshilabs/slips/blob/master/slip-0010.md
+
+const (
+	ed25519Seed   = "ed25519 seed"
+	nist256p1Seed = "Nist256p1 seed"
+	sr25519Seed   = "sr25519 seed"
+)
+
+// DeriveEd25519PrivateKeyForPath derives the ed25519 private key (seed) of a
+// BIP 32 path from a BIP 39 seed, following SLIP-0010. SLIP-0010 only defines
+// hardened derivation for ed25519, so every level of the path is hardened:
+// 44'/118'/0'/0/0 derives the same key as 44'/118'/0'/0'/0'.
+func DeriveEd25519PrivateKeyForPath(seed []byte, path string) ([32]byte, error) {
+	return deriveHardenedPrivateKeyForPath(ed25519Seed, seed, path)
+}
+
+// DeriveSr25519PrivateKeyForPath derives the sr25519 private key (mini secret
+// key) of a BIP 32 path from a BIP 39 seed. SLIP-0010 does not define sr25519,
+// so the ed25519 derivation is used with the "sr25519 seed" master key, which
+// keeps the sr25519 and ed25519 keys of a seed apart. Every level of the path
+// is hardened.
+func DeriveSr25519PrivateKeyForPath(seed []byte, path string) ([32]byte, error) {
+	return deriveHardenedPrivateKeyForPath(sr25519Seed, seed, path)
+}
+
+// deriveHardenedPrivateKeyForPath derives the private key of a path with the
+// SLIP-0010 ed25519 derivation, from the master key of curveSeed.
+func deriveHardenedPrivateKeyForPath(curveSeed string, seed []byte, path string) ([32]byte, error) {
+	components, err := parsePath(path)
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	key, chainCode := i64([]byte(curveSeed), seed)
+	for _, c := range components {
+		data := append([]byte{0}, key[:]...)
+		data = append(data, uint32ToBytes(c.index|0x80000000)...)
+		key, chainCode = i64(chainCode[:], data)
+	}
+
+	return key, nil
+}
+
+// DeriveNist256p1PrivateKeyForPath derives the NIST P-256 (secp256r1) private
+// key of a BIP 32 path from a BIP 39 seed, following SLIP-0010.
+func DeriveNist256p1PrivateKeyForPath(seed []byte, path string) ([32]byte, error) {
+	components, err := parsePath(path)
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	curve := elliptic.P256()
+	n := curve.Params().N
+
+	// the master key is rehashed until it is a valid scalar
+	key, chainCode := i64([]byte(nist256p1Seed), seed)
+	for !isValidScalar(key[:], n) {
+		I := append(key[:], chainCode[:]...)
+		key, chainCode = i64([]byte(nist256p1Seed), I)
+	}
+
+	for _, c := range components {
+		index := c.index
+		var data []byte
+		if c.harden {
+			index |= 0x80000000
+			data = append([]byte{0}, key[:]...)
+		} else {
+			x, y := curve.ScalarBaseMult(key[:])
+			data = compressPoint(x, y)
+		}
+		data = append(data, uint32ToBytes(index)...)
+
+		// the child is derived from the next data if it is invalid
+		for {
+			il, ir := i64(chainCode[:], data)
+			child := new(big.Int).SetBytes(il[:])
+			if child.Cmp(n) < 0 {
+				child.Add(child, new(big.Int).SetBytes(key[:]))
+				child.Mod(child, n)
+				if child.Sign() != 0 {
+					key = [32]byte{}
+					b := child.Bytes()
+					copy(key[32-len(b):], b)
+					chainCode = ir
+					break
+				}
+			}
+
+			data = append([]byte{1}, ir[:]...)
+			data = append(data, uint32ToBytes(index)...)
+		}
+	}
+
+	if !isValidScalar(key[:], n) {
+		return [32]byte{}, fmt.Errorf("invalid nist256p1 key derived for path %s", path)
+	}
+	return key, nil
+}
+
+// isValidScalar returns true if the big endian k is in [1, n-1]
+func isValidScalar(k []byte, n *big.Int) bool {
+	i := new(big.Int).SetBytes(k)
+	return i.Sign() > 0 && i.Cmp(n) < 0
+}
+
+// compressPoint returns the 33 bytes compressed form of a curve point
+func compressPoint(x, y *big.Int) []byte {
+	compressed := make([]byte, 33)
+	compressed[0] = 0x02 + byte(y.Bit(0))
+	b := x.Bytes()
+	copy(compressed[33-len(b):], b)
+	return compressed
+}
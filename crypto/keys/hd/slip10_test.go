@@ -0,0 +1,70 @@
+package hd
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// test vector 1 of SLIP-0010
+const slip10Seed = "000102030405060708090a0b0c0d0e0f"
+
+func TestDeriveEd25519PrivateKeyForPath(t *testing.T) {
+	seed, _ := hex.DecodeString(slip10Seed)
+
+	key, err := DeriveEd25519PrivateKeyForPath(seed, "0'")
+	require.NoError(t, err)
+	require.Equal(t, "68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3", hex.EncodeToString(key[:]))
+
+	key, err = DeriveEd25519PrivateKeyForPath(seed, "0'/1'/2'/2'/1000000000'")
+	require.NoError(t, err)
+	require.Equal(t, "8f94d394a8e8fd6b1bc2f3f49f5c47e385281d5c17e65324b0f62483e37e8793", hex.EncodeToString(key[:]))
+
+	// every level is hardened
+	unhardened, err := DeriveEd25519PrivateKeyForPath(seed, "0/1/2/2/1000000000")
+	require.NoError(t, err)
+	require.Equal(t, key, unhardened)
+
+	_, err = DeriveEd25519PrivateKeyForPath(seed, "0'/x")
+	require.Error(t, err)
+}
+
+func TestDeriveSr25519PrivateKeyForPath(t *testing.T) {
+	seed, _ := hex.DecodeString(slip10Seed)
+
+	key, err := DeriveSr25519PrivateKeyForPath(seed, "0'/1'/2'/2'/1000000000'")
+	require.NoError(t, err)
+
+	// the sr25519 and ed25519 keys of a seed differ
+	ed25519Key, err := DeriveEd25519PrivateKeyForPath(seed, "0'/1'/2'/2'/1000000000'")
+	require.NoError(t, err)
+	require.NotEqual(t, ed25519Key, key)
+
+	// every level is hardened
+	unhardened, err := DeriveSr25519PrivateKeyForPath(seed, "0/1/2/2/1000000000")
+	require.NoError(t, err)
+	require.Equal(t, key, unhardened)
+
+	other, err := DeriveSr25519PrivateKeyForPath(seed, "0'/1'/2'/2'/1000000001'")
+	require.NoError(t, err)
+	require.NotEqual(t, key, other)
+
+	_, err = DeriveSr25519PrivateKeyForPath(seed, "0'/x")
+	require.Error(t, err)
+}
+
+func TestDeriveNist256p1PrivateKeyForPath(t *testing.T) {
+	seed, _ := hex.DecodeString(slip10Seed)
+
+	key, err := DeriveNist256p1PrivateKeyForPath(seed, "0'")
+	require.NoError(t, err)
+	require.Equal(t, "6939694369114c67917a182c59ddb8cafc3004e63ca5d3b84403ba8613debc0c", hex.EncodeToString(key[:]))
+
+	key, err = DeriveNist256p1PrivateKeyForPath(seed, "0'/1/2'/2/1000000000")
+	require.NoError(t, err)
+	require.Equal(t, "21c4f269ef0a5fd1badf47eeacebeeaa3de22eb8e5b0adcd0f27dd99d34d0119", hex.EncodeToString(key[:]))
+
+	_, err = DeriveNist256p1PrivateKeyForPath(seed, "0'/x")
+	require.Error(t, err)
+}
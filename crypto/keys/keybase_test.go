@@ -2,6 +2,7 @@ package keys
 
 import (
 	"fmt"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -9,9 +10,14 @@ import (
 
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/mintkey"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/remote"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/sr25519"
+	"github.com/cosmos/cosmos-sdk/tests"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -36,7 +42,7 @@ func TestCreateAccountInvalidMnemonic(t *testing.T) {
 	_, err := kb.CreateAccount(
 		"some_account",
 		"malarkey pair crucial catch public canyon evil outer stage ten gym tornado",
-		"", "", 0, 1)
+		"", "", 0, 1, Secp256k1)
 	assert.Error(t, err)
 	assert.Equal(t, "Invalid mnemonic", err.Error())
 }
@@ -101,8 +107,8 @@ func TestKeyManagement(t *testing.T) {
 	require.Nil(t, err)
 	assert.Empty(t, l)
 
-	_, _, err = cstore.CreateMnemonic(n1, English, p1, Ed25519)
-	require.Error(t, err, "ed25519 keys are currently not supported by keybase")
+	_, _, err = cstore.CreateMnemonic(n1, English, p1, SigningAlgo("unknown"))
+	require.Error(t, err, "unknown keys are not supported by keybase")
 
 	// create some keys
 	_, err = cstore.Get(n1)
@@ -391,13 +397,60 @@ func TestSeedPhrase(t *testing.T) {
 
 	// let us re-create it from the mnemonic-phrase
 	params := *hd.NewFundraiserParams(0, sdk.CoinType, 0)
-	newInfo, err := cstore.Derive(n2, mnemonic, DefaultBIP39Passphrase, p2, params)
+	newInfo, err := cstore.Derive(n2, mnemonic, DefaultBIP39Passphrase, p2, params, Secp256k1)
 	require.NoError(t, err)
 	require.Equal(t, n2, newInfo.GetName())
 	require.Equal(t, info.GetPubKey().Address(), newInfo.GetPubKey().Address())
 	require.Equal(t, info.GetPubKey(), newInfo.GetPubKey())
 }
 
+func TestSigningAlgos(t *testing.T) {
+	require.Equal(t, []SigningAlgo{Ed25519, Secp256k1, Secp256r1, Sr25519}, SigningAlgos())
+
+	pubKeyTypes := map[SigningAlgo]crypto.PubKey{
+		Secp256k1: secp256k1.PubKeySecp256k1{},
+		Ed25519:   ed25519.PubKeyEd25519{},
+		Secp256r1: secp256r1.PubKeySecp256r1{},
+		Sr25519:   sr25519.PubKeySr25519{},
+	}
+
+	for _, kb := range []Keybase{NewInMemory(), NewKeyringKeybase(NewMemKeyring())} {
+		for _, algo := range SigningAlgos() {
+			name := string(algo)
+			info, mnemonic, err := kb.CreateMnemonic(name, English, nums, algo)
+			require.NoError(t, err)
+			require.IsType(t, pubKeyTypes[algo], info.GetPubKey())
+
+			msg := []byte("to be signed")
+			sig, pub, err := kb.Sign(name, nums, msg)
+			require.NoError(t, err)
+			require.Equal(t, info.GetPubKey(), pub)
+			require.True(t, pub.VerifyBytes(msg, sig))
+
+			// the private key survives an export and an import
+			armor, err := kb.ExportPrivKey(name, nums, foobar)
+			require.NoError(t, err)
+			require.NoError(t, kb.ImportPrivKey(name+"-imported", armor, foobar))
+			imported, err := kb.Get(name + "-imported")
+			require.NoError(t, err)
+			require.Equal(t, info.GetPubKey(), imported.GetPubKey())
+
+			// the key is recovered from the mnemonic with the same algorithm
+			params := *hd.NewFundraiserParams(0, sdk.CoinType, 0)
+			recovered, err := kb.Derive(name+"-recovered", mnemonic, DefaultBIP39Passphrase, nums, params, algo)
+			require.NoError(t, err)
+			require.Equal(t, info.GetPubKey(), recovered.GetPubKey())
+
+			other, err := kb.CreateAccount(name+"-other", mnemonic, DefaultBIP39Passphrase, nums, 0, 1, algo)
+			require.NoError(t, err)
+			require.NotEqual(t, info.GetPubKey(), other.GetPubKey())
+		}
+
+		_, err := kb.CreateAccount("unknown", tests.TestMnemonic, "", nums, 0, 0, SigningAlgo("unknown"))
+		require.Equal(t, ErrUnknownSigningAlgo, err)
+	}
+}
+
 func ExampleNew() {
 	// Select the encryption and storage for your cryptostore
 	cstore := NewInMemory()
@@ -448,6 +501,38 @@ func ExampleNew() {
 	// signed by Bob
 }
 
+func TestRemoteKey(t *testing.T) {
+	priv := secp256k1.GenPrivKey()
+	daemon := httptest.NewServer(remote.NewServer(remote.NewPrivKeySigner(map[string]crypto.PrivKey{"treasury": priv})))
+	defer daemon.Close()
+
+	for _, kb := range []Keybase{NewInMemory(), NewKeyringKeybase(NewMemKeyring())} {
+		_, err := kb.CreateRemote("treasury", daemon.URL, "unknown")
+		require.Error(t, err)
+
+		info, err := kb.CreateRemote("treasury", daemon.URL, "treasury")
+		require.NoError(t, err)
+		require.Equal(t, TypeRemote, info.GetType())
+		require.Equal(t, priv.PubKey(), info.GetPubKey())
+
+		restored, err := kb.Get("treasury")
+		require.NoError(t, err)
+		require.Equal(t, info.GetAddress(), restored.GetAddress())
+
+		// the daemon signs the message
+		msg := []byte("to be signed")
+		sig, pub, err := kb.Sign("treasury", "", msg)
+		require.NoError(t, err)
+		require.Equal(t, priv.PubKey(), pub)
+		require.True(t, pub.VerifyBytes(msg, sig))
+
+		_, err = kb.ExportPrivateKeyObject("treasury", "")
+		require.Error(t, err)
+
+		require.NoError(t, kb.Delete("treasury", "", true))
+	}
+}
+
 func accAddr(info Info) sdk.AccAddress {
 	return (sdk.AccAddress)(info.GetPubKey().Address())
 }
@@ -0,0 +1,475 @@
+package keys
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/keyerror"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/mintkey"
+	"github.com/cosmos/cosmos-sdk/types"
+
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+)
+
+// Names of the keybase backends
+const (
+	// BackendLevelDB is the legacy keybase created by New. It stores the keys
+	// in a LevelDB database and encrypts each private key with its own
+	// passphrase. It is not a keyring backend.
+	BackendLevelDB = "leveldb"
+	// BackendFile stores the keys in a single file encrypted with the
+	// passphrase of the keyring.
+	BackendFile = "file"
+	// BackendTest stores the keys in a single unencrypted file. It must only
+	// be used for testing.
+	BackendTest = "test"
+	// BackendMemory stores the keys in memory.
+	BackendMemory = "memory"
+)
+
+var _ Keybase = keyringKeybase{}
+
+// Keyring is the storage of a keyring keybase. It stores opaque items by key,
+// and is responsible for protecting them, e.g. by encrypting them.
+type Keyring interface {
+	// Get returns the item stored under key, or nil if there is none.
+	Get(key string) ([]byte, error)
+	// Set stores an item under key, replacing the existing one if any.
+	Set(key string, data []byte) error
+	// Remove removes the item stored under key, if any.
+	Remove(key string) error
+	// Keys returns the keys of all the stored items.
+	Keys() ([]string, error)
+}
+
+// PassphrasePrompt returns the passphrase protecting a keyring. The keyring
+// does not exist yet if create is true, in which case the passphrase should
+// be confirmed.
+type PassphrasePrompt func(create bool) (string, error)
+
+// KeyringConstructor creates the keyring of a backend storing its data in the
+// given directory.
+type KeyringConstructor func(dir string, prompt PassphrasePrompt) (Keyring, error)
+
+var (
+	keyringBackendsMtx sync.RWMutex
+	keyringBackends    = map[string]KeyringConstructor{
+		BackendFile: func(dir string, prompt PassphrasePrompt) (Keyring, error) {
+			if prompt == nil {
+				return nil, errors.New("the file keyring backend requires a passphrase prompt")
+			}
+			return NewFileKeyring(dir, prompt), nil
+		},
+		BackendTest: func(dir string, _ PassphrasePrompt) (Keyring, error) {
+			return NewTestKeyring(dir), nil
+		},
+		BackendMemory: func(string, PassphrasePrompt) (Keyring, error) {
+			return NewMemKeyring(), nil
+		},
+	}
+)
+
+// RegisterKeyringBackend registers a keyring backend under a name, so that it
+// can be selected like the built-in ones, e.g. a keyring delegating to an
+// external process. It panics if the name is already taken.
+func RegisterKeyringBackend(name string, constructor KeyringConstructor) {
+	keyringBackendsMtx.Lock()
+	defer keyringBackendsMtx.Unlock()
+
+	if _, ok := keyringBackends[name]; ok || name == BackendLevelDB {
+		panic(fmt.Sprintf("keyring backend %s already registered", name))
+	}
+	keyringBackends[name] = constructor
+}
+
+// KeyringBackends returns the names of the registered keyring backends in
+// alphabetical order.
+func KeyringBackends() []string {
+	keyringBackendsMtx.RLock()
+	defer keyringBackendsMtx.RUnlock()
+
+	names := make([]string, 0, len(keyringBackends))
+	for name := range keyringBackends {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NewKeyring creates the keyring of a registered backend storing its data in
+// the given directory.
+func NewKeyring(backend, dir string, prompt PassphrasePrompt) (Keyring, error) {
+	keyringBackendsMtx.RLock()
+	constructor, ok := keyringBackends[backend]
+	keyringBackendsMtx.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("unknown keyring backend %s, expected one of %s",
+			backend, strings.Join(KeyringBackends(), ", "))
+	}
+	return constructor(dir, prompt)
+}
+
+// keyringKeybase is a Keybase storing the keys in a Keyring. The keyring
+// protects the private keys, which are not encrypted with their own
+// passphrase, hence the passphrases given to a keyringKeybase are ignored.
+type keyringKeybase struct {
+	base baseKeybase
+	db   Keyring
+}
+
+// NewKeyringKeybase creates a new keybase storing the keys in a keyring.
+func NewKeyringKeybase(kr Keyring) Keybase {
+	return keyringKeybase{
+		base: baseKeybase{ignorePassphrases: true},
+		db:   kr,
+	}
+}
+
+// CreateMnemonic generates a new key and persists it to the keyring. It
+// returns the generated mnemonic and the key Info.
+func (kb keyringKeybase) CreateMnemonic(name string, language Language, passwd string, algo SigningAlgo) (info Info, mnemonic string, err error) {
+	return kb.base.CreateMnemonic(kb, name, language, passwd, algo)
+}
+
+// CreateAccount converts a mnemonic to a private key and persists it to the
+// keyring.
+func (kb keyringKeybase) CreateAccount(name, mnemonic, bip39Passwd, encryptPasswd string, account uint32, index uint32, algo SigningAlgo) (Info, error) {
+	return kb.base.CreateAccount(kb, name, mnemonic, bip39Passwd, encryptPasswd, account, index, algo)
+}
+
+// Derive derives a private key from a mnemonic and the BIP44 params and
+// persists it to the keyring.
+func (kb keyringKeybase) Derive(name, mnemonic, bip39Passphrase, encryptPasswd string, params hd.BIP44Params, algo SigningAlgo) (Info, error) {
+	return kb.base.Derive(kb, name, mnemonic, bip39Passphrase, encryptPasswd, params, algo)
+}
+
+// CreateLedger creates a new reference to a Ledger keypair in the keyring.
+func (kb keyringKeybase) CreateLedger(name string, algo SigningAlgo, hrp string, account, index uint32) (Info, error) {
+	return kb.base.CreateLedger(kb, name, algo, hrp, account, index)
+}
+
+// CreateOffline creates a new reference to an offline keypair in the keyring.
+func (kb keyringKeybase) CreateOffline(name string, pub tmcrypto.PubKey) (Info, error) {
+	return kb.base.writeOfflineKey(kb, name, pub)
+}
+
+// CreateMulti creates a new reference to a multisig (offline) keypair in the
+// keyring.
+func (kb keyringKeybase) CreateMulti(name string, pub tmcrypto.PubKey) (Info, error) {
+	return kb.base.writeMultisigKey(kb, name, pub)
+}
+
+// CreateRemote creates a new reference to a key held by a signing daemon. It
+// returns the created key info and an error if the daemon could not be queried.
+func (kb keyringKeybase) CreateRemote(name, endpoint, remoteName string) (Info, error) {
+	return kb.base.CreateRemote(kb, name, endpoint, remoteName)
+}
+
+// List returns the keys from the keyring in alphabetical order.
+func (kb keyringKeybase) List() ([]Info, error) {
+	keys, err := kb.db.Keys()
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(keys)
+
+	var res []Info
+	for _, key := range keys {
+		// need to include only keys in storage that have an info suffix
+		if !strings.HasSuffix(key, infoSuffix) {
+			continue
+		}
+
+		bz, err := kb.db.Get(key)
+		if err != nil {
+			return nil, err
+		}
+		info, err := readInfo(bz)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, info)
+	}
+	return res, nil
+}
+
+// Get returns the public information about one key.
+func (kb keyringKeybase) Get(name string) (Info, error) {
+	bz, err := kb.db.Get(string(infoKey(name)))
+	if err != nil {
+		return nil, err
+	}
+	if len(bz) == 0 {
+		return nil, keyerror.NewErrKeyNotFound(name)
+	}
+	return readInfo(bz)
+}
+
+// GetByAddress returns the public information about the key of an address.
+func (kb keyringKeybase) GetByAddress(address types.AccAddress) (Info, error) {
+	ik, err := kb.db.Get(string(addrKey(address)))
+	if err != nil {
+		return nil, err
+	}
+	if len(ik) == 0 {
+		return nil, fmt.Errorf("key with address %s not found", address)
+	}
+
+	bz, err := kb.db.Get(string(ik))
+	if err != nil {
+		return nil, err
+	}
+	return readInfo(bz)
+}
+
+// Sign signs the msg with the named key. The passphrase is ignored.
+func (kb keyringKeybase) Sign(name, _ string, msg []byte) (sig []byte, pub tmcrypto.PubKey, err error) {
+	info, err := kb.Get(name)
+	if err != nil {
+		return
+	}
+
+	var priv tmcrypto.PrivKey
+
+	switch i := info.(type) {
+	case localInfo:
+		priv, err = localPrivKey(i)
+		if err != nil {
+			return nil, nil, err
+		}
+
+	case ledgerInfo:
+		return kb.base.SignWithLedger(info, msg)
+
+	case remoteInfo:
+		return kb.base.SignWithRemote(info, msg)
+
+	case offlineInfo, multiInfo:
+		return kb.base.SignOffline(info, msg)
+	}
+
+	sig, err = priv.Sign(msg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return sig, priv.PubKey(), nil
+}
+
+// ExportPrivateKeyObject returns the private key of a local key. The
+// passphrase is ignored.
+func (kb keyringKeybase) ExportPrivateKeyObject(name string, _ string) (tmcrypto.PrivKey, error) {
+	info, err := kb.Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	linfo, ok := info.(localInfo)
+	if !ok {
+		return nil, errors.New("only works on local private keys")
+	}
+	return localPrivKey(linfo)
+}
+
+// Export returns the public information about a key in ASCII armored format.
+// Local keys can only be exported with ExportPrivKey, as the keyring does not
+// encrypt their private key with a passphrase.
+func (kb keyringKeybase) Export(name string) (armor string, err error) {
+	bz, err := kb.db.Get(string(infoKey(name)))
+	if err != nil {
+		return "", err
+	}
+	if bz == nil {
+		return "", fmt.Errorf("no key to export with name %s", name)
+	}
+
+	info, err := readInfo(bz)
+	if err != nil {
+		return "", err
+	}
+	if info.GetType() == TypeLocal {
+		return "", errors.New("local keys can only be exported with their private key")
+	}
+	return mintkey.ArmorInfoBytes(bz), nil
+}
+
+// ExportPubKey returns public keys in ASCII armored format.
+func (kb keyringKeybase) ExportPubKey(name string) (armor string, err error) {
+	info, err := kb.Get(name)
+	if err != nil {
+		return "", err
+	}
+	return mintkey.ArmorPubKeyBytes(info.GetPubKey().Bytes()), nil
+}
+
+// ExportPrivKey returns a private key in ASCII armored format, encrypted with
+// encryptPassphrase. The decryption passphrase is ignored.
+func (kb keyringKeybase) ExportPrivKey(name, _, encryptPassphrase string) (armor string, err error) {
+	priv, err := kb.ExportPrivateKeyObject(name, "")
+	if err != nil {
+		return "", err
+	}
+
+	return mintkey.EncryptArmorPrivKey(priv, encryptPassphrase), nil
+}
+
+// Import imports the ASCII armored public information about a key. Local keys
+// can only be imported with ImportPrivKey.
+func (kb keyringKeybase) Import(name string, armor string) error {
+	if _, err := kb.Get(name); err == nil {
+		return errors.New("Cannot overwrite data for name " + name)
+	}
+
+	infoBytes, err := mintkey.UnarmorInfoBytes(armor)
+	if err != nil {
+		return err
+	}
+	info, err := readInfo(infoBytes)
+	if err != nil {
+		return err
+	}
+	if info.GetType() == TypeLocal {
+		return errors.New("local keys can only be imported with their private key")
+	}
+
+	return kb.writeInfo(name, info)
+}
+
+// ImportPrivKey imports a private key in ASCII armor format, decrypting it
+// with passphrase.
+func (kb keyringKeybase) ImportPrivKey(name string, armor string, passphrase string) error {
+	if _, err := kb.Get(name); err == nil {
+		return errors.New("Cannot overwrite key " + name)
+	}
+
+	privKey, err := mintkey.UnarmorDecryptPrivKey(armor, passphrase)
+	if err != nil {
+		return errors.Wrap(err, "couldn't import private key")
+	}
+
+	_, err = kb.writeLocalKey(name, privKey, "")
+	return err
+}
+
+// ImportPubKey imports ASCII-armored public keys.
+func (kb keyringKeybase) ImportPubKey(name string, armor string) error {
+	if _, err := kb.Get(name); err == nil {
+		return errors.New("Cannot overwrite data for name " + name)
+	}
+
+	pubBytes, err := mintkey.UnarmorPubKeyBytes(armor)
+	if err != nil {
+		return err
+	}
+	var pubKey tmcrypto.PubKey
+	err = cdc.UnmarshalBinaryBare(pubBytes, &pubKey)
+	if err != nil {
+		return err
+	}
+
+	_, err = kb.base.writeOfflineKey(kb, name, pubKey)
+	return err
+}
+
+// Delete removes a key from the keyring. The passphrase is ignored.
+func (kb keyringKeybase) Delete(name, _ string, _ bool) error {
+	info, err := kb.Get(name)
+	if err != nil {
+		return err
+	}
+
+	if err := kb.db.Remove(string(addrKey(info.GetAddress()))); err != nil {
+		return err
+	}
+	return kb.db.Remove(string(infoKey(name)))
+}
+
+// Update always fails, as the keys stored in a keyring are not encrypted with
+// their own passphrase.
+func (kb keyringKeybase) Update(name, _ string, _ func() (string, error)) error {
+	return fmt.Errorf("cannot update the passphrase of %s: keyring keys have no passphrase", name)
+}
+
+// CloseDB is a no-op, as the keyring holds no resources between operations.
+func (kb keyringKeybase) CloseDB() {}
+
+func (kb keyringKeybase) writeLocalKey(name string, priv tmcrypto.PrivKey, _ string) (Info, error) {
+	// the keyring protects the private key, which is stored in binary format
+	info := newLocalInfo(name, priv.PubKey(), string(priv.Bytes()))
+	if err := kb.writeInfo(name, info); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+func (kb keyringKeybase) writeInfo(name string, info Info) error {
+	// write the info by key
+	key := infoKey(name)
+	if err := kb.db.Set(string(key), writeInfo(info)); err != nil {
+		return err
+	}
+	// store a pointer to the infokey by address for fast lookup
+	return kb.db.Set(string(addrKey(info.GetAddress())), key)
+}
+
+// localPrivKey decodes the private key of a local key stored in a keyring.
+func localPrivKey(info localInfo) (tmcrypto.PrivKey, error) {
+	if info.PrivKeyArmor == "" {
+		return nil, fmt.Errorf("private key not available")
+	}
+	var priv tmcrypto.PrivKey
+	err := cdc.UnmarshalBinaryBare([]byte(info.PrivKeyArmor), &priv)
+	return priv, err
+}
+
+// memKeyring is a Keyring storing the items in memory.
+type memKeyring struct {
+	mtx   sync.RWMutex
+	items map[string][]byte
+}
+
+// NewMemKeyring creates a transient keyring on top of in-memory storage,
+// useful for testing purposes and on-the-fly key generation.
+func NewMemKeyring() Keyring {
+	return &memKeyring{items: make(map[string][]byte)}
+}
+
+func (kr *memKeyring) Get(key string) ([]byte, error) {
+	kr.mtx.RLock()
+	defer kr.mtx.RUnlock()
+
+	return kr.items[key], nil
+}
+
+func (kr *memKeyring) Set(key string, data []byte) error {
+	kr.mtx.Lock()
+	defer kr.mtx.Unlock()
+
+	kr.items[key] = data
+	return nil
+}
+
+func (kr *memKeyring) Remove(key string) error {
+	kr.mtx.Lock()
+	defer kr.mtx.Unlock()
+
+	delete(kr.items, key)
+	return nil
+}
+
+func (kr *memKeyring) Keys() ([]string, error) {
+	kr.mtx.RLock()
+	defer kr.mtx.RUnlock()
+
+	keys := make([]string, 0, len(kr.items))
+	for key := range kr.items {
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
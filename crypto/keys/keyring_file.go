@@ -0,0 +1,223 @@
+package keys
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/tendermint/crypto/bcrypt"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/armor"
+	"github.com/tendermint/tendermint/crypto/xsalsa20symmetric"
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/mintkey"
+)
+
+const (
+	blockTypeKeyring = "TENDERMINT KEYRING"
+
+	fileKeyringName = "file.keyring"
+	testKeyringName = "test.keyring"
+)
+
+// fileKeyring is a Keyring storing all the items in a single ASCII armored
+// file, so that the keyring can be copied or kept in a secrets manager. The
+// file is encrypted with the passphrase of the keyring, unless the keyring is
+// a test keyring.
+type fileKeyring struct {
+	mtx  sync.Mutex
+	path string
+
+	// prompt returns the passphrase of the keyring. It is nil if the file is
+	// not encrypted.
+	prompt PassphrasePrompt
+
+	// salt and key cache the encryption key derived from the passphrase.
+	salt []byte
+	key  []byte
+}
+
+// NewFileKeyring creates a keyring stored in a file of the given directory,
+// encrypted with the passphrase returned by prompt. The passphrase is asked
+// for on the first access to the keyring.
+func NewFileKeyring(dir string, prompt PassphrasePrompt) Keyring {
+	return &fileKeyring{
+		path:   filepath.Join(dir, fileKeyringName),
+		prompt: prompt,
+	}
+}
+
+// NewTestKeyring creates a keyring stored unencrypted in a file of the given
+// directory. It must only be used for testing.
+func NewTestKeyring(dir string) Keyring {
+	return &fileKeyring{
+		path: filepath.Join(dir, testKeyringName),
+	}
+}
+
+func (kr *fileKeyring) Get(key string) ([]byte, error) {
+	kr.mtx.Lock()
+	defer kr.mtx.Unlock()
+
+	items, err := kr.read()
+	if err != nil {
+		return nil, err
+	}
+	return items[key], nil
+}
+
+func (kr *fileKeyring) Set(key string, data []byte) error {
+	kr.mtx.Lock()
+	defer kr.mtx.Unlock()
+
+	items, err := kr.read()
+	if err != nil {
+		return err
+	}
+	items[key] = data
+	return kr.write(items)
+}
+
+func (kr *fileKeyring) Remove(key string) error {
+	kr.mtx.Lock()
+	defer kr.mtx.Unlock()
+
+	items, err := kr.read()
+	if err != nil {
+		return err
+	}
+	if _, ok := items[key]; !ok {
+		return nil
+	}
+	delete(items, key)
+	return kr.write(items)
+}
+
+func (kr *fileKeyring) Keys() ([]string, error) {
+	kr.mtx.Lock()
+	defer kr.mtx.Unlock()
+
+	items, err := kr.read()
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(items))
+	for key := range items {
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// read returns the items stored in the file, which are empty if the file
+// does not exist yet.
+func (kr *fileKeyring) read() (map[string][]byte, error) {
+	items := make(map[string][]byte)
+
+	bz, err := ioutil.ReadFile(kr.path)
+	if os.IsNotExist(err) {
+		return items, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	blockType, header, data, err := armor.DecodeArmor(string(bz))
+	if err != nil {
+		return nil, err
+	}
+	if blockType != blockTypeKeyring {
+		return nil, fmt.Errorf("unrecognized keyring armor type %q in %s", blockType, kr.path)
+	}
+
+	if kr.prompt != nil {
+		if header["kdf"] != "bcrypt" {
+			return nil, fmt.Errorf("unrecognized keyring KDF %q in %s", header["kdf"], kr.path)
+		}
+		salt, err := hex.DecodeString(header["salt"])
+		if err != nil {
+			return nil, fmt.Errorf("invalid keyring salt in %s: %v", kr.path, err)
+		}
+
+		key, err := kr.deriveKey(salt, false)
+		if err != nil {
+			return nil, err
+		}
+		data, err = xsalsa20symmetric.DecryptSymmetric(data, key)
+		if err != nil {
+			// forget the key so that the passphrase is asked for again
+			kr.salt, kr.key = nil, nil
+			return nil, errors.New("invalid keyring passphrase")
+		}
+	}
+
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, fmt.Errorf("failed to decode keyring %s: %v", kr.path, err)
+	}
+	return items, nil
+}
+
+// write replaces the file with the given items. The file is first written
+// next to the existing one then renamed, so that it is never left truncated.
+func (kr *fileKeyring) write(items map[string][]byte) error {
+	data, err := json.Marshal(items)
+	if err != nil {
+		return err
+	}
+
+	header := make(map[string]string)
+	if kr.prompt != nil {
+		// a new keyring is encrypted with a new passphrase
+		if kr.key == nil {
+			if _, err := kr.deriveKey(crypto.CRandBytes(16), true); err != nil {
+				return err
+			}
+		}
+
+		header["kdf"] = "bcrypt"
+		header["salt"] = fmt.Sprintf("%X", kr.salt)
+		data = xsalsa20symmetric.EncryptSymmetric(data, kr.key)
+	}
+
+	if err := cmn.EnsureDir(filepath.Dir(kr.path), 0700); err != nil {
+		return err
+	}
+
+	tmpPath := kr.path + ".tmp"
+	armored := armor.EncodeArmor(blockTypeKeyring, header, data)
+	if err := ioutil.WriteFile(tmpPath, []byte(armored), 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, kr.path)
+}
+
+// deriveKey returns the encryption key derived from the passphrase of the
+// keyring and the salt, asking for the passphrase unless the key is cached.
+func (kr *fileKeyring) deriveKey(salt []byte, create bool) ([]byte, error) {
+	if kr.key != nil && bytes.Equal(kr.salt, salt) {
+		return kr.key, nil
+	}
+
+	passphrase, err := kr.prompt(create)
+	if err != nil {
+		return nil, err
+	}
+	if create && passphrase == "" {
+		return nil, errors.New("the keyring passphrase must not be empty")
+	}
+
+	key, err := bcrypt.GenerateFromPassword(salt, []byte(passphrase), mintkey.BcryptSecurityParameter)
+	if err != nil {
+		return nil, err
+	}
+
+	kr.salt, kr.key = salt, crypto.Sha256(key)
+	return kr.key, nil
+}
@@ -0,0 +1,190 @@
+package keys
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto/ed25519"
+)
+
+func newTestKeyringDir(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "keyring_test")
+	require.NoError(t, err)
+	return dir, func() { os.RemoveAll(dir) }
+}
+
+func staticPrompt(passphrase string, calls *int) PassphrasePrompt {
+	return func(bool) (string, error) {
+		*calls++
+		return passphrase, nil
+	}
+}
+
+func TestKeyringKeybaseKeyManagement(t *testing.T) {
+	kb := NewKeyringKeybase(NewMemKeyring())
+
+	l, err := kb.List()
+	require.NoError(t, err)
+	require.Empty(t, l)
+
+	// keys are stored without passphrase
+	i1, _, err := kb.CreateMnemonic("personal", English, "", Secp256k1)
+	require.NoError(t, err)
+	require.Equal(t, TypeLocal, i1.GetType())
+	i2, err := kb.CreateOffline("offline", ed25519.GenPrivKey().PubKey())
+	require.NoError(t, err)
+
+	l, err = kb.List()
+	require.NoError(t, err)
+	require.Len(t, l, 2)
+	require.Equal(t, "offline", l[0].GetName())
+	require.Equal(t, "personal", l[1].GetName())
+
+	i, err := kb.GetByAddress(i1.GetAddress())
+	require.NoError(t, err)
+	require.Equal(t, i1.GetPubKey(), i.GetPubKey())
+
+	// the passphrase is ignored when signing
+	msg := []byte("to be signed")
+	sig, pub, err := kb.Sign("personal", "any", msg)
+	require.NoError(t, err)
+	require.True(t, pub.VerifyBytes(msg, sig))
+
+	require.Error(t, kb.Update("personal", "", func() (string, error) { return "new", nil }))
+	_, err = kb.Export("personal")
+	require.Error(t, err)
+	_, err = kb.Export("offline")
+	require.NoError(t, err)
+
+	require.NoError(t, kb.Delete("personal", "", false))
+	require.NoError(t, kb.Delete("offline", "", false))
+	_, err = kb.Get("personal")
+	require.Error(t, err)
+	_, err = kb.GetByAddress(i2.GetAddress())
+	require.Error(t, err)
+
+	l, err = kb.List()
+	require.NoError(t, err)
+	require.Empty(t, l)
+}
+
+func TestKeyringKeybaseImportExportPrivKey(t *testing.T) {
+	legacy := NewInMemory()
+	info, _, err := legacy.CreateMnemonic("john", English, "secretcpw", Secp256k1)
+	require.NoError(t, err)
+
+	armor, err := legacy.ExportPrivKey("john", "secretcpw", "exportpw")
+	require.NoError(t, err)
+
+	kb := NewKeyringKeybase(NewMemKeyring())
+	require.Error(t, kb.ImportPrivKey("john", armor, "wrongpw"))
+	require.NoError(t, kb.ImportPrivKey("john", armor, "exportpw"))
+	require.Error(t, kb.ImportPrivKey("john", armor, "exportpw"))
+
+	priv, err := kb.ExportPrivateKeyObject("john", "")
+	require.NoError(t, err)
+	require.Equal(t, info.GetPubKey(), priv.PubKey())
+
+	armor, err = kb.ExportPrivKey("john", "", "exportpw2")
+	require.NoError(t, err)
+	require.NoError(t, legacy.ImportPrivKey("john2", armor, "exportpw2"))
+}
+
+func TestFileKeyring(t *testing.T) {
+	dir, cleanup := newTestKeyringDir(t)
+	defer cleanup()
+
+	calls := 0
+	kr := NewFileKeyring(dir, staticPrompt("keyringpw", &calls))
+
+	keys, err := kr.Keys()
+	require.NoError(t, err)
+	require.Empty(t, keys)
+	require.Equal(t, 0, calls)
+
+	// the passphrase is asked for once
+	require.NoError(t, kr.Set("first", []byte("secret data")))
+	require.NoError(t, kr.Set("second", []byte("more data")))
+	require.Equal(t, 1, calls)
+
+	bz, err := ioutil.ReadFile(filepath.Join(dir, fileKeyringName))
+	require.NoError(t, err)
+	require.NotContains(t, string(bz), "first")
+
+	// the items are read back with the same passphrase
+	kr = NewFileKeyring(dir, staticPrompt("keyringpw", &calls))
+	data, err := kr.Get("first")
+	require.NoError(t, err)
+	require.Equal(t, []byte("secret data"), data)
+	require.NoError(t, kr.Remove("first"))
+	keys, err = kr.Keys()
+	require.NoError(t, err)
+	require.Equal(t, []string{"second"}, keys)
+	require.Equal(t, 2, calls)
+
+	// a wrong passphrase is rejected
+	kr = NewFileKeyring(dir, staticPrompt("wrong", &calls))
+	_, err = kr.Get("second")
+	require.EqualError(t, err, "invalid keyring passphrase")
+
+	// a new keyring needs a passphrase
+	emptyDir, emptyCleanup := newTestKeyringDir(t)
+	defer emptyCleanup()
+	kr = NewFileKeyring(emptyDir, staticPrompt("", &calls))
+	require.Error(t, kr.Set("first", []byte("secret data")))
+
+	kr = NewFileKeyring(emptyDir, func(bool) (string, error) { return "", errors.New("no input") })
+	require.EqualError(t, kr.Set("first", []byte("secret data")), "no input")
+}
+
+func TestTestKeyring(t *testing.T) {
+	dir, cleanup := newTestKeyringDir(t)
+	defer cleanup()
+
+	kb := NewKeyringKeybase(NewTestKeyring(dir))
+	info, _, err := kb.CreateMnemonic("john", English, "", Secp256k1)
+	require.NoError(t, err)
+
+	// keys persist across keybases
+	kb = NewKeyringKeybase(NewTestKeyring(dir))
+	restored, err := kb.Get("john")
+	require.NoError(t, err)
+	require.Equal(t, info.GetPubKey(), restored.GetPubKey())
+}
+
+func TestNewKeyring(t *testing.T) {
+	dir, cleanup := newTestKeyringDir(t)
+	defer cleanup()
+
+	for _, backend := range []string{BackendTest, BackendMemory} {
+		kr, err := NewKeyring(backend, dir, nil)
+		require.NoError(t, err, backend)
+		require.NotNil(t, kr, backend)
+	}
+
+	_, err := NewKeyring(BackendFile, dir, nil)
+	require.Error(t, err)
+	_, err = NewKeyring(BackendLevelDB, dir, nil)
+	require.Error(t, err)
+	_, err = NewKeyring("unknown", dir, nil)
+	require.Error(t, err)
+
+	RegisterKeyringBackend("custom", func(string, PassphrasePrompt) (Keyring, error) {
+		return NewMemKeyring(), nil
+	})
+	require.Contains(t, KeyringBackends(), "custom")
+	kr, err := NewKeyring("custom", dir, nil)
+	require.NoError(t, err)
+	require.NotNil(t, kr)
+
+	require.Panics(t, func() {
+		RegisterKeyringBackend(BackendTest, func(string, PassphrasePrompt) (Keyring, error) {
+			return NewMemKeyring(), nil
+		})
+	})
+}
@@ -1,5 +1,18 @@
 package keys
 
+import (
+	stded25519 "crypto/ed25519"
+	"sort"
+
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/sr25519"
+)
+
 // SigningAlgo defines an algorithm to derive key-pairs which can be used for cryptographic signing.
 type SigningAlgo string
 
@@ -7,6 +20,86 @@ const (
 	// Secp256k1 uses the Bitcoin secp256k1 ECDSA parameters.
 	Secp256k1 = SigningAlgo("secp256k1")
 	// Ed25519 represents the Ed25519 signature system.
-	// It is currently not supported for end-user keys (wallets/ledgers).
 	Ed25519 = SigningAlgo("ed25519")
+	// Secp256r1 uses the NIST P-256 ECDSA parameters.
+	Secp256r1 = SigningAlgo("secp256r1")
+	// Sr25519 represents the Schnorr signature system on the Ristretto group of Curve25519.
+	Sr25519 = SigningAlgo("sr25519")
 )
+
+// deriveKeyFunc derives the private key of a BIP 32 path from a BIP 39 seed.
+type deriveKeyFunc func(seed []byte, hdPath string) (tmcrypto.PrivKey, error)
+
+// signingAlgos registers the key derivation of the algorithms supported for
+// end-user keys.
+var signingAlgos = map[SigningAlgo]deriveKeyFunc{
+	// BIP 32
+	Secp256k1: func(seed []byte, hdPath string) (tmcrypto.PrivKey, error) {
+		masterPriv, ch := hd.ComputeMastersFromSeed(seed)
+		derivedPriv, err := hd.DerivePrivateKeyForPath(masterPriv, ch, hdPath)
+		if err != nil {
+			return nil, err
+		}
+		return secp256k1.PrivKeySecp256k1(derivedPriv), nil
+	},
+
+	// SLIP-0010, with hardened levels only
+	Ed25519: func(seed []byte, hdPath string) (tmcrypto.PrivKey, error) {
+		derivedSeed, err := hd.DeriveEd25519PrivateKeyForPath(seed, hdPath)
+		if err != nil {
+			return nil, err
+		}
+		return newEd25519PrivKey(derivedSeed), nil
+	},
+
+	// SLIP-0010
+	Secp256r1: func(seed []byte, hdPath string) (tmcrypto.PrivKey, error) {
+		derivedPriv, err := hd.DeriveNist256p1PrivateKeyForPath(seed, hdPath)
+		if err != nil {
+			return nil, err
+		}
+		return secp256r1.PrivKeySecp256r1(derivedPriv), nil
+	},
+
+	// SLIP-0010 ed25519 derivation, with hardened levels only
+	Sr25519: func(seed []byte, hdPath string) (tmcrypto.PrivKey, error) {
+		derivedPriv, err := hd.DeriveSr25519PrivateKeyForPath(seed, hdPath)
+		if err != nil {
+			return nil, err
+		}
+		return sr25519.PrivKeySr25519(derivedPriv), nil
+	},
+}
+
+// SigningAlgos returns the algorithms supported for end-user keys, sorted.
+func SigningAlgos() []SigningAlgo {
+	algos := make([]SigningAlgo, 0, len(signingAlgos))
+	for algo := range signingAlgos {
+		algos = append(algos, algo)
+	}
+	sort.Slice(algos, func(i, j int) bool { return algos[i] < algos[j] })
+	return algos
+}
+
+// IsSupportedAlgorithm returns true if keys of algo can be created.
+func IsSupportedAlgorithm(algo SigningAlgo) bool {
+	_, ok := signingAlgos[algo]
+	return ok
+}
+
+// deriveKey derives the private key of algo for a BIP 32 path from a BIP 39
+// seed.
+func deriveKey(algo SigningAlgo, seed []byte, hdPath string) (tmcrypto.PrivKey, error) {
+	derive, ok := signingAlgos[algo]
+	if !ok {
+		return nil, ErrUnknownSigningAlgo
+	}
+	return derive(seed, hdPath)
+}
+
+// newEd25519PrivKey returns the ed25519 private key of a 32 bytes seed.
+func newEd25519PrivKey(seed [32]byte) ed25519.PrivKeyEd25519 {
+	var privKey ed25519.PrivKeyEd25519
+	copy(privKey[:], stded25519.NewKeyFromSeed(seed[:]))
+	return privKey
+}
@@ -0,0 +1,129 @@
+package remote
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/tendermint/tendermint/crypto"
+)
+
+// DefaultTimeout is the time a client waits for the daemon to answer a request
+const DefaultTimeout = 2 * time.Minute
+
+// Client sends requests to a signing daemon
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewClient creates a client of the signing daemon serving on endpoint
+func NewClient(endpoint string) (*Client, error) {
+	network, address, err := ParseEndpoint(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if network != "unix" {
+		return &Client{
+			baseURL:    strings.TrimSuffix(endpoint, "/"),
+			httpClient: &http.Client{Timeout: DefaultTimeout},
+		}, nil
+	}
+
+	transport := &http.Transport{
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, "unix", address)
+		},
+	}
+
+	// the host is ignored as all the connections go to the socket
+	return &Client{
+		baseURL:    "http://unix",
+		httpClient: &http.Client{Transport: transport, Timeout: DefaultTimeout},
+	}, nil
+}
+
+// PubKey returns the public key of the named key of the daemon
+func (c *Client) PubKey(name string) (crypto.PubKey, error) {
+	var res PubKeyResponse
+	if err := c.post(PubKeyPath, PubKeyRequest{Name: name}, &res); err != nil {
+		return nil, err
+	}
+	if res.PubKey == nil {
+		return nil, fmt.Errorf("signing daemon returned no public key for key %s", name)
+	}
+	return res.PubKey, nil
+}
+
+// Sign returns the signature of msg by the key of address. The signature is
+// verified against pubKey, the public key of the address.
+func (c *Client) Sign(pubKey crypto.PubKey, msg []byte) ([]byte, error) {
+	var res SignResponse
+	req := SignRequest{Address: pubKey.Address(), SignBytes: msg}
+	if err := c.post(SignPath, req, &res); err != nil {
+		return nil, err
+	}
+
+	if !pubKey.VerifyBytes(msg, res.Signature) {
+		return nil, fmt.Errorf("signing daemon returned an invalid signature for address %s", pubKey.Address())
+	}
+	return res.Signature, nil
+}
+
+func (c *Client) post(path string, req, res interface{}) error {
+	bz, err := cdc.MarshalJSON(req)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.httpClient.Post(c.baseURL+path, "application/json", bytes.NewReader(bz))
+	if err != nil {
+		return fmt.Errorf("failed to reach the signing daemon: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var errRes ErrorResponse
+		if err := cdc.UnmarshalJSON(body, &errRes); err != nil || errRes.Error == "" {
+			return fmt.Errorf("signing daemon request failed with status %s", resp.Status)
+		}
+		return fmt.Errorf("signing daemon request failed: %s", errRes.Error)
+	}
+
+	return cdc.UnmarshalJSON(body, res)
+}
+
+// ParseEndpoint returns the network and the address of an endpoint, which is
+// either unix://<path> or an http(s) URL.
+func ParseEndpoint(endpoint string) (network, address string, err error) {
+	switch {
+	case strings.HasPrefix(endpoint, "unix://"):
+		address = strings.TrimPrefix(endpoint, "unix://")
+		if address == "" {
+			return "", "", fmt.Errorf("missing socket path in endpoint %s", endpoint)
+		}
+		return "unix", address, nil
+
+	case strings.HasPrefix(endpoint, "http://"), strings.HasPrefix(endpoint, "https://"):
+		address = endpoint[strings.Index(endpoint, "://")+3:]
+		if address == "" || strings.Contains(strings.TrimSuffix(address, "/"), "/") {
+			return "", "", fmt.Errorf("invalid endpoint %s, expected <scheme>://<host>:<port>", endpoint)
+		}
+		return "tcp", strings.TrimSuffix(address, "/"), nil
+
+	default:
+		return "", "", fmt.Errorf("invalid endpoint %s, expected unix://<path> or http(s)://<host>:<port>", endpoint)
+	}
+}
@@ -0,0 +1,68 @@
+/*
+Package remote implements a small protocol to delegate signing to a signing
+daemon, so that the private keys never leave the isolated service running it.
+
+The daemon serves JSON requests over HTTP, either on a TCP address or on a
+Unix socket. Endpoints are written as http://<host>:<port>, https://<host>:<port>
+or unix://<path>. The daemon serves:
+
+	POST /pubkey  {"name": "<key name>"}
+	  -> {"pub_key": <amino JSON public key>}
+	POST /sign    {"address": "<hex key address>", "sign_bytes": "<base64>"}
+	  -> {"signature": "<base64>", "pub_key": <amino JSON public key>}
+
+A failed request is answered with a non-200 status and {"error": "<message>"}.
+Keys are looked up by name when a reference to them is created, and by address
+when signing, so that a client only needs to store the public key of a key and
+the endpoint of its daemon.
+
+The protocol does not authenticate the clients: a daemon must only listen on a
+Unix socket or an address only trusted clients can reach.
+*/
+package remote
+
+import (
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// Paths of the daemon routes
+const (
+	PubKeyPath = "/pubkey"
+	SignPath   = "/sign"
+)
+
+var cdc = codec.New()
+
+func init() {
+	codec.RegisterCrypto(cdc)
+	cdc.Seal()
+}
+
+// PubKeyRequest requests the public key of a named key
+type PubKeyRequest struct {
+	Name string `json:"name"`
+}
+
+// PubKeyResponse returns the public key of a named key
+type PubKeyResponse struct {
+	PubKey crypto.PubKey `json:"pub_key"`
+}
+
+// SignRequest requests the signature of some bytes by the key of an address
+type SignRequest struct {
+	Address   crypto.Address `json:"address"`
+	SignBytes []byte         `json:"sign_bytes"`
+}
+
+// SignResponse returns a signature along with the public key of the signer
+type SignResponse struct {
+	Signature []byte        `json:"signature"`
+	PubKey    crypto.PubKey `json:"pub_key"`
+}
+
+// ErrorResponse is returned by the daemon when a request fails
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
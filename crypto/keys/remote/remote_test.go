@@ -0,0 +1,129 @@
+package remote
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+func TestParseEndpoint(t *testing.T) {
+	tests := []struct {
+		endpoint         string
+		network, address string
+		expectPass       bool
+	}{
+		{"unix:///tmp/signer.sock", "unix", "/tmp/signer.sock", true},
+		{"http://localhost:26660", "tcp", "localhost:26660", true},
+		{"https://signer.example.com:443/", "tcp", "signer.example.com:443", true},
+		{"unix://", "", "", false},
+		{"http://localhost:26660/path", "", "", false},
+		{"tcp://localhost:26660", "", "", false},
+		{"localhost:26660", "", "", false},
+	}
+
+	for _, tc := range tests {
+		network, address, err := ParseEndpoint(tc.endpoint)
+		if tc.expectPass {
+			require.NoError(t, err, tc.endpoint)
+			require.Equal(t, tc.network, network, tc.endpoint)
+			require.Equal(t, tc.address, address, tc.endpoint)
+		} else {
+			require.Error(t, err, tc.endpoint)
+		}
+	}
+}
+
+func testClient(t *testing.T, client *Client, priv crypto.PrivKey) {
+	pub, err := client.PubKey("treasury")
+	require.NoError(t, err)
+	require.Equal(t, priv.PubKey(), pub)
+
+	_, err = client.PubKey("unknown")
+	require.Error(t, err)
+
+	msg := []byte("to be signed")
+	sig, err := client.Sign(pub, msg)
+	require.NoError(t, err)
+	require.True(t, pub.VerifyBytes(msg, sig))
+
+	_, err = client.Sign(secp256k1.GenPrivKey().PubKey(), msg)
+	require.Error(t, err)
+}
+
+func TestHTTPDaemon(t *testing.T) {
+	priv := secp256k1.GenPrivKey()
+	server := httptest.NewServer(NewServer(NewPrivKeySigner(map[string]crypto.PrivKey{"treasury": priv})))
+	defer server.Close()
+
+	client, err := NewClient(server.URL)
+	require.NoError(t, err)
+	testClient(t, client, priv)
+
+	// only POST requests are served
+	res, err := http.Get(server.URL + SignPath)
+	require.NoError(t, err)
+	res.Body.Close()
+	require.Equal(t, http.StatusMethodNotAllowed, res.StatusCode)
+}
+
+func TestUnixDaemon(t *testing.T) {
+	dir, err := ioutil.TempDir("", "remote_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	endpoint := "unix://" + filepath.Join(dir, "signer.sock")
+	listener, err := Listen(endpoint)
+	require.NoError(t, err)
+	defer listener.Close()
+
+	fi, err := os.Stat(filepath.Join(dir, "signer.sock"))
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), fi.Mode().Perm())
+
+	priv := secp256k1.GenPrivKey()
+	go NewServer(NewPrivKeySigner(map[string]crypto.PrivKey{"treasury": priv})).Serve(listener) // nolint: errcheck
+
+	client, err := NewClient(endpoint)
+	require.NoError(t, err)
+	testClient(t, client, priv)
+}
+
+// badSigner signs with another key than the requested one
+type badSigner struct {
+	*PrivKeySigner
+	other crypto.PrivKey
+}
+
+func (s badSigner) Sign(_ crypto.Address, msg []byte) ([]byte, crypto.PubKey, error) {
+	sig, err := s.other.Sign(msg)
+	return sig, s.other.PubKey(), err
+}
+
+func TestInvalidSignature(t *testing.T) {
+	priv := secp256k1.GenPrivKey()
+	signer := badSigner{NewPrivKeySigner(map[string]crypto.PrivKey{"treasury": priv}), secp256k1.GenPrivKey()}
+	server := httptest.NewServer(NewServer(signer))
+	defer server.Close()
+
+	client, err := NewClient(server.URL)
+	require.NoError(t, err)
+
+	_, err = client.Sign(priv.PubKey(), []byte("to be signed"))
+	require.Error(t, err)
+}
+
+func TestUnreachableDaemon(t *testing.T) {
+	client, err := NewClient("unix:///nonexistent/signer.sock")
+	require.NoError(t, err)
+
+	_, err = client.PubKey("treasury")
+	require.Error(t, err)
+}
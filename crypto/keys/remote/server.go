@@ -0,0 +1,193 @@
+package remote
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/tendermint/tendermint/crypto"
+)
+
+// maxRequestSize bounds the size of the requests served by the daemon
+const maxRequestSize = 1 << 20
+
+// Signer holds the keys served by a signing daemon
+type Signer interface {
+	// PubKey returns the public key of the named key
+	PubKey(name string) (crypto.PubKey, error)
+	// Sign signs msg with the key of address
+	Sign(address crypto.Address, msg []byte) ([]byte, crypto.PubKey, error)
+}
+
+var _ Signer = &PrivKeySigner{}
+
+// PrivKeySigner is the reference Signer, holding private keys in memory
+type PrivKeySigner struct {
+	mtx  sync.RWMutex
+	keys map[string]crypto.PrivKey
+}
+
+// NewPrivKeySigner creates a signer of the given private keys, by name
+func NewPrivKeySigner(keys map[string]crypto.PrivKey) *PrivKeySigner {
+	signer := &PrivKeySigner{keys: make(map[string]crypto.PrivKey, len(keys))}
+	for name, priv := range keys {
+		signer.keys[name] = priv
+	}
+	return signer
+}
+
+// PubKey implements Signer
+func (s *PrivKeySigner) PubKey(name string) (crypto.PubKey, error) {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	priv, ok := s.keys[name]
+	if !ok {
+		return nil, fmt.Errorf("key %s not found", name)
+	}
+	return priv.PubKey(), nil
+}
+
+// Sign implements Signer
+func (s *PrivKeySigner) Sign(address crypto.Address, msg []byte) ([]byte, crypto.PubKey, error) {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	for _, priv := range s.keys {
+		pub := priv.PubKey()
+		if !bytes.Equal(pub.Address(), address) {
+			continue
+		}
+
+		sig, err := priv.Sign(msg)
+		if err != nil {
+			return nil, nil, err
+		}
+		return sig, pub, nil
+	}
+
+	return nil, nil, fmt.Errorf("key with address %s not found", address)
+}
+
+// Server is a signing daemon serving the keys of a Signer
+type Server struct {
+	signer Signer
+	mux    *http.ServeMux
+}
+
+var _ http.Handler = &Server{}
+
+// NewServer creates a signing daemon serving the keys of signer
+func NewServer(signer Signer) *Server {
+	s := &Server{signer: signer, mux: http.NewServeMux()}
+	s.mux.HandleFunc(PubKeyPath, s.handlePubKey)
+	s.mux.HandleFunc(SignPath, s.handleSign)
+	return s
+}
+
+// ServeHTTP implements http.Handler
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// Listen returns a listener of endpoint. The socket of a unix endpoint is
+// only accessible by the user running the daemon.
+func Listen(endpoint string) (net.Listener, error) {
+	network, address, err := ParseEndpoint(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	listener, err := net.Listen(network, address)
+	if err != nil {
+		return nil, err
+	}
+
+	if network == "unix" {
+		if err := os.Chmod(address, 0600); err != nil {
+			listener.Close()
+			return nil, err
+		}
+	}
+	return listener, nil
+}
+
+// Serve serves the signing daemon on listener until it fails
+func (s *Server) Serve(listener net.Listener) error {
+	return http.Serve(listener, s)
+}
+
+func (s *Server) handlePubKey(w http.ResponseWriter, r *http.Request) {
+	var req PubKeyRequest
+	if !readRequest(w, r, &req) {
+		return
+	}
+
+	pubKey, err := s.signer.PubKey(req.Name)
+	if err != nil {
+		writeError(w, http.StatusNotFound, err)
+		return
+	}
+
+	writeResponse(w, PubKeyResponse{PubKey: pubKey})
+}
+
+func (s *Server) handleSign(w http.ResponseWriter, r *http.Request) {
+	var req SignRequest
+	if !readRequest(w, r, &req) {
+		return
+	}
+	if len(req.Address) == 0 || len(req.SignBytes) == 0 {
+		writeError(w, http.StatusBadRequest, errors.New("address and sign bytes are required"))
+		return
+	}
+
+	sig, pubKey, err := s.signer.Sign(req.Address, req.SignBytes)
+	if err != nil {
+		writeError(w, http.StatusNotFound, err)
+		return
+	}
+
+	writeResponse(w, SignResponse{Signature: sig, PubKey: pubKey})
+}
+
+func readRequest(w http.ResponseWriter, r *http.Request, req interface{}) bool {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+		return false
+	}
+
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestSize))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return false
+	}
+
+	if err := cdc.UnmarshalJSON(body, req); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("failed to decode request: %v", err))
+		return false
+	}
+	return true
+}
+
+func writeResponse(w http.ResponseWriter, res interface{}) {
+	bz, err := cdc.MarshalJSON(res)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(bz)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(cdc.MustMarshalJSON(ErrorResponse{Error: err.Error()}))
+}
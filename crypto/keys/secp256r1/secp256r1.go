@@ -0,0 +1,226 @@
+// Package secp256r1 implements ECDSA keys on the NIST P-256 curve (secp256r1),
+// which is supported by most hardware security modules.
+//
+// Signatures are the 64 bytes r || s of the ECDSA signature of the SHA-256
+// hash of the message, with s in the lower half of the curve order so that
+// signatures are not malleable.
+//
+// The keys are not registered with the codec of the Tendermint multisig
+// threshold keys, so they can not be part of a multisig key.
+package secp256r1
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"fmt"
+	"io"
+	"math/big"
+
+	amino "github.com/tendermint/go-amino"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+const (
+	PrivKeyAminoName = "cosmos-sdk/PrivKeySecp256r1"
+	PubKeyAminoName  = "cosmos-sdk/PubKeySecp256r1"
+
+	// PrivKeySize is the size of a private key, the big-endian scalar
+	PrivKeySize = 32
+	// PubKeySize is the size of a public key, the compressed curve point
+	PubKeySize = 33
+	// SignatureSize is the size of a signature, r || s
+	SignatureSize = 64
+)
+
+var cdc = amino.NewCodec()
+
+func init() {
+	cdc.RegisterInterface((*crypto.PubKey)(nil), nil)
+	cdc.RegisterInterface((*crypto.PrivKey)(nil), nil)
+	RegisterAmino(cdc)
+}
+
+// RegisterAmino registers the secp256r1 keys in the given (amino) codec.
+func RegisterAmino(cdc *amino.Codec) {
+	cdc.RegisterConcrete(PubKeySecp256r1{}, PubKeyAminoName, nil)
+	cdc.RegisterConcrete(PrivKeySecp256r1{}, PrivKeyAminoName, nil)
+}
+
+var (
+	curve     = elliptic.P256()
+	halfOrder = new(big.Int).Rsh(curve.Params().N, 1)
+)
+
+//-------------------------------------
+
+var _ crypto.PrivKey = PrivKeySecp256r1{}
+
+// PrivKeySecp256r1 implements PrivKey.
+type PrivKeySecp256r1 [PrivKeySize]byte
+
+// GenPrivKey generates a new secp256r1 private key.
+// It uses OS randomness to generate the private key.
+func GenPrivKey() PrivKeySecp256r1 {
+	return genPrivKey(rand.Reader)
+}
+
+// genPrivKey generates a new secp256r1 private key using the provided reader.
+func genPrivKey(rand io.Reader) PrivKeySecp256r1 {
+	priv, err := ecdsa.GenerateKey(curve, rand)
+	if err != nil {
+		panic(err)
+	}
+
+	var privKey PrivKeySecp256r1
+	d := priv.D.Bytes()
+	copy(privKey[PrivKeySize-len(d):], d)
+	return privKey
+}
+
+// Bytes marshalls the private key using amino encoding.
+func (privKey PrivKeySecp256r1) Bytes() []byte {
+	return cdc.MustMarshalBinaryBare(privKey)
+}
+
+// Sign creates an ECDSA signature on curve secp256r1 of the SHA-256 hash of
+// msg, with a canonical (lower) s.
+func (privKey PrivKeySecp256r1) Sign(msg []byte) ([]byte, error) {
+	hash := sha256.Sum256(msg)
+	r, s, err := ecdsa.Sign(rand.Reader, privKey.ecdsa(), hash[:])
+	if err != nil {
+		return nil, err
+	}
+
+	if s.Cmp(halfOrder) > 0 {
+		s.Sub(curve.Params().N, s)
+	}
+
+	sig := make([]byte, SignatureSize)
+	rBytes, sBytes := r.Bytes(), s.Bytes()
+	copy(sig[SignatureSize/2-len(rBytes):SignatureSize/2], rBytes)
+	copy(sig[SignatureSize-len(sBytes):], sBytes)
+	return sig, nil
+}
+
+// PubKey performs the point-scalar multiplication from the privKey on the
+// generator point to get the pubkey.
+func (privKey PrivKeySecp256r1) PubKey() crypto.PubKey {
+	x, y := curve.ScalarBaseMult(privKey[:])
+	return compress(x, y)
+}
+
+// Equals - you probably don't need to use this.
+// Runs in constant time based on length of the keys.
+func (privKey PrivKeySecp256r1) Equals(other crypto.PrivKey) bool {
+	if otherKey, ok := other.(PrivKeySecp256r1); ok {
+		return subtle.ConstantTimeCompare(privKey[:], otherKey[:]) == 1
+	}
+	return false
+}
+
+func (privKey PrivKeySecp256r1) ecdsa() *ecdsa.PrivateKey {
+	priv := &ecdsa.PrivateKey{D: new(big.Int).SetBytes(privKey[:])}
+	priv.Curve = curve
+	priv.X, priv.Y = curve.ScalarBaseMult(privKey[:])
+	return priv
+}
+
+//-------------------------------------
+
+var _ crypto.PubKey = PubKeySecp256r1{}
+
+// PubKeySecp256r1 implements crypto.PubKey.
+// It is the compressed form of the pubkey: a 0x02 byte if the y-coordinate is
+// even, 0x03 otherwise, followed with the x-coordinate.
+type PubKeySecp256r1 [PubKeySize]byte
+
+// Address returns the SHA-256 hash of the compressed public key, truncated
+// to 20 bytes.
+func (pubKey PubKeySecp256r1) Address() crypto.Address {
+	return crypto.AddressHash(pubKey[:])
+}
+
+// Bytes returns the pubkey marshalled with amino encoding.
+func (pubKey PubKeySecp256r1) Bytes() []byte {
+	return cdc.MustMarshalBinaryBare(pubKey)
+}
+
+// VerifyBytes verifies a signature created by PrivKeySecp256r1.Sign. It
+// rejects signatures which are not in the canonical (lower) s form.
+func (pubKey PubKeySecp256r1) VerifyBytes(msg []byte, sig []byte) bool {
+	if len(sig) != SignatureSize {
+		return false
+	}
+
+	r := new(big.Int).SetBytes(sig[:SignatureSize/2])
+	s := new(big.Int).SetBytes(sig[SignatureSize/2:])
+	if s.Cmp(halfOrder) > 0 {
+		return false
+	}
+
+	pub, ok := pubKey.decompress()
+	if !ok {
+		return false
+	}
+
+	hash := sha256.Sum256(msg)
+	return ecdsa.Verify(pub, hash[:], r, s)
+}
+
+func (pubKey PubKeySecp256r1) String() string {
+	return fmt.Sprintf("PubKeySecp256r1{%X}", pubKey[:])
+}
+
+// Equals returns true if the other public key is the same secp256r1 key.
+func (pubKey PubKeySecp256r1) Equals(other crypto.PubKey) bool {
+	if otherKey, ok := other.(PubKeySecp256r1); ok {
+		return pubKey == otherKey
+	}
+	return false
+}
+
+// compress returns the compressed form of a curve point.
+func compress(x, y *big.Int) PubKeySecp256r1 {
+	var pubKey PubKeySecp256r1
+	pubKey[0] = 0x02 + byte(y.Bit(0))
+	xBytes := x.Bytes()
+	copy(pubKey[PubKeySize-len(xBytes):], xBytes)
+	return pubKey
+}
+
+// decompress returns the curve point of the compressed public key, and false
+// if it is not a valid point.
+func (pubKey PubKeySecp256r1) decompress() (*ecdsa.PublicKey, bool) {
+	if pubKey[0] != 0x02 && pubKey[0] != 0x03 {
+		return nil, false
+	}
+
+	params := curve.Params()
+	x := new(big.Int).SetBytes(pubKey[1:])
+	if x.Cmp(params.P) >= 0 {
+		return nil, false
+	}
+
+	// y² = x³ - 3x + b
+	y := new(big.Int).Mul(x, x)
+	y.Mul(y, x)
+	threeX := new(big.Int).Lsh(x, 1)
+	threeX.Add(threeX, x)
+	y.Sub(y, threeX)
+	y.Add(y, params.B)
+	y.Mod(y, params.P)
+	if y.ModSqrt(y, params.P) == nil {
+		return nil, false
+	}
+	if y.Bit(0) != uint(pubKey[0]&1) {
+		y.Sub(params.P, y)
+	}
+
+	if !curve.IsOnCurve(x, y) {
+		return nil, false
+	}
+	return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, true
+}
@@ -0,0 +1,66 @@
+package secp256r1
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	priv := GenPrivKey()
+	pub := priv.PubKey()
+	require.Len(t, pub.Address(), 20)
+
+	msg := []byte("to be signed")
+	for i := 0; i < 20; i++ {
+		sig, err := priv.Sign(msg)
+		require.NoError(t, err)
+		require.Len(t, sig, SignatureSize)
+		require.True(t, pub.VerifyBytes(msg, sig))
+		require.False(t, pub.VerifyBytes([]byte("other message"), sig))
+		require.False(t, GenPrivKey().PubKey().VerifyBytes(msg, sig))
+
+		// the malleated signature (r, n - s) is rejected
+		s := new(big.Int).SetBytes(sig[SignatureSize/2:])
+		s.Sub(curve.Params().N, s)
+		malleated := make([]byte, SignatureSize)
+		copy(malleated, sig[:SignatureSize/2])
+		sBytes := s.Bytes()
+		copy(malleated[SignatureSize-len(sBytes):], sBytes)
+		require.False(t, pub.VerifyBytes(msg, malleated))
+	}
+
+	require.False(t, pub.VerifyBytes(msg, []byte("too short")))
+}
+
+func TestPubKeyCompression(t *testing.T) {
+	for i := 0; i < 20; i++ {
+		priv := GenPrivKey()
+		pub := priv.PubKey().(PubKeySecp256r1)
+
+		point, ok := pub.decompress()
+		require.True(t, ok)
+		ecdsaPriv := priv.ecdsa()
+		require.Equal(t, ecdsaPriv.X, point.X)
+		require.Equal(t, ecdsaPriv.Y, point.Y)
+	}
+
+	var invalid PubKeySecp256r1
+	invalid[0] = 0x04
+	_, ok := invalid.decompress()
+	require.False(t, ok)
+}
+
+func TestAminoEncoding(t *testing.T) {
+	priv := GenPrivKey()
+	var decodedPriv PrivKeySecp256r1
+	require.NoError(t, cdc.UnmarshalBinaryBare(priv.Bytes(), &decodedPriv))
+	require.True(t, priv.Equals(decodedPriv))
+
+	pub := priv.PubKey()
+	var decodedPub PubKeySecp256r1
+	require.NoError(t, cdc.UnmarshalBinaryBare(pub.Bytes(), &decodedPub))
+	require.True(t, pub.Equals(decodedPub))
+	require.False(t, pub.Equals(GenPrivKey().PubKey()))
+}
@@ -0,0 +1,162 @@
+// Package sr25519 implements Schnorr keys on the Ristretto group of
+// Curve25519 (sr25519), as used by schnorrkel.
+//
+// Private keys are schnorrkel mini secret keys, which are expanded to secret
+// keys with the ed25519 expansion. Signatures are the 64 bytes schnorrkel
+// signatures of the message with an empty signing context.
+//
+// The keys are not registered with the codec of the Tendermint multisig
+// threshold keys, so they can not be part of a multisig key.
+package sr25519
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"io"
+
+	schnorrkel "github.com/ChainSafe/go-schnorrkel"
+	amino "github.com/tendermint/go-amino"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+const (
+	PrivKeyAminoName = "cosmos-sdk/PrivKeySr25519"
+	PubKeyAminoName  = "cosmos-sdk/PubKeySr25519"
+
+	// PrivKeySize is the size of a private key, the mini secret key
+	PrivKeySize = 32
+	// PubKeySize is the size of a public key, the compressed Ristretto point
+	PubKeySize = 32
+	// SignatureSize is the size of a signature, R || s
+	SignatureSize = 64
+)
+
+var cdc = amino.NewCodec()
+
+func init() {
+	cdc.RegisterInterface((*crypto.PubKey)(nil), nil)
+	cdc.RegisterInterface((*crypto.PrivKey)(nil), nil)
+	RegisterAmino(cdc)
+}
+
+// RegisterAmino registers the sr25519 keys in the given (amino) codec.
+func RegisterAmino(cdc *amino.Codec) {
+	cdc.RegisterConcrete(PubKeySr25519{}, PubKeyAminoName, nil)
+	cdc.RegisterConcrete(PrivKeySr25519{}, PrivKeyAminoName, nil)
+}
+
+// signingContext is the schnorrkel signing context of the signatures
+var signingContext = []byte{}
+
+//-------------------------------------
+
+var _ crypto.PrivKey = PrivKeySr25519{}
+
+// PrivKeySr25519 implements PrivKey.
+// It is the schnorrkel mini secret key.
+type PrivKeySr25519 [PrivKeySize]byte
+
+// GenPrivKey generates a new sr25519 private key.
+// It uses OS randomness to generate the private key.
+func GenPrivKey() PrivKeySr25519 {
+	return genPrivKey(crypto.CReader())
+}
+
+// genPrivKey generates a new sr25519 private key using the provided reader.
+func genPrivKey(rand io.Reader) PrivKeySr25519 {
+	var privKey PrivKeySr25519
+	if _, err := io.ReadFull(rand, privKey[:]); err != nil {
+		panic(err)
+	}
+	return privKey
+}
+
+// Bytes marshalls the private key using amino encoding.
+func (privKey PrivKeySr25519) Bytes() []byte {
+	return cdc.MustMarshalBinaryBare(privKey)
+}
+
+// Sign creates a schnorrkel signature of msg.
+func (privKey PrivKeySr25519) Sign(msg []byte) ([]byte, error) {
+	secretKey := privKey.miniSecretKey().ExpandEd25519()
+
+	sig, err := secretKey.Sign(schnorrkel.NewSigningContext(signingContext, msg))
+	if err != nil {
+		return nil, err
+	}
+
+	sigBytes := sig.Encode()
+	return sigBytes[:], nil
+}
+
+// PubKey returns the public key of the expanded secret key.
+func (privKey PrivKeySr25519) PubKey() crypto.PubKey {
+	return PubKeySr25519(privKey.miniSecretKey().Public().Encode())
+}
+
+// Equals - you probably don't need to use this.
+// Runs in constant time based on length of the keys.
+func (privKey PrivKeySr25519) Equals(other crypto.PrivKey) bool {
+	if otherKey, ok := other.(PrivKeySr25519); ok {
+		return subtle.ConstantTimeCompare(privKey[:], otherKey[:]) == 1
+	}
+	return false
+}
+
+func (privKey PrivKeySr25519) miniSecretKey() *schnorrkel.MiniSecretKey {
+	// the raw mini secret key is never rejected
+	miniSecretKey, _ := schnorrkel.NewMiniSecretKeyFromRaw(privKey)
+	return miniSecretKey
+}
+
+//-------------------------------------
+
+var _ crypto.PubKey = PubKeySr25519{}
+
+// PubKeySr25519 implements crypto.PubKey.
+// It is the compressed Ristretto point of the pubkey.
+type PubKeySr25519 [PubKeySize]byte
+
+// Address returns the SHA-256 hash of the public key, truncated to 20 bytes.
+func (pubKey PubKeySr25519) Address() crypto.Address {
+	return crypto.AddressHash(pubKey[:])
+}
+
+// Bytes returns the pubkey marshalled with amino encoding.
+func (pubKey PubKeySr25519) Bytes() []byte {
+	return cdc.MustMarshalBinaryBare(pubKey)
+}
+
+// VerifyBytes verifies a signature created by PrivKeySr25519.Sign. It
+// rejects signatures without the schnorrkel marker bit.
+func (pubKey PubKeySr25519) VerifyBytes(msg []byte, sig []byte) bool {
+	if len(sig) != SignatureSize || sig[SignatureSize-1]&0x80 == 0 {
+		return false
+	}
+
+	var sigBytes [SignatureSize]byte
+	copy(sigBytes[:], sig)
+	signature := new(schnorrkel.Signature)
+	if err := signature.Decode(sigBytes); err != nil {
+		return false
+	}
+
+	publicKey := new(schnorrkel.PublicKey)
+	if err := publicKey.Decode(pubKey); err != nil {
+		return false
+	}
+
+	return publicKey.Verify(signature, schnorrkel.NewSigningContext(signingContext, msg))
+}
+
+func (pubKey PubKeySr25519) String() string {
+	return fmt.Sprintf("PubKeySr25519{%X}", pubKey[:])
+}
+
+// Equals returns true if the other public key is the same sr25519 key.
+func (pubKey PubKeySr25519) Equals(other crypto.PubKey) bool {
+	if otherKey, ok := other.(PubKeySr25519); ok {
+		return pubKey == otherKey
+	}
+	return false
+}
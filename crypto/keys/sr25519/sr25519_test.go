@@ -0,0 +1,60 @@
+package sr25519
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	priv := GenPrivKey()
+	pub := priv.PubKey()
+	require.Len(t, pub.Address(), 20)
+
+	msg := []byte("to be signed")
+	for i := 0; i < 20; i++ {
+		sig, err := priv.Sign(msg)
+		require.NoError(t, err)
+		require.Len(t, sig, SignatureSize)
+		require.True(t, pub.VerifyBytes(msg, sig))
+		require.False(t, pub.VerifyBytes([]byte("other message"), sig))
+		require.False(t, GenPrivKey().PubKey().VerifyBytes(msg, sig))
+
+		// the signature without the schnorrkel marker bit is rejected
+		unmarked := make([]byte, SignatureSize)
+		copy(unmarked, sig)
+		unmarked[SignatureSize-1] &= 0x7f
+		require.False(t, pub.VerifyBytes(msg, unmarked))
+	}
+
+	require.False(t, pub.VerifyBytes(msg, []byte("too short")))
+}
+
+func TestPubKey(t *testing.T) {
+	// the public key only depends on the mini secret key
+	priv := GenPrivKey()
+	require.Equal(t, priv.PubKey(), priv.PubKey())
+	require.False(t, priv.PubKey().Equals(GenPrivKey().PubKey()))
+
+	// a public key which is not a valid point does not verify anything
+	var invalid PubKeySr25519
+	for i := range invalid {
+		invalid[i] = 0xff
+	}
+	sig, err := priv.Sign([]byte("msg"))
+	require.NoError(t, err)
+	require.False(t, invalid.VerifyBytes([]byte("msg"), sig))
+}
+
+func TestAminoEncoding(t *testing.T) {
+	priv := GenPrivKey()
+	var decodedPriv PrivKeySr25519
+	require.NoError(t, cdc.UnmarshalBinaryBare(priv.Bytes(), &decodedPriv))
+	require.True(t, priv.Equals(decodedPriv))
+
+	pub := priv.PubKey()
+	var decodedPub PubKeySr25519
+	require.NoError(t, cdc.UnmarshalBinaryBare(pub.Bytes(), &decodedPub))
+	require.True(t, pub.Equals(decodedPub))
+	require.False(t, pub.Equals(GenPrivKey().PubKey()))
+}
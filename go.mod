@@ -1,16 +1,19 @@
 module github.com/cosmos/cosmos-sdk
 
 require (
+	github.com/ChainSafe/go-schnorrkel v0.0.0-20200405005733-88cbf1b4c40d
 	github.com/bartekn/go-bip39 v0.0.0-20171116152956-a05967ea095d
 	github.com/bgentry/speakeasy v0.1.0
 	github.com/btcsuite/btcd v0.0.0-20190115013929-ed77733ec07d
-	github.com/cosmos/go-bip39 v0.0.0-20180618194314-52158e4697b8
+	github.com/cosmos/go-bip39 v0.0.0-20180819234021-555e2067c45d
 	github.com/cosmos/ledger-cosmos-go v0.10.3
 	github.com/fortytw2/leaktest v1.3.0 // indirect
 	github.com/gogo/protobuf v1.3.0
 	github.com/golang/mock v1.3.1-0.20190508161146-9fa652df1129
 	github.com/gorilla/mux v1.7.3
 	github.com/gorilla/websocket v1.4.1 // indirect
+	github.com/gtank/merlin v0.1.1 // indirect
+	github.com/gtank/ristretto255 v0.1.2 // indirect
 	github.com/hashicorp/golang-lru v0.5.3
 	github.com/mattn/go-isatty v0.0.9
 	github.com/pelletier/go-toml v1.4.0
@@ -32,4 +35,9 @@ require (
 	gopkg.in/yaml.v2 v2.2.2
 )
 
+replace (
+	github.com/cosmos/go-bip39 => github.com/cosmos/go-bip39 v0.0.0-20180618194314-52158e4697b8
+	golang.org/x/crypto => golang.org/x/crypto v0.0.0-20190313024323-a1f597ede03a
+)
+
 go 1.13
@@ -8,7 +8,9 @@ import (
 )
 
 const (
-	defaultMinGasPrices = ""
+	defaultMinGasPrices       = ""
+	defaultPruning            = "syncable"
+	defaultSnapshotKeepRecent = 2
 )
 
 // BaseConfig defines the server's basic configuration
@@ -18,6 +20,15 @@ type BaseConfig struct {
 	// specified in this config (e.g. 0.25token1;0.0001token2).
 	MinGasPrices string `mapstructure:"minimum-gas-prices"`
 
+	// Pruning sets the pruning strategy: syncable, nothing, everything or
+	// custom. The custom strategy keeps the PruningKeepRecent most recent states
+	// and every PruningKeepEvery-th state, and deletes the other states every
+	// PruningInterval blocks.
+	Pruning           string `mapstructure:"pruning"`
+	PruningKeepRecent int64  `mapstructure:"pruning-keep-recent"`
+	PruningKeepEvery  int64  `mapstructure:"pruning-keep-every"`
+	PruningInterval   int64  `mapstructure:"pruning-interval"`
+
 	// HaltHeight contains a non-zero block height at which a node will gracefully
 	// halt and shutdown that can be used to assist upgrades and testing.
 	//
@@ -35,6 +46,16 @@ type BaseConfig struct {
 
 	// InterBlockCache enables inter-block caching.
 	InterBlockCache bool `mapstructure:"inter-block-cache"`
+
+	// SnapshotInterval contains a non-zero block interval at which a snapshot
+	// of the committed state is saved in the node's snapshot directory.
+	//
+	// Note: Snapshots are taken synchronously during the ABCI Commit phase.
+	SnapshotInterval uint64 `mapstructure:"snapshot-interval"`
+
+	// SnapshotKeepRecent defines the number of recent snapshots to keep. A value
+	// of 0 keeps all the snapshots.
+	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
 }
 
 // Config defines the server's top level configuration
@@ -73,8 +94,10 @@ func (c *Config) GetMinGasPrices() sdk.DecCoins {
 func DefaultConfig() *Config {
 	return &Config{
 		BaseConfig{
-			MinGasPrices:    defaultMinGasPrices,
-			InterBlockCache: true,
+			MinGasPrices:       defaultMinGasPrices,
+			Pruning:            defaultPruning,
+			InterBlockCache:    true,
+			SnapshotKeepRecent: defaultSnapshotKeepRecent,
 		},
 	}
 }
@@ -18,6 +18,20 @@ const defaultConfigTemplate = `# This is a TOML config file.
 # specified in this config (e.g. 0.25token1;0.0001token2).
 minimum-gas-prices = "{{ .BaseConfig.MinGasPrices }}"
 
+# Pruning sets the pruning strategy: syncable, nothing, everything or custom.
+#
+# syncable: only those states not needed for state syncing will be deleted (keeps last 100 + every 10000th)
+# nothing: all historic states will be saved, nothing will be deleted (i.e. archiving node)
+# everything: all saved states will be deleted, storing only the current state
+# custom: the pruning-keep-recent most recent states and every pruning-keep-every-th
+# state are kept, and the other states are deleted every pruning-interval blocks
+pruning = "{{ .BaseConfig.Pruning }}"
+
+# These are applied only if the pruning strategy is custom.
+pruning-keep-recent = {{ .BaseConfig.PruningKeepRecent }}
+pruning-keep-every = {{ .BaseConfig.PruningKeepEvery }}
+pruning-interval = {{ .BaseConfig.PruningInterval }}
+
 # HaltHeight contains a non-zero block height at which a node will gracefully
 # halt and shutdown that can be used to assist upgrades and testing.
 #
@@ -35,6 +49,16 @@ halt-time = {{ .BaseConfig.HaltTime }}
 
 # InterBlockCache enables inter-block caching.
 inter-block-cache = {{ .BaseConfig.InterBlockCache }}
+
+# SnapshotInterval contains a non-zero block interval at which a snapshot of
+# the committed state is saved in the node's snapshot directory.
+#
+# Note: Snapshots are taken synchronously during the ABCI Commit phase.
+snapshot-interval = {{ .BaseConfig.SnapshotInterval }}
+
+# SnapshotKeepRecent defines the number of recent snapshots to keep. A value
+# of 0 keeps all the snapshots.
+snapshot-keep-recent = {{ .BaseConfig.SnapshotKeepRecent }}
 `
 
 var configTemplate *template.Template
@@ -99,6 +99,14 @@ func (ms multiStore) SetInterBlockCache(_ sdk.MultiStorePersistentCache) {
 	panic("not implemented")
 }
 
+func (ms multiStore) Snapshot(height int64, w io.Writer) error {
+	panic("not implemented")
+}
+
+func (ms multiStore) Restore(height int64, r io.Reader) error {
+	panic("not implemented")
+}
+
 var _ sdk.KVStore = kvStore{}
 
 type kvStore struct {
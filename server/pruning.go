@@ -0,0 +1,79 @@
+package server
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+)
+
+// GetPruningOptionsFromFlags returns the pruning options of the strategy set by
+// the pruning flags, or the corresponding app.toml options.
+func GetPruningOptionsFromFlags() (store.PruningOptions, error) {
+	return store.NewPruningOptionsFromStrategy(
+		viper.GetString(FlagPruning),
+		viper.GetInt64(FlagPruningKeepRecent),
+		viper.GetInt64(FlagPruningKeepEvery),
+		viper.GetInt64(FlagPruningInterval),
+	)
+}
+
+func registerPruningFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagPruning, store.PruningStrategySyncable, "Pruning strategy: syncable, nothing, everything, custom")
+	cmd.Flags().Int64(FlagPruningKeepRecent, 0, "Number of recent states to keep with the custom pruning strategy")
+	cmd.Flags().Int64(FlagPruningKeepEvery, 0, "Interval of states to keep with the custom pruning strategy (0 to keep none)")
+	cmd.Flags().Int64(FlagPruningInterval, 0, "Block interval at which states are pruned with the custom pruning strategy")
+}
+
+// PruneCmd deletes the historic states of the data directory of a node which
+// are not kept by a pruning strategy. The node must not be running.
+func PruneCmd(ctx *Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune",
+		Short: "Prune the historic states of the application data offline",
+		Long: `Delete the historic states of the application data which are not kept by the
+pruning strategy set via the '--pruning' flag or app.toml, as a node running with this
+strategy would have done. The node must be stopped. Stores mounted with their own
+database are not pruned.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := GetPruningOptionsFromFlags()
+			if err != nil {
+				return err
+			}
+
+			db, err := openDB(viper.GetString(flags.FlagHome))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			pruned, err := rootmulti.PruneDB(db, opts)
+			if err != nil {
+				return err
+			}
+
+			names := make([]string, 0, len(pruned))
+			for name := range pruned {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+
+			for _, name := range names {
+				fmt.Printf("%s: pruned %d versions\n", name, pruned[name])
+			}
+			return nil
+		},
+	}
+
+	registerPruningFlags(cmd)
+	return cmd
+}
@@ -0,0 +1,228 @@
+package server
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/store/snapshots"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const flagArchive = "archive"
+
+// snapshotApp is an application able to create and restore state snapshots,
+// such as any application built on top of BaseApp with a snapshot store set.
+type snapshotApp interface {
+	abci.Application
+
+	LastCommitID() sdk.CommitID
+	SnapshotManager() *snapshots.Manager
+}
+
+// SnapshotDir returns the directory in which the state snapshots of a node are
+// saved. Applications should set their snapshot store to this directory so
+// that snapshots can be managed offline with the snapshots commands.
+func SnapshotDir(home string) string {
+	return filepath.Join(home, "data", "snapshots")
+}
+
+// SnapshotsCmd returns the commands managing the local state snapshots of a
+// node. The node must not be running.
+func SnapshotsCmd(ctx *Context, appCreator AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshots",
+		Short: "Manage local state snapshots",
+	}
+
+	cmd.AddCommand(
+		CreateSnapshotCmd(ctx, appCreator),
+		ListSnapshotsCmd(ctx),
+		RestoreSnapshotCmd(ctx, appCreator),
+		ExportSnapshotCmd(ctx),
+	)
+
+	return cmd
+}
+
+// CreateSnapshotCmd takes a snapshot of the committed state of the node.
+func CreateSnapshotCmd(ctx *Context, appCreator AppCreator) *cobra.Command {
+	return &cobra.Command{
+		Use:   "create [height]",
+		Short: "Take a snapshot of the state committed at a height (defaults to the latest height)",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app, err := openSnapshotApp(ctx, appCreator)
+			if err != nil {
+				return err
+			}
+
+			height := app.LastCommitID().Version
+			if len(args) == 1 {
+				if height, err = strconv.ParseInt(args[0], 10, 64); err != nil {
+					return fmt.Errorf("invalid height %s: %v", args[0], err)
+				}
+			}
+
+			snapshot, err := app.SnapshotManager().Create(height)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(snapshot)
+			return nil
+		},
+	}
+}
+
+// ListSnapshotsCmd lists the local state snapshots of the node.
+func ListSnapshotsCmd(ctx *Context) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List the local state snapshots",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			store, err := snapshots.NewStore(SnapshotDir(viper.GetString(flags.FlagHome)))
+			if err != nil {
+				return err
+			}
+
+			list, err := store.List()
+			if err != nil {
+				return err
+			}
+
+			for _, snapshot := range list {
+				fmt.Println(snapshot)
+			}
+			return nil
+		},
+	}
+}
+
+// RestoreSnapshotCmd restores the state of an empty node from a local state
+// snapshot, or from a snapshot archive which is imported first.
+func RestoreSnapshotCmd(ctx *Context, appCreator AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore [height]",
+		Short: "Restore the state of an empty node from a state snapshot",
+		Long: `Restore the state of an empty node from a local state snapshot of the given height.
+When an archive written by 'snapshots export' is given via the '--archive' flag, the
+snapshot it contains is first imported in the local snapshots and the height can be
+omitted.
+
+Every chunk and every node of the snapshot is verified before being restored and the
+commit hash of the restored state is printed on success. It should be checked against
+the app hash of the following block of a trusted chain.
+`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			archive := viper.GetString(flagArchive)
+			if archive == "" && len(args) == 0 {
+				return fmt.Errorf("either a height or an archive must be given")
+			}
+
+			var height int64
+			if len(args) == 1 {
+				var err error
+				if height, err = strconv.ParseInt(args[0], 10, 64); err != nil {
+					return fmt.Errorf("invalid height %s: %v", args[0], err)
+				}
+			}
+
+			if archive != "" {
+				store, err := snapshots.NewStore(SnapshotDir(viper.GetString(flags.FlagHome)))
+				if err != nil {
+					return err
+				}
+
+				file, err := os.Open(archive)
+				if err != nil {
+					return err
+				}
+				defer file.Close()
+
+				snapshot, err := store.Import(file)
+				if err != nil {
+					return fmt.Errorf("failed to import snapshot archive: %v", err)
+				}
+				if height != 0 && height != snapshot.Height {
+					return fmt.Errorf("archive contains snapshot %d, expected %d", snapshot.Height, height)
+				}
+				height = snapshot.Height
+			}
+
+			app, err := openSnapshotApp(ctx, appCreator)
+			if err != nil {
+				return err
+			}
+
+			if err := app.SnapshotManager().Restore(height); err != nil {
+				return err
+			}
+
+			fmt.Printf("restored state at height %d with commit hash %X\n", height, app.LastCommitID().Hash)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagArchive, "", "Snapshot archive to import and restore")
+	return cmd
+}
+
+// ExportSnapshotCmd writes a local state snapshot to an archive file.
+func ExportSnapshotCmd(ctx *Context) *cobra.Command {
+	return &cobra.Command{
+		Use:   "export [height] [file]",
+		Short: "Export a local state snapshot to an archive file",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height %s: %v", args[0], err)
+			}
+
+			store, err := snapshots.NewStore(SnapshotDir(viper.GetString(flags.FlagHome)))
+			if err != nil {
+				return err
+			}
+
+			file, err := os.Create(args[1])
+			if err != nil {
+				return err
+			}
+
+			if err := store.Export(height, file); err != nil {
+				file.Close()
+				os.Remove(args[1])
+				return err
+			}
+
+			return file.Close()
+		},
+	}
+}
+
+// openSnapshotApp opens the application of the node, which must support state
+// snapshots.
+func openSnapshotApp(ctx *Context, appCreator AppCreator) (snapshotApp, error) {
+	db, err := openDB(viper.GetString(flags.FlagHome))
+	if err != nil {
+		return nil, err
+	}
+
+	app, ok := appCreator(ctx.Logger, db, nil).(snapshotApp)
+	if !ok || app.SnapshotManager() == nil {
+		return nil, fmt.Errorf("application does not support state snapshots")
+	}
+
+	return app, nil
+}
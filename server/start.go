@@ -23,12 +23,19 @@ const (
 	flagWithTendermint  = "with-tendermint"
 	flagAddress         = "address"
 	flagTraceStore      = "trace-store"
-	flagPruning         = "pruning"
+	FlagPruning         = "pruning"
 	flagCPUProfile      = "cpu-profile"
 	FlagMinGasPrices    = "minimum-gas-prices"
 	FlagHaltHeight      = "halt-height"
 	FlagHaltTime        = "halt-time"
 	FlagInterBlockCache = "inter-block-cache"
+
+	FlagPruningKeepRecent = "pruning-keep-recent"
+	FlagPruningKeepEvery  = "pruning-keep-every"
+	FlagPruningInterval   = "pruning-interval"
+
+	FlagSnapshotInterval   = "snapshot-interval"
+	FlagSnapshotKeepRecent = "snapshot-keep-recent"
 )
 
 // StartCmd runs the service passed in, either stand-alone or in-process with
@@ -45,6 +52,10 @@ Pruning options can be provided via the '--pruning' flag. The options are as fol
 syncable: only those states not needed for state syncing will be deleted (keeps last 100 + every 10000th)
 nothing: all historic states will be saved, nothing will be deleted (i.e. archiving node)
 everything: all saved states will be deleted, storing only the current state
+custom: the states kept are set by the '--pruning-keep-recent' and '--pruning-keep-every' flags
+
+States which are no longer kept are deleted in the background every 10 blocks, or every
+'--pruning-interval' blocks with the custom strategy.
 
 Node halting configurations exist in the form of two flags: '--halt-height' and '--halt-time'. During
 the ABCI Commit phase, the node will check if the current block height is greater than or equal to
@@ -52,6 +63,10 @@ the halt-height or if the current block time is greater than or equal to the hal
 node will attempt to gracefully shutdown and the block will not be committed. In addition, the node
 will not be able to commit subsequent blocks.
 
+Snapshots of the committed state can be taken every given number of blocks via the
+'--snapshot-interval' flag, while '--snapshot-keep-recent' sets how many of the most recent
+snapshots are kept. Snapshots are saved in the data/snapshots directory of the node's home.
+
 For profiling and benchmarking purposes, CPU profiling can be enabled via the '--cpu-profile' flag
 which accepts a path for the resulting pprof file.
 `,
@@ -72,7 +87,7 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Bool(flagWithTendermint, true, "Run abci app embedded in-process with tendermint")
 	cmd.Flags().String(flagAddress, "tcp://0.0.0.0:26658", "Listen address")
 	cmd.Flags().String(flagTraceStore, "", "Enable KVStore tracing to an output file")
-	cmd.Flags().String(flagPruning, "syncable", "Pruning strategy: syncable, nothing, everything")
+	registerPruningFlags(cmd)
 	cmd.Flags().String(
 		FlagMinGasPrices, "",
 		"Minimum gas prices to accept for transactions; Any fee in a tx must meet this minimum (e.g. 0.01photino;0.0001stake)",
@@ -80,6 +95,8 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Uint64(FlagHaltHeight, 0, "Block height at which to gracefully halt the chain and shutdown the node")
 	cmd.Flags().Uint64(FlagHaltTime, 0, "Minimum block time (in Unix seconds) at which to gracefully halt the chain and shutdown the node")
 	cmd.Flags().Bool(FlagInterBlockCache, true, "Enable inter-block caching")
+	cmd.Flags().Uint64(FlagSnapshotInterval, 0, "Block interval at which to take state snapshots (0 to disable)")
+	cmd.Flags().Uint32(FlagSnapshotKeepRecent, 2, "Number of recent state snapshots to keep (0 to keep all)")
 	cmd.Flags().String(flagCPUProfile, "", "Enable CPU profiling and write to the provided file")
 
 	// add support for all Tendermint-specific command line options
@@ -142,6 +142,8 @@ func AddCommands(
 		flags.LineBreak,
 		tendermintCmd,
 		ExportCmd(ctx, cdc, appExport),
+		SnapshotsCmd(ctx, appCreator),
+		PruneCmd(ctx),
 		flags.LineBreak,
 		version.Cmd,
 	)
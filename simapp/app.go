@@ -15,11 +15,16 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	distr "github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/evidence"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	"github.com/cosmos/cosmos-sdk/x/gov"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	"github.com/cosmos/cosmos-sdk/x/ibc"
 	"github.com/cosmos/cosmos-sdk/x/mint"
 	"github.com/cosmos/cosmos-sdk/x/nft"
 	"github.com/cosmos/cosmos-sdk/x/params"
@@ -27,6 +32,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/cosmos/cosmos-sdk/x/transfer"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
+	upgradeclient "github.com/cosmos/cosmos-sdk/x/upgrade/client"
 )
 
 const appName = "SimApp"
@@ -49,11 +57,21 @@ var (
 		staking.AppModuleBasic{},
 		mint.AppModuleBasic{},
 		distr.AppModuleBasic{},
-		gov.NewAppModuleBasic(paramsclient.ProposalHandler, distr.ProposalHandler),
+		gov.NewAppModuleBasic(
+			paramsclient.ProposalHandler, distr.ProposalHandler,
+			upgradeclient.ProposalHandler, upgradeclient.CancelProposalHandler,
+			govclient.ExecProposalHandler,
+		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
 		slashing.AppModuleBasic{},
 		nft.AppModuleBasic{},
+		upgrade.AppModuleBasic{},
+		feegrant.AppModuleBasic{},
+		evidence.AppModuleBasic{},
+		authz.AppModuleBasic{},
+		ibc.AppModuleBasic{},
+		transfer.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -64,9 +82,16 @@ var (
 		staking.BondedPoolName:    {supply.Burner, supply.Staking},
 		staking.NotBondedPoolName: {supply.Burner, supply.Staking},
 		gov.ModuleName:            {supply.Burner},
+		transfer.ModuleName:       {supply.Minter, supply.Burner},
 	}
 )
 
+// register the messages which can be executed by exec proposals
+func init() {
+	gov.RegisterExecMsgTypeCodec(bank.MsgSend{}, "cosmos-sdk/MsgSend")
+	gov.RegisterExecMsgTypeCodec(bank.MsgMultiSend{}, "cosmos-sdk/MsgMultiSend")
+}
+
 // custom tx codec
 func MakeCodec() *codec.Codec {
 	var cdc = codec.New()
@@ -101,6 +126,12 @@ type SimApp struct {
 	CrisisKeeper   crisis.Keeper
 	ParamsKeeper   params.Keeper
 	NFTKeeper      nft.Keeper
+	UpgradeKeeper  upgrade.Keeper
+	FeeGrantKeeper feegrant.Keeper
+	EvidenceKeeper evidence.Keeper
+	AuthzKeeper    authz.Keeper
+	IBCKeeper      ibc.Keeper
+	TransferKeeper transfer.Keeper
 
 	// the module manager
 	mm *module.Manager
@@ -109,9 +140,10 @@ type SimApp struct {
 	sm *module.SimulationManager
 }
 
-// NewSimApp returns a reference to an initialized SimApp.
+// NewSimApp returns a reference to an initialized SimApp. The upgrade keeper
+// writes its upgrade info under homePath, which should be the node's home directory.
 func NewSimApp(
-	logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bool,
+	logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bool, homePath string,
 	invCheckPeriod uint, baseAppOptions ...func(*bam.BaseApp),
 ) *SimApp {
 
@@ -121,9 +153,11 @@ func NewSimApp(
 	bApp.SetCommitMultiStoreTracer(traceStore)
 	bApp.SetAppVersion(version.Version)
 
-	keys := sdk.NewKVStoreKeys(bam.MainStoreKey, auth.StoreKey, staking.StoreKey,
+	keys := sdk.NewKVStoreKeys(bam.MainStoreKey, auth.StoreKey, bank.StoreKey, staking.StoreKey,
 		supply.StoreKey, mint.StoreKey, distr.StoreKey, slashing.StoreKey,
-		gov.StoreKey, params.StoreKey, nft.StoreKey)
+		gov.StoreKey, params.StoreKey, nft.StoreKey, upgrade.StoreKey,
+		feegrant.StoreKey, evidence.StoreKey, authz.StoreKey, ibc.StoreKey,
+		transfer.StoreKey)
 	tkeys := sdk.NewTransientStoreKeys(staking.TStoreKey, params.TStoreKey)
 
 	app := &SimApp{
@@ -147,23 +181,46 @@ func NewSimApp(
 
 	// add keepers
 	app.AccountKeeper = auth.NewAccountKeeper(app.cdc, keys[auth.StoreKey], authSubspace, auth.ProtoBaseAccount)
-	app.BankKeeper = bank.NewBaseKeeper(app.AccountKeeper, bankSubspace, bank.DefaultCodespace, app.ModuleAccountAddrs())
+	app.BankKeeper = bank.NewBaseKeeper(app.cdc, keys[bank.StoreKey], app.AccountKeeper, bankSubspace, bank.DefaultCodespace, app.BlacklistedAccAddrs(), nil)
 	app.SupplyKeeper = supply.NewKeeper(app.cdc, keys[supply.StoreKey], app.AccountKeeper, app.BankKeeper, maccPerms)
 	stakingKeeper := staking.NewKeeper(app.cdc, keys[staking.StoreKey], tkeys[staking.TStoreKey],
 		app.SupplyKeeper, stakingSubspace, staking.DefaultCodespace)
 	app.MintKeeper = mint.NewKeeper(app.cdc, keys[mint.StoreKey], mintSubspace, &stakingKeeper, app.SupplyKeeper, auth.FeeCollectorName)
 	app.DistrKeeper = distr.NewKeeper(app.cdc, keys[distr.StoreKey], distrSubspace, &stakingKeeper,
-		app.SupplyKeeper, distr.DefaultCodespace, auth.FeeCollectorName, app.ModuleAccountAddrs())
+		app.SupplyKeeper, distr.DefaultCodespace, auth.FeeCollectorName, app.BlacklistedAccAddrs())
 	app.SlashingKeeper = slashing.NewKeeper(app.cdc, keys[slashing.StoreKey], &stakingKeeper,
 		slashingSubspace, slashing.DefaultCodespace)
 	app.CrisisKeeper = crisis.NewKeeper(crisisSubspace, invCheckPeriod, app.SupplyKeeper, auth.FeeCollectorName)
 	app.NFTKeeper = nft.NewKeeper(app.cdc, keys[nft.StoreKey])
+	app.UpgradeKeeper = upgrade.NewKeeper(app.cdc, keys[upgrade.StoreKey], homePath)
+	app.FeeGrantKeeper = feegrant.NewKeeper(app.cdc, keys[feegrant.StoreKey])
+	app.AuthzKeeper = authz.NewKeeper(app.cdc, keys[authz.StoreKey], app.Router())
+
+	// create evidence keeper with router
+	evidenceKeeper := evidence.NewKeeper(app.cdc, keys[evidence.StoreKey], &stakingKeeper,
+		app.SlashingKeeper, evidence.DefaultCodespace)
+	evidenceRouter := evidence.NewRouter().
+		AddRoute(evidence.RouteEquivocation, evidence.NewEquivocationHandler(*evidenceKeeper))
+	evidenceKeeper.SetRouter(evidenceRouter)
+	app.EvidenceKeeper = *evidenceKeeper
+
+	// create ibc keeper with the router binding the ports to their modules
+	ibcKeeper := ibc.NewKeeper(app.cdc, keys[ibc.StoreKey], ibc.DefaultCodespace)
+	app.TransferKeeper = transfer.NewKeeper(app.cdc, keys[transfer.StoreKey], transfer.DefaultCodespace,
+		ibcKeeper, app.SupplyKeeper)
+	transferModule := transfer.NewAppModule(app.TransferKeeper, app.SupplyKeeper)
+	ibcRouter := ibc.NewRouter().
+		AddRoute(transfer.PortID, transferModule)
+	ibcKeeper.SetRouter(ibcRouter)
+	app.IBCKeeper = *ibcKeeper
 
 	// register the proposal types
 	govRouter := gov.NewRouter()
 	govRouter.AddRoute(gov.RouterKey, gov.ProposalHandler).
 		AddRoute(params.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
-		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper))
+		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
+		AddRoute(upgrade.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
+		AddRoute(gov.ExecRouterKey, gov.NewExecProposalHandler(app.Router()))
 	app.GovKeeper = gov.NewKeeper(app.cdc, keys[gov.StoreKey], govSubspace,
 		app.SupplyKeeper, &stakingKeeper, gov.DefaultCodespace, govRouter)
 
@@ -187,12 +244,21 @@ func NewSimApp(
 		slashing.NewAppModule(app.SlashingKeeper, app.StakingKeeper),
 		staking.NewAppModule(app.StakingKeeper, app.AccountKeeper, app.SupplyKeeper),
 		nft.NewAppModule(app.NFTKeeper),
+		upgrade.NewAppModule(app.UpgradeKeeper),
+		feegrant.NewAppModule(app.FeeGrantKeeper),
+		evidence.NewAppModule(app.EvidenceKeeper),
+		authz.NewAppModule(app.AuthzKeeper),
+		ibc.NewAppModule(app.IBCKeeper),
+		transferModule,
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
 	// there is nothing left over in the validator fee pool, so as to keep the
-	// CanWithdrawInvariant invariant.
-	app.mm.SetOrderBeginBlockers(mint.ModuleName, distr.ModuleName, slashing.ModuleName)
+	// CanWithdrawInvariant invariant. The upgrade module must run first so that
+	// no other module executes a block the running binary should not process.
+	app.mm.SetOrderBeginBlockers(
+		upgrade.ModuleName, mint.ModuleName, distr.ModuleName, slashing.ModuleName, evidence.ModuleName,
+	)
 
 	app.mm.SetOrderEndBlockers(crisis.ModuleName, gov.ModuleName, staking.ModuleName)
 
@@ -200,8 +266,9 @@ func NewSimApp(
 	// properly initialized with tokens from genesis accounts.
 	app.mm.SetOrderInitGenesis(
 		auth.ModuleName, distr.ModuleName, staking.ModuleName,
-		bank.ModuleName, slashing.ModuleName, gov.ModuleName,
+		bank.ModuleName, slashing.ModuleName, evidence.ModuleName, gov.ModuleName,
 		mint.ModuleName, supply.ModuleName, crisis.ModuleName, nft.ModuleName,
+		feegrant.ModuleName, authz.ModuleName, ibc.ModuleName, transfer.ModuleName,
 		genutil.ModuleName,
 	)
 
@@ -233,7 +300,7 @@ func NewSimApp(
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
-	app.SetAnteHandler(auth.NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, auth.DefaultSigVerificationGasConsumer))
+	app.SetAnteHandler(feegrant.NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, app.FeeGrantKeeper, auth.DefaultSigVerificationGasConsumer))
 	app.SetEndBlocker(app.EndBlocker)
 
 	if loadLatest {
@@ -277,6 +344,16 @@ func (app *SimApp) ModuleAccountAddrs() map[string]bool {
 	return modAccAddrs
 }
 
+// BlacklistedAccAddrs returns the app's module account addresses which can not
+// receive coins. The governance module account can, for exec proposals to spend
+// them.
+func (app *SimApp) BlacklistedAccAddrs() map[string]bool {
+	blacklistedAddrs := app.ModuleAccountAddrs()
+	delete(blacklistedAddrs, supply.NewModuleAddress(gov.ModuleName).String())
+
+	return blacklistedAddrs
+}
+
 // Codec returns simapp's codec
 func (app *SimApp) Codec() *codec.Codec {
 	return app.cdc
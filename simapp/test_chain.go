@@ -0,0 +1,339 @@
+package simapp
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/ibc"
+)
+
+// TestChain is a SimApp run in-process as a chain whose blocks are signed by a
+// mock validator, so that its headers and state can be tracked and verified by
+// the ibc module of another TestChain without any network. Messages are
+// delivered to the handlers of the current block, and the relayer of the test
+// submits the headers and proofs of a chain to its counterparty.
+type TestChain struct {
+	t *testing.T
+
+	App     *SimApp
+	ChainID string
+
+	// Header is the header of the block being executed
+	Header abci.Header
+
+	// Relayer is the signer of the ibc messages relayed to the chain
+	Relayer sdk.AccAddress
+
+	vals    *tmtypes.ValidatorSet
+	signers []tmtypes.PrivValidator
+}
+
+// NewTestChain initializes a new TestChain with the passed in genesis
+// accounts, and begins its first block.
+func NewTestChain(t *testing.T, chainID string, genAccs ...authexported.GenesisAccount) *TestChain {
+	app := NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, DefaultNodeHome, 0)
+
+	genesisState := NewDefaultGenesisState()
+	authGenesis := auth.NewGenesisState(auth.DefaultParams(), genAccs)
+	genesisState[auth.ModuleName] = app.cdc.MustMarshalJSON(authGenesis)
+
+	stateBytes, err := codec.MarshalJSONIndent(app.cdc, genesisState)
+	require.NoError(t, err)
+
+	app.InitChain(
+		abci.RequestInitChain{
+			ChainId:       chainID,
+			Validators:    []abci.ValidatorUpdate{},
+			AppStateBytes: stateBytes,
+		},
+	)
+	app.Commit()
+
+	signer := tmtypes.NewMockPV()
+	val := tmtypes.NewValidator(signer.GetPubKey(), 1)
+
+	chain := &TestChain{
+		t:       t,
+		App:     app,
+		ChainID: chainID,
+		Relayer: sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()),
+		vals:    tmtypes.NewValidatorSet([]*tmtypes.Validator{val}),
+		signers: []tmtypes.PrivValidator{signer},
+		Header: abci.Header{
+			ChainID: chainID,
+			Height:  app.LastBlockHeight() + 1,
+			Time:    time.Now().UTC(),
+			AppHash: app.LastCommitID().Hash,
+		},
+	}
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: chain.Header})
+	return chain
+}
+
+// Context returns a context of the block being executed.
+func (chain *TestChain) Context() sdk.Context {
+	return chain.App.NewContext(false, chain.Header)
+}
+
+// NextBlock ends and commits the block being executed, and begins the next
+// block, whose header commits to the state of the committed block.
+func (chain *TestChain) NextBlock() {
+	chain.App.EndBlock(abci.RequestEndBlock{Height: chain.Header.Height})
+	chain.App.Commit()
+
+	chain.Header = abci.Header{
+		ChainID: chain.ChainID,
+		Height:  chain.App.LastBlockHeight() + 1,
+		Time:    chain.Header.Time.Add(5 * time.Second),
+		AppHash: chain.App.LastCommitID().Hash,
+	}
+	chain.App.BeginBlock(abci.RequestBeginBlock{Header: chain.Header})
+}
+
+// Deliver executes messages in the block being executed through the handlers
+// of the modules of the SimApp. The state changes of the messages are only
+// written if all of them succeed.
+func (chain *TestChain) Deliver(msgs ...sdk.Msg) sdk.Result {
+	ctx, writeCache := chain.Context().CacheContext()
+
+	var res sdk.Result
+	for _, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return err.Result()
+		}
+
+		res = chain.handler(msg.Route())(ctx, msg)
+		if !res.IsOK() {
+			return res
+		}
+	}
+
+	writeCache()
+	return res
+}
+
+// handler returns the handler of the module routing messages of a route. The
+// router of the SimApp cannot be accessed once it is sealed.
+func (chain *TestChain) handler(route string) sdk.Handler {
+	for _, m := range chain.App.mm.Modules {
+		if m.Route() == route {
+			return m.NewHandler()
+		}
+	}
+
+	require.FailNow(chain.t, "unrecognized message route", route)
+	return nil
+}
+
+// DeliverOK executes messages like Deliver and requires them to succeed.
+func (chain *TestChain) DeliverOK(msgs ...sdk.Msg) sdk.Result {
+	res := chain.Deliver(msgs...)
+	require.True(chain.t, res.IsOK(), res.Log)
+	return res
+}
+
+// IBCHeader returns the header of the block being executed, signed by the
+// validator of the chain, as tracked by ibc light clients. Its root commits to
+// the state of the last committed block.
+func (chain *TestChain) IBCHeader() ibc.Header {
+	valsHash := chain.vals.Hash()
+	header := tmtypes.Header{
+		ChainID:            chain.ChainID,
+		Height:             chain.Header.Height,
+		Time:               chain.Header.Time,
+		AppHash:            chain.Header.AppHash,
+		ValidatorsHash:     valsHash,
+		NextValidatorsHash: valsHash,
+		ProposerAddress:    chain.vals.Proposer.Address,
+	}
+
+	blockID := tmtypes.BlockID{Hash: header.Hash()}
+	voteSet := tmtypes.NewVoteSet(chain.ChainID, header.Height, 1, tmtypes.PrecommitType, chain.vals)
+	commit, err := tmtypes.MakeCommit(blockID, header.Height, 1, voteSet, chain.signers)
+	require.NoError(chain.t, err)
+
+	return ibc.NewHeader(tmtypes.SignedHeader{Header: &header, Commit: commit}, chain.vals)
+}
+
+// QueryProof returns the proof of the value, or of the absence, of a key of
+// the ibc store in the last committed block, along with the height of the
+// header of the block being executed, which commits to it.
+func (chain *TestChain) QueryProof(key []byte) (ibc.MerkleProof, uint64) {
+	res := chain.App.Query(abci.RequestQuery{
+		Path:   fmt.Sprintf("/store/%s/key", ibc.StoreKey),
+		Data:   key,
+		Height: chain.App.LastBlockHeight(),
+		Prove:  true,
+	})
+	require.True(chain.t, res.IsOK(), res.Log)
+
+	return ibc.NewMerkleProof(res.Proof), uint64(chain.Header.Height)
+}
+
+// CreateClient creates a light client of the counterparty chain, trusting its
+// current header, and commits it.
+func (chain *TestChain) CreateClient(counterparty *TestChain, clientID string) {
+	chain.DeliverOK(ibc.NewMsgCreateClient(clientID, counterparty.IBCHeader(), chain.Relayer))
+	chain.NextBlock()
+}
+
+// UpdateClient updates a light client of the counterparty chain with its
+// current header, unless the client already tracks it.
+func (chain *TestChain) UpdateClient(counterparty *TestChain, clientID string) {
+	client, found := chain.App.IBCKeeper.GetClientState(chain.Context(), clientID)
+	require.True(chain.t, found, "client %s not found", clientID)
+
+	if client.LatestHeight < uint64(counterparty.Header.Height) {
+		chain.DeliverOK(ibc.NewMsgUpdateClient(clientID, counterparty.IBCHeader(), chain.Relayer))
+	}
+}
+
+// TestEndpoint is an end of a channel between two TestChains, identified by
+// the client of the chain tracking the counterparty chain, and by the
+// connection and the port and channel of the chain.
+type TestEndpoint struct {
+	Chain        *TestChain
+	ClientID     string
+	ConnectionID string
+	PortID       string
+	ChannelID    string
+}
+
+// NewTestEndpoint creates a new TestEndpoint instance
+func NewTestEndpoint(chain *TestChain, clientID, connectionID, portID, channelID string) *TestEndpoint {
+	return &TestEndpoint{
+		Chain:        chain,
+		ClientID:     clientID,
+		ConnectionID: connectionID,
+		PortID:       portID,
+		ChannelID:    channelID,
+	}
+}
+
+// CreateClients creates the light clients of the two endpoints, each tracking
+// the chain of the other endpoint.
+func CreateClients(a, b *TestEndpoint) {
+	a.Chain.CreateClient(b.Chain, a.ClientID)
+	b.Chain.CreateClient(a.Chain, b.ClientID)
+}
+
+// OpenConnection opens a connection between the two endpoints, initialized by
+// the first endpoint. The clients of the endpoints must have been created.
+func OpenConnection(a, b *TestEndpoint) {
+	a.Chain.DeliverOK(ibc.NewMsgConnectionOpenInit(a.ConnectionID, a.ClientID,
+		ibc.NewConnectionCounterparty(b.ClientID, b.ConnectionID, ibc.DefaultPrefix), a.Chain.Relayer))
+	a.Chain.NextBlock()
+
+	b.Chain.UpdateClient(a.Chain, b.ClientID)
+	proof, proofHeight := a.Chain.QueryProof(ibc.ConnectionKey(a.ConnectionID))
+	b.Chain.DeliverOK(ibc.NewMsgConnectionOpenTry(b.ConnectionID, b.ClientID,
+		ibc.NewConnectionCounterparty(a.ClientID, a.ConnectionID, ibc.DefaultPrefix),
+		proof, proofHeight, b.Chain.Relayer))
+	b.Chain.NextBlock()
+
+	a.Chain.UpdateClient(b.Chain, a.ClientID)
+	proof, proofHeight = b.Chain.QueryProof(ibc.ConnectionKey(b.ConnectionID))
+	a.Chain.DeliverOK(ibc.NewMsgConnectionOpenAck(a.ConnectionID, proof, proofHeight, a.Chain.Relayer))
+	a.Chain.NextBlock()
+
+	b.Chain.UpdateClient(a.Chain, b.ClientID)
+	proof, proofHeight = a.Chain.QueryProof(ibc.ConnectionKey(a.ConnectionID))
+	b.Chain.DeliverOK(ibc.NewMsgConnectionOpenConfirm(b.ConnectionID, proof, proofHeight, b.Chain.Relayer))
+	b.Chain.NextBlock()
+}
+
+// OpenChannel opens a channel between the ports of the two endpoints over
+// their connection, initialized by the first endpoint. The connection of the
+// endpoints must be open.
+func OpenChannel(a, b *TestEndpoint, order ibc.Order, version string) {
+	a.Chain.DeliverOK(ibc.NewMsgChannelOpenInit(a.PortID, a.ChannelID,
+		ibc.NewChannel(ibc.StateInit, order, ibc.NewChannelCounterparty(b.PortID, b.ChannelID),
+			[]string{a.ConnectionID}, version), a.Chain.Relayer))
+	a.Chain.NextBlock()
+
+	b.Chain.UpdateClient(a.Chain, b.ClientID)
+	proof, proofHeight := a.Chain.QueryProof(ibc.ChannelKey(a.PortID, a.ChannelID))
+	b.Chain.DeliverOK(ibc.NewMsgChannelOpenTry(b.PortID, b.ChannelID,
+		ibc.NewChannel(ibc.StateTryOpen, order, ibc.NewChannelCounterparty(a.PortID, a.ChannelID),
+			[]string{b.ConnectionID}, version), proof, proofHeight, b.Chain.Relayer))
+	b.Chain.NextBlock()
+
+	a.Chain.UpdateClient(b.Chain, a.ClientID)
+	proof, proofHeight = b.Chain.QueryProof(ibc.ChannelKey(b.PortID, b.ChannelID))
+	a.Chain.DeliverOK(ibc.NewMsgChannelOpenAck(a.PortID, a.ChannelID, proof, proofHeight, a.Chain.Relayer))
+	a.Chain.NextBlock()
+
+	b.Chain.UpdateClient(a.Chain, b.ClientID)
+	proof, proofHeight = a.Chain.QueryProof(ibc.ChannelKey(a.PortID, a.ChannelID))
+	b.Chain.DeliverOK(ibc.NewMsgChannelOpenConfirm(b.PortID, b.ChannelID, proof, proofHeight, b.Chain.Relayer))
+	b.Chain.NextBlock()
+}
+
+// Connect creates the clients of the two endpoints and opens a connection and
+// a channel between them.
+func Connect(a, b *TestEndpoint, order ibc.Order, version string) {
+	CreateClients(a, b)
+	OpenConnection(a, b)
+	OpenChannel(a, b, order, version)
+}
+
+// RelayPacket relays a packet committed to by the chain of its source endpoint
+// to the chain of its destination endpoint, and returns the result of
+// receiving it. The packet must have been committed.
+func RelayPacket(src, dst *TestEndpoint, packet ibc.Packet) sdk.Result {
+	dst.Chain.UpdateClient(src.Chain, dst.ClientID)
+	proof, proofHeight := src.Chain.QueryProof(
+		ibc.PacketCommitmentKey(packet.SourcePort, packet.SourceChannel, packet.Sequence))
+	return dst.Chain.Deliver(ibc.NewMsgRecvPacket(packet, proof, proofHeight, dst.Chain.Relayer))
+}
+
+// RelayAcknowledgement relays the acknowledgement of a packet by the chain of
+// its destination endpoint back to the chain of its source endpoint, and
+// returns the result of acknowledging it. The acknowledgement must have been
+// committed.
+func RelayAcknowledgement(src, dst *TestEndpoint, packet ibc.Packet, ack []byte) sdk.Result {
+	src.Chain.UpdateClient(dst.Chain, src.ClientID)
+	proof, proofHeight := dst.Chain.QueryProof(
+		ibc.PacketAcknowledgementKey(packet.DestinationPort, packet.DestinationChannel, packet.Sequence))
+	return src.Chain.Deliver(ibc.NewMsgAcknowledgement(packet, ack, proof, proofHeight, src.Chain.Relayer))
+}
+
+// RelayTimeout relays the proof that the chain of the destination endpoint of
+// a packet has not received it to the chain of its source endpoint, and
+// returns the result of timing it out. The proof is of the absence of the
+// acknowledgement of the packet on unordered channels, and of the next receive
+// sequence of the channel on ordered channels.
+func RelayTimeout(src, dst *TestEndpoint, packet ibc.Packet) sdk.Result {
+	src.Chain.UpdateClient(dst.Chain, src.ClientID)
+
+	channel, found := src.Chain.App.IBCKeeper.GetChannel(src.Chain.Context(), packet.SourcePort, packet.SourceChannel)
+	require.True(src.Chain.t, found)
+
+	var (
+		key              []byte
+		nextSequenceRecv uint64
+	)
+	if channel.Ordering == ibc.OrderOrdered {
+		key = ibc.NextSequenceRecvKey(packet.DestinationPort, packet.DestinationChannel)
+		nextSequenceRecv = dst.Chain.App.IBCKeeper.GetNextSequenceRecv(
+			dst.Chain.App.NewContext(true, abci.Header{}), packet.DestinationPort, packet.DestinationChannel)
+	} else {
+		key = ibc.PacketAcknowledgementKey(packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+	}
+
+	proof, proofHeight := dst.Chain.QueryProof(key)
+	return src.Chain.Deliver(ibc.NewMsgTimeout(packet, nextSequenceRecv, proof, proofHeight, src.Chain.Relayer))
+}
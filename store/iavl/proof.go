@@ -0,0 +1,245 @@
+package iavl
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/tendermint/iavl"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// ProofOpIAVLRange is the type of the proof operation of a range proof
+const ProofOpIAVLRange = "iavl:r"
+
+var _ merkle.ProofOperator = RangeProofOp{}
+
+// RangeProofOp is a proof operation proving all the key-value pairs of an IAVL
+// tree whose keys start with a prefix, the key of the operation. Its only
+// argument is the amino encoding of the key-value pairs, as returned by a
+// subspace query, so that the absence of any key of the subspace which is not
+// in the pairs is proven too.
+type RangeProofOp struct {
+	// Encoded in ProofOp.Key
+	prefix []byte
+
+	// To encode in ProofOp.Data.
+	// Proof is nil for an empty tree.
+	Proof *iavl.RangeProof `json:"proof"`
+}
+
+// NewRangeProofOp creates a new RangeProofOp instance
+func NewRangeProofOp(prefix []byte, proof *iavl.RangeProof) RangeProofOp {
+	return RangeProofOp{
+		prefix: prefix,
+		Proof:  proof,
+	}
+}
+
+// RangeProofOpDecoder returns a range proof operator from a given proof
+// operation.
+func RangeProofOpDecoder(pop merkle.ProofOp) (merkle.ProofOperator, error) {
+	if pop.Type != ProofOpIAVLRange {
+		return nil, errors.Errorf("unexpected ProofOp.Type; got %v, want %v", pop.Type, ProofOpIAVLRange)
+	}
+
+	var op RangeProofOp
+	if err := cdc.UnmarshalBinaryLengthPrefixed(pop.Data, &op); err != nil {
+		return nil, errors.Wrap(err, "decoding ProofOp.Data into RangeProofOp")
+	}
+
+	return NewRangeProofOp(pop.Key, op.Proof), nil
+}
+
+// ProofOp returns a merkle proof operation from a range proof operation.
+func (op RangeProofOp) ProofOp() merkle.ProofOp {
+	bz := cdc.MustMarshalBinaryLengthPrefixed(op)
+	return merkle.ProofOp{
+		Type: ProofOpIAVLRange,
+		Key:  op.prefix,
+		Data: bz,
+	}
+}
+
+// String implements the Stringer interface for a range proof operation.
+func (op RangeProofOp) String() string {
+	return fmt.Sprintf("RangeProofOp{%v}", op.GetKey())
+}
+
+// GetKey returns the prefix of the keys proven by a range proof operation.
+func (op RangeProofOp) GetKey() []byte {
+	return op.prefix
+}
+
+// Run verifies that the key-value pairs of its argument are exactly the pairs
+// of the subspace of the prefix in the tree proven by the range proof, and
+// returns the root hash of the tree.
+func (op RangeProofOp) Run(args [][]byte) ([][]byte, error) {
+	if len(args) != 1 {
+		return nil, errors.Errorf("expected 1 arg, got %v", len(args))
+	}
+
+	var kvs []types.KVPair
+	if err := cdc.UnmarshalBinaryLengthPrefixed(args[0], &kvs); err != nil {
+		return nil, errors.Wrap(err, "decoding key-value pairs")
+	}
+
+	// If the tree is nil, the proof is nil, and all subspaces are empty.
+	if op.Proof == nil {
+		if len(kvs) != 0 {
+			return nil, errors.New("key-value pairs of an empty tree")
+		}
+		return [][]byte{[]byte(nil)}, nil
+	}
+
+	// Compute the root hash and assume it is valid.
+	// The caller checks the ultimate root later.
+	root := op.Proof.ComputeRootHash()
+	if err := op.Proof.Verify(root); err != nil {
+		return nil, errors.Wrap(err, "computing root hash")
+	}
+
+	if err := verifyRange(op.Proof, op.prefix, types.PrefixEndBytes(op.prefix), kvs); err != nil {
+		return nil, errors.Wrap(err, "verifying range")
+	}
+	return [][]byte{root}, nil
+}
+
+// verifyRange checks that the leaves of a verified range proof are exactly the
+// key-value pairs of the range [start, end) of the tree, which requires the
+// leaves to be consecutive leaves of the tree surrounding the range.
+func verifyRange(proof *iavl.RangeProof, start, end []byte, kvs []types.KVPair) error {
+	// The paths to the leaves following the first one must lead to the
+	// leftmost leaves of the subtrees on their right, so that no leaf is
+	// skipped.
+	for _, inners := range proof.InnerNodes {
+		for _, node := range inners {
+			if len(node.Left) != 0 {
+				return errors.New("leaves of the proof are not consecutive")
+			}
+		}
+	}
+
+	if proof.LeftIndex() < 0 {
+		return errors.New("invalid path to the first leaf of the proof")
+	}
+
+	leaves := proof.Leaves
+	if bytes.Compare(leaves[0].Key, start) > 0 && proof.LeftIndex() != 0 {
+		return errors.New("range start not proved by the first leaf")
+	}
+
+	last := leaves[len(leaves)-1].Key
+	if (end == nil || bytes.Compare(last, end) < 0) && !isTreeEnd(proof) {
+		return errors.New("range end not proved by the last leaf")
+	}
+
+	i := 0
+	for _, leaf := range leaves {
+		if bytes.Compare(leaf.Key, start) < 0 || (end != nil && bytes.Compare(leaf.Key, end) >= 0) {
+			continue
+		}
+
+		if i >= len(kvs) || !bytes.Equal(kvs[i].Key, leaf.Key) {
+			return errors.Errorf("key %X of the range is missing", leaf.Key)
+		}
+		if !bytes.Equal(tmhash.Sum(kvs[i].Value), leaf.ValueHash) {
+			return errors.Errorf("value of key %X does not match", leaf.Key)
+		}
+		i++
+	}
+
+	if i != len(kvs) {
+		return errors.Errorf("key %X is not in the range", kvs[i].Key)
+	}
+	return nil
+}
+
+// isTreeEnd returns true if the last leaf of a verified range proof is the
+// last leaf of the tree, i.e. if the number of leaves up to it is the size of
+// the root, the first node of the paths.
+func isTreeEnd(proof *iavl.RangeProof) bool {
+	size := int64(1)
+	if len(proof.LeftPath) > 0 {
+		size = proof.LeftPath[0].Size
+	}
+	return proof.LeftIndex()+int64(len(proof.Leaves)) == size
+}
+
+// getSubspaceWithProof returns the key-value pairs of the tree at a version
+// whose keys start with a prefix, along with a range proof of the subspace.
+// The range proofs of IAVL trees may be invalid when the range does not start
+// with the first leaf of the tree, so the proof is assembled from the paths to
+// each of its leaves: the path to the first leaf, and for each following leaf
+// the part of its path below the node where it diverges from the previous one.
+func getSubspaceWithProof(tree Tree, prefix []byte, version int64) ([]types.KVPair, *iavl.RangeProof, error) {
+	itree, err := tree.GetImmutable(version)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	end := types.PrefixEndBytes(prefix)
+
+	// The leaves of the proof are the leaves of the subspace surrounded by the
+	// leaf before its first key and the leaf after its last key.
+	var (
+		kvs  []types.KVPair
+		keys [][]byte
+	)
+	if len(prefix) > 0 {
+		itree.IterateRange(nil, prefix, false, func(key, _ []byte) bool {
+			keys = append(keys, key)
+			return true
+		})
+	}
+	itree.IterateRange(prefix, end, true, func(key, value []byte) bool {
+		kvs = append(kvs, types.KVPair{Key: key, Value: value})
+		keys = append(keys, key)
+		return false
+	})
+	if end != nil {
+		itree.IterateRange(end, nil, true, func(key, _ []byte) bool {
+			keys = append(keys, key)
+			return true
+		})
+	}
+
+	// the proof of an empty tree is nil
+	var (
+		proof    *iavl.RangeProof
+		prevPath iavl.PathToLeaf
+	)
+	for _, key := range keys {
+		_, leafProof, err := itree.GetWithProof(key)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		path := leafProof.LeftPath
+		if proof == nil {
+			proof = leafProof
+			prevPath = path
+			continue
+		}
+
+		// The paths are ordered from the root, and the paths to consecutive
+		// leaves are the same down to the node where the previous one goes
+		// left and the next one goes right.
+		d := 0
+		for d < len(path) && bytes.Equal(path[d].Left, prevPath[d].Left) {
+			d++
+		}
+		if d == len(path) {
+			return nil, nil, errors.Errorf("paths to keys %X and %X do not diverge", proof.Leaves[len(proof.Leaves)-1].Key, key)
+		}
+
+		proof.InnerNodes = append(proof.InnerNodes, path[d+1:])
+		proof.Leaves = append(proof.Leaves, leafProof.Leaves...)
+		prevPath = path
+	}
+
+	return kvs, proof, nil
+}
@@ -0,0 +1,242 @@
+package iavl
+
+import (
+	"bytes"
+	"fmt"
+
+	amino "github.com/tendermint/go-amino"
+	"github.com/tendermint/iavl"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// The key formats below mirror the ones used internally by the IAVL nodedb so
+// that persisted nodes can be copied between databases without being loaded
+// into a tree.
+var (
+	nodeKeyFormat = iavl.NewKeyFormat('n', tmhash.Size) // n<hash>
+	rootKeyFormat = iavl.NewKeyFormat('r', 8)           // r<version>
+)
+
+// ExportNodes walks the persisted IAVL tree rooted at the given version of db
+// in pre-order and passes the raw bytes of every node to fn. It returns the
+// root hash of the exported version, which is empty for an empty tree.
+func ExportNodes(db dbm.DB, version int64, fn func(node []byte) error) ([]byte, error) {
+	rootHash := db.Get(rootKeyFormat.Key(version))
+	if rootHash == nil {
+		return nil, fmt.Errorf("version %d does not exist", version)
+	}
+	if len(rootHash) == 0 {
+		return rootHash, nil
+	}
+
+	stack := [][]byte{rootHash}
+	for len(stack) > 0 {
+		hash := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		bz := db.Get(nodeKeyFormat.KeyBytes(hash))
+		if bz == nil {
+			return nil, fmt.Errorf("node %X of version %d not found", hash, version)
+		}
+
+		node, err := decodeNode(bz)
+		if err != nil {
+			return nil, err
+		}
+		if err := fn(bz); err != nil {
+			return nil, err
+		}
+
+		// push the right child first so that the left subtree is visited next
+		if node.height > 0 {
+			stack = append(stack, node.rightHash, node.leftHash)
+		}
+	}
+
+	return rootHash, nil
+}
+
+// NodeImporter writes nodes produced by ExportNodes into a database, verifying
+// every node against the hash expected by its parent. Nodes must be added in
+// the order they were exported.
+type NodeImporter struct {
+	db       dbm.DB
+	batch    dbm.Batch
+	version  int64
+	rootHash []byte
+	pending  [][]byte
+}
+
+// NewNodeImporter returns a NodeImporter that restores the given version of a
+// tree with the given root hash into db.
+func NewNodeImporter(db dbm.DB, version int64, rootHash []byte) *NodeImporter {
+	importer := &NodeImporter{
+		db:       db,
+		batch:    db.NewBatch(),
+		version:  version,
+		rootHash: rootHash,
+	}
+	if len(rootHash) > 0 {
+		importer.pending = [][]byte{rootHash}
+	}
+
+	return importer
+}
+
+// Done returns true once every node of the tree has been added.
+func (im *NodeImporter) Done() bool {
+	return len(im.pending) == 0
+}
+
+// Add verifies a single exported node and stages it for writing.
+func (im *NodeImporter) Add(bz []byte) error {
+	if im.Done() {
+		return fmt.Errorf("unexpected node, tree of version %d is already complete", im.version)
+	}
+
+	node, err := decodeNode(bz)
+	if err != nil {
+		return err
+	}
+	if node.version > im.version {
+		return fmt.Errorf("node version %d is greater than tree version %d", node.version, im.version)
+	}
+
+	expected := im.pending[len(im.pending)-1]
+	im.pending = im.pending[:len(im.pending)-1]
+
+	hash, err := node.hash()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(hash, expected) {
+		return fmt.Errorf("node hash mismatch: expected %X, got %X", expected, hash)
+	}
+
+	im.batch.Set(nodeKeyFormat.KeyBytes(hash), bz)
+	if node.height > 0 {
+		im.pending = append(im.pending, node.rightHash, node.leftHash)
+	}
+
+	return nil
+}
+
+// Commit writes the imported nodes and the root of the imported version. It
+// fails if the tree is incomplete or if the database already holds a tree.
+func (im *NodeImporter) Commit() error {
+	defer im.batch.Close()
+
+	if !im.Done() {
+		return fmt.Errorf("tree of version %d is incomplete, %d nodes missing", im.version, len(im.pending))
+	}
+
+	it := dbm.IteratePrefix(im.db, rootKeyFormat.Key())
+	exists := it.Valid()
+	it.Close()
+	if exists {
+		return fmt.Errorf("cannot import into a non-empty tree")
+	}
+
+	root := im.rootHash
+	if root == nil {
+		root = []byte{}
+	}
+	im.batch.Set(rootKeyFormat.Key(im.version), root)
+	im.batch.Write()
+
+	return nil
+}
+
+// snapshotNode holds the fields of a persisted IAVL node needed to compute its
+// hash and walk its children.
+type snapshotNode struct {
+	height    int8
+	size      int64
+	version   int64
+	key       []byte
+	value     []byte
+	leftHash  []byte
+	rightHash []byte
+}
+
+// decodeNode decodes a node using the IAVL persistence format.
+func decodeNode(bz []byte) (*snapshotNode, error) {
+	node := &snapshotNode{}
+
+	height, n, err := amino.DecodeInt8(bz)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode node height: %v", err)
+	}
+	bz = bz[n:]
+	node.height = height
+
+	if node.size, n, err = amino.DecodeVarint(bz); err != nil {
+		return nil, fmt.Errorf("failed to decode node size: %v", err)
+	}
+	bz = bz[n:]
+
+	if node.version, n, err = amino.DecodeVarint(bz); err != nil {
+		return nil, fmt.Errorf("failed to decode node version: %v", err)
+	}
+	bz = bz[n:]
+
+	if node.key, n, err = amino.DecodeByteSlice(bz); err != nil {
+		return nil, fmt.Errorf("failed to decode node key: %v", err)
+	}
+	bz = bz[n:]
+
+	if node.height == 0 {
+		if node.value, _, err = amino.DecodeByteSlice(bz); err != nil {
+			return nil, fmt.Errorf("failed to decode node value: %v", err)
+		}
+		return node, nil
+	}
+
+	if node.leftHash, n, err = amino.DecodeByteSlice(bz); err != nil {
+		return nil, fmt.Errorf("failed to decode node left hash: %v", err)
+	}
+	bz = bz[n:]
+
+	if node.rightHash, _, err = amino.DecodeByteSlice(bz); err != nil {
+		return nil, fmt.Errorf("failed to decode node right hash: %v", err)
+	}
+	if len(node.leftHash) == 0 || len(node.rightHash) == 0 {
+		return nil, fmt.Errorf("inner node is missing a child hash")
+	}
+
+	return node, nil
+}
+
+// hash computes the node hash the same way IAVL does.
+func (node *snapshotNode) hash() ([]byte, error) {
+	var buf bytes.Buffer
+
+	if err := amino.EncodeInt8(&buf, node.height); err != nil {
+		return nil, err
+	}
+	if err := amino.EncodeVarint(&buf, node.size); err != nil {
+		return nil, err
+	}
+	if err := amino.EncodeVarint(&buf, node.version); err != nil {
+		return nil, err
+	}
+
+	if node.height == 0 {
+		if err := amino.EncodeByteSlice(&buf, node.key); err != nil {
+			return nil, err
+		}
+		if err := amino.EncodeByteSlice(&buf, tmhash.Sum(node.value)); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := amino.EncodeByteSlice(&buf, node.leftHash); err != nil {
+			return nil, err
+		}
+		if err := amino.EncodeByteSlice(&buf, node.rightHash); err != nil {
+			return nil, err
+		}
+	}
+
+	return tmhash.Sum(buf.Bytes()), nil
+}
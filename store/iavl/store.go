@@ -44,6 +44,10 @@ type Store struct {
 	// By default this value should be set the same across all nodes,
 	// so that nodes can know the waypoints their peers store.
 	storeEvery int64
+
+	// Guards the versions of the tree, which can be deleted by Commit and
+	// DeleteVersions concurrently with the queries of the store.
+	mtx sync.RWMutex
 }
 
 // LoadStore returns an IAVL Store as a CommitKVStore. Internally it will load the
@@ -86,7 +90,10 @@ func UnsafeNewStore(tree *iavl.MutableTree, numRecent int64, storeEvery int64) *
 // been pruned, an error will be returned. Any mutable operations executed will
 // result in a panic.
 func (st *Store) GetImmutable(version int64) (*Store, error) {
-	if !st.VersionExists(version) {
+	st.mtx.RLock()
+	defer st.mtx.RUnlock()
+
+	if !st.tree.VersionExists(version) {
 		return nil, iavl.ErrVersionDoesNotExist
 	}
 
@@ -104,6 +111,9 @@ func (st *Store) GetImmutable(version int64) (*Store, error) {
 
 // Implements Committer.
 func (st *Store) Commit() types.CommitID {
+	st.mtx.Lock()
+	defer st.mtx.Unlock()
+
 	// Save a new version.
 	hash, version, err := st.tree.SaveVersion()
 	if err != nil {
@@ -145,9 +155,57 @@ func (st *Store) SetPruning(opt types.PruningOptions) {
 
 // VersionExists returns whether or not a given version is stored.
 func (st *Store) VersionExists(version int64) bool {
+	st.mtx.RLock()
+	defer st.mtx.RUnlock()
+
 	return st.tree.VersionExists(version)
 }
 
+// AvailableVersions returns the versions of the tree that are stored in
+// ascending order.
+func (st *Store) AvailableVersions() []int64 {
+	st.mtx.RLock()
+	defer st.mtx.RUnlock()
+
+	tree, ok := st.tree.(*iavl.MutableTree)
+	if !ok {
+		return []int64{st.tree.Version()}
+	}
+
+	available := tree.AvailableVersions()
+	versions := make([]int64, len(available))
+	for i, version := range available {
+		versions[i] = int64(version)
+	}
+
+	return versions
+}
+
+// DeleteVersions deletes the given versions of the tree regardless of the
+// pruning options of the store. Versions that do not exist are skipped. It is
+// safe to call concurrently with Query and GetImmutable, but not with the
+// operations on the working tree.
+func (st *Store) DeleteVersions(versions ...int64) error {
+	for _, version := range versions {
+		if err := st.deleteVersion(version); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (st *Store) deleteVersion(version int64) error {
+	st.mtx.Lock()
+	defer st.mtx.Unlock()
+
+	if !st.tree.VersionExists(version) {
+		return nil
+	}
+
+	return st.tree.DeleteVersion(version)
+}
+
 // Implements Store.
 func (st *Store) GetStoreType() types.StoreType {
 	return types.StoreTypeIAVL
@@ -240,6 +298,9 @@ func (st *Store) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 		return serrors.ErrTxDecode(msg).QueryResult()
 	}
 
+	st.mtx.RLock()
+	defer st.mtx.RUnlock()
+
 	tree := st.tree
 
 	// store the height we chose in the response, with 0 being changed to the
@@ -251,7 +312,7 @@ func (st *Store) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 		key := req.Data // data holds the key bytes
 
 		res.Key = key
-		if !st.VersionExists(res.Height) {
+		if !tree.VersionExists(res.Height) {
 			res.Log = cmn.ErrorWrap(iavl.ErrVersionDoesNotExist, "").Error()
 			break
 		}
@@ -287,6 +348,24 @@ func (st *Store) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 		subspace := req.Data
 		res.Key = subspace
 
+		if req.Prove {
+			// the subspace is proven at the queried height
+			if !tree.VersionExists(res.Height) {
+				res.Log = cmn.ErrorWrap(iavl.ErrVersionDoesNotExist, "").Error()
+				break
+			}
+
+			kvs, proof, err := getSubspaceWithProof(tree, subspace, res.Height)
+			if err != nil {
+				res.Log = err.Error()
+				break
+			}
+
+			res.Value = cdc.MustMarshalBinaryLengthPrefixed(kvs)
+			res.Proof = &merkle.Proof{Ops: []merkle.ProofOp{NewRangeProofOp(subspace, proof).ProofOp()}}
+			break
+		}
+
 		iterator := types.KVStorePrefixIterator(st, subspace)
 		for ; iterator.Valid(); iterator.Next() {
 			KVs = append(KVs, types.KVPair{Key: iterator.Key(), Value: iterator.Value()})
@@ -37,4 +37,15 @@ var (
 	PruneNothing    = types.PruneNothing
 	PruneEverything = types.PruneEverything
 	PruneSyncable   = types.PruneSyncable
+
+	NewPruningOptions             = types.NewPruningOptions
+	NewPruningOptionsFromStrategy = types.NewPruningOptionsFromStrategy
+)
+
+// nolint - reexport
+const (
+	PruningStrategyNothing    = types.PruningStrategyNothing
+	PruningStrategyEverything = types.PruningStrategyEverything
+	PruningStrategySyncable   = types.PruningStrategySyncable
+	PruningStrategyCustom     = types.PruningStrategyCustom
 )
@@ -174,3 +174,121 @@ func TestVerifyMultiStoreQueryProofAbsence(t *testing.T) {
 	err = prt.VerifyValue(res.Proof, cid.Hash, "/iavlStoreKey/MYABSENTKEY", []byte(""))
 	require.NotNil(t, err)
 }
+
+func TestVerifyMultiStoreQueryProofRange(t *testing.T) {
+	// Create main tree for testing.
+	db := dbm.NewMemDB()
+	store := NewStore(db)
+	iavlStoreKey := types.NewKVStoreKey("iavlStoreKey")
+
+	store.MountStoreWithDB(iavlStoreKey, types.StoreTypeIAVL, nil)
+	store.LoadVersion(0)
+
+	iavlStore := store.GetCommitStore(iavlStoreKey).(*iavl.Store)
+	for _, key := range []string{"a", "b", "b1", "b2", "b3", "c", "d", "e"} {
+		iavlStore.Set([]byte(key), []byte("value of "+key))
+	}
+	cid := store.Commit()
+
+	kvs := func(keys ...string) []byte {
+		pairs := []types.KVPair{}
+		for _, key := range keys {
+			pairs = append(pairs, types.KVPair{Key: []byte(key), Value: []byte("value of " + key)})
+		}
+		return cdc.MustMarshalBinaryLengthPrefixed(pairs)
+	}
+
+	testCases := []struct {
+		prefix string
+		keys   []string
+	}{
+		{"b", []string{"b", "b1", "b2", "b3"}},
+		{"a", []string{"a"}},
+		{"e", []string{"e"}},
+		{"b2", []string{"b2"}},
+		{"bb", []string{}},
+		{"0", []string{}},
+		{"f", []string{}},
+	}
+
+	for _, tc := range testCases {
+		// Get Proof
+		res := store.Query(abci.RequestQuery{
+			Path:  "/iavlStoreKey/subspace", // required path to get key/value pairs+proof
+			Data:  []byte(tc.prefix),
+			Prove: true,
+		})
+		require.True(t, res.IsOK(), res.Log)
+		require.NotNil(t, res.Proof)
+		require.Equal(t, kvs(tc.keys...), res.Value, tc.prefix)
+
+		// Verify proof.
+		prt := DefaultProofRuntime()
+		keyPath := "/iavlStoreKey/" + tc.prefix
+		err := prt.VerifyValue(res.Proof, cid.Hash, keyPath, res.Value)
+		require.Nil(t, err, tc.prefix)
+
+		// Verify (bad) proof.
+		if len(tc.keys) > 0 {
+			err = prt.VerifyValue(res.Proof, cid.Hash, keyPath, kvs(tc.keys[1:]...))
+			require.NotNil(t, err, tc.prefix)
+
+			err = prt.VerifyValue(res.Proof, cid.Hash, keyPath, kvs(tc.keys[:len(tc.keys)-1]...))
+			require.NotNil(t, err, tc.prefix)
+		}
+
+		// Verify (bad) proof.
+		err = prt.VerifyValue(res.Proof, cid.Hash, keyPath, kvs(append(tc.keys, tc.prefix+"0")...))
+		require.NotNil(t, err, tc.prefix)
+
+		// Verify (bad) proof.
+		err = prt.VerifyValue(res.Proof, cid.Hash, keyPath+"0", res.Value)
+		require.NotNil(t, err, tc.prefix)
+	}
+
+	// Verify (bad) proof.
+	res := store.Query(abci.RequestQuery{
+		Path:  "/iavlStoreKey/subspace",
+		Data:  []byte("b"),
+		Prove: true,
+	})
+	modified := cdc.MustMarshalBinaryLengthPrefixed([]types.KVPair{
+		{Key: []byte("b"), Value: []byte("value of b")},
+		{Key: []byte("b1"), Value: []byte("modified value")},
+		{Key: []byte("b2"), Value: []byte("value of b2")},
+		{Key: []byte("b3"), Value: []byte("value of b3")},
+	})
+	prt := DefaultProofRuntime()
+	err := prt.VerifyValue(res.Proof, cid.Hash, "/iavlStoreKey/b", modified)
+	require.NotNil(t, err)
+}
+
+func TestVerifyMultiStoreQueryProofRangeEmptyStore(t *testing.T) {
+	// Create main tree for testing.
+	db := dbm.NewMemDB()
+	store := NewStore(db)
+	iavlStoreKey := types.NewKVStoreKey("iavlStoreKey")
+
+	store.MountStoreWithDB(iavlStoreKey, types.StoreTypeIAVL, nil)
+	store.LoadVersion(0)
+	cid := store.Commit() // Commit with empty iavl store.
+
+	// Get Proof
+	res := store.Query(abci.RequestQuery{
+		Path:  "/iavlStoreKey/subspace", // required path to get key/value pairs+proof
+		Data:  []byte("MYPREFIX"),
+		Prove: true,
+	})
+	require.True(t, res.IsOK(), res.Log)
+	require.NotNil(t, res.Proof)
+
+	// Verify proof.
+	prt := DefaultProofRuntime()
+	err := prt.VerifyValue(res.Proof, cid.Hash, "/iavlStoreKey/MYPREFIX", res.Value)
+	require.Nil(t, err)
+
+	// Verify (bad) proof.
+	kvs := cdc.MustMarshalBinaryLengthPrefixed([]types.KVPair{{Key: []byte("MYPREFIX"), Value: []byte("MYVALUE")}})
+	err = prt.VerifyValue(res.Proof, cid.Hash, "/iavlStoreKey/MYPREFIX", kvs)
+	require.NotNil(t, err)
+}
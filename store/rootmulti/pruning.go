@@ -0,0 +1,137 @@
+package rootmulti
+
+import (
+	"fmt"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// pruner holds the versions of the IAVL stores that are no longer kept until
+// they are deleted with the next pruning batch, every pruning interval. The
+// batches are deleted by Commit, as the IAVL stores can not delete versions
+// while DeliverTx or CheckTx use their working tree.
+type pruner struct {
+	pending map[types.StoreKey][]int64
+}
+
+// add schedules the deletion of a version of a store.
+func (p *pruner) add(key types.StoreKey, version int64) {
+	if p.pending == nil {
+		p.pending = make(map[types.StoreKey][]int64)
+	}
+	p.pending[key] = append(p.pending[key], version)
+}
+
+// take returns the pending versions and clears them.
+func (p *pruner) take() map[types.StoreKey][]int64 {
+	batch := p.pending
+	p.pending = nil
+	return batch
+}
+
+// reset drops the pending versions.
+func (p *pruner) reset() {
+	p.pending = nil
+}
+
+// schedulePrunableVersions schedules the deletion of the versions of the
+// loaded IAVL stores that are no longer kept. The pending versions only live in
+// memory, so they are rebuilt from the versions on disk whenever the stores are
+// loaded, e.g. after a restart before the pruning interval was reached.
+func (rs *Store) schedulePrunableVersions() {
+	for key := range rs.stores {
+		iavlStore, ok := rs.GetCommitKVStore(key).(*iavl.Store)
+		if !ok {
+			continue
+		}
+
+		latest := iavlStore.LastCommitID().Version
+		for _, version := range iavlStore.AvailableVersions() {
+			if version < latest && !rs.pruningOpts.KeepVersion(latest, version) {
+				rs.pruner.add(key, version)
+			}
+		}
+	}
+}
+
+// pruneStores schedules the deletion of the versions of the IAVL stores that
+// are no longer kept once the given commitInfo has been committed, and deletes
+// the pending versions if the pruning interval is reached. The versions of a
+// store which fails to delete them are logged and kept pending, so that they
+// are deleted with the next batch.
+func (rs *Store) pruneStores(cInfo commitInfo) {
+	for _, info := range cInfo.StoreInfos {
+		version := info.Core.CommitID.Version
+		prunable := version - 1 - rs.pruningOpts.KeepRecent()
+		if prunable > 0 && !rs.pruningOpts.KeepVersion(version, prunable) {
+			rs.pruner.add(rs.keysByName[info.Name], prunable)
+		}
+	}
+
+	if !rs.pruningOpts.ShouldPrune(cInfo.Version) {
+		return
+	}
+
+	for key, versions := range rs.pruner.take() {
+		store, ok := rs.GetCommitKVStore(key).(*iavl.Store)
+		if !ok {
+			continue
+		}
+
+		if err := store.DeleteVersions(versions...); err != nil {
+			rs.logger.Error("failed to prune store, retrying with the next batch", "store", key.Name(), "err", err)
+			for _, version := range versions {
+				rs.pruner.add(key, version)
+			}
+		}
+	}
+}
+
+// PruneDB deletes the versions of the IAVL stores persisted in db that are not
+// kept by the given pruning options, as if they had been committed by a store
+// using these options. Stores mounted with their own database are not pruned.
+// It returns the number of versions deleted for every store.
+func PruneDB(db dbm.DB, opts types.PruningOptions) (map[string]int, error) {
+	pruned := make(map[string]int)
+
+	latest := getLatestVersion(db)
+	if latest == 0 {
+		return pruned, nil
+	}
+
+	cInfo, err := getCommitInfo(db, latest)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, info := range cInfo.StoreInfos {
+		// stores which are not backed by an IAVL tree have no version
+		id := info.Core.CommitID
+		if id.Version <= 0 {
+			continue
+		}
+
+		prefix := "s/k:" + info.Name + "/"
+		store, err := iavl.LoadStore(dbm.NewPrefixDB(db, []byte(prefix)), id, types.PruneNothing, false)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load store %s: %v", info.Name, err)
+		}
+
+		var versions []int64
+		for _, version := range store.(*iavl.Store).AvailableVersions() {
+			if !opts.KeepVersion(id.Version, version) {
+				versions = append(versions, version)
+			}
+		}
+
+		if err := store.(*iavl.Store).DeleteVersions(versions...); err != nil {
+			return nil, fmt.Errorf("failed to prune store %s: %v", info.Name, err)
+		}
+		pruned[info.Name] = len(versions)
+	}
+
+	return pruned, nil
+}
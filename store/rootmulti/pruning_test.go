@@ -0,0 +1,129 @@
+package rootmulti
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func commitVersions(t *testing.T, store *Store, n int) {
+	for i := 0; i < n; i++ {
+		version := store.LastCommitID().Version
+		store.getStoreByName("store1").(types.KVStore).Set([]byte("key"), []byte(fmt.Sprintf("value%d", version)))
+		store.Commit()
+	}
+}
+
+func storeVersions(store *Store, name string) []int64 {
+	return store.GetCommitKVStore(store.keysByName[name]).(*iavl.Store).AvailableVersions()
+}
+
+func TestPruningInterval(t *testing.T) {
+	store := newMultiStoreWithMounts(dbm.NewMemDB())
+	store.SetPruning(types.NewPruningOptions(2, 5, 4))
+	require.NoError(t, store.LoadLatestVersion())
+
+	// versions become prunable as soon as they are no longer kept, but are only
+	// deleted every 4 commits
+	commitVersions(t, store, 3)
+	require.Equal(t, []int64{1, 2, 3}, storeVersions(store, "store1"))
+
+	commitVersions(t, store, 4)
+	require.Equal(t, []int64{2, 3, 4, 5, 6, 7}, storeVersions(store, "store1"))
+
+	commitVersions(t, store, 1)
+	require.Equal(t, []int64{5, 6, 7, 8}, storeVersions(store, "store1"))
+
+	commitVersions(t, store, 4)
+	require.Equal(t, []int64{5, 10, 11, 12}, storeVersions(store, "store1"))
+	require.Equal(t, []int64{5, 10, 11, 12}, storeVersions(store, "store3"))
+
+	// old versions remain queryable as long as they are kept
+	_, err := store.CacheMultiStoreWithVersion(5)
+	require.NoError(t, err)
+	_, err = store.CacheMultiStoreWithVersion(6)
+	require.Error(t, err)
+}
+
+func TestPruningRestart(t *testing.T) {
+	db := dbm.NewMemDB()
+	opts := types.NewPruningOptions(2, 5, 4)
+	store := newMultiStoreWithMounts(db)
+	store.SetPruning(opts)
+	require.NoError(t, store.LoadLatestVersion())
+
+	// restart before the pruning interval is reached, while versions 2 and 3
+	// are pending
+	commitVersions(t, store, 6)
+	require.Equal(t, []int64{2, 3, 4, 5, 6}, storeVersions(store, "store1"))
+
+	store = newMultiStoreWithMounts(db)
+	store.SetPruning(opts)
+	require.NoError(t, store.LoadLatestVersion())
+
+	// the pending versions are rebuilt from the versions on disk and pruned
+	// with the next batch
+	commitVersions(t, store, 2)
+	require.Equal(t, []int64{5, 6, 7, 8}, storeVersions(store, "store1"))
+	require.Equal(t, []int64{5, 6, 7, 8}, storeVersions(store, "store3"))
+}
+
+func TestPruningRetry(t *testing.T) {
+	store := newMultiStoreWithMounts(dbm.NewMemDB())
+	store.SetPruning(types.NewPruningOptions(2, 5, 4))
+	require.NoError(t, store.LoadLatestVersion())
+
+	// the deletion of the latest version fails, which keeps the versions of
+	// the batch pending instead of halting the next commits
+	commitVersions(t, store, 3)
+	store.pruner.add(store.keysByName["store1"], 4)
+	commitVersions(t, store, 1)
+	require.Equal(t, []int64{1, 2, 3, 4}, storeVersions(store, "store1"))
+	require.Equal(t, []int64{2, 3, 4}, storeVersions(store, "store3"))
+
+	// they are deleted with the next batch
+	commitVersions(t, store, 4)
+	require.Equal(t, []int64{5, 6, 7, 8}, storeVersions(store, "store1"))
+	require.Equal(t, []int64{5, 6, 7, 8}, storeVersions(store, "store3"))
+}
+
+func TestPruneNothing(t *testing.T) {
+	store := newMultiStoreWithMounts(dbm.NewMemDB())
+	store.SetPruning(types.PruneNothing)
+	require.NoError(t, store.LoadLatestVersion())
+
+	commitVersions(t, store, 20)
+	require.Len(t, storeVersions(store, "store1"), 20)
+}
+
+func TestPruneDB(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := newMultiStoreWithMounts(db)
+	store.SetPruning(types.PruneNothing)
+	require.NoError(t, store.LoadLatestVersion())
+	commitVersions(t, store, 12)
+	commitID := store.LastCommitID()
+
+	pruned, err := PruneDB(db, types.NewPruningOptions(3, 4, 1))
+	require.NoError(t, err)
+	require.Equal(t, map[string]int{"store1": 6, "store2": 6, "store3": 6}, pruned)
+
+	store = newMultiStoreWithMounts(db)
+	require.NoError(t, store.LoadLatestVersion())
+	require.Equal(t, commitID, store.LastCommitID())
+	require.Equal(t, []int64{4, 8, 9, 10, 11, 12}, storeVersions(store, "store1"))
+
+	// pruning again is a no-op
+	pruned, err = PruneDB(db, types.NewPruningOptions(3, 4, 1))
+	require.NoError(t, err)
+	require.Equal(t, map[string]int{"store1": 0, "store2": 0, "store3": 0}, pruned)
+
+	pruned, err = PruneDB(dbm.NewMemDB(), types.PruneEverything)
+	require.NoError(t, err)
+	require.Empty(t, pruned)
+}
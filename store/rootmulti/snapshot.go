@@ -0,0 +1,180 @@
+package rootmulti
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// snapshotMaxItemSize is the maximum size of a single item of a snapshot.
+const snapshotMaxItemSize = 64 << 20
+
+// snapshotStore is the item marking the beginning of the nodes of a single
+// store in a snapshot.
+type snapshotStore struct {
+	Name string `json:"name"`
+}
+
+// Snapshot implements Snapshotter. The snapshot is a stream of amino length
+// prefixed items: the commitInfo of the given height, followed by every
+// committed store in name order, each being a snapshotStore item and the raw
+// IAVL nodes of the store in pre-order. Only IAVL stores can be snapshotted.
+func (rs *Store) Snapshot(height int64, w io.Writer) error {
+	if height <= 0 {
+		return fmt.Errorf("cannot snapshot height %d", height)
+	}
+
+	cInfo, err := getCommitInfo(rs.db, height)
+	if err != nil {
+		return fmt.Errorf("failed to snapshot height %d: %v", height, err)
+	}
+
+	bw := bufio.NewWriter(w)
+	if err := writeSnapshotItem(bw, cInfo); err != nil {
+		return err
+	}
+
+	for _, info := range sortedStoreInfos(cInfo) {
+		params, err := rs.snapshotStoreParams(info.Name)
+		if err != nil {
+			return err
+		}
+
+		if err := writeSnapshotItem(bw, snapshotStore{Name: info.Name}); err != nil {
+			return err
+		}
+
+		rootHash, err := iavl.ExportNodes(rs.storeDB(params), info.Core.CommitID.Version, func(node []byte) error {
+			return writeSnapshotItem(bw, node)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to snapshot store %s: %v", info.Name, err)
+		}
+		if !bytes.Equal(rootHash, info.Core.CommitID.Hash) {
+			return fmt.Errorf("store %s has root hash %X, expected %X", info.Name, rootHash, info.Core.CommitID.Hash)
+		}
+	}
+
+	return bw.Flush()
+}
+
+// Restore implements Snapshotter. Every node is verified against the hashes of
+// the commitInfo found at the beginning of the snapshot, and the store is loaded
+// at the restored height once all the stores have been imported.
+func (rs *Store) Restore(height int64, r io.Reader) error {
+	if getLatestVersion(rs.db) != 0 {
+		return fmt.Errorf("cannot restore snapshot into a non-empty store")
+	}
+
+	br := bufio.NewReader(r)
+
+	var cInfo commitInfo
+	if err := readSnapshotItem(br, &cInfo); err != nil {
+		return fmt.Errorf("failed to read commit info: %v", err)
+	}
+	if cInfo.Version != height {
+		return fmt.Errorf("snapshot is of height %d, expected %d", cInfo.Version, height)
+	}
+
+	for _, info := range sortedStoreInfos(cInfo) {
+		params, err := rs.snapshotStoreParams(info.Name)
+		if err != nil {
+			return err
+		}
+
+		var item snapshotStore
+		if err := readSnapshotItem(br, &item); err != nil {
+			return fmt.Errorf("failed to read store %s: %v", info.Name, err)
+		}
+		if item.Name != info.Name {
+			return fmt.Errorf("unexpected store %s in snapshot, expected %s", item.Name, info.Name)
+		}
+
+		importer := iavl.NewNodeImporter(rs.storeDB(params), info.Core.CommitID.Version, info.Core.CommitID.Hash)
+		for !importer.Done() {
+			var node []byte
+			if err := readSnapshotItem(br, &node); err != nil {
+				return fmt.Errorf("failed to read node of store %s: %v", info.Name, err)
+			}
+			if err := importer.Add(node); err != nil {
+				return fmt.Errorf("failed to restore store %s: %v", info.Name, err)
+			}
+		}
+		if err := importer.Commit(); err != nil {
+			return fmt.Errorf("failed to restore store %s: %v", info.Name, err)
+		}
+	}
+
+	if _, err := br.ReadByte(); err != io.EOF {
+		return fmt.Errorf("unexpected data at the end of the snapshot")
+	}
+
+	batch := rs.db.NewBatch()
+	defer batch.Close()
+	setCommitInfo(batch, height, cInfo)
+	setLatestVersion(batch, height)
+	batch.Write()
+
+	if err := rs.LoadLatestVersion(); err != nil {
+		return err
+	}
+
+	for _, info := range cInfo.StoreInfos {
+		store := rs.stores[rs.keysByName[info.Name]]
+		if !bytes.Equal(store.LastCommitID().Hash, info.Core.CommitID.Hash) {
+			return fmt.Errorf("restored store %s has hash %X, expected %X",
+				info.Name, store.LastCommitID().Hash, info.Core.CommitID.Hash)
+		}
+	}
+
+	return nil
+}
+
+// snapshotStoreParams returns the parameters of a committed store, which must
+// be a mounted IAVL store.
+func (rs *Store) snapshotStoreParams(name string) (storeParams, error) {
+	key, ok := rs.keysByName[name]
+	if !ok {
+		return storeParams{}, fmt.Errorf("store %s is not mounted", name)
+	}
+
+	params := rs.storesParams[key]
+	if params.typ != types.StoreTypeIAVL {
+		return storeParams{}, fmt.Errorf("cannot snapshot store %s of type %v", name, params.typ)
+	}
+
+	return params, nil
+}
+
+// sortedStoreInfos returns the store infos of a commitInfo sorted by name.
+func sortedStoreInfos(cInfo commitInfo) []storeInfo {
+	infos := make([]storeInfo, len(cInfo.StoreInfos))
+	copy(infos, cInfo.StoreInfos)
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Name < infos[j].Name })
+
+	return infos
+}
+
+func writeSnapshotItem(w io.Writer, item interface{}) error {
+	bz, err := cdc.MarshalBinaryLengthPrefixed(item)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(bz)
+	return err
+}
+
+func readSnapshotItem(r io.Reader, ptr interface{}) error {
+	_, err := cdc.UnmarshalBinaryLengthPrefixedReader(r, ptr, snapshotMaxItemSize)
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+
+	return err
+}
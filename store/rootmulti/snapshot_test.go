@@ -0,0 +1,97 @@
+package rootmulti
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func newSnapshotTestStore(t *testing.T) *Store {
+	store := newMultiStoreWithMounts(dbm.NewMemDB())
+	require.NoError(t, store.LoadLatestVersion())
+
+	// store3 is left empty on purpose
+	for version := 0; version < 5; version++ {
+		for i := 0; i < 50; i++ {
+			key := []byte(fmt.Sprintf("key%03d", i*(version+1)))
+			store.getStoreByName("store1").(types.KVStore).Set(key, []byte(fmt.Sprintf("value%d", version)))
+		}
+		store.getStoreByName("store2").(types.KVStore).Set([]byte("version"), []byte(fmt.Sprintf("%d", version)))
+		store.Commit()
+	}
+
+	return store
+}
+
+func TestSnapshotRestore(t *testing.T) {
+	source := newSnapshotTestStore(t)
+	commitID := source.LastCommitID()
+
+	var buf bytes.Buffer
+	require.NoError(t, source.Snapshot(commitID.Version, &buf))
+
+	target := newMultiStoreWithMounts(dbm.NewMemDB())
+	require.NoError(t, target.Restore(commitID.Version, bytes.NewReader(buf.Bytes())))
+	require.Equal(t, commitID, target.LastCommitID())
+
+	for _, name := range []string{"store1", "store2", "store3"} {
+		sourceStore := source.getStoreByName(name).(types.KVStore)
+		targetStore := target.getStoreByName(name).(types.KVStore)
+
+		it := sourceStore.Iterator(nil, nil)
+		for ; it.Valid(); it.Next() {
+			require.Equal(t, it.Value(), targetStore.Get(it.Key()))
+		}
+		it.Close()
+	}
+
+	// both stores keep producing the same hashes after the restore
+	for _, store := range []*Store{source, target} {
+		store.getStoreByName("store3").(types.KVStore).Set([]byte("key"), []byte("value"))
+	}
+	require.Equal(t, source.Commit(), target.Commit())
+
+	// restoring into a non-empty store fails
+	require.Error(t, target.Restore(commitID.Version, bytes.NewReader(buf.Bytes())))
+}
+
+func TestSnapshotRestoreErrors(t *testing.T) {
+	source := newSnapshotTestStore(t)
+	version := source.LastCommitID().Version
+
+	require.Error(t, source.Snapshot(0, &bytes.Buffer{}))
+	require.Error(t, source.Snapshot(version+1, &bytes.Buffer{}))
+
+	var buf bytes.Buffer
+	require.NoError(t, source.Snapshot(version, &buf))
+	snapshot := buf.Bytes()
+
+	// wrong height
+	target := newMultiStoreWithMounts(dbm.NewMemDB())
+	require.Error(t, target.Restore(version-1, bytes.NewReader(snapshot)))
+
+	// truncated snapshot
+	target = newMultiStoreWithMounts(dbm.NewMemDB())
+	require.Error(t, target.Restore(version, bytes.NewReader(snapshot[:len(snapshot)/2])))
+	require.Equal(t, int64(0), getLatestVersion(target.db))
+
+	// corrupted node value
+	corrupted := make([]byte, len(snapshot))
+	copy(corrupted, snapshot)
+	idx := bytes.LastIndex(corrupted, []byte("value4"))
+	require.True(t, idx > 0)
+	corrupted[idx] = 'V'
+
+	target = newMultiStoreWithMounts(dbm.NewMemDB())
+	require.Error(t, target.Restore(version, bytes.NewReader(corrupted)))
+	require.Equal(t, int64(0), getLatestVersion(target.db))
+
+	// trailing data
+	target = newMultiStoreWithMounts(dbm.NewMemDB())
+	require.Error(t, target.Restore(version, bytes.NewReader(append(snapshot, 0x01))))
+}
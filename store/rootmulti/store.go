@@ -8,6 +8,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto/merkle"
 	"github.com/tendermint/tendermint/crypto/tmhash"
+	"github.com/tendermint/tendermint/libs/log"
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/store/cachemulti"
@@ -40,6 +41,9 @@ type Store struct {
 	traceContext types.TraceContext
 
 	interBlockCache types.MultiStorePersistentCache
+
+	pruner pruner
+	logger log.Logger
 }
 
 var _ types.CommitMultiStore = (*Store)(nil)
@@ -52,15 +56,19 @@ func NewStore(db dbm.DB) *Store {
 		storesParams: make(map[types.StoreKey]storeParams),
 		stores:       make(map[types.StoreKey]types.CommitKVStore),
 		keysByName:   make(map[string]types.StoreKey),
+		logger:       log.NewNopLogger(),
 	}
 }
 
-// Implements CommitMultiStore
+// Implements CommitMultiStore. The IAVL stores are not pruned by their own
+// commit but by the multistore every pruning interval, see pruneStores.
 func (rs *Store) SetPruning(pruningOpts types.PruningOptions) {
 	rs.pruningOpts = pruningOpts
-	for _, substore := range rs.stores {
-		substore.SetPruning(pruningOpts)
-	}
+}
+
+// SetLogger sets the logger of the Store, which reports the pruning failures.
+func (rs *Store) SetLogger(logger log.Logger) {
+	rs.logger = logger
 }
 
 // SetLazyLoading sets if the iavl store should be loaded lazily or not
@@ -136,6 +144,8 @@ func (rs *Store) LoadVersion(ver int64) error {
 }
 
 func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
+	rs.pruner.reset()
+
 	infos := make(map[string]storeInfo)
 	var lastCommitID types.CommitID
 
@@ -191,6 +201,7 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 
 	rs.lastCommitID = lastCommitID
 	rs.stores = newStores
+	rs.schedulePrunableVersions()
 
 	return nil
 }
@@ -272,7 +283,16 @@ func (rs *Store) TracingEnabled() bool {
 // +CommitStore
 
 // Implements Committer/CommitStore.
+//
+// If no version has been loaded yet, the latest version persisted in the
+// underlying DB is returned (without a hash), so that store loaders can decide
+// how to load the store.
 func (rs *Store) LastCommitID() types.CommitID {
+	if rs.lastCommitID.IsZero() {
+		return types.CommitID{
+			Version: getLatestVersion(rs.db),
+		}
+	}
 	return rs.lastCommitID
 }
 
@@ -290,6 +310,8 @@ func (rs *Store) Commit() types.CommitID {
 	setLatestVersion(batch, version)
 	batch.Write()
 
+	rs.pruneStores(commitInfo)
+
 	// Prepare for next version.
 	commitID := types.CommitID{
 		Version: version,
@@ -470,24 +492,27 @@ func parsePath(path string) (storeName string, subpath string, err errors.Error)
 	return
 }
 
+// storeDB returns the database backing a mounted store.
+func (rs *Store) storeDB(params storeParams) dbm.DB {
+	if params.db != nil {
+		return dbm.NewPrefixDB(params.db, []byte("s/_/"))
+	}
+
+	prefix := "s/k:" + params.key.Name() + "/"
+	return dbm.NewPrefixDB(rs.db, []byte(prefix))
+}
+
 //----------------------------------------
 // Note: why do we use key and params.key in different places. Seems like there should be only one key used.
 func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (types.CommitKVStore, error) {
-	var db dbm.DB
-
-	if params.db != nil {
-		db = dbm.NewPrefixDB(params.db, []byte("s/_/"))
-	} else {
-		prefix := "s/k:" + params.key.Name() + "/"
-		db = dbm.NewPrefixDB(rs.db, []byte(prefix))
-	}
+	db := rs.storeDB(params)
 
 	switch params.typ {
 	case types.StoreTypeMulti:
 		panic("recursive MultiStores not yet supported")
 
 	case types.StoreTypeIAVL:
-		store, err := iavl.LoadStore(db, id, rs.pruningOpts, rs.lazyLoading)
+		store, err := iavl.LoadStore(db, id, types.PruneNothing, rs.lazyLoading)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,90 @@
+package snapshots
+
+import (
+	"compress/zlib"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// Manager creates snapshots of a Snapshotter, usually the CommitMultiStore of
+// an application, into a Store and restores the Snapshotter from them.
+type Manager struct {
+	store  *Store
+	target types.Snapshotter
+
+	mtx sync.Mutex
+}
+
+// NewManager returns a new Manager of the snapshots of target kept in store.
+func NewManager(store *Store, target types.Snapshotter) *Manager {
+	return &Manager{
+		store:  store,
+		target: target,
+	}
+}
+
+// Store returns the snapshot store of the manager.
+func (m *Manager) Store() *Store {
+	return m.store
+}
+
+// Create takes a snapshot of the state committed at the given height and saves
+// it in the store.
+func (m *Manager) Create(height int64) (*Snapshot, error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	pr, pw := io.Pipe()
+	go func() {
+		zw := zlib.NewWriter(pw)
+		err := m.target.Snapshot(height, zw)
+		if err == nil {
+			err = zw.Close()
+		}
+		pw.CloseWithError(err) // nolint: errcheck
+	}()
+
+	snapshot, err := m.store.Save(height, CurrentFormat, pr)
+	if err != nil {
+		// unblock the snapshotter if the store failed first
+		pr.CloseWithError(err) // nolint: errcheck
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+// Restore restores the target from the snapshot of the given height.
+func (m *Manager) Restore(height int64) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	snapshot, rc, err := m.store.Load(height)
+	if err != nil {
+		return err
+	}
+	defer rc.Close() // nolint: errcheck
+
+	if snapshot.Format != CurrentFormat {
+		return fmt.Errorf("unsupported format %d of snapshot %d", snapshot.Format, height)
+	}
+
+	zr, err := zlib.NewReader(rc)
+	if err != nil {
+		return fmt.Errorf("failed to read snapshot %d: %v", height, err)
+	}
+	defer zr.Close() // nolint: errcheck
+
+	return m.target.Restore(height, zr)
+}
+
+// Prune removes all the snapshots but the given number of most recent ones.
+func (m *Manager) Prune(retain uint32) (int, error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	return m.store.Prune(retain)
+}
@@ -0,0 +1,69 @@
+package snapshots_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/cosmos/cosmos-sdk/store/snapshots"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func newMultiStore(t *testing.T) (*rootmulti.Store, []types.StoreKey) {
+	keys := []types.StoreKey{types.NewKVStoreKey("store1"), types.NewKVStoreKey("store2")}
+
+	store := rootmulti.NewStore(dbm.NewMemDB())
+	for _, key := range keys {
+		store.MountStoreWithDB(key, types.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, store.LoadLatestVersion())
+
+	return store, keys
+}
+
+func TestManagerCreateRestore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "snapshots")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	snapshotStore, err := snapshots.NewStore(dir)
+	require.NoError(t, err)
+
+	source, keys := newMultiStore(t)
+	for i := 0; i < 3; i++ {
+		for j, key := range keys {
+			kv := source.GetKVStore(key)
+			for k := 0; k < 100; k++ {
+				kv.Set([]byte(fmt.Sprintf("key%d-%d", j, k)), []byte(fmt.Sprintf("value%d", i)))
+			}
+		}
+		source.Commit()
+	}
+	commitID := source.LastCommitID()
+
+	manager := snapshots.NewManager(snapshotStore, source)
+	snapshot, err := manager.Create(commitID.Version)
+	require.NoError(t, err)
+	require.Equal(t, commitID.Version, snapshot.Height)
+	require.Equal(t, snapshots.CurrentFormat, snapshot.Format)
+
+	_, err = manager.Create(commitID.Version + 1)
+	require.Error(t, err)
+	missing, err := snapshotStore.Get(commitID.Version + 1)
+	require.NoError(t, err)
+	require.Nil(t, missing)
+
+	target, targetKeys := newMultiStore(t)
+	require.NoError(t, snapshots.NewManager(snapshotStore, target).Restore(commitID.Version))
+	require.Equal(t, commitID, target.LastCommitID())
+	require.Equal(t, []byte("value2"), target.GetKVStore(targetKeys[1]).Get([]byte("key1-99")))
+
+	pruned, err := manager.Prune(0)
+	require.NoError(t, err)
+	require.Equal(t, 1, pruned)
+}
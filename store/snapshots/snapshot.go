@@ -0,0 +1,58 @@
+package snapshots
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+)
+
+// CurrentFormat is the format of the snapshots created by the Manager: a zlib
+// compressed stream written by a Snapshotter.
+const CurrentFormat uint32 = 1
+
+// Snapshot contains the metadata of a snapshot. The snapshot data is split in
+// chunks which can be verified individually against their hash, and the hash
+// of the snapshot is the hash of the concatenated chunk hashes.
+type Snapshot struct {
+	Height      int64    `json:"height" yaml:"height"`
+	Format      uint32   `json:"format" yaml:"format"`
+	Chunks      uint32   `json:"chunks" yaml:"chunks"`
+	Hash        []byte   `json:"hash" yaml:"hash"`
+	ChunkHashes [][]byte `json:"chunk_hashes" yaml:"chunk_hashes"`
+}
+
+// String implements fmt.Stringer
+func (s Snapshot) String() string {
+	return fmt.Sprintf("height: %d format: %d chunks: %d hash: %X", s.Height, s.Format, s.Chunks, s.Hash)
+}
+
+// Validate checks that the snapshot metadata is consistent.
+func (s Snapshot) Validate() error {
+	if s.Height <= 0 {
+		return fmt.Errorf("invalid snapshot height %d", s.Height)
+	}
+	if s.Chunks == 0 || int(s.Chunks) != len(s.ChunkHashes) {
+		return fmt.Errorf("snapshot %d has %d chunks but %d chunk hashes", s.Height, s.Chunks, len(s.ChunkHashes))
+	}
+	if !bytes.Equal(s.Hash, hashChunkHashes(s.ChunkHashes)) {
+		return fmt.Errorf("snapshot %d hash does not match its chunk hashes", s.Height)
+	}
+
+	return nil
+}
+
+// hashChunk returns the hash of a chunk.
+func hashChunk(chunk []byte) []byte {
+	hash := sha256.Sum256(chunk)
+	return hash[:]
+}
+
+// hashChunkHashes returns the hash of a snapshot from its chunk hashes.
+func hashChunkHashes(chunkHashes [][]byte) []byte {
+	hasher := sha256.New()
+	for _, hash := range chunkHashes {
+		hasher.Write(hash) // nolint: errcheck
+	}
+
+	return hasher.Sum(nil)
+}
@@ -0,0 +1,441 @@
+package snapshots
+
+import (
+	"archive/tar"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+)
+
+const (
+	// DefaultChunkSize is the maximum size of a snapshot chunk.
+	DefaultChunkSize = 16 << 20
+
+	metadataFile = "metadata.json"
+	tmpSuffix    = ".tmp"
+)
+
+// Store is a file-based store of snapshots. Every snapshot is kept in its own
+// <dir>/<height> directory containing the snapshot metadata and its chunks,
+// which are named after their index.
+type Store struct {
+	dir       string
+	chunkSize int
+}
+
+// NewStore returns a Store keeping its snapshots in the given directory, which
+// is created if it does not exist.
+func NewStore(dir string) (*Store, error) {
+	if dir == "" {
+		return nil, fmt.Errorf("snapshot directory cannot be empty")
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create snapshot directory %s: %v", dir, err)
+	}
+
+	return &Store{
+		dir:       dir,
+		chunkSize: DefaultChunkSize,
+	}, nil
+}
+
+// Dir returns the directory of the store.
+func (s *Store) Dir() string {
+	return s.dir
+}
+
+// Save splits the snapshot data read from r in chunks and saves it as the
+// snapshot of the given height. Nothing is saved if the data can't be read
+// entirely.
+func (s *Store) Save(height int64, format uint32, r io.Reader) (*Snapshot, error) {
+	if height <= 0 {
+		return nil, fmt.Errorf("invalid snapshot height %d", height)
+	}
+
+	exists, err := s.has(height)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, fmt.Errorf("snapshot %d already exists", height)
+	}
+
+	tmpDir, err := s.tmpDir(height)
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmpDir) // nolint: errcheck
+
+	snapshot := &Snapshot{
+		Height: height,
+		Format: format,
+	}
+
+	buf := make([]byte, s.chunkSize)
+	for {
+		n, err := io.ReadFull(r, buf)
+		if n > 0 {
+			chunk := buf[:n]
+			if err := ioutil.WriteFile(chunkPath(tmpDir, snapshot.Chunks), chunk, 0644); err != nil {
+				return nil, fmt.Errorf("failed to write chunk %d of snapshot %d: %v", snapshot.Chunks, height, err)
+			}
+			snapshot.ChunkHashes = append(snapshot.ChunkHashes, hashChunk(chunk))
+			snapshot.Chunks++
+		}
+
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read snapshot %d: %v", height, err)
+		}
+	}
+
+	if snapshot.Chunks == 0 {
+		return nil, fmt.Errorf("snapshot %d is empty", height)
+	}
+	snapshot.Hash = hashChunkHashes(snapshot.ChunkHashes)
+
+	if err := s.commit(tmpDir, snapshot); err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+// Get returns the metadata of the snapshot of the given height, or nil if it
+// does not exist.
+func (s *Store) Get(height int64) (*Snapshot, error) {
+	bz, err := ioutil.ReadFile(filepath.Join(s.path(height), metadataFile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot %d: %v", height, err)
+	}
+
+	var snapshot Snapshot
+	if err := json.Unmarshal(bz, &snapshot); err != nil {
+		return nil, fmt.Errorf("failed to decode snapshot %d: %v", height, err)
+	}
+
+	return &snapshot, nil
+}
+
+// List returns the metadata of all the snapshots of the store, the most recent
+// first.
+func (s *Store) List() ([]*Snapshot, error) {
+	heights, err := s.heights()
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]*Snapshot, 0, len(heights))
+	for _, height := range heights {
+		snapshot, err := s.Get(height)
+		if err != nil {
+			return nil, err
+		}
+		if snapshot != nil {
+			snapshots = append(snapshots, snapshot)
+		}
+	}
+
+	return snapshots, nil
+}
+
+// Load returns the metadata of the snapshot of the given height and a reader of
+// its data. Every chunk is verified against its hash before being read, and the
+// reader fails if a chunk is missing or corrupted.
+func (s *Store) Load(height int64) (*Snapshot, io.ReadCloser, error) {
+	snapshot, err := s.Get(height)
+	if err != nil {
+		return nil, nil, err
+	}
+	if snapshot == nil {
+		return nil, nil, fmt.Errorf("snapshot %d not found", height)
+	}
+	if err := snapshot.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	return snapshot, &chunkReader{store: s, snapshot: snapshot}, nil
+}
+
+// LoadChunk returns a chunk of the snapshot of the given height after verifying
+// it against its hash.
+func (s *Store) LoadChunk(snapshot *Snapshot, index uint32) ([]byte, error) {
+	if index >= snapshot.Chunks {
+		return nil, fmt.Errorf("snapshot %d has no chunk %d", snapshot.Height, index)
+	}
+
+	chunk, err := ioutil.ReadFile(chunkPath(s.path(snapshot.Height), index))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read chunk %d of snapshot %d: %v", index, snapshot.Height, err)
+	}
+	if !bytes.Equal(hashChunk(chunk), snapshot.ChunkHashes[index]) {
+		return nil, fmt.Errorf("chunk %d of snapshot %d is corrupted", index, snapshot.Height)
+	}
+
+	return chunk, nil
+}
+
+// Delete removes the snapshot of the given height.
+func (s *Store) Delete(height int64) error {
+	exists, err := s.has(height)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("snapshot %d not found", height)
+	}
+
+	return os.RemoveAll(s.path(height))
+}
+
+// Prune removes all the snapshots but the given number of most recent ones. It
+// returns the number of snapshots removed.
+func (s *Store) Prune(retain uint32) (int, error) {
+	heights, err := s.heights()
+	if err != nil {
+		return 0, err
+	}
+	if len(heights) <= int(retain) {
+		return 0, nil
+	}
+
+	for _, height := range heights[retain:] {
+		if err := os.RemoveAll(s.path(height)); err != nil {
+			return 0, fmt.Errorf("failed to prune snapshot %d: %v", height, err)
+		}
+	}
+
+	return len(heights) - int(retain), nil
+}
+
+// Export writes the snapshot of the given height as a tar archive, holding its
+// metadata followed by its chunks, which can be imported in another store.
+func (s *Store) Export(height int64, w io.Writer) error {
+	snapshot, err := s.Get(height)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return fmt.Errorf("snapshot %d not found", height)
+	}
+	if err := snapshot.Validate(); err != nil {
+		return err
+	}
+
+	tw := tar.NewWriter(w)
+
+	bz, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	if err := writeTarFile(tw, metadataFile, bz); err != nil {
+		return err
+	}
+
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := s.LoadChunk(snapshot, i)
+		if err != nil {
+			return err
+		}
+		if err := writeTarFile(tw, strconv.FormatUint(uint64(i), 10), chunk); err != nil {
+			return err
+		}
+	}
+
+	return tw.Close()
+}
+
+// Import saves a snapshot from a tar archive written by Export. The snapshot
+// is verified against its metadata before being saved.
+func (s *Store) Import(r io.Reader) (*Snapshot, error) {
+	tr := tar.NewReader(r)
+
+	bz, err := readTarFile(tr, metadataFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshot Snapshot
+	if err := json.Unmarshal(bz, &snapshot); err != nil {
+		return nil, fmt.Errorf("failed to decode snapshot metadata: %v", err)
+	}
+	if err := snapshot.Validate(); err != nil {
+		return nil, err
+	}
+
+	exists, err := s.has(snapshot.Height)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, fmt.Errorf("snapshot %d already exists", snapshot.Height)
+	}
+
+	tmpDir, err := s.tmpDir(snapshot.Height)
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmpDir) // nolint: errcheck
+
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := readTarFile(tr, strconv.FormatUint(uint64(i), 10))
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(hashChunk(chunk), snapshot.ChunkHashes[i]) {
+			return nil, fmt.Errorf("chunk %d of snapshot %d is corrupted", i, snapshot.Height)
+		}
+		if err := ioutil.WriteFile(chunkPath(tmpDir, i), chunk, 0644); err != nil {
+			return nil, fmt.Errorf("failed to write chunk %d of snapshot %d: %v", i, snapshot.Height, err)
+		}
+	}
+
+	if err := s.commit(tmpDir, &snapshot); err != nil {
+		return nil, err
+	}
+
+	return &snapshot, nil
+}
+
+// commit writes the snapshot metadata in a temporary snapshot directory and
+// moves it to its final location.
+func (s *Store) commit(tmpDir string, snapshot *Snapshot) error {
+	bz, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(filepath.Join(tmpDir, metadataFile), bz, 0644); err != nil {
+		return fmt.Errorf("failed to write snapshot %d metadata: %v", snapshot.Height, err)
+	}
+
+	return os.Rename(tmpDir, s.path(snapshot.Height))
+}
+
+// tmpDir creates an empty temporary directory for the snapshot of the given
+// height.
+func (s *Store) tmpDir(height int64) (string, error) {
+	dir := s.path(height) + tmpSuffix
+	if err := os.RemoveAll(dir); err != nil {
+		return "", err
+	}
+	if err := os.Mkdir(dir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create snapshot directory: %v", err)
+	}
+
+	return dir, nil
+}
+
+// heights returns the heights of the snapshots of the store in descending
+// order.
+func (s *Store) heights() ([]int64, error) {
+	entries, err := ioutil.ReadDir(s.dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list snapshots: %v", err)
+	}
+
+	heights := make([]int64, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		height, err := strconv.ParseInt(entry.Name(), 10, 64)
+		if err != nil || height <= 0 {
+			continue
+		}
+		heights = append(heights, height)
+	}
+
+	sort.Slice(heights, func(i, j int) bool { return heights[i] > heights[j] })
+	return heights, nil
+}
+
+func (s *Store) has(height int64) (bool, error) {
+	_, err := os.Stat(s.path(height))
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+func (s *Store) path(height int64) string {
+	return filepath.Join(s.dir, strconv.FormatInt(height, 10))
+}
+
+func chunkPath(dir string, index uint32) string {
+	return filepath.Join(dir, strconv.FormatUint(uint64(index), 10))
+}
+
+func writeTarFile(tw *tar.Writer, name string, bz []byte) error {
+	header := &tar.Header{
+		Name: name,
+		Mode: 0644,
+		Size: int64(len(bz)),
+	}
+	if err := tw.WriteHeader(header); err != nil {
+		return err
+	}
+
+	_, err := tw.Write(bz)
+	return err
+}
+
+func readTarFile(tr *tar.Reader, name string) ([]byte, error) {
+	header, err := tr.Next()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s from snapshot archive: %v", name, err)
+	}
+	if header.Name != name {
+		return nil, fmt.Errorf("unexpected file %s in snapshot archive, expected %s", header.Name, name)
+	}
+	if header.Size > DefaultChunkSize<<2 {
+		return nil, fmt.Errorf("file %s of snapshot archive is too large", name)
+	}
+
+	return ioutil.ReadAll(tr)
+}
+
+// chunkReader reads the chunks of a snapshot in order, verifying every chunk
+// before returning any of its data.
+type chunkReader struct {
+	store    *Store
+	snapshot *Snapshot
+	next     uint32
+	chunk    []byte
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.chunk) == 0 {
+		if r.next >= r.snapshot.Chunks {
+			return 0, io.EOF
+		}
+
+		chunk, err := r.store.LoadChunk(r.snapshot, r.next)
+		if err != nil {
+			return 0, err
+		}
+		r.chunk = chunk
+		r.next++
+	}
+
+	n := copy(p, r.chunk)
+	r.chunk = r.chunk[n:]
+	return n, nil
+}
+
+func (r *chunkReader) Close() error {
+	r.chunk = nil
+	r.next = r.snapshot.Chunks
+	return nil
+}
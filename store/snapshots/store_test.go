@@ -0,0 +1,158 @@
+package snapshots
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func setupStore(t *testing.T) (*Store, func()) {
+	dir, err := ioutil.TempDir("", "snapshots")
+	require.NoError(t, err)
+
+	store, err := NewStore(dir)
+	require.NoError(t, err)
+	store.chunkSize = 4
+
+	return store, func() { os.RemoveAll(dir) }
+}
+
+func TestStoreSaveLoad(t *testing.T) {
+	store, cleanup := setupStore(t)
+	defer cleanup()
+
+	data := []byte("snapshot data of height 3")
+	snapshot, err := store.Save(3, CurrentFormat, bytes.NewReader(data))
+	require.NoError(t, err)
+	require.Equal(t, int64(3), snapshot.Height)
+	require.Equal(t, uint32(7), snapshot.Chunks)
+	require.NoError(t, snapshot.Validate())
+
+	_, err = store.Save(3, CurrentFormat, bytes.NewReader(data))
+	require.Error(t, err)
+	_, err = store.Save(4, CurrentFormat, bytes.NewReader(nil))
+	require.Error(t, err)
+	_, err = store.Save(0, CurrentFormat, bytes.NewReader(data))
+	require.Error(t, err)
+
+	got, err := store.Get(3)
+	require.NoError(t, err)
+	require.Equal(t, snapshot, got)
+
+	got, err = store.Get(4)
+	require.NoError(t, err)
+	require.Nil(t, got)
+
+	_, rc, err := store.Load(3)
+	require.NoError(t, err)
+	loaded, err := ioutil.ReadAll(rc)
+	require.NoError(t, err)
+	require.NoError(t, rc.Close())
+	require.Equal(t, data, loaded)
+
+	_, _, err = store.Load(4)
+	require.Error(t, err)
+}
+
+func TestStoreLoadCorrupted(t *testing.T) {
+	store, cleanup := setupStore(t)
+	defer cleanup()
+
+	_, err := store.Save(1, CurrentFormat, bytes.NewReader([]byte("0123456789")))
+	require.NoError(t, err)
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(store.Dir(), "1", "1"), []byte("abcd"), 0644))
+
+	_, rc, err := store.Load(1)
+	require.NoError(t, err)
+	_, err = ioutil.ReadAll(rc)
+	require.Error(t, err)
+
+	require.NoError(t, os.Remove(filepath.Join(store.Dir(), "1", "2")))
+	snapshot, err := store.Get(1)
+	require.NoError(t, err)
+	_, err = store.LoadChunk(snapshot, 2)
+	require.Error(t, err)
+	_, err = store.LoadChunk(snapshot, 3)
+	require.Error(t, err)
+}
+
+func TestStoreListPrune(t *testing.T) {
+	store, cleanup := setupStore(t)
+	defer cleanup()
+
+	for _, height := range []int64{2, 10, 4, 6} {
+		_, err := store.Save(height, CurrentFormat, bytes.NewReader([]byte("data")))
+		require.NoError(t, err)
+	}
+	// leftovers of an interrupted save are ignored
+	require.NoError(t, os.Mkdir(filepath.Join(store.Dir(), "12.tmp"), 0755))
+
+	snapshots, err := store.List()
+	require.NoError(t, err)
+	require.Len(t, snapshots, 4)
+	for i, height := range []int64{10, 6, 4, 2} {
+		require.Equal(t, height, snapshots[i].Height)
+	}
+
+	pruned, err := store.Prune(2)
+	require.NoError(t, err)
+	require.Equal(t, 2, pruned)
+
+	pruned, err = store.Prune(2)
+	require.NoError(t, err)
+	require.Equal(t, 0, pruned)
+
+	require.NoError(t, store.Delete(10))
+	require.Error(t, store.Delete(10))
+
+	snapshots, err = store.List()
+	require.NoError(t, err)
+	require.Len(t, snapshots, 1)
+	require.Equal(t, int64(6), snapshots[0].Height)
+}
+
+func TestStoreExportImport(t *testing.T) {
+	store, cleanup := setupStore(t)
+	defer cleanup()
+
+	data := []byte("exported snapshot data")
+	snapshot, err := store.Save(5, CurrentFormat, bytes.NewReader(data))
+	require.NoError(t, err)
+
+	var archive bytes.Buffer
+	require.NoError(t, store.Export(5, &archive))
+	require.Error(t, store.Export(6, &bytes.Buffer{}))
+
+	target, cleanupTarget := setupStore(t)
+	defer cleanupTarget()
+
+	imported, err := target.Import(bytes.NewReader(archive.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, snapshot, imported)
+
+	_, rc, err := target.Load(5)
+	require.NoError(t, err)
+	loaded, err := ioutil.ReadAll(rc)
+	require.NoError(t, err)
+	require.Equal(t, data, loaded)
+
+	// importing an existing snapshot fails
+	_, err = target.Import(bytes.NewReader(archive.Bytes()))
+	require.Error(t, err)
+
+	// corrupted chunks are rejected
+	corrupted := bytes.Replace(archive.Bytes(), []byte("expo"), []byte("EXPO"), 1)
+	other, cleanupOther := setupStore(t)
+	defer cleanupOther()
+
+	_, err = other.Import(bytes.NewReader(corrupted))
+	require.Error(t, err)
+	snapshots, err := other.List()
+	require.NoError(t, err)
+	require.Empty(t, snapshots)
+}
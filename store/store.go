@@ -16,16 +16,15 @@ func NewCommitKVStoreCacheManager() types.MultiStorePersistentCache {
 	return cache.NewCommitKVStoreCacheManager(cache.DefaultCommitKVStoreCacheSize)
 }
 
-func NewPruningOptionsFromString(strategy string) (opt PruningOptions) {
+// NewPruningOptionsFromString returns the pruning options of a named strategy,
+// defaulting to PruneSyncable for unknown and custom strategies.
+func NewPruningOptionsFromString(strategy string) PruningOptions {
 	switch strategy {
-	case "nothing":
-		opt = PruneNothing
-	case "everything":
-		opt = PruneEverything
-	case "syncable":
-		opt = PruneSyncable
+	case types.PruningStrategyNothing:
+		return PruneNothing
+	case types.PruningStrategyEverything:
+		return PruneEverything
 	default:
-		opt = PruneSyncable
+		return PruneSyncable
 	}
-	return
 }
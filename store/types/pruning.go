@@ -1,16 +1,36 @@
 package types
 
+import (
+	"fmt"
+)
+
+// Pruning strategies that can be selected by name
+const (
+	// PruningStrategyNothing keeps all historic states (i.e. archiving node)
+	PruningStrategyNothing = "nothing"
+	// PruningStrategyEverything keeps only the current state
+	PruningStrategyEverything = "everything"
+	// PruningStrategySyncable keeps the states needed for state syncing
+	PruningStrategySyncable = "syncable"
+	// PruningStrategyCustom keeps the states defined by explicit options
+	PruningStrategyCustom = "custom"
+)
+
 // PruningStrategy specifies how old states will be deleted over time where
-// keepRecent can be used with keepEvery to create a pruning "strategy".
+// keepRecent can be used with keepEvery to create a pruning "strategy". The
+// interval defines how often, in blocks, the states that are no longer kept
+// are deleted; an interval of 0 or 1 deletes them after every commit.
 type PruningOptions struct {
 	keepRecent int64
 	keepEvery  int64
+	interval   int64
 }
 
-func NewPruningOptions(keepRecent, keepEvery int64) PruningOptions {
+func NewPruningOptions(keepRecent, keepEvery, interval int64) PruningOptions {
 	return PruningOptions{
 		keepRecent: keepRecent,
 		keepEvery:  keepEvery,
+		interval:   interval,
 	}
 }
 
@@ -24,12 +44,80 @@ func (po PruningOptions) KeepEvery() int64 {
 	return po.keepEvery
 }
 
+// Interval returns the number of blocks between two batches of pruning.
+func (po PruningOptions) Interval() int64 {
+	return po.interval
+}
+
+// KeepVersion returns true if the given version must be kept once the latest
+// version has been committed.
+func (po PruningOptions) KeepVersion(latest, version int64) bool {
+	if version >= latest-po.keepRecent {
+		return true
+	}
+	return po.keepEvery > 0 && version%po.keepEvery == 0
+}
+
+// ShouldPrune returns true if the versions that are no longer kept must be
+// deleted once the given version has been committed.
+func (po PruningOptions) ShouldPrune(version int64) bool {
+	return po.interval <= 1 || version%po.interval == 0
+}
+
+// Validate checks that the pruning options are consistent.
+func (po PruningOptions) Validate() error {
+	if po.keepRecent < 0 {
+		return fmt.Errorf("invalid number of recent states to keep: %d", po.keepRecent)
+	}
+	if po.keepEvery < 0 {
+		return fmt.Errorf("invalid interval of states to keep: %d", po.keepEvery)
+	}
+	if po.interval < 0 {
+		return fmt.Errorf("invalid pruning interval: %d", po.interval)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (po PruningOptions) String() string {
+	return fmt.Sprintf("keep-recent: %d keep-every: %d interval: %d", po.keepRecent, po.keepEvery, po.interval)
+}
+
 // default pruning strategies
 var (
 	// PruneEverything means all saved states will be deleted, storing only the current state
-	PruneEverything = NewPruningOptions(0, 0)
+	PruneEverything = NewPruningOptions(0, 0, 10)
 	// PruneNothing means all historic states will be saved, nothing will be deleted
-	PruneNothing = NewPruningOptions(0, 1)
+	PruneNothing = NewPruningOptions(0, 1, 0)
 	// PruneSyncable means only those states not needed for state syncing will be deleted (keeps last 100 + every 10000th)
-	PruneSyncable = NewPruningOptions(100, 10000)
+	PruneSyncable = NewPruningOptions(100, 10000, 10)
 )
+
+// NewPruningOptionsFromStrategy returns the pruning options of a named
+// strategy. The keepRecent, keepEvery and interval values are only used by the
+// custom strategy.
+func NewPruningOptionsFromStrategy(strategy string, keepRecent, keepEvery, interval int64) (PruningOptions, error) {
+	switch strategy {
+	case PruningStrategyNothing:
+		return PruneNothing, nil
+
+	case PruningStrategyEverything:
+		return PruneEverything, nil
+
+	case PruningStrategySyncable, "":
+		return PruneSyncable, nil
+
+	case PruningStrategyCustom:
+		opts := NewPruningOptions(keepRecent, keepEvery, interval)
+		if opts.interval == 0 && opts.keepEvery != 1 {
+			return PruningOptions{}, fmt.Errorf("custom pruning requires a non-zero interval")
+		}
+		if err := opts.Validate(); err != nil {
+			return PruningOptions{}, err
+		}
+		return opts, nil
+
+	default:
+		return PruningOptions{}, fmt.Errorf("unknown pruning strategy %s", strategy)
+	}
+}
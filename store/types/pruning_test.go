@@ -0,0 +1,68 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPruningOptionsKeepVersion(t *testing.T) {
+	tests := []struct {
+		opts    PruningOptions
+		latest  int64
+		version int64
+		keep    bool
+	}{
+		{PruneNothing, 100, 1, true},
+		{PruneEverything, 100, 100, true},
+		{PruneEverything, 100, 99, false},
+		{PruneSyncable, 1000, 900, true},
+		{PruneSyncable, 1000, 899, false},
+		{PruneSyncable, 20001, 10000, true},
+		{NewPruningOptions(3, 4, 1), 12, 9, true},
+		{NewPruningOptions(3, 4, 1), 12, 8, true},
+		{NewPruningOptions(3, 4, 1), 12, 7, false},
+	}
+
+	for i, tc := range tests {
+		require.Equal(t, tc.keep, tc.opts.KeepVersion(tc.latest, tc.version), "test %d", i)
+	}
+}
+
+func TestPruningOptionsShouldPrune(t *testing.T) {
+	require.True(t, NewPruningOptions(0, 0, 0).ShouldPrune(7))
+	require.True(t, NewPruningOptions(0, 0, 1).ShouldPrune(7))
+	require.False(t, NewPruningOptions(0, 0, 10).ShouldPrune(7))
+	require.True(t, NewPruningOptions(0, 0, 10).ShouldPrune(70))
+}
+
+func TestNewPruningOptionsFromStrategy(t *testing.T) {
+	tests := []struct {
+		strategy   string
+		keepRecent int64
+		keepEvery  int64
+		interval   int64
+		expected   PruningOptions
+		expectErr  bool
+	}{
+		{PruningStrategyNothing, 5, 5, 5, PruneNothing, false},
+		{PruningStrategyEverything, 0, 0, 0, PruneEverything, false},
+		{PruningStrategySyncable, 0, 0, 0, PruneSyncable, false},
+		{"", 0, 0, 0, PruneSyncable, false},
+		{PruningStrategyCustom, 10, 100, 5, NewPruningOptions(10, 100, 5), false},
+		{PruningStrategyCustom, 0, 1, 0, NewPruningOptions(0, 1, 0), false},
+		{PruningStrategyCustom, 10, 100, 0, PruningOptions{}, true},
+		{PruningStrategyCustom, -1, 100, 5, PruningOptions{}, true},
+		{"unknown", 0, 0, 0, PruningOptions{}, true},
+	}
+
+	for i, tc := range tests {
+		opts, err := NewPruningOptionsFromStrategy(tc.strategy, tc.keepRecent, tc.keepEvery, tc.interval)
+		if tc.expectErr {
+			require.Error(t, err, "test %d", i)
+			continue
+		}
+		require.NoError(t, err, "test %d", i)
+		require.Equal(t, tc.expected, opts, "test %d", i)
+	}
+}
@@ -155,6 +155,19 @@ type CommitMultiStore interface {
 	// Set an inter-block (persistent) cache that maintains a mapping from
 	// StoreKeys to CommitKVStores.
 	SetInterBlockCache(MultiStorePersistentCache)
+
+	Snapshotter
+}
+
+// Snapshotter is something that can write a snapshot of its committed state at
+// a given height and restore its state from such a snapshot.
+type Snapshotter interface {
+	// Snapshot writes a snapshot of the state committed at the given height.
+	Snapshot(height int64, w io.Writer) error
+
+	// Restore restores the state committed at the given height from a snapshot
+	// written by Snapshot. It must only be called on an empty store.
+	Restore(height int64, r io.Reader) error
 }
 
 //---------subsp-------------------------------
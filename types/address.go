@@ -9,10 +9,11 @@ import (
 	"strings"
 
 	"github.com/tendermint/tendermint/crypto"
-	cryptoAmino "github.com/tendermint/tendermint/crypto/encoding/amino"
 	yaml "gopkg.in/yaml.v2"
 
 	"github.com/tendermint/tendermint/libs/bech32"
+
+	"github.com/cosmos/cosmos-sdk/codec"
 )
 
 const (
@@ -635,7 +636,7 @@ func GetAccPubKeyBech32(pubkey string) (pk crypto.PubKey, err error) {
 		return nil, err
 	}
 
-	pk, err = cryptoAmino.PubKeyFromBytes(bz)
+	err = codec.Cdc.UnmarshalBinaryBare(bz, &pk)
 	if err != nil {
 		return nil, err
 	}
@@ -663,7 +664,7 @@ func GetValPubKeyBech32(pubkey string) (pk crypto.PubKey, err error) {
 		return nil, err
 	}
 
-	pk, err = cryptoAmino.PubKeyFromBytes(bz)
+	err = codec.Cdc.UnmarshalBinaryBare(bz, &pk)
 	if err != nil {
 		return nil, err
 	}
@@ -691,7 +692,7 @@ func GetConsPubKeyBech32(pubkey string) (pk crypto.PubKey, err error) {
 		return nil, err
 	}
 
-	pk, err = cryptoAmino.PubKeyFromBytes(bz)
+	err = codec.Cdc.UnmarshalBinaryBare(bz, &pk)
 	if err != nil {
 		return nil, err
 	}
@@ -60,6 +60,8 @@ func TestCoinIsValid(t *testing.T) {
 		{Coin{"Atom", NewInt(1)}, false},
 		{Coin{"a", NewInt(1)}, false},
 		{Coin{"a very long coin denom", NewInt(1)}, false},
+		{Coin{"a" + strings.Repeat("0", 127), NewInt(1)}, true},
+		{Coin{"a" + strings.Repeat("0", 128), NewInt(1)}, false},
 		{Coin{"atOm", NewInt(1)}, false},
 		{Coin{"     ", NewInt(1)}, false},
 	}
@@ -6,3 +6,36 @@ type Handler func(ctx Context, msg Msg) Result
 // AnteHandler authenticates transactions, before their internal messages are handled.
 // If newCtx.IsZero(), ctx is used instead.
 type AnteHandler func(ctx Context, tx Tx, simulate bool) (newCtx Context, result Result, abort bool)
+
+// AnteDecorator wraps the next AnteHandler to perform custom pre- and post-processing.
+type AnteDecorator interface {
+	AnteHandle(ctx Context, tx Tx, simulate bool, next AnteHandler) (newCtx Context, result Result, abort bool)
+}
+
+// ChainAnteDecorators links the given AnteDecorators in order and returns the
+// resulting AnteHandler, so that a single AnteHandler can still be set in the
+// baseapp. The last decorator is passed an AnteHandler which returns the
+// context it is given without aborting. It returns nil if no decorator is given.
+func ChainAnteDecorators(chain ...AnteDecorator) AnteHandler {
+	if len(chain) == 0 {
+		return nil
+	}
+
+	next := terminator
+	for i := len(chain) - 1; i >= 0; i-- {
+		next = chainAnteDecorator(chain[i], next)
+	}
+
+	return next
+}
+
+func chainAnteDecorator(decorator AnteDecorator, next AnteHandler) AnteHandler {
+	return func(ctx Context, tx Tx, simulate bool) (Context, Result, bool) {
+		return decorator.AnteHandle(ctx, tx, simulate, next)
+	}
+}
+
+// terminator ends a chain of AnteDecorators.
+func terminator(ctx Context, _ Tx, _ bool) (Context, Result, bool) {
+	return ctx, Result{}, false
+}
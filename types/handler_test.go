@@ -0,0 +1,54 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testAnteDecorator struct {
+	name  string
+	abort bool
+	calls *[]string
+}
+
+func (d testAnteDecorator) AnteHandle(ctx Context, tx Tx, simulate bool, next AnteHandler) (Context, Result, bool) {
+	*d.calls = append(*d.calls, d.name)
+	if d.abort {
+		return ctx, ErrUnauthorized(d.name).Result(), true
+	}
+
+	newCtx, res, abort := next(ctx, tx, simulate)
+	*d.calls = append(*d.calls, d.name+" done")
+	return newCtx, res, abort
+}
+
+func TestChainAnteDecorators(t *testing.T) {
+	require.Nil(t, ChainAnteDecorators())
+
+	var calls []string
+	handler := ChainAnteDecorators(
+		testAnteDecorator{name: "a", calls: &calls},
+		testAnteDecorator{name: "b", calls: &calls},
+	)
+
+	ctx := Context{}.WithChainID("test")
+	newCtx, res, abort := handler(ctx, nil, false)
+	require.False(t, abort)
+	require.True(t, res.IsOK())
+	require.Equal(t, "test", newCtx.ChainID())
+	require.Equal(t, []string{"a", "b", "b done", "a done"}, calls)
+
+	// an aborting decorator does not call the rest of the chain
+	calls = nil
+	handler = ChainAnteDecorators(
+		testAnteDecorator{name: "a", calls: &calls},
+		testAnteDecorator{name: "b", abort: true, calls: &calls},
+		testAnteDecorator{name: "c", calls: &calls},
+	)
+
+	_, res, abort = handler(ctx, nil, false)
+	require.True(t, abort)
+	require.Equal(t, CodeUnauthorized, res.Code)
+	require.Equal(t, []string{"a", "b", "a done"}, calls)
+}
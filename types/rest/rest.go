@@ -54,6 +54,7 @@ type BaseReq struct {
 	Sequence      uint64       `json:"sequence"`
 	Fees          sdk.Coins    `json:"fees"`
 	GasPrices     sdk.DecCoins `json:"gas_prices"`
+	FeeGranter    string       `json:"fee_granter,omitempty"`
 	Gas           string       `json:"gas"`
 	GasAdjustment string       `json:"gas_adjustment"`
 	Simulate      bool         `json:"simulate"`
@@ -81,10 +82,12 @@ func NewBaseReq(
 
 // Sanitize performs basic sanitization on a BaseReq object.
 func (br BaseReq) Sanitize() BaseReq {
-	return NewBaseReq(
+	sanitized := NewBaseReq(
 		br.From, br.Memo, br.ChainID, br.Gas, br.GasAdjustment,
 		br.AccountNumber, br.Sequence, br.Fees, br.GasPrices, br.Simulate,
 	)
+	sanitized.FeeGranter = strings.TrimSpace(br.FeeGranter)
+	return sanitized
 }
 
 // ValidateBasic performs basic validation of a BaseReq. If custom validation
@@ -114,6 +117,13 @@ func (br BaseReq) ValidateBasic(w http.ResponseWriter) bool {
 		return false
 	}
 
+	if len(br.FeeGranter) != 0 {
+		if _, err := sdk.AccAddressFromBech32(br.FeeGranter); err != nil {
+			WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("invalid fee granter address: %s", br.FeeGranter))
+			return false
+		}
+	}
+
 	return true
 }
 
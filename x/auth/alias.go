@@ -24,6 +24,8 @@ const (
 	DefaultTxSizeCostPerByte      = types.DefaultTxSizeCostPerByte
 	DefaultSigVerifyCostED25519   = types.DefaultSigVerifyCostED25519
 	DefaultSigVerifyCostSecp256k1 = types.DefaultSigVerifyCostSecp256k1
+	DefaultSigVerifyCostSecp256r1 = types.DefaultSigVerifyCostSecp256r1
+	DefaultSigVerifyCostSr25519   = types.DefaultSigVerifyCostSr25519
 	QueryAccount                  = types.QueryAccount
 )
 
@@ -39,6 +41,17 @@ var (
 	EnsureSufficientMempoolFees       = ante.EnsureSufficientMempoolFees
 	SetGasMeter                       = ante.SetGasMeter
 	GetSignBytes                      = ante.GetSignBytes
+	NewSetUpContextDecorator          = ante.NewSetUpContextDecorator
+	NewMempoolFeeDecorator            = ante.NewMempoolFeeDecorator
+	NewDeductFeeDecorator             = ante.NewDeductFeeDecorator
+	NewValidateBasicDecorator         = ante.NewValidateBasicDecorator
+	NewValidateSigCountDecorator      = ante.NewValidateSigCountDecorator
+	NewValidateMemoDecorator          = ante.NewValidateMemoDecorator
+	NewConsumeTxSizeGasDecorator      = ante.NewConsumeTxSizeGasDecorator
+	NewSetPubKeyDecorator             = ante.NewSetPubKeyDecorator
+	NewSigGasConsumeDecorator         = ante.NewSigGasConsumeDecorator
+	NewSigVerificationDecorator       = ante.NewSigVerificationDecorator
+	NewIncrementSequenceDecorator     = ante.NewIncrementSequenceDecorator
 	NewAccountKeeper                  = keeper.NewAccountKeeper
 	NewQuerier                        = keeper.NewQuerier
 	NewBaseAccount                    = types.NewBaseAccount
@@ -49,6 +62,9 @@ var (
 	NewContinuousVestingAccount       = types.NewContinuousVestingAccount
 	NewDelayedVestingAccountRaw       = types.NewDelayedVestingAccountRaw
 	NewDelayedVestingAccount          = types.NewDelayedVestingAccount
+	NewPeriodicVestingAccountRaw      = types.NewPeriodicVestingAccountRaw
+	NewPeriodicVestingAccount         = types.NewPeriodicVestingAccount
+	NewPeriod                         = types.NewPeriod
 	NewAccountRetriever               = types.NewAccountRetriever
 	RegisterCodec                     = types.RegisterCodec
 	RegisterAccountTypeCodec          = types.RegisterAccountTypeCodec
@@ -81,10 +97,23 @@ var (
 	KeyTxSizeCostPerByte      = types.KeyTxSizeCostPerByte
 	KeySigVerifyCostED25519   = types.KeySigVerifyCostED25519
 	KeySigVerifyCostSecp256k1 = types.KeySigVerifyCostSecp256k1
+	KeySigVerifyCostSecp256r1 = types.KeySigVerifyCostSecp256r1
+	KeySigVerifyCostSr25519   = types.KeySigVerifyCostSr25519
 )
 
 type (
 	SignatureVerificationGasConsumer = ante.SignatureVerificationGasConsumer
+	SetUpContextDecorator            = ante.SetUpContextDecorator
+	MempoolFeeDecorator              = ante.MempoolFeeDecorator
+	DeductFeeDecorator               = ante.DeductFeeDecorator
+	ValidateBasicDecorator           = ante.ValidateBasicDecorator
+	ValidateSigCountDecorator        = ante.ValidateSigCountDecorator
+	ValidateMemoDecorator            = ante.ValidateMemoDecorator
+	ConsumeTxSizeGasDecorator        = ante.ConsumeTxSizeGasDecorator
+	SetPubKeyDecorator               = ante.SetPubKeyDecorator
+	SigGasConsumeDecorator           = ante.SigGasConsumeDecorator
+	SigVerificationDecorator         = ante.SigVerificationDecorator
+	IncrementSequenceDecorator       = ante.IncrementSequenceDecorator
 	Account                          = exported.Account
 	VestingAccount                   = exported.VestingAccount
 	AccountKeeper                    = keeper.AccountKeeper
@@ -92,6 +121,9 @@ type (
 	BaseVestingAccount               = types.BaseVestingAccount
 	ContinuousVestingAccount         = types.ContinuousVestingAccount
 	DelayedVestingAccount            = types.DelayedVestingAccount
+	PeriodicVestingAccount           = types.PeriodicVestingAccount
+	Period                           = types.Period
+	Periods                          = types.Periods
 	NodeQuerier                      = types.NodeQuerier
 	AccountRetriever                 = types.AccountRetriever
 	GenesisState                     = types.GenesisState
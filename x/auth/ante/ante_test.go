@@ -12,6 +12,8 @@ import (
 	"github.com/tendermint/tendermint/crypto/multisig"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/sr25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/exported"
@@ -94,6 +96,48 @@ func TestAnteHandlerSigErrors(t *testing.T) {
 	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeUnknownAddress)
 }
 
+// Test that the signatures of the keys of all the supported algorithms are verified.
+func TestAnteHandlerSigningAlgos(t *testing.T) {
+	// setup
+	app, ctx := createTestApp(true)
+	ctx = ctx.WithBlockHeight(1)
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, ante.DefaultSigVerificationGasConsumer)
+
+	privs := []crypto.PrivKey{secp256k1.GenPrivKey(), ed25519.GenPrivKey(), secp256r1.GenPrivKey(), sr25519.GenPrivKey()}
+	addrs := make([]sdk.AccAddress, len(privs))
+	accNums, seqs := make([]uint64, len(privs)), make([]uint64, len(privs))
+	for i, priv := range privs {
+		addrs[i] = sdk.AccAddress(priv.PubKey().Address())
+		acc := app.AccountKeeper.NewAccountWithAddress(ctx, addrs[i])
+		acc.SetCoins(types.NewTestCoins())
+		app.AccountKeeper.SetAccount(ctx, acc)
+		accNums[i] = acc.GetAccountNumber()
+	}
+
+	msgs := []sdk.Msg{types.NewTestMsg(addrs...)}
+	fee := types.NewTestStdFee()
+
+	// the signature of another key is rejected
+	invalidPrivs := []crypto.PrivKey{privs[0], privs[1], secp256r1.GenPrivKey(), privs[3]}
+	tx := types.NewTestTx(ctx, msgs, invalidPrivs, accNums, seqs, fee)
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeInvalidPubKey)
+	invalidPrivs = []crypto.PrivKey{privs[0], privs[1], privs[2], sr25519.GenPrivKey()}
+	tx = types.NewTestTx(ctx, msgs, invalidPrivs, accNums, seqs, fee)
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeInvalidPubKey)
+
+	tx = types.NewTestTx(ctx, msgs, privs, accNums, seqs, fee)
+	checkValidTx(t, anteHandler, ctx, tx, false)
+
+	// the public keys are stored with the accounts
+	for i, priv := range privs {
+		require.Equal(t, priv.PubKey(), app.AccountKeeper.GetAccount(ctx, addrs[i]).GetPubKey())
+	}
+
+	seqs = []uint64{1, 1, 1, 1}
+	tx = types.NewTestTx(ctx, msgs, privs, accNums, seqs, fee)
+	checkValidTx(t, anteHandler, ctx, tx, false)
+}
+
 // Test logic around account number checking with one signer and many signers.
 func TestAnteHandlerAccountNumbers(t *testing.T) {
 	// setup
@@ -323,6 +367,35 @@ func TestAnteHandlerFees(t *testing.T) {
 	require.True(sdk.IntEq(t, app.AccountKeeper.GetAccount(ctx, addr1).GetCoins().AmountOf("atom"), sdk.NewInt(0)))
 }
 
+// Test that fee grants are rejected by the default AnteHandler.
+func TestAnteHandlerFeeGranter(t *testing.T) {
+	// setup
+	app, ctx := createTestApp(true)
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, ante.DefaultSigVerificationGasConsumer)
+
+	// keys and addresses
+	priv1, _, addr1 := types.KeyTestPubAddr()
+	_, _, addr2 := types.KeyTestPubAddr()
+
+	// set the accounts
+	acc1 := app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	acc1.SetCoins(sdk.NewCoins(sdk.NewInt64Coin("atom", 150)))
+	app.AccountKeeper.SetAccount(ctx, acc1)
+
+	msgs := []sdk.Msg{types.NewTestMsg(addr1)}
+	privs, accnums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+
+	fee := types.NewTestStdFee()
+	fee.Granter = addr2
+	tx := types.NewTestTx(ctx, msgs, privs, accnums, seqs, fee)
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeUnauthorized)
+
+	// the fee payer may be set as granter
+	fee.Granter = addr1
+	tx = types.NewTestTx(ctx, msgs, privs, accnums, seqs, fee)
+	checkValidTx(t, anteHandler, ctx, tx, false)
+}
+
 // Test logic around memo gas consumption.
 func TestAnteHandlerMemoGas(t *testing.T) {
 	// setup
@@ -359,7 +432,7 @@ func TestAnteHandlerMemoGas(t *testing.T) {
 	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeMemoTooLarge)
 
 	// tx with memo has enough gas
-	fee = types.NewStdFee(9000, sdk.NewCoins(sdk.NewInt64Coin("atom", 0)))
+	fee = types.NewStdFee(20000, sdk.NewCoins(sdk.NewInt64Coin("atom", 0)))
 	tx = types.NewTestTxWithMemo(ctx, []sdk.Msg{msg}, privs, accnums, seqs, fee, strings.Repeat("0123456789", 10))
 	checkValidTx(t, anteHandler, ctx, tx, false)
 }
@@ -602,8 +675,10 @@ func TestConsumeSignatureVerificationGas(t *testing.T) {
 		gasConsumed uint64
 		shouldErr   bool
 	}{
-		{"PubKeyEd25519", args{sdk.NewInfiniteGasMeter(), nil, ed25519.GenPrivKey().PubKey(), params}, types.DefaultSigVerifyCostED25519, true},
+		{"PubKeyEd25519", args{sdk.NewInfiniteGasMeter(), nil, ed25519.GenPrivKey().PubKey(), params}, types.DefaultSigVerifyCostED25519, false},
 		{"PubKeySecp256k1", args{sdk.NewInfiniteGasMeter(), nil, secp256k1.GenPrivKey().PubKey(), params}, types.DefaultSigVerifyCostSecp256k1, false},
+		{"PubKeySecp256r1", args{sdk.NewInfiniteGasMeter(), nil, secp256r1.GenPrivKey().PubKey(), params}, types.DefaultSigVerifyCostSecp256r1, false},
+		{"PubKeySr25519", args{sdk.NewInfiniteGasMeter(), nil, sr25519.GenPrivKey().PubKey(), params}, types.DefaultSigVerifyCostSr25519, false},
 		{"Multisig", args{sdk.NewInfiniteGasMeter(), multisignature1.Marshal(), multisigKey1, params}, expectedCost1, false},
 		{"unknown key", args{sdk.NewInfiniteGasMeter(), nil, nil, params}, 0, true},
 	}
@@ -819,3 +894,58 @@ func TestCustomSignatureVerificationGasConsumer(t *testing.T) {
 	tx = types.NewTestTx(ctx, msgs, privs, accnums, seqs, fee)
 	checkValidTx(t, anteHandler, ctx, tx, false)
 }
+
+// rejectMemoDecorator is a custom decorator rejecting transactions with a memo.
+type rejectMemoDecorator struct{}
+
+func (rmd rejectMemoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, sdk.Result, bool) {
+	if tx.(types.StdTx).GetMemo() != "" {
+		return ctx, sdk.ErrMemoTooLarge("no memo allowed").Result(), true
+	}
+	return next(ctx, tx, simulate)
+}
+
+// Test that the decorators can be reordered, replaced or extended.
+func TestCustomAnteDecoratorChain(t *testing.T) {
+	// setup
+	app, ctx := createTestApp(true)
+	ctx = ctx.WithBlockHeight(1)
+
+	// the signature verification is replaced by a custom decorator
+	anteHandler := sdk.ChainAnteDecorators(
+		ante.NewSetUpContextDecorator(),
+		ante.NewValidateBasicDecorator(),
+		rejectMemoDecorator{},
+		ante.NewDeductFeeDecorator(app.AccountKeeper, app.SupplyKeeper),
+		ante.NewSetPubKeyDecorator(app.AccountKeeper),
+		ante.NewIncrementSequenceDecorator(app.AccountKeeper),
+	)
+
+	// keys and addresses
+	priv1, _, addr1 := types.KeyTestPubAddr()
+
+	// set the accounts
+	acc1 := app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	acc1.SetCoins(types.NewTestCoins())
+	require.NoError(t, acc1.SetAccountNumber(0))
+	app.AccountKeeper.SetAccount(ctx, acc1)
+
+	msgs := []sdk.Msg{types.NewTestMsg(addr1)}
+	privs, accnums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	fee := types.NewTestStdFee()
+
+	// the custom decorator rejects the memo
+	tx := types.NewTestTxWithMemo(ctx, msgs, privs, accnums, seqs, fee, "memo")
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeMemoTooLarge)
+
+	// signatures are not verified, so the wrong account number is accepted
+	tx = types.NewTestTx(ctx, msgs, privs, []uint64{1}, seqs, fee)
+	newCtx, res, abort := anteHandler(ctx, tx, false)
+	require.False(t, abort, res.Log)
+	require.Equal(t, fee.Gas, res.GasWanted)
+
+	acc1 = app.AccountKeeper.GetAccount(newCtx, addr1)
+	require.Equal(t, uint64(1), acc1.GetSequence())
+	require.Equal(t, priv1.PubKey(), acc1.GetPubKey())
+	require.Equal(t, types.NewTestCoins().Sub(fee.Amount), acc1.GetCoins())
+}
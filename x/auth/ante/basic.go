@@ -0,0 +1,113 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+var (
+	_ sdk.AnteDecorator = ValidateBasicDecorator{}
+	_ sdk.AnteDecorator = ValidateSigCountDecorator{}
+	_ sdk.AnteDecorator = ValidateMemoDecorator{}
+	_ sdk.AnteDecorator = ConsumeTxSizeGasDecorator{}
+)
+
+// ValidateBasicDecorator calls the ValidateBasic method of the transaction.
+type ValidateBasicDecorator struct{}
+
+// NewValidateBasicDecorator returns a new ValidateBasicDecorator.
+func NewValidateBasicDecorator() ValidateBasicDecorator {
+	return ValidateBasicDecorator{}
+}
+
+// AnteHandle implements the sdk.AnteDecorator interface.
+func (vbd ValidateBasicDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, sdk.Result, bool) {
+
+	if err := tx.ValidateBasic(); err != nil {
+		return ctx, err.Result(), true
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// ValidateSigCountDecorator validates that the transaction does not carry more
+// signatures, including the ones of multisig public keys, than the TxSigLimit
+// parameter allows.
+type ValidateSigCountDecorator struct {
+	ak keeper.AccountKeeper
+}
+
+// NewValidateSigCountDecorator returns a new ValidateSigCountDecorator.
+func NewValidateSigCountDecorator(ak keeper.AccountKeeper) ValidateSigCountDecorator {
+	return ValidateSigCountDecorator{ak: ak}
+}
+
+// AnteHandle implements the sdk.AnteDecorator interface.
+func (vscd ValidateSigCountDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, sdk.Result, bool) {
+
+	stdTx, ok := tx.(types.StdTx)
+	if !ok {
+		return ctx, sdk.ErrInternal("tx must be StdTx").Result(), true
+	}
+
+	if res := ValidateSigCount(stdTx, getParams(ctx, vscd.ak)); !res.IsOK() {
+		return ctx, res, true
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// ValidateMemoDecorator validates that the memo of the transaction does not
+// exceed the MaxMemoCharacters parameter.
+type ValidateMemoDecorator struct {
+	ak keeper.AccountKeeper
+}
+
+// NewValidateMemoDecorator returns a new ValidateMemoDecorator.
+func NewValidateMemoDecorator(ak keeper.AccountKeeper) ValidateMemoDecorator {
+	return ValidateMemoDecorator{ak: ak}
+}
+
+// AnteHandle implements the sdk.AnteDecorator interface.
+func (vmd ValidateMemoDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, sdk.Result, bool) {
+
+	stdTx, ok := tx.(types.StdTx)
+	if !ok {
+		return ctx, sdk.ErrInternal("tx must be StdTx").Result(), true
+	}
+
+	if res := ValidateMemo(stdTx, getParams(ctx, vmd.ak)); !res.IsOK() {
+		return ctx, res, true
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// ConsumeTxSizeGasDecorator consumes gas proportionally to the size of the
+// transaction bytes, as set by the TxSizeCostPerByte parameter.
+type ConsumeTxSizeGasDecorator struct {
+	ak keeper.AccountKeeper
+}
+
+// NewConsumeTxSizeGasDecorator returns a new ConsumeTxSizeGasDecorator.
+func NewConsumeTxSizeGasDecorator(ak keeper.AccountKeeper) ConsumeTxSizeGasDecorator {
+	return ConsumeTxSizeGasDecorator{ak: ak}
+}
+
+// AnteHandle implements the sdk.AnteDecorator interface.
+func (ctgd ConsumeTxSizeGasDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, sdk.Result, bool) {
+
+	params := getParams(ctx, ctgd.ak)
+	ctx.GasMeter().ConsumeGas(params.TxSizeCostPerByte*sdk.Gas(len(ctx.TxBytes())), "txSize")
+
+	return next(ctx, tx, simulate)
+}
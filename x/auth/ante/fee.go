@@ -0,0 +1,93 @@
+package ante
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+var (
+	_ sdk.AnteDecorator = MempoolFeeDecorator{}
+	_ sdk.AnteDecorator = DeductFeeDecorator{}
+)
+
+// MempoolFeeDecorator ensures that the fees of the transaction meet the minimum
+// gas prices of the validator during CheckTx. It is only used for local
+// mempool purposes and is skipped during DeliverTx and simulation.
+type MempoolFeeDecorator struct{}
+
+// NewMempoolFeeDecorator returns a new MempoolFeeDecorator.
+func NewMempoolFeeDecorator() MempoolFeeDecorator {
+	return MempoolFeeDecorator{}
+}
+
+// AnteHandle implements the sdk.AnteDecorator interface.
+func (mfd MempoolFeeDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, sdk.Result, bool) {
+
+	stdTx, ok := tx.(types.StdTx)
+	if !ok {
+		return ctx, sdk.ErrInternal("tx must be StdTx").Result(), true
+	}
+
+	if ctx.IsCheckTx() && !simulate {
+		if res := EnsureSufficientMempoolFees(ctx, stdTx.Fee); !res.IsOK() {
+			return ctx, res, true
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// DeductFeeDecorator deducts the fees of the transaction from the first signer,
+// who must have an account, and sends them to the fee collector module account.
+// Transactions whose fees are paid by a fee granter are rejected.
+type DeductFeeDecorator struct {
+	ak           keeper.AccountKeeper
+	supplyKeeper types.SupplyKeeper
+}
+
+// NewDeductFeeDecorator returns a new DeductFeeDecorator.
+func NewDeductFeeDecorator(ak keeper.AccountKeeper, supplyKeeper types.SupplyKeeper) DeductFeeDecorator {
+	return DeductFeeDecorator{
+		ak:           ak,
+		supplyKeeper: supplyKeeper,
+	}
+}
+
+// AnteHandle implements the sdk.AnteDecorator interface.
+func (dfd DeductFeeDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, sdk.Result, bool) {
+
+	if addr := dfd.supplyKeeper.GetModuleAddress(types.FeeCollectorName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.FeeCollectorName))
+	}
+
+	stdTx, ok := tx.(types.StdTx)
+	if !ok {
+		return ctx, sdk.ErrInternal("tx must be StdTx").Result(), true
+	}
+
+	// fee grants require the DeductFeeDecorator to be replaced
+	if granter := stdTx.Fee.Granter; !granter.Empty() && !granter.Equals(stdTx.FeePayer()) {
+		return ctx, sdk.ErrUnauthorized("fee grants are not supported").Result(), true
+	}
+
+	// fetch first signer, who's going to pay the fees
+	feePayer, res := GetSignerAcc(ctx, dfd.ak, stdTx.FeePayer())
+	if !res.IsOK() {
+		return ctx, res, true
+	}
+
+	if !stdTx.Fee.Amount.IsZero() {
+		if res := DeductFees(dfd.supplyKeeper, ctx, feePayer, stdTx.Fee.Amount); !res.IsOK() {
+			return ctx, res, true
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,228 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+var (
+	_ sdk.AnteDecorator = SetPubKeyDecorator{}
+	_ sdk.AnteDecorator = SigGasConsumeDecorator{}
+	_ sdk.AnteDecorator = SigVerificationDecorator{}
+	_ sdk.AnteDecorator = IncrementSequenceDecorator{}
+)
+
+// SetPubKeyDecorator sets the public key of the signers of the transaction
+// which do not have one yet from their signature, after checking that it
+// matches their address. When simulating, a secp256k1 public key is set
+// instead since the transaction carries no signature.
+type SetPubKeyDecorator struct {
+	ak keeper.AccountKeeper
+}
+
+// NewSetPubKeyDecorator returns a new SetPubKeyDecorator.
+func NewSetPubKeyDecorator(ak keeper.AccountKeeper) SetPubKeyDecorator {
+	return SetPubKeyDecorator{ak: ak}
+}
+
+// AnteHandle implements the sdk.AnteDecorator interface.
+func (spkd SetPubKeyDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, sdk.Result, bool) {
+
+	stdTx, ok := tx.(types.StdTx)
+	if !ok {
+		return ctx, sdk.ErrInternal("tx must be StdTx").Result(), true
+	}
+
+	signerAccs, res := getSignerAccs(ctx, spkd.ak, stdTx)
+	if !res.IsOK() {
+		return ctx, res, true
+	}
+
+	for i, sig := range stdTx.GetSignatures() {
+		pubKey, res := ProcessPubKey(signerAccs[i], sig, simulate)
+		if !res.IsOK() {
+			return ctx, res, true
+		}
+
+		// avoid the gas of writing accounts which already have a public key
+		if signerAccs[i].GetPubKey() != nil {
+			continue
+		}
+
+		if err := signerAccs[i].SetPubKey(pubKey); err != nil {
+			return ctx, sdk.ErrInternal("setting PubKey on signer's account").Result(), true
+		}
+
+		spkd.ak.SetAccount(ctx, signerAccs[i])
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// SigGasConsumeDecorator consumes the gas of the verification of the
+// signatures of the transaction with the given SignatureVerificationGasConsumer,
+// which may also reject some types of public key. When simulating, the gas of
+// the size of the missing signatures is consumed as well.
+//
+// CONTRACT: The public keys of the signers must have been set, see
+// SetPubKeyDecorator.
+type SigGasConsumeDecorator struct {
+	ak             keeper.AccountKeeper
+	sigGasConsumer SignatureVerificationGasConsumer
+}
+
+// NewSigGasConsumeDecorator returns a new SigGasConsumeDecorator.
+func NewSigGasConsumeDecorator(ak keeper.AccountKeeper, sigGasConsumer SignatureVerificationGasConsumer) SigGasConsumeDecorator {
+	return SigGasConsumeDecorator{
+		ak:             ak,
+		sigGasConsumer: sigGasConsumer,
+	}
+}
+
+// AnteHandle implements the sdk.AnteDecorator interface.
+func (sgcd SigGasConsumeDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, sdk.Result, bool) {
+
+	stdTx, ok := tx.(types.StdTx)
+	if !ok {
+		return ctx, sdk.ErrInternal("tx must be StdTx").Result(), true
+	}
+
+	signerAccs, res := getSignerAccs(ctx, sgcd.ak, stdTx)
+	if !res.IsOK() {
+		return ctx, res, true
+	}
+
+	params := getParams(ctx, sgcd.ak)
+	for i, sig := range stdTx.GetSignatures() {
+		pubKey := signerAccs[i].GetPubKey()
+
+		if simulate {
+			// Simulated txs should not contain a signature and are not required to
+			// contain a pubkey, so we must account for tx size of including a
+			// StdSignature (Amino encoding) and simulate gas consumption
+			// (assuming a SECP256k1 simulation key).
+			consumeSimSigGas(ctx.GasMeter(), pubKey, sig, params)
+		}
+
+		if res := sgcd.sigGasConsumer(ctx.GasMeter(), sig.Signature, pubKey, params); !res.IsOK() {
+			return ctx, res, true
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// SigVerificationDecorator verifies the signatures of the transaction against
+// the public keys, account numbers and sequences of the signers. Signatures
+// are not verified when simulating.
+//
+// CONTRACT: The public keys of the signers must have been set, see
+// SetPubKeyDecorator.
+type SigVerificationDecorator struct {
+	ak keeper.AccountKeeper
+}
+
+// NewSigVerificationDecorator returns a new SigVerificationDecorator.
+func NewSigVerificationDecorator(ak keeper.AccountKeeper) SigVerificationDecorator {
+	return SigVerificationDecorator{ak: ak}
+}
+
+// AnteHandle implements the sdk.AnteDecorator interface.
+func (svd SigVerificationDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, sdk.Result, bool) {
+
+	if simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	stdTx, ok := tx.(types.StdTx)
+	if !ok {
+		return ctx, sdk.ErrInternal("tx must be StdTx").Result(), true
+	}
+
+	signerAccs, res := getSignerAccs(ctx, svd.ak, stdTx)
+	if !res.IsOK() {
+		return ctx, res, true
+	}
+
+	isGenesis := ctx.BlockHeight() == 0
+	for i, sig := range stdTx.GetSignatures() {
+		pubKey := signerAccs[i].GetPubKey()
+		if pubKey == nil {
+			return ctx, sdk.ErrInvalidPubKey("PubKey not found").Result(), true
+		}
+
+		signBytes := GetSignBytes(ctx.ChainID(), stdTx, signerAccs[i], isGenesis)
+		if !pubKey.VerifyBytes(signBytes, sig.Signature) {
+			return ctx, sdk.ErrUnauthorized("signature verification failed; verify correct account sequence and chain-id").Result(), true
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// IncrementSequenceDecorator increments the sequence of the signers of the
+// transaction, so that it cannot be replayed.
+//
+// CONTRACT: It must be placed after the signature verification.
+type IncrementSequenceDecorator struct {
+	ak keeper.AccountKeeper
+}
+
+// NewIncrementSequenceDecorator returns a new IncrementSequenceDecorator.
+func NewIncrementSequenceDecorator(ak keeper.AccountKeeper) IncrementSequenceDecorator {
+	return IncrementSequenceDecorator{ak: ak}
+}
+
+// AnteHandle implements the sdk.AnteDecorator interface.
+func (isd IncrementSequenceDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, sdk.Result, bool) {
+
+	stdTx, ok := tx.(types.StdTx)
+	if !ok {
+		return ctx, sdk.ErrInternal("tx must be StdTx").Result(), true
+	}
+
+	signerAccs, res := getSignerAccs(ctx, isd.ak, stdTx)
+	if !res.IsOK() {
+		return ctx, res, true
+	}
+
+	for _, acc := range signerAccs {
+		if err := acc.SetSequence(acc.GetSequence() + 1); err != nil {
+			panic(err)
+		}
+
+		isd.ak.SetAccount(ctx, acc)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// getSignerAccs returns the accounts of the signers of the transaction, in the
+// order of its signatures.
+func getSignerAccs(ctx sdk.Context, ak keeper.AccountKeeper, stdTx types.StdTx) ([]exported.Account, sdk.Result) {
+	signerAddrs := stdTx.GetSigners()
+	if len(signerAddrs) != len(stdTx.GetSignatures()) {
+		return nil, sdk.ErrUnauthorized("wrong number of signers").Result()
+	}
+
+	signerAccs := make([]exported.Account, len(signerAddrs))
+	for i, addr := range signerAddrs {
+		acc, res := GetSignerAcc(ctx, ak, addr)
+		if !res.IsOK() {
+			return nil, res
+		}
+		signerAccs[i] = acc
+	}
+
+	return signerAccs, sdk.Result{}
+}
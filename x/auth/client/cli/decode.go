@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+)
+
+const flagEncoding = "encoding"
+
+// GetDecodeCommand returns the decode command to take Amino-serialized bytes
+// and turn them into a JSONified transaction.
+func GetDecodeCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decode [amino-byte-string]",
+		Short: "Decode an amino-encoded transaction string",
+		Long: `Decode a transaction serialized with the Amino wire protocol, such as the output of the
+encode command or the raw transaction bytes returned by Tendermint's RPC, and print it as JSON.
+The bytes are expected in base64 unless --encoding=hex is given.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			stdTx, err := utils.DecodeStdTx(cliCtx.Codec, args[0], viper.GetString(flagEncoding))
+			if err != nil {
+				return err
+			}
+
+			var json []byte
+			if cliCtx.Indent {
+				json, err = cliCtx.Codec.MarshalJSONIndent(stdTx, "", "  ")
+			} else {
+				json, err = cliCtx.Codec.MarshalJSON(stdTx)
+			}
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("%s\n", json)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagEncoding, utils.EncodingBase64, "Encoding of the transaction bytes (base64|hex)")
+	cmd.Flags().Bool(flags.FlagIndentResponse, false, "Add indent to JSON response")
+	return cmd
+}
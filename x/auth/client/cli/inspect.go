@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"io/ioutil"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// GetInspectCommand returns the inspect command to print a human-readable
+// description of a transaction and verify its signatures against the current
+// account state.
+func GetInspectCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inspect [file]",
+		Short: "Describe a transaction and verify its signatures",
+		Long: `Read a transaction from <file> and print the route and type of each message, the signer
+addresses, the fee, the gas and the memo. Each signer's signature is verified against the account
+number, sequence and public key currently stored on chain, which requires a connection to a node
+and the --chain-id flag.
+
+The transaction is read as JSON unless --encoding is given, in which case <file> holds the
+Amino-serialized bytes in that encoding (base64|hex). If you supply a dash (-) argument in place
+of an input filename, the command reads from standard input.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			stdTx, err := readInspectedTx(cliCtx.Codec, args[0], viper.GetString(flagEncoding))
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(utils.InspectTx(cliCtx, viper.GetString(flags.FlagChainID), stdTx))
+		},
+	}
+
+	cmd.Flags().String(flagEncoding, "", "Encoding of the transaction bytes (base64|hex), JSON if empty")
+	return flags.GetCommands(cmd)[0]
+}
+
+func readInspectedTx(cdc *codec.Codec, filename, encoding string) (types.StdTx, error) {
+	if encoding == "" {
+		return utils.ReadStdTxFromFile(cdc, filename)
+	}
+
+	var (
+		bz  []byte
+		err error
+	)
+
+	if filename == "-" {
+		bz, err = ioutil.ReadAll(os.Stdin)
+	} else {
+		bz, err = ioutil.ReadFile(filename)
+	}
+	if err != nil {
+		return types.StdTx{}, err
+	}
+
+	return utils.DecodeStdTx(cdc, string(bz), encoding)
+}
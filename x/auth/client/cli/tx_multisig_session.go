@@ -0,0 +1,358 @@
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/multisig"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/codec"
+	crkeys "github.com/cosmos/cosmos-sdk/crypto/keys"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+const (
+	flagMembers           = "members"
+	flagMultisigThreshold = "multisig-threshold"
+	flagNoSort            = "nosort"
+)
+
+// GetMultisigSessionCommand returns the multisig signing session commands.
+func GetMultisigSessionCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multisig-session",
+		Short: "Coordinate the offline signing of a transaction by the members of a multisig account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`A multisig signing session is a JSON file holding an unsigned transaction, the account
+number and sequence of the multisig account that signs it, the threshold and member public keys of
+that account, and the signatures its members have collected so far. Once created, a session can be
+signed, inspected and finalized without access to a full node.
+
+Example:
+$ %[1]s tx multisig-session create unsigned.json --members=k1,k2,k3 --multisig-threshold=2 \
+    --output-document=session.json
+$ %[1]s tx multisig-session sign session.json --from=k1
+$ %[1]s tx multisig-session sign session.json --from=k2
+$ %[1]s tx multisig-session status session.json
+$ %[1]s tx multisig-session finalize session.json --output-document=signed.json
+`,
+				version.ClientName,
+			),
+		),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		getMultisigSessionCreateCommand(cdc),
+		getMultisigSessionSignCommand(cdc),
+		getMultisigSessionStatusCommand(cdc),
+		getMultisigSessionFinalizeCommand(cdc),
+	)
+
+	return cmd
+}
+
+func getMultisigSessionCreateCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create [file] [[multisig-key]]",
+		Short: "Start a multisig signing session for a transaction generated offline",
+		Long: `Start a signing session for the transaction read from [file], which must be signed by
+the multisig account only.
+
+The multisig account is either the multisig key [multisig-key] stored in the keybase, or it is
+built from the keys passed to --members, each one given as the name of a key in the keybase or
+as a bech32 account public key, and the threshold given by --multisig-threshold. The keys are
+sorted by address, as 'keys add --multisig' does, unless the flag --nosort is set.
+
+Unless --offline is set, the account number and sequence of the multisig account are queried
+from a full node. With --offline they must be given with --account-number and --sequence.
+`,
+		PreRun: preSignCmd,
+		RunE:   makeMultisigSessionCreateCmd(cdc),
+		Args:   cobra.RangeArgs(1, 2),
+	}
+
+	cmd.Flags().StringSlice(flagMembers, nil, "Names or bech32 public keys of the members of the multisig account")
+	cmd.Flags().Uint(flagMultisigThreshold, 1, "Number of member signatures required by the multisig account")
+	cmd.Flags().Bool(flagNoSort, false, "Keys passed to --members are taken in the order they're supplied")
+	cmd.Flags().Bool(flagOffline, false, "Offline mode. Do not query a full node")
+	cmd.Flags().String(flagOutfile, "", "The session will be written to the given file instead of STDOUT")
+
+	return flags.PostCommands(cmd)[0]
+}
+
+func makeMultisigSessionCreateCmd(cdc *codec.Codec) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		stdTx, err := utils.ReadStdTxFromFile(cdc, args[0])
+		if err != nil {
+			return err
+		}
+
+		txBldr := types.NewTxBuilderFromCLI()
+
+		var multisigPub multisig.PubKeyMultisigThreshold
+		if len(args) == 2 {
+			multisigPub, err = getMultisigPubKey(txBldr.Keybase(), args[1])
+		} else {
+			multisigPub, err = buildMultisigPubKey(
+				txBldr.Keybase(), viper.GetStringSlice(flagMembers), viper.GetInt(flagMultisigThreshold),
+				!viper.GetBool(flagNoSort),
+			)
+		}
+		if err != nil {
+			return err
+		}
+
+		if !viper.GetBool(flagOffline) {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			addr := sdk.AccAddress(multisigPub.Address())
+
+			accnum, seq, err := types.NewAccountRetriever(cliCtx).GetAccountNumberSequence(addr)
+			if err != nil {
+				return err
+			}
+
+			txBldr = txBldr.WithAccountNumber(accnum).WithSequence(seq)
+		}
+
+		session, err := utils.NewMultisigSession(
+			txBldr.ChainID(), txBldr.AccountNumber(), txBldr.Sequence(), multisigPub, stdTx,
+		)
+		if err != nil {
+			return err
+		}
+
+		return writeMultisigSession(cdc, session, viper.GetString(flagOutfile))
+	}
+}
+
+func getMultisigSessionSignCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign [session-file]",
+		Short: "Add the signature of a multisig account member to a signing session",
+		Long: `Sign the transaction of the session read from [session-file] with the key given by --from,
+which must be a member of the multisig account, and add the signature to the session. A signature
+collected earlier from the same member is replaced. The session file is updated in place unless
+--output-document is set. No full node is queried.
+`,
+		RunE: makeMultisigSessionSignCmd(cdc),
+		Args: cobra.ExactArgs(1),
+	}
+
+	cmd.Flags().String(flagOutfile, "", "The session will be written to the given file instead of [session-file]")
+
+	cmd = flags.PostCommands(cmd)[0]
+	cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+func makeMultisigSessionSignCmd(cdc *codec.Codec) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		session, err := utils.ReadMultisigSessionFromFile(cdc, args[0])
+		if err != nil {
+			return err
+		}
+
+		cliCtx := context.NewCLIContext().WithCodec(cdc)
+		txBldr := types.NewTxBuilderFromCLI()
+		name := cliCtx.GetFromName()
+
+		passphrase, err := keys.GetPassphrase(name)
+		if err != nil {
+			return err
+		}
+
+		sig, err := types.MakeSignature(txBldr.Keybase(), name, passphrase, session.SignMsg())
+		if err != nil {
+			return err
+		}
+
+		if err := session.AddSignature(sig); err != nil {
+			return err
+		}
+
+		outfile := viper.GetString(flagOutfile)
+		if outfile == "" {
+			outfile = args[0]
+		}
+
+		return writeMultisigSession(cdc, session, outfile)
+	}
+}
+
+func getMultisigSessionStatusCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status [session-file] [[session-file]...]",
+		Short: "Show which members of the multisig account have signed in a session",
+		Long: `Show the multisig account of the session read from [session-file], its threshold, and
+which of its members have signed so far. The signatures collected in further copies of the same
+session are merged in. No full node is queried.
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			session, err := readAndMergeMultisigSessions(cdc, args)
+			if err != nil {
+				return err
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			return cliCtx.PrintOutput(session.Status())
+		},
+		Args: cobra.MinimumNArgs(1),
+	}
+
+	cmd.Flags().Bool(flags.FlagIndentResponse, false, "Add indent to JSON response")
+	return cmd
+}
+
+func getMultisigSessionFinalizeCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finalize [session-file] [[session-file]...]",
+		Short: "Combine the signatures of a session into a signed multisig transaction",
+		Long: `Combine the signatures collected in the session read from [session-file], and in further
+copies of the same session, into a multisig signature and print the signed transaction, ready to
+be broadcast. The session must hold at least as many signatures as the threshold of the multisig
+account. No full node is queried.
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			session, err := readAndMergeMultisigSessions(cdc, args)
+			if err != nil {
+				return err
+			}
+
+			stdTx, err := session.Finalize()
+			if err != nil {
+				return err
+			}
+
+			var json []byte
+			if viper.GetBool(flags.FlagIndentResponse) {
+				json, err = cdc.MarshalJSONIndent(stdTx, "", "  ")
+			} else {
+				json, err = cdc.MarshalJSON(stdTx)
+			}
+			if err != nil {
+				return err
+			}
+
+			return writeJSONOutput(json, viper.GetString(flagOutfile))
+		},
+		Args: cobra.MinimumNArgs(1),
+	}
+
+	cmd.Flags().Bool(flags.FlagIndentResponse, false, "Add indent to JSON response")
+	cmd.Flags().String(flagOutfile, "", "The document will be written to the given file instead of STDOUT")
+	return cmd
+}
+
+// getMultisigPubKey returns the public key of a multisig key of the keybase.
+func getMultisigPubKey(kb crkeys.Keybase, name string) (multisig.PubKeyMultisigThreshold, error) {
+	info, err := kb.Get(name)
+	if err != nil {
+		return multisig.PubKeyMultisigThreshold{}, err
+	}
+	if info.GetType() != crkeys.TypeMulti {
+		return multisig.PubKeyMultisigThreshold{}, fmt.Errorf(
+			"%q must be of type %s: %s", name, crkeys.TypeMulti, info.GetType(),
+		)
+	}
+
+	return info.GetPubKey().(multisig.PubKeyMultisigThreshold), nil
+}
+
+// buildMultisigPubKey builds a multisig public key from its members, each one
+// given as a key name or a bech32 account public key.
+func buildMultisigPubKey(
+	kb crkeys.Keybase, members []string, threshold int, sortKeys bool,
+) (multisig.PubKeyMultisigThreshold, error) {
+
+	if len(members) == 0 {
+		return multisig.PubKeyMultisigThreshold{}, fmt.Errorf(
+			"either a multisig key or the --%s flag is required", flagMembers,
+		)
+	}
+	if threshold <= 0 || threshold > len(members) {
+		return multisig.PubKeyMultisigThreshold{}, fmt.Errorf(
+			"threshold must be between 1 and the number of members (%d): %d", len(members), threshold,
+		)
+	}
+
+	pks := make([]tmcrypto.PubKey, len(members))
+	for i, member := range members {
+		info, err := kb.Get(member)
+		if err == nil {
+			pks[i] = info.GetPubKey()
+			continue
+		}
+
+		pks[i], err = sdk.GetAccPubKeyBech32(member)
+		if err != nil {
+			return multisig.PubKeyMultisigThreshold{}, fmt.Errorf(
+				"%q is neither a key name nor a bech32 account public key", member,
+			)
+		}
+	}
+
+	if sortKeys {
+		sort.Slice(pks, func(i, j int) bool {
+			return bytes.Compare(pks[i].Address(), pks[j].Address()) < 0
+		})
+	}
+
+	return multisig.PubKeyMultisigThreshold{K: uint(threshold), PubKeys: pks}, nil
+}
+
+func readAndMergeMultisigSessions(cdc *codec.Codec, filenames []string) (utils.MultisigSession, error) {
+	session, err := utils.ReadMultisigSessionFromFile(cdc, filenames[0])
+	if err != nil {
+		return session, err
+	}
+
+	for _, filename := range filenames[1:] {
+		other, err := utils.ReadMultisigSessionFromFile(cdc, filename)
+		if err != nil {
+			return session, err
+		}
+
+		if err := session.Merge(other); err != nil {
+			return session, fmt.Errorf("%s: %v", filename, err)
+		}
+	}
+
+	return session, nil
+}
+
+func writeMultisigSession(cdc *codec.Codec, session utils.MultisigSession, filename string) error {
+	json, err := cdc.MarshalJSONIndent(session, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return writeJSONOutput(json, filename)
+}
+
+// writeJSONOutput writes the document to the given file, or prints it if the
+// filename is empty.
+func writeJSONOutput(json []byte, filename string) error {
+	if filename == "" {
+		fmt.Printf("%s\n", json)
+		return nil
+	}
+
+	return ioutil.WriteFile(filename, append(json, '\n'), 0644)
+}
@@ -0,0 +1,88 @@
+package cli
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
+	"github.com/cosmos/cosmos-sdk/tests"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// testMsg is a message signed by a single signer
+type testMsg struct {
+	Signer sdk.AccAddress `json:"signer"`
+}
+
+func (msg testMsg) Route() string            { return "test" }
+func (msg testMsg) Type() string             { return "test" }
+func (msg testMsg) ValidateBasic() sdk.Error { return nil }
+func (msg testMsg) GetSignBytes() []byte {
+	return sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(msg))
+}
+func (msg testMsg) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Signer} }
+
+func TestSignCommandFileKeyringPipedStdin(t *testing.T) {
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	types.RegisterCodec(cdc)
+	cdc.RegisterConcrete(testMsg{}, "cosmos-sdk/TestMsg", nil)
+
+	home, cleanUp := tests.NewTestCaseDir(t)
+	defer cleanUp()
+
+	const passphrase = "12345678"
+	kr, err := keys.NewKeyring(keys.BackendFile, filepath.Join(home, "keyring"), func(bool) (string, error) {
+		return passphrase, nil
+	})
+	require.NoError(t, err)
+	info, err := keys.NewKeyringKeybase(kr).CreateAccount("signer", tests.TestMnemonic, "", "", 0, 0, keys.Secp256k1)
+	require.NoError(t, err)
+
+	fee := types.NewStdFee(50000, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	stdTx := types.NewStdTx([]sdk.Msg{testMsg{info.GetAddress()}}, fee, nil, "")
+	txFile := filepath.Join(home, "tx.json")
+	require.NoError(t, ioutil.WriteFile(txFile, cdc.MustMarshalJSON(stdTx), 0600))
+	outFile := filepath.Join(home, "signed.json")
+
+	// the passphrase is piped once, whereas the keybase is opened by the
+	// context, the tx builder and the passphrase prompt
+	r, w, err := os.Pipe()
+	require.NoError(t, err)
+	_, err = w.WriteString(passphrase + "\n")
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	stdin := os.Stdin
+	os.Stdin = r
+	defer func() { os.Stdin = stdin }()
+
+	viper.Set(flags.FlagHome, home)
+	viper.Set(flags.FlagKeyringBackend, keys.BackendFile)
+	viper.Set(flags.FlagFrom, "signer")
+	viper.Set(flags.FlagChainID, "test-chain")
+	viper.Set(flags.FlagAccountNumber, 1)
+	viper.Set(flags.FlagSequence, 0)
+	viper.Set(flagOffline, true)
+	viper.Set(flagOutfile, outFile)
+	defer viper.Reset()
+
+	cmd := GetSignCommand(cdc)
+	require.NoError(t, cmd.RunE(cmd, []string{txFile}))
+
+	bz, err := ioutil.ReadFile(outFile)
+	require.NoError(t, err)
+	var signedTx types.StdTx
+	require.NoError(t, cdc.UnmarshalJSON(bz, &signedTx))
+	require.Len(t, signedTx.Signatures, 1)
+	require.Equal(t, info.GetPubKey(), signedTx.Signatures[0].PubKey)
+}
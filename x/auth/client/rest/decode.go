@@ -0,0 +1,49 @@
+package rest
+
+import (
+	"io/ioutil"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+)
+
+// DecodeReq defines a tx decoding request.
+type DecodeReq struct {
+	Tx       string `json:"tx" yaml:"tx"`
+	Encoding string `json:"encoding" yaml:"encoding"` // base64 if empty
+}
+
+// DecodeTxRequestHandlerFn returns the decode tx REST handler. In particular,
+// it takes base64 or hex-encoded Amino-serialized transaction bytes, decodes
+// them and responds with the json-formatted transaction.
+func DecodeTxRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req DecodeReq
+
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		err = cliCtx.Codec.UnmarshalJSON(body, &req)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		if req.Encoding == "" {
+			req.Encoding = utils.EncodingBase64
+		}
+
+		stdTx, err := utils.DecodeStdTx(cliCtx.Codec, req.Tx, req.Encoding)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		rest.PostProcessResponseBare(w, cliCtx, stdTx)
+	}
+}
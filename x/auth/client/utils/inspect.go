@@ -0,0 +1,138 @@
+package utils
+
+import (
+	"bytes"
+	"errors"
+
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/exported"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// TxInspection defines a human-readable description of a transaction along
+// with the verification status of each of its signatures.
+type TxInspection struct {
+	Messages   []MsgInspection  `json:"messages" yaml:"messages"`
+	Signers    []sdk.AccAddress `json:"signers" yaml:"signers"`
+	Fee        sdk.Coins        `json:"fee" yaml:"fee"`
+	Gas        uint64           `json:"gas" yaml:"gas"`
+	Memo       string           `json:"memo" yaml:"memo"`
+	Signatures []SigInspection  `json:"signatures" yaml:"signatures"`
+}
+
+func (ti TxInspection) String() string {
+	out, _ := yaml.Marshal(ti)
+	return string(out)
+}
+
+// MsgInspection describes a single message of an inspected transaction.
+type MsgInspection struct {
+	Route   string           `json:"route" yaml:"route"`
+	Type    string           `json:"type" yaml:"type"`
+	Signers []sdk.AccAddress `json:"signers" yaml:"signers"`
+}
+
+// SigInspection reports whether the signature expected from a signer verifies
+// against the signer's current account number, sequence and public key.
+type SigInspection struct {
+	Signer        sdk.AccAddress `json:"signer" yaml:"signer"`
+	PubKey        string         `json:"pub_key" yaml:"pub_key"`
+	AccountNumber uint64         `json:"account_number" yaml:"account_number"`
+	Sequence      uint64         `json:"sequence" yaml:"sequence"`
+	Valid         bool           `json:"valid" yaml:"valid"`
+	Error         string         `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// InspectTx describes the given transaction and verifies its signatures
+// for the given chain against the account state of the node the context is
+// connected to.
+func InspectTx(cliCtx context.CLIContext, chainID string, stdTx authtypes.StdTx) TxInspection {
+	accGetter := authtypes.NewAccountRetriever(cliCtx)
+	return inspectTx(chainID, stdTx, accGetter.GetAccount)
+}
+
+func inspectTx(
+	chainID string, stdTx authtypes.StdTx, getAccount func(sdk.AccAddress) (exported.Account, error),
+) TxInspection {
+
+	msgs := make([]MsgInspection, len(stdTx.Msgs))
+	for i, msg := range stdTx.Msgs {
+		msgs[i] = MsgInspection{
+			Route:   msg.Route(),
+			Type:    msg.Type(),
+			Signers: msg.GetSigners(),
+		}
+	}
+
+	signers := stdTx.GetSigners()
+	sigs := make([]SigInspection, len(signers))
+	for i, signer := range signers {
+		sigs[i] = SigInspection{Signer: signer}
+
+		if i >= len(stdTx.Signatures) {
+			sigs[i].Error = "missing signature"
+			continue
+		}
+
+		if err := verifySignature(chainID, stdTx, stdTx.Signatures[i], &sigs[i], getAccount); err != nil {
+			sigs[i].Error = err.Error()
+			continue
+		}
+
+		sigs[i].Valid = true
+	}
+
+	return TxInspection{
+		Messages:   msgs,
+		Signers:    signers,
+		Fee:        stdTx.Fee.Amount,
+		Gas:        stdTx.Fee.Gas,
+		Memo:       stdTx.Memo,
+		Signatures: sigs,
+	}
+}
+
+// verifySignature checks a signature the same way the ante handler does, using
+// the signer's current account state, and records what it used in sigInfo.
+func verifySignature(
+	chainID string, stdTx authtypes.StdTx, sig authtypes.StdSignature, sigInfo *SigInspection,
+	getAccount func(sdk.AccAddress) (exported.Account, error),
+) error {
+
+	acc, err := getAccount(sigInfo.Signer)
+	if err != nil {
+		return err
+	}
+
+	sigInfo.AccountNumber = acc.GetAccountNumber()
+	sigInfo.Sequence = acc.GetSequence()
+
+	pubKey := acc.GetPubKey()
+	if pubKey == nil {
+		pubKey = sig.PubKey
+	}
+	if pubKey == nil {
+		return errors.New("public key is neither set on the account nor included in the signature")
+	}
+
+	sigInfo.PubKey, err = sdk.Bech32ifyAccPub(pubKey)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(pubKey.Address(), sigInfo.Signer) {
+		return errors.New("public key does not match the signer address")
+	}
+
+	signBytes := authtypes.StdSignBytes(
+		chainID, sigInfo.AccountNumber, sigInfo.Sequence, stdTx.Fee, stdTx.Msgs, stdTx.Memo,
+	)
+	if !pubKey.VerifyBytes(signBytes, sig.Signature) {
+		return errors.New("signature verification failed; verify the correct account number, sequence and chain-id")
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package utils
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/exported"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestInspectTx(t *testing.T) {
+	priv2 := ed25519.GenPrivKey()
+	addr2 := sdk.AccAddress(priv2.PubKey().Address())
+
+	acc1 := authtypes.NewBaseAccount(addr, nil, priv.PubKey(), 3, 7)
+	acc2 := authtypes.NewBaseAccount(addr2, nil, nil, 4, 0)
+	getAccount := func(a sdk.AccAddress) (exported.Account, error) {
+		switch {
+		case a.Equals(addr):
+			return acc1, nil
+		case a.Equals(addr2):
+			return acc2, nil
+		}
+		return nil, errors.New("account not found")
+	}
+
+	fee := authtypes.NewStdFee(50000, sdk.NewCoins(sdk.NewInt64Coin("atom", 150)))
+	msgs := []sdk.Msg{sdk.NewTestMsg(addr), sdk.NewTestMsg(addr2)}
+	sign := func(chainID string, acc exported.Account, key ed25519.PrivKeyEd25519, withPubKey bool) authtypes.StdSignature {
+		sig, err := key.Sign(authtypes.StdSignBytes(
+			chainID, acc.GetAccountNumber(), acc.GetSequence(), fee, msgs, "memo",
+		))
+		require.NoError(t, err)
+
+		stdSig := authtypes.StdSignature{Signature: sig}
+		if withPubKey {
+			stdSig.PubKey = key.PubKey()
+		}
+		return stdSig
+	}
+
+	// all signatures verify, the second one with the pubkey from the signature
+	stdTx := authtypes.NewStdTx(msgs, fee, []authtypes.StdSignature{
+		sign("test-chain", acc1, priv, false), sign("test-chain", acc2, priv2, true),
+	}, "memo")
+	res := inspectTx("test-chain", stdTx, getAccount)
+	require.Len(t, res.Messages, 2)
+	require.Equal(t, "TestMsg", res.Messages[0].Route)
+	require.Equal(t, []sdk.AccAddress{addr2}, res.Messages[1].Signers)
+	require.Equal(t, []sdk.AccAddress{addr, addr2}, res.Signers)
+	require.Equal(t, fee.Amount, res.Fee)
+	require.Equal(t, fee.Gas, res.Gas)
+	require.Equal(t, "memo", res.Memo)
+	require.Len(t, res.Signatures, 2)
+	for _, sig := range res.Signatures {
+		require.True(t, sig.Valid, sig.Error)
+		require.Empty(t, sig.Error)
+	}
+	require.Equal(t, uint64(3), res.Signatures[0].AccountNumber)
+	require.Equal(t, uint64(7), res.Signatures[0].Sequence)
+	require.NotEmpty(t, res.String())
+
+	// wrong chain-id
+	res = inspectTx("other-chain", stdTx, getAccount)
+	require.False(t, res.Signatures[0].Valid)
+	require.NotEmpty(t, res.Signatures[0].Error)
+
+	// the account of the second signer has no pubkey and the signature carries none
+	stdTx.Signatures[1] = sign("test-chain", acc2, priv2, false)
+	res = inspectTx("test-chain", stdTx, getAccount)
+	require.True(t, res.Signatures[0].Valid)
+	require.False(t, res.Signatures[1].Valid)
+
+	// the pubkey in the signature does not belong to the signer
+	stdTx.Signatures[1] = sign("test-chain", acc2, priv, true)
+	res = inspectTx("test-chain", stdTx, getAccount)
+	require.False(t, res.Signatures[1].Valid)
+
+	// missing signature
+	stdTx.Signatures = stdTx.Signatures[:1]
+	res = inspectTx("test-chain", stdTx, getAccount)
+	require.True(t, res.Signatures[0].Valid)
+	require.Equal(t, "missing signature", res.Signatures[1].Error)
+
+	// unknown account
+	stdTx = authtypes.NewStdTx([]sdk.Msg{sdk.NewTestMsg(sdk.AccAddress([]byte("unknown")))}, fee,
+		[]authtypes.StdSignature{sign("test-chain", acc1, priv, true)}, "memo")
+	res = inspectTx("test-chain", stdTx, getAccount)
+	require.Equal(t, "account not found", res.Signatures[0].Error)
+}
@@ -0,0 +1,256 @@
+package utils
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/multisig"
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/sr25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// MultisigSession is the state of an offline multisig signing session. It
+// carries everything the members of a multisig account need to sign a
+// transaction without querying a full node: the unsigned transaction, the
+// account number and sequence of the multisig account, its threshold and
+// member public keys, and the signatures collected so far.
+type MultisigSession struct {
+	ChainID       string                   `json:"chain_id" yaml:"chain_id"`
+	AccountNumber uint64                   `json:"account_number" yaml:"account_number"`
+	Sequence      uint64                   `json:"sequence" yaml:"sequence"`
+	Threshold     uint                     `json:"threshold" yaml:"threshold"`
+	PubKeys       []crypto.PubKey          `json:"pubkeys" yaml:"pubkeys"`
+	Tx            authtypes.StdTx          `json:"tx" yaml:"tx"`
+	Signatures    []authtypes.StdSignature `json:"signatures" yaml:"signatures"`
+}
+
+// NewMultisigSession starts a signing session of the given transaction on
+// behalf of a multisig account, which must be the transaction's only signer.
+// Signatures already attached to the transaction are dropped.
+func NewMultisigSession(
+	chainID string, accNum, seq uint64, pubKey multisig.PubKeyMultisigThreshold, stdTx authtypes.StdTx,
+) (MultisigSession, error) {
+
+	stdTx.Signatures = nil
+	session := MultisigSession{
+		ChainID:       chainID,
+		AccountNumber: accNum,
+		Sequence:      seq,
+		Threshold:     pubKey.K,
+		PubKeys:       pubKey.PubKeys,
+		Tx:            stdTx,
+	}
+
+	return session, session.Validate()
+}
+
+// PubKey returns the multisig public key of the session's account.
+func (s MultisigSession) PubKey() multisig.PubKeyMultisigThreshold {
+	return multisig.PubKeyMultisigThreshold{K: s.Threshold, PubKeys: s.PubKeys}
+}
+
+// Address returns the address of the session's multisig account.
+func (s MultisigSession) Address() sdk.AccAddress {
+	return sdk.AccAddress(s.PubKey().Address())
+}
+
+// SignMsg returns the message each member of the multisig account signs.
+func (s MultisigSession) SignMsg() authtypes.StdSignMsg {
+	return authtypes.StdSignMsg{
+		ChainID:       s.ChainID,
+		AccountNumber: s.AccountNumber,
+		Sequence:      s.Sequence,
+		Fee:           s.Tx.Fee,
+		Msgs:          s.Tx.GetMsgs(),
+		Memo:          s.Tx.GetMemo(),
+	}
+}
+
+// Validate performs a stateless validation of the session, including the
+// signatures collected so far.
+func (s MultisigSession) Validate() error {
+	if s.ChainID == "" {
+		return errors.New("chain ID required but not specified")
+	}
+	if s.Threshold == 0 {
+		return errors.New("threshold must be a positive integer")
+	}
+	if int(s.Threshold) > len(s.PubKeys) {
+		return fmt.Errorf("threshold k of n multisignature: %d < %d", len(s.PubKeys), s.Threshold)
+	}
+	for _, pk := range s.PubKeys {
+		switch pk.(type) {
+		case nil:
+			return errors.New("member public key required but not specified")
+		case secp256r1.PubKeySecp256r1:
+			return errors.New("secp256r1 keys can not be members of a multisig account")
+		case sr25519.PubKeySr25519:
+			return errors.New("sr25519 keys can not be members of a multisig account")
+		}
+	}
+
+	signers := s.Tx.GetSigners()
+	if len(signers) != 1 || !signers[0].Equals(s.Address()) {
+		return fmt.Errorf("the multisig account %s must be the only signer of the transaction", s.Address())
+	}
+
+	seen := make(map[int]bool)
+	for _, sig := range s.Signatures {
+		i, err := s.verifySignature(sig)
+		if err != nil {
+			return err
+		}
+		if seen[i] {
+			return fmt.Errorf("duplicate signature of member %s", sdk.AccAddress(sig.PubKey.Address()))
+		}
+		seen[i] = true
+	}
+
+	return nil
+}
+
+// AddSignature adds the signature of a member of the multisig account to the
+// session, replacing any signature of the same member collected earlier.
+func (s *MultisigSession) AddSignature(sig authtypes.StdSignature) error {
+	i, err := s.verifySignature(sig)
+	if err != nil {
+		return err
+	}
+
+	for j, collected := range s.Signatures {
+		if collected.PubKey.Equals(s.PubKeys[i]) {
+			s.Signatures[j] = sig
+			return nil
+		}
+	}
+
+	s.Signatures = append(s.Signatures, sig)
+	return nil
+}
+
+// Merge adds the signatures collected in another copy of the same session.
+func (s *MultisigSession) Merge(other MultisigSession) error {
+	if !s.PubKey().Equals(other.PubKey()) || !bytes.Equal(s.SignMsg().Bytes(), other.SignMsg().Bytes()) {
+		return errors.New("sessions differ in their multisig account or in the transaction to sign")
+	}
+
+	for _, sig := range other.Signatures {
+		if err := s.AddSignature(sig); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Finalize combines the collected signatures into a multisig signature and
+// returns the transaction with that signature attached.
+func (s MultisigSession) Finalize() (authtypes.StdTx, error) {
+	if len(s.Signatures) < int(s.Threshold) {
+		return authtypes.StdTx{}, fmt.Errorf(
+			"not enough signatures: %d collected, %d required", len(s.Signatures), s.Threshold,
+		)
+	}
+
+	multisigSig := multisig.NewMultisig(len(s.PubKeys))
+	for _, sig := range s.Signatures {
+		if err := multisigSig.AddSignatureFromPubKey(sig.Signature, sig.PubKey, s.PubKeys); err != nil {
+			return authtypes.StdTx{}, err
+		}
+	}
+
+	stdSig := authtypes.StdSignature{PubKey: s.PubKey(), Signature: multisigSig.Marshal()}
+	return authtypes.NewStdTx(s.Tx.GetMsgs(), s.Tx.Fee, []authtypes.StdSignature{stdSig}, s.Tx.GetMemo()), nil
+}
+
+// Status returns which members of the multisig account have signed so far.
+func (s MultisigSession) Status() MultisigSessionStatus {
+	members := make([]MultisigMemberStatus, len(s.PubKeys))
+	for i, pk := range s.PubKeys {
+		members[i] = MultisigMemberStatus{
+			Address: sdk.AccAddress(pk.Address()),
+			PubKey:  sdk.MustBech32ifyAccPub(pk),
+		}
+		for _, sig := range s.Signatures {
+			if sig.PubKey.Equals(pk) {
+				members[i].Signed = true
+			}
+		}
+	}
+
+	return MultisigSessionStatus{
+		Address:   s.Address(),
+		Threshold: s.Threshold,
+		Signed:    uint(len(s.Signatures)),
+		Ready:     len(s.Signatures) >= int(s.Threshold),
+		Members:   members,
+	}
+}
+
+// verifySignature checks that a signature was made by a member of the multisig
+// account over the session's sign bytes and returns the index of the member.
+func (s MultisigSession) verifySignature(sig authtypes.StdSignature) (int, error) {
+	if sig.PubKey == nil {
+		return 0, errors.New("signature has no public key")
+	}
+
+	for i, pk := range s.PubKeys {
+		if !pk.Equals(sig.PubKey) {
+			continue
+		}
+
+		if !sig.PubKey.VerifyBytes(s.SignMsg().Bytes(), sig.Signature) {
+			return i, fmt.Errorf("couldn't verify signature of member %s", sdk.AccAddress(pk.Address()))
+		}
+		return i, nil
+	}
+
+	return 0, fmt.Errorf(
+		"%s is not a member of the multisig account %s", sdk.AccAddress(sig.PubKey.Address()), s.Address(),
+	)
+}
+
+// MultisigSessionStatus defines the signing progress of a multisig session.
+type MultisigSessionStatus struct {
+	Address   sdk.AccAddress         `json:"address" yaml:"address"`
+	Threshold uint                   `json:"threshold" yaml:"threshold"`
+	Signed    uint                   `json:"signed" yaml:"signed"`
+	Ready     bool                   `json:"ready" yaml:"ready"`
+	Members   []MultisigMemberStatus `json:"members" yaml:"members"`
+}
+
+func (ss MultisigSessionStatus) String() string {
+	out, _ := yaml.Marshal(ss)
+	return string(out)
+}
+
+// MultisigMemberStatus defines whether a member of a multisig account has
+// signed in a session.
+type MultisigMemberStatus struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	PubKey  string         `json:"pub_key" yaml:"pub_key"`
+	Signed  bool           `json:"signed" yaml:"signed"`
+}
+
+// ReadMultisigSessionFromFile reads and validates a multisig session from the
+// given filename.
+func ReadMultisigSessionFromFile(cdc *codec.Codec, filename string) (session MultisigSession, err error) {
+	bz, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return
+	}
+
+	if err = cdc.UnmarshalJSON(bz, &session); err != nil {
+		return
+	}
+
+	return session, session.Validate()
+}
@@ -0,0 +1,119 @@
+package utils
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/multisig"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/sr25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestMultisigSession(t *testing.T) {
+	cdc := makeCodec()
+	cdc.RegisterConcrete(testSessionMsg{}, "cosmos-sdk/TestSessionMsg", nil)
+
+	privs := []crypto.PrivKey{secp256k1.GenPrivKey(), secp256k1.GenPrivKey(), secp256k1.GenPrivKey()}
+	pks := []crypto.PubKey{privs[0].PubKey(), privs[1].PubKey(), privs[2].PubKey()}
+	multisigPub := multisig.NewPubKeyMultisigThreshold(2, pks).(multisig.PubKeyMultisigThreshold)
+	multisigAddr := sdk.AccAddress(multisigPub.Address())
+
+	fee := authtypes.NewStdFee(50000, sdk.NewCoins(sdk.NewInt64Coin("atom", 150)))
+	stdTx := authtypes.NewStdTx([]sdk.Msg{testSessionMsg{multisigAddr}}, fee, nil, "memo")
+
+	// the multisig account must be the only signer
+	_, err := NewMultisigSession("test-chain", 1, 2, multisigPub,
+		authtypes.NewStdTx([]sdk.Msg{testSessionMsg{multisigAddr}, testSessionMsg{addr}}, fee, nil, "memo"))
+	require.Error(t, err)
+	_, err = NewMultisigSession("", 1, 2, multisigPub, stdTx)
+	require.Error(t, err)
+	r1Pub := secp256r1.GenPrivKey().PubKey()
+	_, err = NewMultisigSession("test-chain", 1, 2,
+		multisig.PubKeyMultisigThreshold{K: 1, PubKeys: []crypto.PubKey{pks[0], r1Pub}}, stdTx)
+	require.Error(t, err)
+	srPub := sr25519.GenPrivKey().PubKey()
+	_, err = NewMultisigSession("test-chain", 1, 2,
+		multisig.PubKeyMultisigThreshold{K: 1, PubKeys: []crypto.PubKey{pks[0], srPub}}, stdTx)
+	require.Error(t, err)
+
+	session, err := NewMultisigSession("test-chain", 1, 2, multisigPub, stdTx)
+	require.NoError(t, err)
+	require.Equal(t, multisigAddr, session.Address())
+
+	sign := func(s MultisigSession, priv crypto.PrivKey) authtypes.StdSignature {
+		sig, err := priv.Sign(s.SignMsg().Bytes())
+		require.NoError(t, err)
+		return authtypes.StdSignature{PubKey: priv.PubKey(), Signature: sig}
+	}
+
+	// not enough signatures
+	_, err = session.Finalize()
+	require.Error(t, err)
+
+	// signatures of non-members and invalid signatures are rejected
+	require.Error(t, session.AddSignature(sign(session, secp256k1.GenPrivKey())))
+	invalidSig := sign(session, privs[0])
+	invalidSig.Signature = invalidSig.Signature[1:]
+	require.Error(t, session.AddSignature(invalidSig))
+
+	// signing again replaces the signature of the member
+	require.NoError(t, session.AddSignature(sign(session, privs[0])))
+	require.NoError(t, session.AddSignature(sign(session, privs[0])))
+	status := session.Status()
+	require.Equal(t, uint(1), status.Signed)
+	require.False(t, status.Ready)
+	require.True(t, status.Members[0].Signed)
+	require.False(t, status.Members[1].Signed)
+	require.NotEmpty(t, status.String())
+
+	// the session survives a round trip through a file
+	bz, err := cdc.MarshalJSON(session)
+	require.NoError(t, err)
+	fp := writeToNewTempFile(t, string(bz))
+	defer os.Remove(fp.Name())
+	other, err := ReadMultisigSessionFromFile(cdc, fp.Name())
+	require.NoError(t, err)
+	require.Equal(t, session.SignMsg().Bytes(), other.SignMsg().Bytes())
+
+	// signatures collected in another copy are merged
+	require.NoError(t, other.AddSignature(sign(other, privs[2])))
+	require.NoError(t, session.Merge(other))
+	require.True(t, session.Status().Ready)
+
+	// copies of a different session can not be merged
+	different, err := NewMultisigSession("test-chain", 1, 3, multisigPub, stdTx)
+	require.NoError(t, err)
+	require.Error(t, session.Merge(different))
+
+	signedTx, err := session.Finalize()
+	require.NoError(t, err)
+	require.Len(t, signedTx.Signatures, 1)
+	require.True(t, signedTx.Signatures[0].PubKey.Equals(multisigPub))
+	require.True(t, multisigPub.VerifyBytes(session.SignMsg().Bytes(), signedTx.Signatures[0].Signature))
+}
+
+// testSessionMsg is a message which, unlike sdk.TestMsg, keeps its signer
+// through a JSON round trip.
+type testSessionMsg struct {
+	Signer sdk.AccAddress `json:"signer"`
+}
+
+func (msg testSessionMsg) Route() string                { return "test" }
+func (msg testSessionMsg) Type() string                 { return "session" }
+func (msg testSessionMsg) ValidateBasic() sdk.Error     { return nil }
+func (msg testSessionMsg) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Signer} }
+
+func (msg testSessionMsg) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
@@ -27,7 +27,7 @@ func WriteGenerateStdTxResponse(w http.ResponseWriter, cliCtx context.CLIContext
 	txBldr := types.NewTxBuilder(
 		GetTxEncoder(cliCtx.Codec), br.AccountNumber, br.Sequence, gas, gasAdj,
 		br.Simulate, br.ChainID, br.Memo, br.Fees, br.GasPrices,
-	)
+	).WithFeeGranter(br.FeeGranter)
 
 	if br.Simulate || simAndExec {
 		if gasAdj < 0 {
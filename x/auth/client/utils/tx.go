@@ -1,11 +1,13 @@
 package utils
 
 import (
-	"bufio"
 	"bytes"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"io/ioutil"
 	"os"
+	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/viper"
@@ -19,6 +21,12 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
+// Supported encodings of Amino-serialized transaction bytes
+const (
+	EncodingBase64 = "base64"
+	EncodingHex    = "hex"
+)
+
 // GasEstimateResponse defines a response definition for tx gas estimation.
 type GasEstimateResponse struct {
 	GasEstimate uint64 `json:"gas_estimate" yaml:"gas_estimate"`
@@ -85,8 +93,7 @@ func CompleteAndBroadcastTxCLI(txBldr authtypes.TxBuilder, cliCtx context.CLICon
 
 		_, _ = fmt.Fprintf(os.Stderr, "%s\n\n", json)
 
-		buf := bufio.NewReader(os.Stdin)
-		ok, err := input.GetConfirmation("confirm transaction before signing and broadcasting", buf)
+		ok, err := input.GetConfirmation("confirm transaction before signing and broadcasting", input.Stdin())
 		if err != nil || !ok {
 			_, _ = fmt.Fprintf(os.Stderr, "%s\n", "cancelled transaction")
 			return err
@@ -248,6 +255,32 @@ func ReadStdTxFromFile(cdc *codec.Codec, filename string) (stdTx authtypes.StdTx
 	return
 }
 
+// DecodeStdTx decodes a StdTx from its Amino-serialized bytes given either as
+// a base64 or a hex string.
+func DecodeStdTx(cdc *codec.Codec, encodedTx, encoding string) (stdTx authtypes.StdTx, err error) {
+	var txBytes []byte
+
+	encodedTx = strings.TrimSpace(encodedTx)
+	switch encoding {
+	case EncodingBase64:
+		txBytes, err = base64.StdEncoding.DecodeString(encodedTx)
+	case EncodingHex:
+		txBytes, err = hex.DecodeString(strings.TrimPrefix(encodedTx, "0x"))
+	default:
+		return stdTx, fmt.Errorf("unsupported encoding %q, expected %s or %s", encoding, EncodingBase64, EncodingHex)
+	}
+
+	if err != nil {
+		return stdTx, errors.Wrapf(err, "failed to decode %s transaction bytes", encoding)
+	}
+
+	if err = cdc.UnmarshalBinaryLengthPrefixed(txBytes, &stdTx); err != nil {
+		return stdTx, errors.Wrap(err, "failed to decode amino transaction")
+	}
+
+	return stdTx, nil
+}
+
 func populateAccountFromState(
 	txBldr authtypes.TxBuilder, cliCtx context.CLIContext, addr sdk.AccAddress,
 ) (authtypes.TxBuilder, error) {
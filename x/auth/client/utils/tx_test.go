@@ -1,10 +1,13 @@
 package utils
 
 import (
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"io/ioutil"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -111,6 +114,29 @@ func TestReadStdTxFromFile(t *testing.T) {
 	require.Equal(t, decodedTx.Memo, "foomemo")
 }
 
+func TestDecodeStdTx(t *testing.T) {
+	cdc := makeCodec()
+
+	fee := authtypes.NewStdFee(50000, sdk.Coins{sdk.NewInt64Coin("atom", 150)})
+	stdTx := authtypes.NewStdTx([]sdk.Msg{}, fee, []authtypes.StdSignature{}, "foomemo")
+	txBytes := cdc.MustMarshalBinaryLengthPrefixed(stdTx)
+
+	decodedTx, err := DecodeStdTx(cdc, base64.StdEncoding.EncodeToString(txBytes), EncodingBase64)
+	require.NoError(t, err)
+	require.Equal(t, stdTx.Memo, decodedTx.Memo)
+
+	decodedTx, err = DecodeStdTx(cdc, "0x"+strings.ToUpper(hex.EncodeToString(txBytes)), EncodingHex)
+	require.NoError(t, err)
+	require.Equal(t, stdTx.Fee, decodedTx.Fee)
+
+	_, err = DecodeStdTx(cdc, hex.EncodeToString(txBytes), EncodingBase64)
+	require.Error(t, err)
+	_, err = DecodeStdTx(cdc, base64.StdEncoding.EncodeToString([]byte("fuzzy")), EncodingBase64)
+	require.Error(t, err)
+	_, err = DecodeStdTx(cdc, hex.EncodeToString(txBytes), "base32")
+	require.Error(t, err)
+}
+
 func compareEncoders(t *testing.T, expected sdk.TxEncoder, actual sdk.TxEncoder) {
 	msgs := []sdk.Msg{sdk.NewTestMsg(addr)}
 	tx := authtypes.NewStdTx(msgs, authtypes.StdFee{}, []authtypes.StdSignature{}, "")
@@ -7,7 +7,18 @@ import (
 )
 
 // Migrate accepts exported genesis state from v0.34 and migrates it to v0.38
-// genesis state.
+// genesis state. The secp256r1 and sr25519 signature verification costs are
+// set to their default value.
 func Migrate(oldGenState v036auth.GenesisState, accounts json.RawMessage) GenesisState {
-	return NewGenesisState(oldGenState.Params, accounts)
+	params := Params{
+		MaxMemoCharacters:      oldGenState.Params.MaxMemoCharacters,
+		TxSigLimit:             oldGenState.Params.TxSigLimit,
+		TxSizeCostPerByte:      oldGenState.Params.TxSizeCostPerByte,
+		SigVerifyCostED25519:   oldGenState.Params.SigVerifyCostED25519,
+		SigVerifyCostSecp256k1: oldGenState.Params.SigVerifyCostSecp256k1,
+		SigVerifyCostSecp256r1: DefaultSigVerifyCostSecp256r1,
+		SigVerifyCostSr25519:   DefaultSigVerifyCostSr25519,
+	}
+
+	return NewGenesisState(params, accounts)
 }
@@ -120,5 +120,14 @@ func TestMigrate(t *testing.T) {
 		)
 	})
 
-	require.Equal(t, genesisState, GenesisState{Params: params, Accounts: json.RawMessage(rawAccounts)})
+	expectedParams := Params{
+		MaxMemoCharacters:      10,
+		TxSigLimit:             10,
+		TxSizeCostPerByte:      10,
+		SigVerifyCostED25519:   10,
+		SigVerifyCostSecp256k1: 10,
+		SigVerifyCostSecp256r1: DefaultSigVerifyCostSecp256r1,
+		SigVerifyCostSr25519:   DefaultSigVerifyCostSr25519,
+	}
+	require.Equal(t, genesisState, GenesisState{Params: expectedParams, Accounts: json.RawMessage(rawAccounts)})
 }
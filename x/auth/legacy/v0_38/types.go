@@ -2,8 +2,6 @@ package v038
 
 import (
 	"encoding/json"
-
-	v034auth "github.com/cosmos/cosmos-sdk/x/auth/legacy/v0_34"
 )
 
 // DONTCOVER
@@ -11,14 +9,29 @@ import (
 // nolint
 const (
 	ModuleName = "auth"
+
+	DefaultSigVerifyCostSecp256r1 uint64 = 1000
+	DefaultSigVerifyCostSr25519   uint64 = 1000
 )
 
-type GenesisState struct {
-	Params   v034auth.Params `json:"params"`
-	Accounts json.RawMessage `json:"accounts"`
-}
+type (
+	Params struct {
+		MaxMemoCharacters      uint64 `json:"max_memo_characters"`
+		TxSigLimit             uint64 `json:"tx_sig_limit"`
+		TxSizeCostPerByte      uint64 `json:"tx_size_cost_per_byte"`
+		SigVerifyCostED25519   uint64 `json:"sig_verify_cost_ed25519"`
+		SigVerifyCostSecp256k1 uint64 `json:"sig_verify_cost_secp256k1"`
+		SigVerifyCostSecp256r1 uint64 `json:"sig_verify_cost_secp256r1"`
+		SigVerifyCostSr25519   uint64 `json:"sig_verify_cost_sr25519"`
+	}
+
+	GenesisState struct {
+		Params   Params          `json:"params"`
+		Accounts json.RawMessage `json:"accounts"`
+	}
+)
 
-func NewGenesisState(params v034auth.Params, accounts json.RawMessage) GenesisState {
+func NewGenesisState(params Params, accounts json.RawMessage) GenesisState {
 	return GenesisState{
 		Params:   params,
 		Accounts: accounts,
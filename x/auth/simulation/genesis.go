@@ -21,6 +21,8 @@ const (
 	TxSizeCostPerByte      = "tx_size_cost_per_byte"
 	SigVerifyCostED25519   = "sig_verify_cost_ed25519"
 	SigVerifyCostSECP256K1 = "sig_verify_cost_secp256k1"
+	SigVerifyCostSECP256R1 = "sig_verify_cost_secp256r1"
+	SigVerifyCostSR25519   = "sig_verify_cost_sr25519"
 )
 
 // GenMaxMemoChars randomized MaxMemoChars
@@ -48,6 +50,16 @@ func GenSigVerifyCostSECP256K1(r *rand.Rand) uint64 {
 	return uint64(simulation.RandIntBetween(r, 500, 1000))
 }
 
+// GenSigVerifyCostSECP256R1 randomized SigVerifyCostSECP256R1
+func GenSigVerifyCostSECP256R1(r *rand.Rand) uint64 {
+	return uint64(simulation.RandIntBetween(r, 500, 1000))
+}
+
+// GenSigVerifyCostSR25519 randomized SigVerifyCostSR25519
+func GenSigVerifyCostSR25519(r *rand.Rand) uint64 {
+	return uint64(simulation.RandIntBetween(r, 500, 1000))
+}
+
 // RandomizedGenState generates a random GenesisState for auth
 func RandomizedGenState(simState *module.SimulationState) {
 	var maxMemoChars uint64
@@ -77,11 +89,23 @@ func RandomizedGenState(simState *module.SimulationState) {
 	var sigVerifyCostSECP256K1 uint64
 	simState.AppParams.GetOrGenerate(
 		simState.Cdc, SigVerifyCostSECP256K1, &sigVerifyCostSECP256K1, simState.Rand,
-		func(r *rand.Rand) { sigVerifyCostED25519 = GenSigVerifyCostSECP256K1(r) },
+		func(r *rand.Rand) { sigVerifyCostSECP256K1 = GenSigVerifyCostSECP256K1(r) },
+	)
+
+	var sigVerifyCostSECP256R1 uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SigVerifyCostSECP256R1, &sigVerifyCostSECP256R1, simState.Rand,
+		func(r *rand.Rand) { sigVerifyCostSECP256R1 = GenSigVerifyCostSECP256R1(r) },
+	)
+
+	var sigVerifyCostSR25519 uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SigVerifyCostSR25519, &sigVerifyCostSR25519, simState.Rand,
+		func(r *rand.Rand) { sigVerifyCostSR25519 = GenSigVerifyCostSR25519(r) },
 	)
 
 	params := types.NewParams(maxMemoChars, txSigLimit, txSizeCostPerByte,
-		sigVerifyCostED25519, sigVerifyCostSECP256K1)
+		sigVerifyCostED25519, sigVerifyCostSECP256K1, sigVerifyCostSECP256R1, sigVerifyCostSR25519)
 	genesisAccs := RandomGenesisAccounts(simState)
 
 	authGenesis := types.NewGenesisState(params, genesisAccs)
@@ -115,10 +139,14 @@ func RandomGenesisAccounts(simState *module.SimulationState) (genesisAccs export
 				endTime = int64(simulation.RandIntBetween(simState.Rand, int(startTime)+1, int(startTime+(60*60*12))))
 			}
 
-			if simState.Rand.Intn(100) < 50 {
+			switch simState.Rand.Intn(3) {
+			case 0:
 				gacc = types.NewContinuousVestingAccount(&bacc, startTime, endTime)
-			} else {
+			case 1:
 				gacc = types.NewDelayedVestingAccount(&bacc, endTime)
+			default:
+				periods := RandomPeriods(simState.Rand, endTime-startTime, coins)
+				gacc = types.NewPeriodicVestingAccount(&bacc, startTime, periods)
 			}
 		}
 		genesisAccs = append(genesisAccs, gacc)
@@ -126,3 +154,26 @@ func RandomGenesisAccounts(simState *module.SimulationState) (genesisAccs export
 
 	return
 }
+
+// RandomPeriods splits a vesting schedule of the given length and amount into
+// up to four vesting periods of equal length and amount.
+func RandomPeriods(r *rand.Rand, length int64, amount sdk.Coins) types.Periods {
+	n := int64(simulation.RandIntBetween(r, 1, 5))
+	if n > length {
+		n = length
+	}
+
+	periods := make(types.Periods, n)
+	remaining := amount
+	for i := int64(0); i < n-1; i++ {
+		var periodAmount sdk.Coins
+		for _, coin := range amount {
+			periodAmount = periodAmount.Add(sdk.NewCoins(sdk.NewCoin(coin.Denom, coin.Amount.QuoRaw(n))))
+		}
+		periods[i] = types.NewPeriod(length/n, periodAmount)
+		remaining = remaining.Sub(periodAmount)
+	}
+	periods[n-1] = types.NewPeriod(length-(n-1)*(length/n), remaining)
+
+	return periods
+}
@@ -0,0 +1,97 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Period defines a length of time, in seconds, after which an amount of coins
+// vests in a periodic vesting schedule.
+type Period struct {
+	Length int64     `json:"length" yaml:"length"` // length of the period, in seconds
+	Amount sdk.Coins `json:"amount" yaml:"amount"` // amount of coins vesting at the end of the period
+}
+
+// NewPeriod returns a new Period
+func NewPeriod(length int64, amount sdk.Coins) Period {
+	return Period{
+		Length: length,
+		Amount: amount,
+	}
+}
+
+// String implements fmt.Stringer
+func (p Period) String() string {
+	return fmt.Sprintf(`Length: %d
+Amount: %s`, p.Length, p.Amount)
+}
+
+// Periods is a sequence of consecutive vesting periods
+type Periods []Period
+
+// TotalLength returns the sum of the lengths of the periods.
+//
+// CONTRACT: the periods are valid, so that the sum does not overflow.
+func (p Periods) TotalLength() int64 {
+	var total int64
+	for _, period := range p {
+		total += period.Length
+	}
+	return total
+}
+
+// TotalAmount returns the sum of the amounts of the periods
+func (p Periods) TotalAmount() sdk.Coins {
+	var total sdk.Coins
+	for _, period := range p {
+		total = total.Add(period.Amount)
+	}
+	return total
+}
+
+// Validate checks that the periods have positive lengths whose sum fits in an
+// int64 and valid, non-zero amounts.
+func (p Periods) Validate() error {
+	if len(p) == 0 {
+		return fmt.Errorf("vesting periods cannot be empty")
+	}
+
+	var total int64
+	for i, period := range p {
+		if period.Length <= 0 {
+			return fmt.Errorf("vesting period %d must have a positive length", i)
+		}
+		if period.Length > math.MaxInt64-total {
+			return fmt.Errorf("vesting period %d overflows the total length of the periods", i)
+		}
+		total += period.Length
+		if !period.Amount.IsValid() || period.Amount.IsZero() {
+			return fmt.Errorf("vesting period %d has an invalid amount: %s", i, period.Amount)
+		}
+	}
+	return nil
+}
+
+// ValidateEndTime checks that the periods are valid and that they end at a
+// time which fits in an int64 when they start at startTime.
+func (p Periods) ValidateEndTime(startTime int64) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+	if startTime > math.MaxInt64-p.TotalLength() {
+		return fmt.Errorf("vesting periods starting at %d end after the maximum time", startTime)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (p Periods) String() string {
+	periods := make([]string, len(p))
+	for i, period := range p {
+		periods[i] = fmt.Sprintf("Period %d:\n  %s", i, strings.Replace(period.String(), "\n", "\n  ", -1))
+	}
+	return strings.Join(periods, "\n")
+}
@@ -94,6 +94,15 @@ func (tx StdTx) GetSigners() []sdk.AccAddress {
 	return signers
 }
 
+// FeePayer returns the address of the account paying the fees of the
+// transaction, which is its first signer, or nil if it has no signer.
+func (tx StdTx) FeePayer() sdk.AccAddress {
+	if signers := tx.GetSigners(); len(signers) > 0 {
+		return signers[0]
+	}
+	return nil
+}
+
 // GetMemo returns the memo
 func (tx StdTx) GetMemo() string { return tx.Memo }
 
@@ -112,9 +121,14 @@ func (tx StdTx) GetSignatures() []StdSignature { return tx.Signatures }
 // StdFee includes the amount of coins paid in fees and the maximum
 // gas to be used by the transaction. The ratio yields an effective "gasprice",
 // which must be above some miminum to be accepted into the mempool.
+//
+// The fees are paid by the first signer, unless an optional fee granter is set
+// to pay them on its behalf, which requires an AnteHandler supporting fee
+// grants.
 type StdFee struct {
-	Amount sdk.Coins `json:"amount" yaml:"amount"`
-	Gas    uint64    `json:"gas" yaml:"gas"`
+	Amount  sdk.Coins      `json:"amount" yaml:"amount"`
+	Gas     uint64         `json:"gas" yaml:"gas"`
+	Granter sdk.AccAddress `json:"granter,omitempty" yaml:"granter,omitempty"`
 }
 
 // NewStdFee returns a new instance of StdFee
@@ -31,6 +31,9 @@ func TestStdTx(t *testing.T) {
 
 	feePayer := tx.GetSigners()[0]
 	require.Equal(t, addr, feePayer)
+	require.Equal(t, addr, tx.FeePayer())
+
+	require.Nil(t, NewStdTx(nil, fee, sigs, "").FeePayer())
 }
 
 func TestStdSignBytes(t *testing.T) {
@@ -49,7 +52,7 @@ func TestStdSignBytes(t *testing.T) {
 	}{
 		{
 			args{"1234", 3, 6, defaultFee, []sdk.Msg{sdk.NewTestMsg(addr)}, "memo"},
-			fmt.Sprintf("{\"account_number\":\"3\",\"chain_id\":\"1234\",\"fee\":{\"amount\":[{\"amount\":\"150\",\"denom\":\"atom\"}],\"gas\":\"50000\"},\"memo\":\"memo\",\"msgs\":[[\"%s\"]],\"sequence\":\"6\"}", addr),
+			fmt.Sprintf("{\"account_number\":\"3\",\"chain_id\":\"1234\",\"fee\":{\"amount\":[{\"amount\":\"150\",\"denom\":\"atom\"}],\"gas\":\"100000\"},\"memo\":\"memo\",\"msgs\":[[\"%s\"]],\"sequence\":\"6\"}", addr),
 		},
 	}
 	for i, tc := range tests {
@@ -1,12 +1,17 @@
 package types
 
 import (
+	"net/http/httptest"
 	"reflect"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/remote"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -145,3 +150,27 @@ func TestTxBuilderBuild(t *testing.T) {
 		})
 	}
 }
+
+func TestTxBuilderSignStdTxRemote(t *testing.T) {
+	priv := secp256k1.GenPrivKey()
+	daemon := httptest.NewServer(remote.NewServer(remote.NewPrivKeySigner(map[string]crypto.PrivKey{"treasury": priv})))
+	defer daemon.Close()
+
+	kb := keys.NewInMemory()
+	info, err := kb.CreateRemote("treasury", daemon.URL, "treasury")
+	require.NoError(t, err)
+
+	txBldr := NewTxBuilder(DefaultTxEncoder(codec.New()), 1, 2, 200000, 1.1, false, "test-chain", "", nil, nil).
+		WithKeybase(kb)
+	stdTx := NewStdTx([]sdk.Msg{sdk.NewTestMsg(info.GetAddress())}, NewStdFee(200000, nil), nil, "")
+
+	// the signature is made by the signing daemon, without passphrase
+	signedTx, err := txBldr.SignStdTx("treasury", "", stdTx, false)
+	require.NoError(t, err)
+	require.Len(t, signedTx.Signatures, 1)
+
+	sig := signedTx.Signatures[0]
+	require.Equal(t, priv.PubKey(), sig.PubKey)
+	signBytes := StdSignBytes("test-chain", 1, 2, stdTx.Fee, stdTx.Msgs, stdTx.Memo)
+	require.True(t, sig.PubKey.VerifyBytes(signBytes, sig.Signature))
+}
@@ -0,0 +1,81 @@
+// nolint
+// autogenerated code using github.com/rigelrozanski/multitool
+// aliases generated for the following subdirectories:
+// ALIASGEN: github.com/cosmos/cosmos-sdk/x/authz/exported
+// ALIASGEN: github.com/cosmos/cosmos-sdk/x/authz/internal/keeper
+// ALIASGEN: github.com/cosmos/cosmos-sdk/x/authz/internal/types
+package authz
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+	"github.com/cosmos/cosmos-sdk/x/authz/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/authz/internal/types"
+)
+
+const (
+	ModuleName               = types.ModuleName
+	StoreKey                 = types.StoreKey
+	RouterKey                = types.RouterKey
+	QuerierRoute             = types.QuerierRoute
+	DefaultCodespace         = types.DefaultCodespace
+	CodeNoAuthorization      = types.CodeNoAuthorization
+	CodeInvalidAuthorization = types.CodeInvalidAuthorization
+	CodeInvalidGrant         = types.CodeInvalidGrant
+	CodeSpendLimitExceeded   = types.CodeSpendLimitExceeded
+	CodeInvalidMsgs          = types.CodeInvalidMsgs
+	QueryGetAuthorization    = types.QueryGetAuthorization
+	QueryGetAuthorizations   = types.QueryGetAuthorizations
+)
+
+var (
+	// functions aliases
+	NewKeeper                      = keeper.NewKeeper
+	NewQuerier                     = keeper.NewQuerier
+	MsgTypeOf                      = types.MsgTypeOf
+	NewGenericAuthorization        = types.NewGenericAuthorization
+	NewSendAuthorization           = types.NewSendAuthorization
+	NewAuthorizationGrant          = types.NewAuthorizationGrant
+	RegisterCodec                  = types.RegisterCodec
+	RegisterAuthorizationTypeCodec = types.RegisterAuthorizationTypeCodec
+	ErrNoAuthorization             = types.ErrNoAuthorization
+	ErrInvalidAuthorization        = types.ErrInvalidAuthorization
+	ErrInvalidGrant                = types.ErrInvalidGrant
+	ErrSpendLimitExceeded          = types.ErrSpendLimitExceeded
+	ErrInvalidMsgs                 = types.ErrInvalidMsgs
+	NewGenesisState                = types.NewGenesisState
+	DefaultGenesisState            = types.DefaultGenesisState
+	ValidateGenesis                = types.ValidateGenesis
+	GrantKey                       = types.GrantKey
+	GrantsPrefix                   = types.GrantsPrefix
+	NewMsgGrant                    = types.NewMsgGrant
+	NewMsgRevoke                   = types.NewMsgRevoke
+	NewMsgExec                     = types.NewMsgExec
+	NewQueryAuthorizationParams    = types.NewQueryAuthorizationParams
+	NewQueryAuthorizationsParams   = types.NewQueryAuthorizationsParams
+
+	// variable aliases
+	ModuleCdc              = types.ModuleCdc
+	GrantKeyPrefix         = types.GrantKeyPrefix
+	EventTypeGrant         = types.EventTypeGrant
+	EventTypeRevoke        = types.EventTypeRevoke
+	EventTypeExec          = types.EventTypeExec
+	AttributeValueCategory = types.AttributeValueCategory
+	AttributeKeyGranter    = types.AttributeKeyGranter
+	AttributeKeyGrantee    = types.AttributeKeyGrantee
+	AttributeKeyMsgType    = types.AttributeKeyMsgType
+)
+
+type (
+	Authorization             = exported.Authorization
+	Keeper                    = keeper.Keeper
+	GenericAuthorization      = types.GenericAuthorization
+	SendAuthorization         = types.SendAuthorization
+	AuthorizationGrant        = types.AuthorizationGrant
+	AuthorizationGrants       = types.AuthorizationGrants
+	GenesisState              = types.GenesisState
+	MsgGrant                  = types.MsgGrant
+	MsgRevoke                 = types.MsgRevoke
+	MsgExec                   = types.MsgExec
+	QueryAuthorizationParams  = types.QueryAuthorizationParams
+	QueryAuthorizationsParams = types.QueryAuthorizationsParams
+)
@@ -0,0 +1,193 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+	"github.com/cosmos/cosmos-sdk/x/authz/internal/types"
+)
+
+// flags of the grant command
+const (
+	FlagSpendLimit = "spend-limit"
+	FlagMsgType    = "msg-type"
+	FlagExpiration = "expiration"
+)
+
+// authorization types of the grant command
+const (
+	AuthorizationTypeSend    = "send"
+	AuthorizationTypeGeneric = "generic"
+)
+
+// GetTxCmd returns the transaction commands for the authz module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	authzTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Authorization transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	authzTxCmd.AddCommand(client.PostCommands(
+		GetCmdGrantAuthorization(cdc),
+		GetCmdRevokeAuthorization(cdc),
+		GetCmdExecAuthorization(cdc),
+	)...)
+
+	return authzTxCmd
+}
+
+// GetCmdGrantAuthorization returns the command to grant an authorization to a
+// grantee
+func GetCmdGrantAuthorization(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [granter_key_or_address] [grantee] [send|generic]",
+		Short: "Grant an authorization to execute messages to a grantee",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Grant an authorization to a grantee to execute messages on behalf of the
+granter until the optional --expiration time, replacing any existing authorization
+for the same message type. A send authorization allows bank sends up to --spend-limit,
+and a generic authorization allows any message of the --msg-type, given as route/type.
+
+Example:
+$ %s tx %s grant mykey cosmos1lwjm... send --spend-limit=100stake --expiration=2020-01-01T00:00:00Z
+$ %s tx %s grant mykey cosmos1lwjm... generic --msg-type=distr/withdraw_delegator_reward
+`, version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithFrom(args[0]).WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			var authorization exported.Authorization
+			switch args[2] {
+			case AuthorizationTypeSend:
+				spendLimit, err := sdk.ParseCoins(viper.GetString(FlagSpendLimit))
+				if err != nil {
+					return err
+				}
+				authorization = types.NewSendAuthorization(spendLimit)
+
+			case AuthorizationTypeGeneric:
+				authorization = types.NewGenericAuthorization(viper.GetString(FlagMsgType))
+
+			default:
+				return fmt.Errorf("invalid authorization type %s, expected %s or %s",
+					args[2], AuthorizationTypeSend, AuthorizationTypeGeneric)
+			}
+
+			var expiration time.Time
+			if exp := viper.GetString(FlagExpiration); exp != "" {
+				expiration, err = time.Parse(time.RFC3339, exp)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgGrant(cliCtx.GetFromAddress(), grantee, authorization, expiration)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(FlagSpendLimit, "", "Coins which can be sent with a send authorization")
+	cmd.Flags().String(FlagMsgType, "", "Type of the messages allowed by a generic authorization, as route/type")
+	cmd.Flags().String(FlagExpiration, "", "Expiration time of the authorization in RFC3339 format (never expires if empty)")
+
+	return cmd
+}
+
+// GetCmdRevokeAuthorization returns the command to revoke the authorization of
+// a grantee
+func GetCmdRevokeAuthorization(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke [granter_key_or_address] [grantee] [msg_type]",
+		Short: "Revoke the authorization of a grantee for a message type",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Revoke the authorization granted by the granter to a grantee for a message
+type, given as route/type.
+
+Example:
+$ %s tx %s revoke mykey cosmos1lwjm... bank/send
+`, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithFrom(args[0]).WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevoke(cliCtx.GetFromAddress(), grantee, args[2])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdExecAuthorization returns the command to execute the messages of a
+// transaction file on behalf of their granters
+func GetCmdExecAuthorization(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "exec [grantee_key_or_address] [tx_json_file]",
+		Short: "Execute the messages of a transaction on behalf of their granters",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Execute the messages of an unsigned transaction on behalf of their signers,
+which must have authorized the grantee to execute them. The transaction is typically
+generated with the --generate-only flag and the granter as --from address.
+
+Example:
+$ %s tx bank send cosmos1skjw... cosmos1ghek... 10stake --generate-only > tx.json
+$ %s tx %s exec mykey tx.json
+`, version.ClientName, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithFrom(args[0]).WithCodec(cdc)
+
+			stdTx, err := utils.ReadStdTxFromFile(cdc, args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgExec(cliCtx.GetFromAddress(), stdTx.GetMsgs())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
@@ -0,0 +1,23 @@
+package exported
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Authorization defines the permission of a grantee to execute messages on
+// behalf of a granter, with the rules deciding which messages are accepted.
+type Authorization interface {
+	// MsgType returns the type of the messages the authorization applies to,
+	// which is the route and the type of the message separated by a slash
+	// (e.g. "bank/send").
+	MsgType() string
+
+	// Accept returns an error if the message can not be executed with the
+	// authorization. Otherwise it updates the authorization, which must then be
+	// saved, and returns true if the authorization is used up and must be
+	// removed.
+	Accept(msg sdk.Msg) (remove bool, err sdk.Error)
+
+	// ValidateBasic performs a stateless validation of the authorization.
+	ValidateBasic() sdk.Error
+}
@@ -0,0 +1,202 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+	"github.com/cosmos/cosmos-sdk/x/authz/internal/types"
+)
+
+// Keeper manages the authorizations of the authz store and executes messages
+// on behalf of their granters
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      *codec.Codec
+	router   sdk.Router
+}
+
+// NewKeeper creates a new authz Keeper. The router is used to execute the
+// messages of MsgExec, and is typically the router of the BaseApp.
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, router sdk.Router) Keeper {
+	return Keeper{
+		storeKey: storeKey,
+		cdc:      cdc,
+		router:   router,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// Grant sets the authorization of the grantee of the grant from its granter,
+// replacing any existing authorization between them for the same message type.
+func (k Keeper) Grant(ctx sdk.Context, grant types.AuthorizationGrant) {
+	k.setGrant(ctx, grant)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeGrant,
+			sdk.NewAttribute(types.AttributeKeyGranter, grant.Granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grant.Grantee.String()),
+			sdk.NewAttribute(types.AttributeKeyMsgType, grant.Authorization.MsgType()),
+		),
+	)
+}
+
+// Revoke removes the authorization of a grantee from a granter for a message
+// type. It fails if there is no such authorization.
+func (k Keeper) Revoke(ctx sdk.Context, granter, grantee sdk.AccAddress, msgType string) sdk.Error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GrantKey(granter, grantee, msgType)
+	if !store.Has(key) {
+		return types.ErrNoAuthorization(types.DefaultCodespace, granter, grantee, msgType)
+	}
+
+	store.Delete(key)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevoke,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+			sdk.NewAttribute(types.AttributeKeyMsgType, msgType),
+		),
+	)
+	return nil
+}
+
+// GetAuthorization returns the authorization of a grantee from a granter for a
+// message type, or nil if there is none or if it has expired.
+func (k Keeper) GetAuthorization(ctx sdk.Context, granter, grantee sdk.AccAddress, msgType string) exported.Authorization {
+	grant, found := k.GetGrant(ctx, granter, grantee, msgType)
+	if !found || grant.IsExpired(ctx.BlockTime()) {
+		return nil
+	}
+	return grant.Authorization
+}
+
+// GetGrant returns the grant of an authorization to a grantee from a granter
+// for a message type.
+func (k Keeper) GetGrant(ctx sdk.Context, granter, grantee sdk.AccAddress, msgType string) (grant types.AuthorizationGrant, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GrantKey(granter, grantee, msgType))
+	if bz == nil {
+		return grant, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &grant)
+	return grant, true
+}
+
+// IterateGrants iterates over the grants of the authorizations of a grantee
+// from a granter, ordered by message type, until cb returns true.
+func (k Keeper) IterateGrants(ctx sdk.Context, granter, grantee sdk.AccAddress, cb func(types.AuthorizationGrant) (stop bool)) {
+	k.iterateGrants(ctx, types.GrantsPrefix(granter, grantee), cb)
+}
+
+// IterateAllGrants iterates over the grants of all the authorizations, ordered
+// by granter, grantee and message type, until cb returns true.
+func (k Keeper) IterateAllGrants(ctx sdk.Context, cb func(types.AuthorizationGrant) (stop bool)) {
+	k.iterateGrants(ctx, types.GrantKeyPrefix, cb)
+}
+
+func (k Keeper) iterateGrants(ctx sdk.Context, prefix []byte, cb func(types.AuthorizationGrant) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var grant types.AuthorizationGrant
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &grant)
+		if cb(grant) {
+			break
+		}
+	}
+}
+
+// DispatchActions executes messages on behalf of their signers through the
+// router. A message signed by the grantee itself is executed directly, any
+// other message must be accepted by an authorization of the grantee from its
+// signer. Used up authorizations are removed.
+func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []sdk.Msg) sdk.Result {
+	var data []byte
+	events := sdk.EmptyEvents()
+
+	for _, msg := range msgs {
+		signers := msg.GetSigners()
+		if len(signers) != 1 {
+			return types.ErrInvalidMsgs(types.DefaultCodespace, "messages must have a single signer").Result()
+		}
+
+		granter := signers[0]
+		if !granter.Equals(grantee) {
+			if err := k.useAuthorization(ctx, granter, grantee, msg); err != nil {
+				return err.Result()
+			}
+		}
+
+		handler := k.router.Route(msg.Route())
+		if handler == nil {
+			return sdk.ErrUnknownRequest("unrecognized message route: " + msg.Route()).Result()
+		}
+
+		res := handler(ctx, msg)
+		if !res.IsOK() {
+			return res
+		}
+
+		data = append(data, res.Data...)
+		events = events.AppendEvents(res.Events)
+	}
+
+	return sdk.Result{Data: data, Events: events}
+}
+
+// useAuthorization checks that the authorization of a grantee from a granter
+// accepts a message and saves the updated authorization.
+func (k Keeper) useAuthorization(ctx sdk.Context, granter, grantee sdk.AccAddress, msg sdk.Msg) sdk.Error {
+	msgType := types.MsgTypeOf(msg)
+	grant, found := k.GetGrant(ctx, granter, grantee, msgType)
+	if !found {
+		return types.ErrNoAuthorization(types.DefaultCodespace, granter, grantee, msgType)
+	}
+
+	if grant.IsExpired(ctx.BlockTime()) {
+		return types.ErrNoAuthorization(types.DefaultCodespace, granter, grantee, msgType)
+	}
+
+	remove, err := grant.Authorization.Accept(msg)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExec,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+			sdk.NewAttribute(types.AttributeKeyMsgType, msgType),
+		),
+	)
+
+	if remove {
+		// ignore the error as the authorization is known to exist
+		_ = k.Revoke(ctx, granter, grantee, msgType)
+	} else {
+		// save the authorization updated by the message
+		k.setGrant(ctx, grant)
+	}
+	return nil
+}
+
+func (k Keeper) setGrant(ctx sdk.Context, grant types.AuthorizationGrant) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(grant)
+	store.Set(types.GrantKey(grant.Granter, grant.Grantee, grant.Authorization.MsgType()), bz)
+}
@@ -0,0 +1,141 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/internal/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+)
+
+var (
+	granter  = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	granter2 = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	grantee  = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	grantee2 = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+)
+
+func createTestApp(isCheckTx bool) (*simapp.SimApp, sdk.Context) {
+	app := simapp.Setup(isCheckTx)
+	ctx := app.BaseApp.NewContext(isCheckTx, abci.Header{Time: time.Now().UTC()})
+
+	return app, ctx
+}
+
+func atoms(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin("atom", amount))
+}
+
+func TestKeeperCrud(t *testing.T) {
+	app, ctx := createTestApp(false)
+	keeper := app.AuthzKeeper
+
+	send := types.NewSendAuthorization(atoms(555))
+	send2 := types.NewSendAuthorization(atoms(334455))
+	generic := types.NewGenericAuthorization("distr/withdraw_delegator_reward")
+
+	keeper.Grant(ctx, types.NewAuthorizationGrant(granter, grantee, send, time.Time{}))
+	keeper.Grant(ctx, types.NewAuthorizationGrant(granter, grantee, generic, time.Time{}))
+	keeper.Grant(ctx, types.NewAuthorizationGrant(granter2, grantee, send2, time.Time{}))
+	keeper.Grant(ctx, types.NewAuthorizationGrant(granter, grantee2, send2, time.Time{}))
+
+	// a new grant overwrites the previous one
+	keeper.Grant(ctx, types.NewAuthorizationGrant(granter2, grantee2, send, time.Time{}))
+	keeper.Grant(ctx, types.NewAuthorizationGrant(granter2, grantee2, send2, time.Time{}))
+
+	require.Equal(t, send, keeper.GetAuthorization(ctx, granter, grantee, "bank/send"))
+	require.Equal(t, generic, keeper.GetAuthorization(ctx, granter, grantee, generic.MsgType()))
+	require.Equal(t, send2, keeper.GetAuthorization(ctx, granter2, grantee, "bank/send"))
+	require.Equal(t, send2, keeper.GetAuthorization(ctx, granter2, grantee2, "bank/send"))
+	require.Nil(t, keeper.GetAuthorization(ctx, grantee, granter, "bank/send"))
+	require.Nil(t, keeper.GetAuthorization(ctx, granter2, grantee, generic.MsgType()))
+
+	require.NoError(t, keeper.Revoke(ctx, granter2, grantee2, "bank/send"))
+	require.Nil(t, keeper.GetAuthorization(ctx, granter2, grantee2, "bank/send"))
+	require.Error(t, keeper.Revoke(ctx, granter2, grantee2, "bank/send"))
+
+	var grants []types.AuthorizationGrant
+	keeper.IterateGrants(ctx, granter, grantee, func(grant types.AuthorizationGrant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+	require.Len(t, grants, 2)
+
+	grants = nil
+	keeper.IterateAllGrants(ctx, func(grant types.AuthorizationGrant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+	require.Len(t, grants, 4)
+
+	// expired authorizations are ignored
+	keeper.Grant(ctx, types.NewAuthorizationGrant(granter2, grantee2, send, ctx.BlockTime().Add(time.Hour)))
+	require.Equal(t, send, keeper.GetAuthorization(ctx, granter2, grantee2, "bank/send"))
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	require.Nil(t, keeper.GetAuthorization(ctx, granter2, grantee2, "bank/send"))
+}
+
+func TestDispatchActions(t *testing.T) {
+	app, ctx := createTestApp(false)
+	keeper := app.AuthzKeeper
+
+	_, err := app.BankKeeper.AddCoins(ctx, granter, atoms(1000))
+	require.NoError(t, err)
+	_, err = app.BankKeeper.AddCoins(ctx, grantee, atoms(1000))
+	require.NoError(t, err)
+
+	recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	sendMsg := func(from sdk.AccAddress, amount int64) sdk.Msg {
+		return bank.NewMsgSend(from, recipient, atoms(amount))
+	}
+
+	// a message can't be executed without an authorization
+	res := keeper.DispatchActions(ctx, grantee, []sdk.Msg{sendMsg(granter, 100)})
+	require.False(t, res.IsOK())
+	require.Equal(t, types.CodeNoAuthorization, res.Code)
+
+	keeper.Grant(ctx, types.NewAuthorizationGrant(granter, grantee, types.NewSendAuthorization(atoms(300)), ctx.BlockTime().Add(time.Hour)))
+
+	// messages of the grantee itself don't need an authorization
+	res = keeper.DispatchActions(ctx, grantee, []sdk.Msg{sendMsg(granter, 100), sendMsg(grantee, 50)})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, atoms(900), app.BankKeeper.GetCoins(ctx, granter))
+	require.Equal(t, atoms(950), app.BankKeeper.GetCoins(ctx, grantee))
+	require.Equal(t, atoms(150), app.BankKeeper.GetCoins(ctx, recipient))
+	require.Equal(t, types.NewSendAuthorization(atoms(200)), keeper.GetAuthorization(ctx, granter, grantee, "bank/send"))
+
+	// the spend limit can't be exceeded
+	res = keeper.DispatchActions(ctx, grantee, []sdk.Msg{sendMsg(granter, 201)})
+	require.False(t, res.IsOK())
+	require.Equal(t, types.CodeSpendLimitExceeded, res.Code)
+
+	// an authorization is removed once used up
+	res = keeper.DispatchActions(ctx, grantee, []sdk.Msg{sendMsg(granter, 200)})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, atoms(700), app.BankKeeper.GetCoins(ctx, granter))
+	require.Nil(t, keeper.GetAuthorization(ctx, granter, grantee, "bank/send"))
+
+	// an expired authorization can't be used
+	keeper.Grant(ctx, types.NewAuthorizationGrant(granter, grantee, types.NewSendAuthorization(atoms(300)), ctx.BlockTime().Add(time.Hour)))
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	res = keeper.DispatchActions(ctx, grantee, []sdk.Msg{sendMsg(granter, 100)})
+	require.False(t, res.IsOK())
+	require.Equal(t, types.CodeNoAuthorization, res.Code)
+
+	// a generic authorization accepts any message of its type
+	keeper.Grant(ctx, types.NewAuthorizationGrant(granter, grantee, types.NewGenericAuthorization("bank/send"), time.Time{}))
+	res = keeper.DispatchActions(ctx, grantee, []sdk.Msg{sendMsg(granter, 500)})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, atoms(200), app.BankKeeper.GetCoins(ctx, granter))
+	require.NotNil(t, keeper.GetAuthorization(ctx, granter, grantee, "bank/send"))
+
+	// failed messages are reported
+	res = keeper.DispatchActions(ctx, grantee, []sdk.Msg{sendMsg(granter, 500)})
+	require.False(t, res.IsOK())
+}
@@ -0,0 +1,54 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	keep "github.com/cosmos/cosmos-sdk/x/authz/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/authz/internal/types"
+)
+
+func TestQuerier(t *testing.T) {
+	app, ctx := createTestApp(false)
+	cdc := app.Codec()
+	querier := keep.NewQuerier(app.AuthzKeeper)
+
+	_, err := querier(ctx, []string{"foo"}, abci.RequestQuery{})
+	require.Error(t, err)
+
+	grant := types.NewAuthorizationGrant(granter, grantee, types.NewSendAuthorization(atoms(555)), time.Time{})
+	grant2 := types.NewAuthorizationGrant(granter, grantee, types.NewGenericAuthorization("distr/withdraw_delegator_reward"), time.Time{})
+	app.AuthzKeeper.Grant(ctx, grant)
+	app.AuthzKeeper.Grant(ctx, grant2)
+	app.AuthzKeeper.Grant(ctx, types.NewAuthorizationGrant(granter2, grantee2, types.NewSendAuthorization(atoms(1)), time.Time{}))
+
+	// single authorization
+	bz := cdc.MustMarshalJSON(types.NewQueryAuthorizationParams(granter, grantee, "bank/send"))
+	res, err := querier(ctx, []string{types.QueryGetAuthorization}, abci.RequestQuery{Data: bz})
+	require.NoError(t, err)
+
+	var resGrant types.AuthorizationGrant
+	cdc.MustUnmarshalJSON(res, &resGrant)
+	require.Equal(t, grant, resGrant)
+
+	bz = cdc.MustMarshalJSON(types.NewQueryAuthorizationParams(granter2, grantee, "bank/send"))
+	_, err = querier(ctx, []string{types.QueryGetAuthorization}, abci.RequestQuery{Data: bz})
+	require.Error(t, err)
+
+	// authorizations of a grantee from a granter
+	bz = cdc.MustMarshalJSON(types.NewQueryAuthorizationsParams(granter, grantee))
+	res, err = querier(ctx, []string{types.QueryGetAuthorizations}, abci.RequestQuery{Data: bz})
+	require.NoError(t, err)
+
+	var grants []types.AuthorizationGrant
+	cdc.MustUnmarshalJSON(res, &grants)
+	require.Len(t, grants, 2)
+	require.Contains(t, grants, grant)
+	require.Contains(t, grants, grant2)
+
+	_, err = querier(ctx, []string{types.QueryGetAuthorizations}, abci.RequestQuery{Data: []byte("?")})
+	require.Error(t, err)
+}
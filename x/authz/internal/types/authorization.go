@@ -0,0 +1,56 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+)
+
+var _ exported.Authorization = (*GenericAuthorization)(nil)
+
+// MsgTypeOf returns the type of a message used to look up its authorizations,
+// which is its route and type separated by a slash.
+func MsgTypeOf(msg sdk.Msg) string {
+	return fmt.Sprintf("%s/%s", msg.Route(), msg.Type())
+}
+
+// GenericAuthorization authorizes the execution of any message of a type,
+// without restriction.
+type GenericAuthorization struct {
+	Msg string `json:"msg" yaml:"msg"`
+}
+
+// NewGenericAuthorization creates a new GenericAuthorization
+func NewGenericAuthorization(msgType string) *GenericAuthorization {
+	return &GenericAuthorization{
+		Msg: msgType,
+	}
+}
+
+// MsgType implements the Authorization interface.
+func (a GenericAuthorization) MsgType() string {
+	return a.Msg
+}
+
+// Accept implements the Authorization interface. It accepts any message of
+// its type.
+func (a *GenericAuthorization) Accept(msg sdk.Msg) (remove bool, err sdk.Error) {
+	return false, nil
+}
+
+// ValidateBasic implements the Authorization interface.
+func (a GenericAuthorization) ValidateBasic() sdk.Error {
+	parts := strings.Split(a.Msg, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return ErrInvalidAuthorization(DefaultCodespace, fmt.Sprintf("invalid message type %q, expected route/type", a.Msg))
+	}
+	return nil
+}
+
+// String implements the fmt.Stringer interface.
+func (a GenericAuthorization) String() string {
+	return fmt.Sprintf(`Generic Authorization:
+  Msg: %s`, a.Msg)
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+)
+
+func TestGenericAuthorization(t *testing.T) {
+	require.Equal(t, "bank/send", MsgTypeOf(bank.MsgSend{}))
+
+	authorization := NewGenericAuthorization("bank/send")
+	require.NoError(t, authorization.ValidateBasic())
+	require.Equal(t, "bank/send", authorization.MsgType())
+
+	remove, err := authorization.Accept(bank.MsgSend{})
+	require.NoError(t, err)
+	require.False(t, remove)
+
+	require.Error(t, NewGenericAuthorization("").ValidateBasic())
+	require.Error(t, NewGenericAuthorization("bank").ValidateBasic())
+	require.Error(t, NewGenericAuthorization("bank/").ValidateBasic())
+	require.Error(t, NewGenericAuthorization("bank/send/foo").ValidateBasic())
+}
+
+func TestSendAuthorization(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	atoms := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin("atom", amount))
+	}
+
+	authorization := NewSendAuthorization(atoms(100))
+	require.NoError(t, authorization.ValidateBasic())
+	require.Equal(t, "bank/send", authorization.MsgType())
+
+	// only sends are accepted
+	_, err := authorization.Accept(bank.NewMsgMultiSend(nil, nil))
+	require.Error(t, err)
+
+	// sends above the limit are rejected
+	_, err = authorization.Accept(bank.NewMsgSend(addr1, addr2, atoms(101)))
+	require.Error(t, err)
+	_, err = authorization.Accept(bank.NewMsgSend(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("eth", 1))))
+	require.Error(t, err)
+	require.Equal(t, atoms(100), authorization.SpendLimit)
+
+	// the limit is deducted
+	remove, err := authorization.Accept(bank.NewMsgSend(addr1, addr2, atoms(60)))
+	require.NoError(t, err)
+	require.False(t, remove)
+	require.Equal(t, atoms(40), authorization.SpendLimit)
+
+	// the authorization is used up once the limit is reached
+	remove, err = authorization.Accept(bank.NewMsgSend(addr1, addr2, atoms(40)))
+	require.NoError(t, err)
+	require.True(t, remove)
+
+	require.Error(t, NewSendAuthorization(nil).ValidateBasic())
+	require.Error(t, NewSendAuthorization(sdk.Coins{sdk.NewInt64Coin("atom", 0)}).ValidateBasic())
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Codes for authz errors
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeNoAuthorization      sdk.CodeType = 101
+	CodeInvalidAuthorization sdk.CodeType = 102
+	CodeInvalidGrant         sdk.CodeType = 103
+	CodeSpendLimitExceeded   sdk.CodeType = 104
+	CodeInvalidMsgs          sdk.CodeType = 105
+)
+
+// ErrNoAuthorization is returned when a grantee has no authorization from a
+// granter for a message type
+func ErrNoAuthorization(codespace sdk.CodespaceType, granter, grantee sdk.AccAddress, msgType string) sdk.Error {
+	return sdk.NewError(codespace, CodeNoAuthorization,
+		fmt.Sprintf("no authorization from %s to %s for %s messages", granter, grantee, msgType))
+}
+
+// ErrInvalidAuthorization is returned when an authorization is invalid or does
+// not accept a message
+func ErrInvalidAuthorization(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidAuthorization, fmt.Sprintf("invalid authorization: %s", msg))
+}
+
+// ErrInvalidGrant is returned when the granter, grantee or expiration of a
+// grant are invalid
+func ErrInvalidGrant(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidGrant, fmt.Sprintf("invalid authorization grant: %s", msg))
+}
+
+// ErrSpendLimitExceeded is returned when a send exceeds the remaining spend
+// limit of a send authorization
+func ErrSpendLimitExceeded(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeSpendLimitExceeded, fmt.Sprintf("spend limit exceeded: %s", msg))
+}
+
+// ErrInvalidMsgs is returned when the messages to execute are invalid
+func ErrInvalidMsgs(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidMsgs, fmt.Sprintf("invalid messages: %s", msg))
+}
@@ -0,0 +1,14 @@
+package types
+
+// authz module event types
+var (
+	EventTypeGrant  = "grant_authorization"
+	EventTypeRevoke = "revoke_authorization"
+	EventTypeExec   = "exec_authorization"
+
+	AttributeValueCategory = ModuleName
+
+	AttributeKeyGranter = "granter"
+	AttributeKeyGrantee = "grantee"
+	AttributeKeyMsgType = "msg_type"
+)
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"fmt"
+)
+
+// GenesisState contains the authorization grants of the authz module
+type GenesisState struct {
+	Authorizations []AuthorizationGrant `json:"authorizations" yaml:"authorizations"`
+}
+
+// NewGenesisState creates a new GenesisState
+func NewGenesisState(authorizations []AuthorizationGrant) GenesisState {
+	return GenesisState{
+		Authorizations: authorizations,
+	}
+}
+
+// DefaultGenesisState returns a default genesis state without authorizations
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState([]AuthorizationGrant{})
+}
+
+// ValidateGenesis validates the authorization grants of the genesis state
+func ValidateGenesis(data GenesisState) error {
+	seen := make(map[string]bool, len(data.Authorizations))
+	for _, grant := range data.Authorizations {
+		if err := grant.ValidateBasic(); err != nil {
+			return err
+		}
+
+		key := string(GrantKey(grant.Granter, grant.Grantee, grant.Authorization.MsgType()))
+		if seen[key] {
+			return fmt.Errorf("duplicate %s authorization from %s to %s",
+				grant.Authorization.MsgType(), grant.Granter, grant.Grantee)
+		}
+		seen[key] = true
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	addr2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	send := NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("atom", 10)))
+	generic := NewGenericAuthorization("distr/withdraw_delegator_reward")
+
+	require.NoError(t, ValidateGenesis(DefaultGenesisState()))
+	require.NoError(t, ValidateGenesis(NewGenesisState([]AuthorizationGrant{
+		NewAuthorizationGrant(addr1, addr2, send, time.Time{}),
+		NewAuthorizationGrant(addr1, addr2, generic, time.Time{}),
+		NewAuthorizationGrant(addr2, addr1, send, time.Time{}),
+	})))
+
+	// duplicate grant
+	require.Error(t, ValidateGenesis(NewGenesisState([]AuthorizationGrant{
+		NewAuthorizationGrant(addr1, addr2, send, time.Time{}),
+		NewAuthorizationGrant(addr1, addr2, send, time.Time{}),
+	})))
+
+	// invalid grants
+	require.Error(t, ValidateGenesis(NewGenesisState([]AuthorizationGrant{NewAuthorizationGrant(addr1, addr1, send, time.Time{})})))
+	require.Error(t, ValidateGenesis(NewGenesisState([]AuthorizationGrant{NewAuthorizationGrant(nil, addr1, send, time.Time{})})))
+	require.Error(t, ValidateGenesis(NewGenesisState([]AuthorizationGrant{NewAuthorizationGrant(addr1, addr2, nil, time.Time{})})))
+}
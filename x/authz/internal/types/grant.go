@@ -0,0 +1,80 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+)
+
+// AuthorizationGrant is the authorization of a grantee to execute messages on
+// behalf of a granter until an optional expiration time.
+type AuthorizationGrant struct {
+	Granter       sdk.AccAddress         `json:"granter" yaml:"granter"`
+	Grantee       sdk.AccAddress         `json:"grantee" yaml:"grantee"`
+	Authorization exported.Authorization `json:"authorization" yaml:"authorization"`
+	Expiration    time.Time              `json:"expiration" yaml:"expiration"`
+}
+
+// NewAuthorizationGrant returns a new AuthorizationGrant
+func NewAuthorizationGrant(granter, grantee sdk.AccAddress, authorization exported.Authorization,
+	expiration time.Time) AuthorizationGrant {
+
+	return AuthorizationGrant{
+		Granter:       granter,
+		Grantee:       grantee,
+		Authorization: authorization,
+		Expiration:    expiration,
+	}
+}
+
+// IsExpired returns true if the grant has an expiration time which has been
+// reached at the given block time.
+func (g AuthorizationGrant) IsExpired(blockTime time.Time) bool {
+	return !g.Expiration.IsZero() && !blockTime.Before(g.Expiration)
+}
+
+// ValidateBasic performs a stateless validation of the grant.
+func (g AuthorizationGrant) ValidateBasic() sdk.Error {
+	if g.Granter.Empty() {
+		return ErrInvalidGrant(DefaultCodespace, "missing granter address")
+	}
+	if g.Grantee.Empty() {
+		return ErrInvalidGrant(DefaultCodespace, "missing grantee address")
+	}
+	if g.Granter.Equals(g.Grantee) {
+		return ErrInvalidGrant(DefaultCodespace, "cannot self-grant authorization")
+	}
+	if g.Authorization == nil {
+		return ErrInvalidAuthorization(DefaultCodespace, "missing authorization")
+	}
+	return g.Authorization.ValidateBasic()
+}
+
+// String implements the fmt.Stringer interface.
+func (g AuthorizationGrant) String() string {
+	return fmt.Sprintf(`Authorization Grant:
+  Granter:       %s
+  Grantee:       %s
+  Authorization: %s
+  Expiration:    %s`, g.Granter, g.Grantee, g.Authorization, g.Expiration,
+	)
+}
+
+// AuthorizationGrants is a list of authorization grants
+type AuthorizationGrants []AuthorizationGrant
+
+// String implements the fmt.Stringer interface.
+func (gs AuthorizationGrants) String() string {
+	if len(gs) == 0 {
+		return "[]"
+	}
+
+	out := make([]string, len(gs))
+	for i, g := range gs {
+		out[i] = g.String()
+	}
+	return strings.Join(out, "\n")
+}
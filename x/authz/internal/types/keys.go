@@ -0,0 +1,37 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "authz"
+
+	// StoreKey is the store key string for the authz module
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the authz module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the authz module
+	QuerierRoute = ModuleName
+)
+
+var (
+	// GrantKeyPrefix is the prefix of the authorization grants, which are keyed
+	// by granter, grantee and message type.
+	GrantKeyPrefix = []byte{0x00}
+)
+
+// GrantKey returns the store key of the authorization of a grantee from a
+// granter for a message type.
+func GrantKey(granter, grantee sdk.AccAddress, msgType string) []byte {
+	return append(GrantsPrefix(granter, grantee), []byte(msgType)...)
+}
+
+// GrantsPrefix returns the store key prefix of the authorizations of a grantee
+// from a granter.
+func GrantsPrefix(granter, grantee sdk.AccAddress) []byte {
+	return append(append(GrantKeyPrefix, granter...), grantee...)
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+)
+
+func TestMsgGrantValidation(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	send := NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("atom", 10)))
+
+	require.NoError(t, NewMsgGrant(addr1, addr2, send, time.Time{}).ValidateBasic())
+	require.Error(t, NewMsgGrant(addr1, addr1, send, time.Time{}).ValidateBasic())
+	require.Error(t, NewMsgGrant(nil, addr2, send, time.Time{}).ValidateBasic())
+	require.Error(t, NewMsgGrant(addr1, addr2, nil, time.Time{}).ValidateBasic())
+	require.Error(t, NewMsgGrant(addr1, addr2, NewSendAuthorization(nil), time.Time{}).ValidateBasic())
+
+	msg := NewMsgGrant(addr1, addr2, send, time.Time{})
+	require.Equal(t, []sdk.AccAddress{addr1}, msg.GetSigners())
+	require.NotPanics(t, func() { msg.GetSignBytes() })
+}
+
+func TestMsgRevokeValidation(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+
+	require.NoError(t, NewMsgRevoke(addr1, addr2, "bank/send").ValidateBasic())
+	require.Error(t, NewMsgRevoke(nil, addr2, "bank/send").ValidateBasic())
+	require.Error(t, NewMsgRevoke(addr1, nil, "bank/send").ValidateBasic())
+	require.Error(t, NewMsgRevoke(addr1, addr2, "").ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{addr1}, NewMsgRevoke(addr1, addr2, "bank/send").GetSigners())
+}
+
+func TestMsgExec(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	send := bank.NewMsgSend(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("atom", 10)))
+
+	msg := NewMsgExec(addr2, []sdk.Msg{send})
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{addr2}, msg.GetSigners())
+
+	// the messages are signed with their own sign bytes
+	expected := `{"type":"cosmos-sdk/MsgExec","value":{"grantee":"` + addr2.String() + `","msgs":[` +
+		string(send.GetSignBytes()) + `]}}`
+	require.Equal(t, expected, string(msg.GetSignBytes()))
+
+	require.Error(t, NewMsgExec(nil, []sdk.Msg{send}).ValidateBasic())
+	require.Error(t, NewMsgExec(addr2, nil).ValidateBasic())
+	require.Error(t, NewMsgExec(addr2, []sdk.Msg{bank.NewMsgSend(addr1, addr2, nil)}).ValidateBasic())
+	require.Error(t, NewMsgExec(addr2, []sdk.Msg{bank.NewMsgMultiSend(
+		[]bank.Input{bank.NewInput(addr1, sdk.NewCoins(sdk.NewInt64Coin("atom", 5))), bank.NewInput(addr2, sdk.NewCoins(sdk.NewInt64Coin("atom", 5)))},
+		[]bank.Output{bank.NewOutput(addr2, sdk.NewCoins(sdk.NewInt64Coin("atom", 10)))},
+	)}).ValidateBasic())
+}
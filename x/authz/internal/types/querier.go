@@ -0,0 +1,43 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// query endpoints supported by the authz Querier
+const (
+	QueryGetAuthorization  = "authorization"
+	QueryGetAuthorizations = "authorizations"
+)
+
+// QueryAuthorizationParams defines the params for querying the authorization
+// of a grantee from a granter for a message type
+type QueryAuthorizationParams struct {
+	Granter sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	MsgType string         `json:"msg_type" yaml:"msg_type"`
+}
+
+// NewQueryAuthorizationParams creates a new QueryAuthorizationParams
+func NewQueryAuthorizationParams(granter, grantee sdk.AccAddress, msgType string) QueryAuthorizationParams {
+	return QueryAuthorizationParams{
+		Granter: granter,
+		Grantee: grantee,
+		MsgType: msgType,
+	}
+}
+
+// QueryAuthorizationsParams defines the params for querying all the
+// authorizations of a grantee from a granter
+type QueryAuthorizationsParams struct {
+	Granter sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+}
+
+// NewQueryAuthorizationsParams creates a new QueryAuthorizationsParams
+func NewQueryAuthorizationsParams(granter, grantee sdk.AccAddress) QueryAuthorizationsParams {
+	return QueryAuthorizationsParams{
+		Granter: granter,
+		Grantee: grantee,
+	}
+}
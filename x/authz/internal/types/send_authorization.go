@@ -0,0 +1,61 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+)
+
+var _ exported.Authorization = (*SendAuthorization)(nil)
+
+// SendAuthorization authorizes the grantee to send coins of the granter with
+// bank MsgSend messages, up to a spend limit.
+type SendAuthorization struct {
+	SpendLimit sdk.Coins `json:"spend_limit" yaml:"spend_limit"`
+}
+
+// NewSendAuthorization creates a new SendAuthorization
+func NewSendAuthorization(spendLimit sdk.Coins) *SendAuthorization {
+	return &SendAuthorization{
+		SpendLimit: spendLimit,
+	}
+}
+
+// MsgType implements the Authorization interface.
+func (a SendAuthorization) MsgType() string {
+	return MsgTypeOf(bank.MsgSend{})
+}
+
+// Accept implements the Authorization interface. It deducts the amount sent
+// from the spend limit, and the authorization is used up once the spend limit
+// is reached.
+func (a *SendAuthorization) Accept(msg sdk.Msg) (remove bool, err sdk.Error) {
+	send, ok := msg.(bank.MsgSend)
+	if !ok {
+		return false, ErrInvalidAuthorization(DefaultCodespace, fmt.Sprintf("cannot accept %T messages", msg))
+	}
+
+	left, invalid := a.SpendLimit.SafeSub(send.Amount)
+	if invalid {
+		return false, ErrSpendLimitExceeded(DefaultCodespace, fmt.Sprintf("%s > %s", send.Amount, a.SpendLimit))
+	}
+
+	a.SpendLimit = left
+	return left.IsZero(), nil
+}
+
+// ValidateBasic implements the Authorization interface.
+func (a SendAuthorization) ValidateBasic() sdk.Error {
+	if !a.SpendLimit.IsValid() || a.SpendLimit.Empty() {
+		return ErrInvalidAuthorization(DefaultCodespace, fmt.Sprintf("invalid spend limit: %s", a.SpendLimit))
+	}
+	return nil
+}
+
+// String implements the fmt.Stringer interface.
+func (a SendAuthorization) String() string {
+	return fmt.Sprintf(`Send Authorization:
+  SpendLimit: %s`, a.SpendLimit)
+}
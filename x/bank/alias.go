@@ -15,53 +15,82 @@ const (
 	DefaultCodespace         = types.DefaultCodespace
 	CodeSendDisabled         = types.CodeSendDisabled
 	CodeInvalidInputsOutputs = types.CodeInvalidInputsOutputs
+	CodeUnknownDenomMetadata = types.CodeUnknownDenomMetadata
+	CodeInvalidVesting       = types.CodeInvalidVesting
 	ModuleName               = types.ModuleName
 	QuerierRoute             = types.QuerierRoute
+	StoreKey                 = types.StoreKey
 	RouterKey                = types.RouterKey
 	DefaultParamspace        = types.DefaultParamspace
 	DefaultSendEnabled       = types.DefaultSendEnabled
+	QueryDenomMetadata       = types.QueryDenomMetadata
+	QueryAllDenomMetadata    = types.QueryAllDenomMetadata
 )
 
 var (
 	// functions aliases
-	RegisterInvariants          = keeper.RegisterInvariants
-	NonnegativeBalanceInvariant = keeper.NonnegativeBalanceInvariant
-	NewBaseKeeper               = keeper.NewBaseKeeper
-	NewBaseSendKeeper           = keeper.NewBaseSendKeeper
-	NewBaseViewKeeper           = keeper.NewBaseViewKeeper
-	NewQuerier                  = keeper.NewQuerier
-	RegisterCodec               = types.RegisterCodec
-	ErrNoInputs                 = types.ErrNoInputs
-	ErrNoOutputs                = types.ErrNoOutputs
-	ErrInputOutputMismatch      = types.ErrInputOutputMismatch
-	ErrSendDisabled             = types.ErrSendDisabled
-	NewGenesisState             = types.NewGenesisState
-	DefaultGenesisState         = types.DefaultGenesisState
-	ValidateGenesis             = types.ValidateGenesis
-	NewMsgSend                  = types.NewMsgSend
-	NewMsgMultiSend             = types.NewMsgMultiSend
-	NewInput                    = types.NewInput
-	NewOutput                   = types.NewOutput
-	ValidateInputsOutputs       = types.ValidateInputsOutputs
-	ParamKeyTable               = types.ParamKeyTable
-	NewQueryBalanceParams       = types.NewQueryBalanceParams
+	RegisterInvariants                 = keeper.RegisterInvariants
+	NonnegativeBalanceInvariant        = keeper.NonnegativeBalanceInvariant
+	NewBaseKeeper                      = keeper.NewBaseKeeper
+	NewBaseSendKeeper                  = keeper.NewBaseSendKeeper
+	NewBaseViewKeeper                  = keeper.NewBaseViewKeeper
+	NewQuerier                         = keeper.NewQuerier
+	RegisterCodec                      = types.RegisterCodec
+	ErrNoInputs                        = types.ErrNoInputs
+	ErrNoOutputs                       = types.ErrNoOutputs
+	ErrInputOutputMismatch             = types.ErrInputOutputMismatch
+	ErrSendDisabled                    = types.ErrSendDisabled
+	ErrDenomSendDisabled               = types.ErrDenomSendDisabled
+	ErrUnknownDenomMetadata            = types.ErrUnknownDenomMetadata
+	ErrInvalidVestingSchedule          = types.ErrInvalidVestingSchedule
+	ErrAccountExists                   = types.ErrAccountExists
+	NewGenesisState                    = types.NewGenesisState
+	DefaultGenesisState                = types.DefaultGenesisState
+	ValidateGenesis                    = types.ValidateGenesis
+	DenomMetadataKey                   = types.DenomMetadataKey
+	NewDenomUnit                       = types.NewDenomUnit
+	NewMetadata                        = types.NewMetadata
+	ConvertCoinsToBase                 = types.ConvertCoinsToBase
+	ValidateMetadataList               = types.ValidateMetadataList
+	NewMsgSend                         = types.NewMsgSend
+	NewMsgMultiSend                    = types.NewMsgMultiSend
+	NewMsgCreateVestingAccount         = types.NewMsgCreateVestingAccount
+	NewMsgCreatePeriodicVestingAccount = types.NewMsgCreatePeriodicVestingAccount
+	NewInput                           = types.NewInput
+	NewOutput                          = types.NewOutput
+	ValidateInputsOutputs              = types.ValidateInputsOutputs
+	NewDenomSendEnabled                = types.NewDenomSendEnabled
+	ParamKeyTable                      = types.ParamKeyTable
+	NewMultiSendRestriction            = types.NewMultiSendRestriction
+	NewQueryBalanceParams              = types.NewQueryBalanceParams
+	NewQueryDenomMetadataParams        = types.NewQueryDenomMetadataParams
 
 	// variable aliases
-	ModuleCdc                = types.ModuleCdc
-	ParamStoreKeySendEnabled = types.ParamStoreKeySendEnabled
+	ModuleCdc                     = types.ModuleCdc
+	DenomMetadataPrefix           = types.DenomMetadataPrefix
+	ParamStoreKeySendEnabled      = types.ParamStoreKeySendEnabled
+	ParamStoreKeyDenomSendEnabled = types.ParamStoreKeyDenomSendEnabled
 )
 
 type (
-	Keeper             = keeper.Keeper
-	BaseKeeper         = keeper.BaseKeeper
-	SendKeeper         = keeper.SendKeeper
-	BaseSendKeeper     = keeper.BaseSendKeeper
-	ViewKeeper         = keeper.ViewKeeper
-	BaseViewKeeper     = keeper.BaseViewKeeper
-	GenesisState       = types.GenesisState
-	MsgSend            = types.MsgSend
-	MsgMultiSend       = types.MsgMultiSend
-	Input              = types.Input
-	Output             = types.Output
-	QueryBalanceParams = types.QueryBalanceParams
+	Keeper                   = keeper.Keeper
+	BaseKeeper               = keeper.BaseKeeper
+	SendKeeper               = keeper.SendKeeper
+	BaseSendKeeper           = keeper.BaseSendKeeper
+	ViewKeeper               = keeper.ViewKeeper
+	BaseViewKeeper           = keeper.BaseViewKeeper
+	SendRestriction          = types.SendRestriction
+	GenesisState             = types.GenesisState
+	MsgSend                  = types.MsgSend
+	MsgMultiSend             = types.MsgMultiSend
+	MsgCreateVestingAccount  = types.MsgCreateVestingAccount
+	Input                    = types.Input
+	Output                   = types.Output
+	DenomSendEnabled         = types.DenomSendEnabled
+	DenomSendEnabledList     = types.DenomSendEnabledList
+	DenomUnit                = types.DenomUnit
+	Metadata                 = types.Metadata
+	MultiSendRestriction     = types.MultiSendRestriction
+	QueryBalanceParams       = types.QueryBalanceParams
+	QueryDenomMetadataParams = types.QueryDenomMetadataParams
 )
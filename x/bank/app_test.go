@@ -1,7 +1,9 @@
 package bank_test
 
 import (
+	"math"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -13,6 +15,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/bank/internal/types"
 )
 
@@ -337,3 +340,95 @@ func TestMsgMultiSendDependent(t *testing.T) {
 		}
 	}
 }
+
+func TestSendDisabledDenom(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	handler := bank.NewHandler(app.BankKeeper)
+
+	app.BankKeeper.SetCoins(ctx, addr1, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10), sdk.NewInt64Coin("barcoin", 10)))
+	app.BankKeeper.SetDenomSendEnabled(ctx, types.DenomSendEnabledList{types.NewDenomSendEnabled("barcoin", false)})
+
+	res := handler(ctx, types.NewMsgSend(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("barcoin", 5))))
+	require.Equal(t, types.CodeSendDisabled, res.Code)
+
+	msg := types.NewMsgMultiSend(
+		[]types.Input{types.NewInput(addr1, sdk.NewCoins(sdk.NewInt64Coin("barcoin", 5), sdk.NewInt64Coin("foocoin", 5)))},
+		[]types.Output{types.NewOutput(addr2, sdk.NewCoins(sdk.NewInt64Coin("barcoin", 5), sdk.NewInt64Coin("foocoin", 5)))},
+	)
+	res = handler(ctx, msg)
+	require.Equal(t, types.CodeSendDisabled, res.Code)
+
+	res = handler(ctx, types.NewMsgSend(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 5))))
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("barcoin", 10), sdk.NewInt64Coin("foocoin", 5)), app.BankKeeper.GetCoins(ctx, addr1))
+}
+
+func TestCreateVestingAccount(t *testing.T) {
+	app := simapp.Setup(false)
+	now := time.Now()
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: now})
+	handler := bank.NewHandler(app.BankKeeper)
+
+	addr3 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	app.BankKeeper.SetCoins(ctx, addr1, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 100)))
+	app.BankKeeper.SetCoins(ctx, addr3, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10)))
+
+	// the recipient must not exist
+	msg := types.NewMsgCreateVestingAccount(addr1, addr3, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10)), now.Unix()+100, false)
+	res := handler(ctx, msg)
+	require.Equal(t, types.CodeInvalidVesting, res.Code)
+
+	// the end time must be after the block time
+	msg = types.NewMsgCreateVestingAccount(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10)), now.Unix(), false)
+	res = handler(ctx, msg)
+	require.Equal(t, types.CodeInvalidVesting, res.Code)
+
+	msg = types.NewMsgCreateVestingAccount(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10)), now.Unix()+100, false)
+	res = handler(ctx, msg)
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 90)), app.BankKeeper.GetCoins(ctx, addr1))
+
+	cva, ok := app.AccountKeeper.GetAccount(ctx, addr2).(*auth.ContinuousVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, now.Unix(), cva.GetStartTime())
+	require.Equal(t, now.Unix()+100, cva.GetEndTime())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10)), cva.GetOriginalVesting())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10)), cva.GetCoins())
+
+	periods := auth.Periods{
+		auth.NewPeriod(100, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 20))),
+		auth.NewPeriod(200, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 30))),
+	}
+	addr4 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	res = handler(ctx, types.NewMsgCreatePeriodicVestingAccount(addr1, addr4, periods))
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 40)), app.BankKeeper.GetCoins(ctx, addr1))
+
+	pva, ok := app.AccountKeeper.GetAccount(ctx, addr4).(*auth.PeriodicVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, now.Unix()+300, pva.GetEndTime())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 50)), pva.GetOriginalVesting())
+	require.NoError(t, pva.Validate())
+
+	// the first period vests after 100 seconds
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 20)), pva.SpendableCoins(now.Add(150*time.Second)))
+
+	// the periods can not end after the maximum time
+	periods = auth.Periods{
+		auth.NewPeriod(100, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 5))),
+		auth.NewPeriod(math.MaxInt64-100, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 5))),
+	}
+	addr6 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	res = handler(ctx, types.NewMsgCreatePeriodicVestingAccount(addr1, addr6, periods))
+	require.False(t, res.IsOK())
+	require.Equal(t, types.CodeInvalidVesting, res.Code)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 40)), app.BankKeeper.GetCoins(ctx, addr1))
+
+	// the sender must have enough spendable coins
+	addr5 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	msg = types.NewMsgCreateVestingAccount(addr1, addr5, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 50)), now.Unix()+100, true)
+	res = handler(ctx, msg)
+	require.False(t, res.IsOK())
+	require.Nil(t, app.AccountKeeper.GetAccount(ctx, addr5))
+}
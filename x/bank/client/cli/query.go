@@ -0,0 +1,107 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/bank/internal/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	// Group bank queries under a subcommand
+	bankQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the bank module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	bankQueryCmd.AddCommand(client.GetCommands(
+		GetCmdQueryDenomMetadata(cdc),
+	)...)
+
+	return bankQueryCmd
+}
+
+// GetCmdQueryDenomMetadata implements the query denom metadata command.
+func GetCmdQueryDenomMetadata(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "denom-metadata [denom]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Query the metadata of coin denominations",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the description and the denom units of the base denominations
+of the chain.
+
+Example:
+$ %s query %s denom-metadata
+
+To query for the metadata of a specific base denomination use:
+$ %s query %s denom-metadata stake
+`,
+				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			if len(args) == 0 {
+				metadata, err := queryAllDenomMetadata(cliCtx)
+				if err != nil {
+					return err
+				}
+				return cliCtx.PrintOutput(metadataList(metadata))
+			}
+
+			params := types.NewQueryDenomMetadataParams(args[0])
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDenomMetadata), bz)
+			if err != nil {
+				return err
+			}
+
+			var metadata types.Metadata
+			if err := cdc.UnmarshalJSON(res, &metadata); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(metadata)
+		},
+	}
+}
+
+// queryAllDenomMetadata returns the metadata of all the base denominations.
+func queryAllDenomMetadata(cliCtx context.CLIContext) ([]types.Metadata, error) {
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAllDenomMetadata), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var metadata []types.Metadata
+	if err := cliCtx.Codec.UnmarshalJSON(res, &metadata); err != nil {
+		return nil, err
+	}
+	return metadata, nil
+}
+
+// metadataList implements fmt.Stringer for a list of denom metadata
+type metadataList []types.Metadata
+
+func (l metadataList) String() string {
+	out := make([]string, len(l))
+	for i, m := range l {
+		out[i] = m.String()
+	}
+	return strings.Join(out, "\n")
+}
@@ -1,7 +1,14 @@
 package cli
 
 import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -12,6 +19,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank/internal/types"
 )
 
+// FlagConvertUnits is the flag to convert the amount of a send from any denom
+// unit to base units
+const FlagConvertUnits = "convert-units"
+
+// FlagDelayed is the flag to create a delayed vesting account instead of a
+// continuous one
+const FlagDelayed = "delayed"
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	txCmd := &cobra.Command{
@@ -23,6 +38,8 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	}
 	txCmd.AddCommand(
 		SendTxCmd(cdc),
+		CreateVestingAccountTxCmd(cdc),
+		CreatePeriodicVestingAccountTxCmd(cdc),
 	)
 	return txCmd
 }
@@ -32,7 +49,10 @@ func SendTxCmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "send [from_key_or_address] [to_address] [amount]",
 		Short: "Create and sign a send tx",
-		Args:  cobra.ExactArgs(3),
+		Long: `Create and sign a send tx. With the --convert-units flag, the amount can
+be expressed in any denom unit, such as 1.5atom, and is converted to base units
+using the denom metadata of the chain.`,
+		Args: cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
 			cliCtx := context.NewCLIContextWithFrom(args[0]).WithCodec(cdc)
@@ -43,7 +63,7 @@ func SendTxCmd(cdc *codec.Codec) *cobra.Command {
 			}
 
 			// parse coins trying to be sent
-			coins, err := sdk.ParseCoins(args[2])
+			coins, err := parseCoins(cliCtx, args[2], viper.GetBool(FlagConvertUnits))
 			if err != nil {
 				return err
 			}
@@ -54,7 +74,146 @@ func SendTxCmd(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Bool(FlagConvertUnits, false, "Convert the amount from any denom unit to base units using the denom metadata of the chain")
+	cmd = client.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+// CreateVestingAccountTxCmd will create a tx creating a continuous or delayed
+// vesting account and sign it with the given key.
+func CreateVestingAccountTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-vesting-account [from_key_or_address] [to_address] [amount] [end_time]",
+		Short: "Create and sign a tx creating a new vesting account",
+		Long: `Create and sign a tx creating a new vesting account funded with the given amount.
+The coins vest continuously from the block time until the end time, a UNIX timestamp,
+or all at once at the end time with the --delayed flag.`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithFrom(args[0]).WithCodec(cdc)
+
+			to, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoins(args[2])
+			if err != nil {
+				return err
+			}
+
+			endTime, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid end time %s: %v", args[3], err)
+			}
+
+			msg := types.NewMsgCreateVestingAccount(cliCtx.GetFromAddress(), to, coins, endTime, viper.GetBool(FlagDelayed))
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Bool(FlagDelayed, false, "Create a delayed vesting account which vests all coins at the end time")
+	cmd = client.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+// CreatePeriodicVestingAccountTxCmd will create a tx creating a periodic
+// vesting account and sign it with the given key.
+func CreatePeriodicVestingAccountTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-periodic-vesting-account [from_key_or_address] [to_address] [periods_file]",
+		Short: "Create and sign a tx creating a new periodic vesting account",
+		Long: `Create and sign a tx creating a new periodic vesting account funded with the sum of
+the amounts of the periods, which start at the block time. The periods are read from a
+JSON file, where the length of each period is given in seconds:
+
+[
+  {"length": 7776000, "amount": "1000stake"},
+  {"length": 7776000, "amount": "2500stake"}
+]
+`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithFrom(args[0]).WithCodec(cdc)
+
+			to, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			periods, err := readPeriods(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreatePeriodicVestingAccount(cliCtx.GetFromAddress(), to, periods)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
 	cmd = client.PostCommands(cmd)[0]
 
 	return cmd
 }
+
+// readPeriods reads vesting periods from a JSON file.
+func readPeriods(path string) (auth.Periods, error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var input []struct {
+		Length int64  `json:"length"`
+		Amount string `json:"amount"`
+	}
+	if err := json.Unmarshal(bz, &input); err != nil {
+		return nil, fmt.Errorf("failed to parse vesting periods: %v", err)
+	}
+
+	periods := make(auth.Periods, len(input))
+	for i, p := range input {
+		amount, err := sdk.ParseCoins(p.Amount)
+		if err != nil {
+			return nil, err
+		}
+		periods[i] = auth.NewPeriod(p.Length, amount)
+	}
+	return periods, nil
+}
+
+// parseCoins parses an amount of coins, which may be expressed in any denom
+// unit if convertUnits is set.
+func parseCoins(cliCtx context.CLIContext, coinsStr string, convertUnits bool) (sdk.Coins, error) {
+	if !convertUnits {
+		return sdk.ParseCoins(coinsStr)
+	}
+
+	var decCoins sdk.DecCoins
+	for _, coinStr := range strings.Split(coinsStr, ",") {
+		decCoin, err := parseDecCoin(coinStr)
+		if err != nil {
+			return nil, err
+		}
+		decCoins = append(decCoins, decCoin)
+	}
+
+	metadata, err := queryAllDenomMetadata(cliCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.ConvertCoinsToBase(metadata, decCoins)
+}
+
+// parseDecCoin parses an amount expressed as an integer or a decimal.
+func parseDecCoin(coinStr string) (sdk.DecCoin, error) {
+	if coin, err := sdk.ParseCoin(coinStr); err == nil {
+		return sdk.NewDecCoinFromCoin(coin), nil
+	}
+	return sdk.ParseDecCoin(coinStr)
+}
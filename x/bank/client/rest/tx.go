@@ -17,6 +17,8 @@ import (
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/bank/accounts/{address}/transfers", SendRequestHandlerFn(cliCtx)).Methods("POST")
 	r.HandleFunc("/bank/balances/{address}", QueryBalancesRequestHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/bank/denom_metadata", QueryAllDenomMetadataRequestHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/bank/denom_metadata/{denom}", QueryDenomMetadataRequestHandlerFn(cliCtx)).Methods("GET")
 }
 
 // SendReq defines the properties of a send request's body.
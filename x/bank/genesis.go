@@ -7,9 +7,14 @@ import (
 // InitGenesis sets distribution information for genesis.
 func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 	keeper.SetSendEnabled(ctx, data.SendEnabled)
+	keeper.SetDenomSendEnabled(ctx, data.DenomSendEnabled)
+
+	for _, metadata := range data.DenomMetadata {
+		keeper.SetDenomMetadata(ctx, metadata)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
-	return NewGenesisState(keeper.GetSendEnabled(ctx))
+	return NewGenesisState(keeper.GetSendEnabled(ctx), keeper.GetDenomSendEnabled(ctx), keeper.GetAllDenomMetadata(ctx))
 }
@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/exported"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/internal/keeper"
 	"github.com/cosmos/cosmos-sdk/x/bank/internal/types"
 )
@@ -20,6 +22,9 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case types.MsgMultiSend:
 			return handleMsgMultiSend(ctx, k, msg)
 
+		case types.MsgCreateVestingAccount:
+			return handleMsgCreateVestingAccount(ctx, k, msg)
+
 		default:
 			errMsg := fmt.Sprintf("unrecognized bank message type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -29,8 +34,8 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 
 // Handle MsgSend.
 func handleMsgSend(ctx sdk.Context, k keeper.Keeper, msg types.MsgSend) sdk.Result {
-	if !k.GetSendEnabled(ctx) {
-		return types.ErrSendDisabled(k.Codespace()).Result()
+	if err := k.IsSendEnabledCoins(ctx, msg.Amount); err != nil {
+		return err.Result()
 	}
 
 	if k.BlacklistedAddr(msg.ToAddress) {
@@ -55,8 +60,10 @@ func handleMsgSend(ctx sdk.Context, k keeper.Keeper, msg types.MsgSend) sdk.Resu
 // Handle MsgMultiSend.
 func handleMsgMultiSend(ctx sdk.Context, k keeper.Keeper, msg types.MsgMultiSend) sdk.Result {
 	// NOTE: totalIn == totalOut should already have been checked
-	if !k.GetSendEnabled(ctx) {
-		return types.ErrSendDisabled(k.Codespace()).Result()
+	for _, in := range msg.Inputs {
+		if err := k.IsSendEnabledCoins(ctx, in.Coins); err != nil {
+			return err.Result()
+		}
 	}
 
 	for _, out := range msg.Outputs {
@@ -79,3 +86,50 @@ func handleMsgMultiSend(ctx sdk.Context, k keeper.Keeper, msg types.MsgMultiSend
 
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
+
+// Handle MsgCreateVestingAccount.
+func handleMsgCreateVestingAccount(ctx sdk.Context, k keeper.Keeper, msg types.MsgCreateVestingAccount) sdk.Result {
+	if err := k.IsSendEnabledCoins(ctx, msg.Amount); err != nil {
+		return err.Result()
+	}
+
+	if k.BlacklistedAddr(msg.ToAddress) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("%s is not allowed to receive transactions", msg.ToAddress)).Result()
+	}
+
+	// vesting schedules start at the block time
+	startTime := ctx.BlockHeader().Time.Unix()
+	if len(msg.Periods) == 0 && msg.EndTime <= startTime {
+		return types.ErrInvalidVestingSchedule(k.Codespace(), "end time must be after the block time").Result()
+	}
+	if len(msg.Periods) > 0 {
+		if err := msg.Periods.ValidateEndTime(startTime); err != nil {
+			return types.ErrInvalidVestingSchedule(k.Codespace(), err.Error()).Result()
+		}
+	}
+
+	err := k.CreateVestingAccount(ctx, msg.FromAddress, msg.ToAddress, msg.Amount,
+		func(baseAcc *authtypes.BaseAccount) exported.VestingAccount {
+			switch {
+			case len(msg.Periods) > 0:
+				return authtypes.NewPeriodicVestingAccount(baseAcc, startTime, msg.Periods)
+			case msg.Delayed:
+				return authtypes.NewDelayedVestingAccount(baseAcc, msg.EndTime)
+			default:
+				return authtypes.NewContinuousVestingAccount(baseAcc, startTime, msg.EndTime)
+			}
+		},
+	)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
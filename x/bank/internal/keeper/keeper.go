@@ -6,8 +6,10 @@ import (
 
 	"github.com/tendermint/tendermint/libs/log"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/exported"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/internal/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 )
@@ -21,24 +23,33 @@ type Keeper interface {
 
 	DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
 	UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
+
+	GetDenomMetadata(ctx sdk.Context, denom string) (types.Metadata, bool)
+	SetDenomMetadata(ctx sdk.Context, metadata types.Metadata)
+	IterateDenomMetadata(ctx sdk.Context, cb func(metadata types.Metadata) (stop bool))
+	GetAllDenomMetadata(ctx sdk.Context) []types.Metadata
 }
 
 // BaseKeeper manages transfers between accounts. It implements the Keeper interface.
 type BaseKeeper struct {
 	BaseSendKeeper
 
+	cdc        *codec.Codec
+	storeKey   sdk.StoreKey
 	ak         types.AccountKeeper
 	paramSpace params.Subspace
 }
 
 // NewBaseKeeper returns a new BaseKeeper
-func NewBaseKeeper(ak types.AccountKeeper,
-	paramSpace params.Subspace,
-	codespace sdk.CodespaceType, blacklistedAddrs map[string]bool) BaseKeeper {
+func NewBaseKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, ak types.AccountKeeper,
+	paramSpace params.Subspace, codespace sdk.CodespaceType, blacklistedAddrs map[string]bool,
+	sendRestriction types.SendRestriction) BaseKeeper {
 
 	ps := paramSpace.WithKeyTable(types.ParamKeyTable())
 	return BaseKeeper{
-		BaseSendKeeper: NewBaseSendKeeper(ak, ps, codespace, blacklistedAddrs),
+		BaseSendKeeper: NewBaseSendKeeper(ak, ps, codespace, blacklistedAddrs, sendRestriction),
+		cdc:            cdc,
+		storeKey:       storeKey,
 		ak:             ak,
 		paramSpace:     ps,
 	}
@@ -131,6 +142,53 @@ func (keeper BaseKeeper) UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegat
 	return nil
 }
 
+// GetDenomMetadata returns the metadata of a base denom, if any.
+func (keeper BaseKeeper) GetDenomMetadata(ctx sdk.Context, denom string) (types.Metadata, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.DenomMetadataKey(denom))
+	if bz == nil {
+		return types.Metadata{}, false
+	}
+
+	var metadata types.Metadata
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &metadata)
+	return metadata, true
+}
+
+// SetDenomMetadata sets the metadata of its base denom.
+func (keeper BaseKeeper) SetDenomMetadata(ctx sdk.Context, metadata types.Metadata) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(metadata)
+	store.Set(types.DenomMetadataKey(metadata.Base), bz)
+}
+
+// IterateDenomMetadata iterates over the metadata of all the base denoms,
+// ordered by base denom, until cb returns true.
+func (keeper BaseKeeper) IterateDenomMetadata(ctx sdk.Context, cb func(metadata types.Metadata) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DenomMetadataPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var metadata types.Metadata
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &metadata)
+
+		if cb(metadata) {
+			break
+		}
+	}
+}
+
+// GetAllDenomMetadata returns the metadata of all the base denoms.
+func (keeper BaseKeeper) GetAllDenomMetadata(ctx sdk.Context) []types.Metadata {
+	metadata := []types.Metadata{}
+	keeper.IterateDenomMetadata(ctx, func(m types.Metadata) bool {
+		metadata = append(metadata, m)
+		return false
+	})
+	return metadata
+}
+
 // SendKeeper defines a module interface that facilitates the transfer of coins
 // between accounts without the possibility of creating coins.
 type SendKeeper interface {
@@ -145,6 +203,13 @@ type SendKeeper interface {
 
 	GetSendEnabled(ctx sdk.Context) bool
 	SetSendEnabled(ctx sdk.Context, enabled bool)
+	GetDenomSendEnabled(ctx sdk.Context) types.DenomSendEnabledList
+	SetDenomSendEnabled(ctx sdk.Context, denomSendEnabled types.DenomSendEnabledList)
+	IsSendEnabledDenom(ctx sdk.Context, denom string) bool
+	IsSendEnabledCoins(ctx sdk.Context, coins sdk.Coins) sdk.Error
+
+	CreateVestingAccount(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins,
+		newAccount func(*authtypes.BaseAccount) exported.VestingAccount) sdk.Error
 
 	BlacklistedAddr(addr sdk.AccAddress) bool
 }
@@ -161,21 +226,38 @@ type BaseSendKeeper struct {
 
 	// list of addresses that are restricted from receiving transactions
 	blacklistedAddrs map[string]bool
+
+	// restriction checked for every transfer of coins
+	sendRestriction types.SendRestriction
 }
 
-// NewBaseSendKeeper returns a new BaseSendKeeper.
-func NewBaseSendKeeper(ak types.AccountKeeper,
-	paramSpace params.Subspace, codespace sdk.CodespaceType, blacklistedAddrs map[string]bool) BaseSendKeeper {
+// NewBaseSendKeeper returns a new BaseSendKeeper. The send restriction, if not
+// nil, is checked for every transfer of coins; several restrictions can be
+// combined with a MultiSendRestriction.
+func NewBaseSendKeeper(ak types.AccountKeeper, paramSpace params.Subspace, codespace sdk.CodespaceType,
+	blacklistedAddrs map[string]bool, sendRestriction types.SendRestriction) BaseSendKeeper {
 
 	return BaseSendKeeper{
 		BaseViewKeeper:   NewBaseViewKeeper(ak, codespace),
 		ak:               ak,
 		paramSpace:       paramSpace,
 		blacklistedAddrs: blacklistedAddrs,
+		sendRestriction:  sendRestriction,
 	}
 }
 
-// InputOutputCoins handles a list of inputs and outputs
+// restrictSend returns the address which the coins sent from fromAddr to
+// toAddr must be sent to, as decided by the send restriction.
+func (keeper BaseSendKeeper) restrictSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, sdk.Error) {
+	if keeper.sendRestriction == nil {
+		return toAddr, nil
+	}
+	return keeper.sendRestriction.RestrictSend(ctx, fromAddr, toAddr, amt)
+}
+
+// InputOutputCoins handles a list of inputs and outputs. The send restriction
+// is checked for every output with the address of each input as sender, and
+// all the inputs must agree on the address the output is sent to.
 func (keeper BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input, outputs []types.Output) sdk.Error {
 	// Safety check ensuring that when sending coins the keeper must maintain the
 	// Check supply invariant and validity of Coins.
@@ -183,6 +265,23 @@ func (keeper BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.In
 		return err
 	}
 
+	toAddrs := make([]sdk.AccAddress, len(outputs))
+	for i, out := range outputs {
+		for _, in := range inputs {
+			toAddr, err := keeper.restrictSend(ctx, in.Address, out.Address, out.Coins)
+			if err != nil {
+				return err
+			}
+
+			if toAddrs[i] != nil && !toAddrs[i].Equals(toAddr) {
+				return sdk.ErrInvalidAddress(
+					fmt.Sprintf("send restriction redirects the output to %s to different addresses", out.Address),
+				)
+			}
+			toAddrs[i] = toAddr
+		}
+	}
+
 	for _, in := range inputs {
 		_, err := keeper.SubtractCoins(ctx, in.Address, in.Coins)
 		if err != nil {
@@ -197,8 +296,8 @@ func (keeper BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.In
 		)
 	}
 
-	for _, out := range outputs {
-		_, err := keeper.AddCoins(ctx, out.Address, out.Coins)
+	for i, out := range outputs {
+		_, err := keeper.AddCoins(ctx, toAddrs[i], out.Coins)
 		if err != nil {
 			return err
 		}
@@ -206,7 +305,7 @@ func (keeper BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.In
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypeTransfer,
-				sdk.NewAttribute(types.AttributeKeyRecipient, out.Address.String()),
+				sdk.NewAttribute(types.AttributeKeyRecipient, toAddrs[i].String()),
 			),
 		)
 	}
@@ -214,8 +313,14 @@ func (keeper BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.In
 	return nil
 }
 
-// SendCoins moves coins from one account to another
+// SendCoins moves coins from one account to another, or to the address the
+// send restriction redirects them to.
 func (keeper BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	toAddr, err := keeper.restrictSend(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return err
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeTransfer,
@@ -228,7 +333,7 @@ func (keeper BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress,
 		),
 	})
 
-	_, err := keeper.SubtractCoins(ctx, fromAddr, amt)
+	_, err = keeper.SubtractCoins(ctx, fromAddr, amt)
 	if err != nil {
 		return err
 	}
@@ -241,6 +346,54 @@ func (keeper BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress,
 	return nil
 }
 
+// CreateVestingAccount moves coins from an account to a new vesting account
+// holding them, which is created by newAccount from a base account at toAddr.
+// It fails if an account already exists at toAddr or if the send restriction
+// redirects the coins.
+func (keeper BaseSendKeeper) CreateVestingAccount(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins,
+	newAccount func(*authtypes.BaseAccount) exported.VestingAccount) sdk.Error {
+
+	if keeper.ak.GetAccount(ctx, toAddr) != nil {
+		return types.ErrAccountExists(keeper.Codespace(), toAddr)
+	}
+
+	restrictedAddr, err := keeper.restrictSend(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return err
+	}
+	if !restrictedAddr.Equals(toAddr) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("coins sent to %s cannot be redirected", toAddr))
+	}
+
+	_, err = keeper.SubtractCoins(ctx, fromAddr, amt)
+	if err != nil {
+		return err
+	}
+
+	baseAcc, ok := keeper.ak.NewAccountWithAddress(ctx, toAddr).(*authtypes.BaseAccount)
+	if !ok {
+		return sdk.ErrInternal("vesting accounts require base accounts")
+	}
+	if err := baseAcc.SetCoins(amt); err != nil {
+		panic(err)
+	}
+	keeper.ak.SetAccount(ctx, newAccount(baseAcc))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTransfer,
+			sdk.NewAttribute(types.AttributeKeyRecipient, toAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(types.AttributeKeySender, fromAddr.String()),
+		),
+	})
+
+	return nil
+}
+
 // SubtractCoins subtracts amt from the coins at the addr.
 //
 // CONTRACT: If the account is a vesting account, the amount has to be spendable.
@@ -327,6 +480,40 @@ func (keeper BaseSendKeeper) SetSendEnabled(ctx sdk.Context, enabled bool) {
 	keeper.paramSpace.Set(ctx, types.ParamStoreKeySendEnabled, &enabled)
 }
 
+// GetDenomSendEnabled returns the denominations whose transfers are enabled or
+// disabled regardless of SendEnabled
+func (keeper BaseSendKeeper) GetDenomSendEnabled(ctx sdk.Context) types.DenomSendEnabledList {
+	denomSendEnabled := types.DenomSendEnabledList{}
+	keeper.paramSpace.GetIfExists(ctx, types.ParamStoreKeyDenomSendEnabled, &denomSendEnabled)
+	return denomSendEnabled
+}
+
+// SetDenomSendEnabled sets the denominations whose transfers are enabled or
+// disabled regardless of SendEnabled
+func (keeper BaseSendKeeper) SetDenomSendEnabled(ctx sdk.Context, denomSendEnabled types.DenomSendEnabledList) {
+	keeper.paramSpace.Set(ctx, types.ParamStoreKeyDenomSendEnabled, &denomSendEnabled)
+}
+
+// IsSendEnabledDenom returns whether the transfers of a denomination are
+// enabled. Denominations without a DenomSendEnabled entry follow SendEnabled.
+func (keeper BaseSendKeeper) IsSendEnabledDenom(ctx sdk.Context, denom string) bool {
+	if enabled, found := keeper.GetDenomSendEnabled(ctx).IsSendEnabled(denom); found {
+		return enabled
+	}
+	return keeper.GetSendEnabled(ctx)
+}
+
+// IsSendEnabledCoins returns an error if the transfers of any of the coins'
+// denominations are disabled.
+func (keeper BaseSendKeeper) IsSendEnabledCoins(ctx sdk.Context, coins sdk.Coins) sdk.Error {
+	for _, coin := range coins {
+		if !keeper.IsSendEnabledDenom(ctx, coin.Denom) {
+			return types.ErrDenomSendDisabled(keeper.Codespace(), coin.Denom)
+		}
+	}
+	return nil
+}
+
 // BlacklistedAddr checks if a given address is blacklisted (i.e restricted from
 // receiving funds)
 func (keeper BaseSendKeeper) BlacklistedAddr(addr sdk.AccAddress) bool {
@@ -16,6 +16,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	keep "github.com/cosmos/cosmos-sdk/x/bank/internal/keeper"
 	"github.com/cosmos/cosmos-sdk/x/bank/internal/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
 )
 
 func TestKeeper(t *testing.T) {
@@ -96,7 +97,7 @@ func TestKeeper(t *testing.T) {
 
 	// Test retrieving black listed accounts
 	for acc := range simapp.GetMaccPerms() {
-		require.True(t, app.BankKeeper.BlacklistedAddr(app.SupplyKeeper.GetModuleAddress(acc)))
+		require.Equal(t, acc != gov.ModuleName, app.BankKeeper.BlacklistedAddr(app.SupplyKeeper.GetModuleAddress(acc)))
 	}
 }
 
@@ -106,7 +107,7 @@ func TestSendKeeper(t *testing.T) {
 	blacklistedAddrs := make(map[string]bool)
 
 	paramSpace := app.ParamsKeeper.Subspace("newspace")
-	sendKeeper := keep.NewBaseSendKeeper(app.AccountKeeper, paramSpace, types.DefaultCodespace, blacklistedAddrs)
+	sendKeeper := keep.NewBaseSendKeeper(app.AccountKeeper, paramSpace, types.DefaultCodespace, blacklistedAddrs, nil)
 	app.BankKeeper.SetSendEnabled(ctx, true)
 
 	addr := sdk.AccAddress([]byte("addr1"))
@@ -150,6 +151,172 @@ func TestSendKeeper(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestDenomSendEnabled(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	require.Empty(t, app.BankKeeper.GetDenomSendEnabled(ctx))
+	require.True(t, app.BankKeeper.IsSendEnabledDenom(ctx, "foocoin"))
+
+	denomSendEnabled := types.DenomSendEnabledList{
+		types.NewDenomSendEnabled("foocoin", false),
+		types.NewDenomSendEnabled("barcoin", true),
+	}
+	app.BankKeeper.SetDenomSendEnabled(ctx, denomSendEnabled)
+	require.Equal(t, denomSendEnabled, app.BankKeeper.GetDenomSendEnabled(ctx))
+
+	require.False(t, app.BankKeeper.IsSendEnabledDenom(ctx, "foocoin"))
+	require.True(t, app.BankKeeper.IsSendEnabledDenom(ctx, "barcoin"))
+	require.True(t, app.BankKeeper.IsSendEnabledDenom(ctx, "bazcoin"))
+
+	require.NoError(t, app.BankKeeper.IsSendEnabledCoins(ctx, sdk.NewCoins(sdk.NewInt64Coin("barcoin", 1), sdk.NewInt64Coin("bazcoin", 1))))
+	err := app.BankKeeper.IsSendEnabledCoins(ctx, sdk.NewCoins(sdk.NewInt64Coin("barcoin", 1), sdk.NewInt64Coin("foocoin", 1)))
+	require.Error(t, err)
+	require.Equal(t, types.CodeSendDisabled, err.Code())
+
+	// denominations without an entry follow SendEnabled
+	app.BankKeeper.SetSendEnabled(ctx, false)
+	require.False(t, app.BankKeeper.IsSendEnabledDenom(ctx, "bazcoin"))
+	require.True(t, app.BankKeeper.IsSendEnabledDenom(ctx, "barcoin"))
+
+	require.Panics(t, func() {
+		app.BankKeeper.SetDenomSendEnabled(ctx, types.DenomSendEnabledList{types.NewDenomSendEnabled("foocoin", true), types.NewDenomSendEnabled("foocoin", false)})
+	})
+}
+
+type testSendRestriction struct {
+	blocked  sdk.AccAddress
+	redirect sdk.AccAddress
+}
+
+func (r testSendRestriction) RestrictSend(_ sdk.Context, fromAddr, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, sdk.Error) {
+	if fromAddr.Equals(r.blocked) {
+		return nil, sdk.ErrUnauthorized("blocked sender")
+	}
+	if r.redirect != nil {
+		return r.redirect, nil
+	}
+	return toAddr, nil
+}
+
+// testFromSendRestriction redirects the transfers of a single sender.
+type testFromSendRestriction struct {
+	from     sdk.AccAddress
+	redirect sdk.AccAddress
+}
+
+func (r testFromSendRestriction) RestrictSend(_ sdk.Context, fromAddr, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, sdk.Error) {
+	if fromAddr.Equals(r.from) {
+		return r.redirect, nil
+	}
+	return toAddr, nil
+}
+
+func TestSendRestriction(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	addr := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	addr3 := sdk.AccAddress([]byte("addr3"))
+
+	paramSpace := app.ParamsKeeper.Subspace("newspace")
+	sendKeeper := keep.NewBaseSendKeeper(app.AccountKeeper, paramSpace, types.DefaultCodespace, make(map[string]bool),
+		types.NewMultiSendRestriction(
+			testSendRestriction{blocked: addr2},
+			testSendRestriction{redirect: addr3},
+		),
+	)
+
+	sendKeeper.SetCoins(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10)))
+	sendKeeper.SetCoins(ctx, addr2, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10)))
+
+	// coins are redirected
+	require.NoError(t, sendKeeper.SendCoins(ctx, addr, addr2, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 5))))
+	require.True(t, sendKeeper.GetCoins(ctx, addr).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("foocoin", 5))))
+	require.True(t, sendKeeper.GetCoins(ctx, addr2).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10))))
+	require.True(t, sendKeeper.GetCoins(ctx, addr3).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("foocoin", 5))))
+
+	// transfers are blocked
+	require.Error(t, sendKeeper.SendCoins(ctx, addr2, addr, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 5))))
+	require.True(t, sendKeeper.GetCoins(ctx, addr2).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10))))
+
+	inputs := []types.Input{types.NewInput(addr2, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 5)))}
+	outputs := []types.Output{types.NewOutput(addr, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 5)))}
+	require.Error(t, sendKeeper.InputOutputCoins(ctx, inputs, outputs))
+	require.True(t, sendKeeper.GetCoins(ctx, addr2).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10))))
+
+	inputs = []types.Input{types.NewInput(addr, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 5)))}
+	outputs = []types.Output{types.NewOutput(addr2, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 5)))}
+	require.NoError(t, sendKeeper.InputOutputCoins(ctx, inputs, outputs))
+	require.True(t, sendKeeper.GetCoins(ctx, addr).Empty())
+	require.True(t, sendKeeper.GetCoins(ctx, addr2).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10))))
+	require.True(t, sendKeeper.GetCoins(ctx, addr3).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10))))
+
+	// the restriction is checked for every input
+	sendKeeper.SetCoins(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10)))
+	inputs = []types.Input{
+		types.NewInput(addr, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 5))),
+		types.NewInput(addr2, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 5))),
+	}
+	outputs = []types.Output{types.NewOutput(addr3, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10)))}
+	require.Error(t, sendKeeper.InputOutputCoins(ctx, inputs, outputs))
+	require.True(t, sendKeeper.GetCoins(ctx, addr).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10))))
+	require.True(t, sendKeeper.GetCoins(ctx, addr2).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10))))
+
+	// all the inputs must agree on the address an output is sent to
+	addr4 := sdk.AccAddress([]byte("addr4"))
+	sendKeeper = keep.NewBaseSendKeeper(app.AccountKeeper, paramSpace, types.DefaultCodespace, make(map[string]bool),
+		testFromSendRestriction{from: addr, redirect: addr4},
+	)
+	inputs = []types.Input{
+		types.NewInput(addr, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 5))),
+		types.NewInput(addr3, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 5))),
+	}
+	outputs = []types.Output{types.NewOutput(addr2, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10)))}
+	require.Error(t, sendKeeper.InputOutputCoins(ctx, inputs, outputs))
+
+	inputs = []types.Input{
+		types.NewInput(addr, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 5))),
+		types.NewInput(addr3, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 5))),
+	}
+	outputs = []types.Output{
+		types.NewOutput(addr2, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 5))),
+		types.NewOutput(addr2, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 5))),
+	}
+	sendKeeper = keep.NewBaseSendKeeper(app.AccountKeeper, paramSpace, types.DefaultCodespace, make(map[string]bool), nil)
+	require.NoError(t, sendKeeper.InputOutputCoins(ctx, inputs, outputs))
+	require.True(t, sendKeeper.GetCoins(ctx, addr).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("foocoin", 5))))
+	require.True(t, sendKeeper.GetCoins(ctx, addr3).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("foocoin", 5))))
+	require.True(t, sendKeeper.GetCoins(ctx, addr2).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("foocoin", 20))))
+}
+
+func TestDenomMetadata(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	_, found := app.BankKeeper.GetDenomMetadata(ctx, "uatom")
+	require.False(t, found)
+	require.Empty(t, app.BankKeeper.GetAllDenomMetadata(ctx))
+
+	atom := types.NewMetadata("The native token", "uatom", "atom",
+		types.NewDenomUnit("uatom", 0),
+		types.NewDenomUnit("atom", 6),
+	)
+	foo := types.NewMetadata("The foo token", "foo", "foo", types.NewDenomUnit("foo", 0))
+	app.BankKeeper.SetDenomMetadata(ctx, atom)
+	app.BankKeeper.SetDenomMetadata(ctx, foo)
+
+	metadata, found := app.BankKeeper.GetDenomMetadata(ctx, "uatom")
+	require.True(t, found)
+	require.Equal(t, atom, metadata)
+	require.Equal(t, []types.Metadata{foo, atom}, app.BankKeeper.GetAllDenomMetadata(ctx))
+
+	var iterated []types.Metadata
+	app.BankKeeper.IterateDenomMetadata(ctx, func(m types.Metadata) bool {
+		iterated = append(iterated, m)
+		return true
+	})
+	require.Equal(t, []types.Metadata{foo}, iterated)
+}
+
 func TestMsgSendEvents(t *testing.T) {
 	app, ctx := createTestApp(false)
 
@@ -47,6 +47,41 @@ func TestBalances(t *testing.T) {
 	require.True(t, coins.AmountOf("foo").Equal(sdk.NewInt(10)))
 }
 
+func TestQueryDenomMetadata(t *testing.T) {
+	app, ctx := createTestApp(false)
+	querier := keep.NewQuerier(app.BankKeeper)
+
+	metadata := types.NewMetadata("The native token", "uatom", "atom",
+		types.NewDenomUnit("uatom", 0, "microatom"),
+		types.NewDenomUnit("atom", 6),
+	)
+	app.BankKeeper.SetDenomMetadata(ctx, metadata)
+
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/bank/%s", types.QueryDenomMetadata),
+		Data: app.Codec().MustMarshalJSON(types.NewQueryDenomMetadataParams("uatom")),
+	}
+	res, err := querier(ctx, []string{types.QueryDenomMetadata}, req)
+	require.NoError(t, err)
+
+	var resMetadata types.Metadata
+	require.NoError(t, app.Codec().UnmarshalJSON(res, &resMetadata))
+	require.Equal(t, metadata, resMetadata)
+
+	req.Data = app.Codec().MustMarshalJSON(types.NewQueryDenomMetadataParams("atom"))
+	_, err = querier(ctx, []string{types.QueryDenomMetadata}, req)
+	require.Error(t, err)
+	require.Equal(t, types.CodeUnknownDenomMetadata, err.Code())
+
+	req = abci.RequestQuery{Path: fmt.Sprintf("custom/bank/%s", types.QueryAllDenomMetadata)}
+	res, err = querier(ctx, []string{types.QueryAllDenomMetadata}, req)
+	require.NoError(t, err)
+
+	var allMetadata []types.Metadata
+	require.NoError(t, app.Codec().UnmarshalJSON(res, &allMetadata))
+	require.Equal(t, []types.Metadata{metadata}, allMetadata)
+}
+
 func TestQuerierRouteNotFound(t *testing.T) {
 	app, ctx := createTestApp(false)
 	req := abci.RequestQuery{
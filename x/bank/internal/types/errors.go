@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -10,6 +12,8 @@ const (
 
 	CodeSendDisabled         sdk.CodeType = 101
 	CodeInvalidInputsOutputs sdk.CodeType = 102
+	CodeUnknownDenomMetadata sdk.CodeType = 103
+	CodeInvalidVesting       sdk.CodeType = 104
 )
 
 // ErrNoInputs is an error
@@ -31,3 +35,23 @@ func ErrInputOutputMismatch(codespace sdk.CodespaceType) sdk.Error {
 func ErrSendDisabled(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeSendDisabled, "send transactions are currently disabled")
 }
+
+// ErrDenomSendDisabled is an error
+func ErrDenomSendDisabled(codespace sdk.CodespaceType, denom string) sdk.Error {
+	return sdk.NewError(codespace, CodeSendDisabled, fmt.Sprintf("%s transfers are currently disabled", denom))
+}
+
+// ErrUnknownDenomMetadata is an error
+func ErrUnknownDenomMetadata(codespace sdk.CodespaceType, denom string) sdk.Error {
+	return sdk.NewError(codespace, CodeUnknownDenomMetadata, fmt.Sprintf("no metadata for denom %s", denom))
+}
+
+// ErrInvalidVestingSchedule is an error
+func ErrInvalidVestingSchedule(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidVesting, fmt.Sprintf("invalid vesting schedule: %s", msg))
+}
+
+// ErrAccountExists is an error
+func ErrAccountExists(codespace sdk.CodespaceType, addr sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidVesting, fmt.Sprintf("account %s already exists", addr))
+}
@@ -16,3 +16,11 @@ type AccountKeeper interface {
 
 	IterateAccounts(ctx sdk.Context, process func(exported.Account) bool)
 }
+
+// SendRestriction defines a restriction on the transfers of coins which is
+// checked by the bank keeper for every SendCoins and InputOutputCoins. It
+// returns the address the coins must be sent to, which allows transfers to be
+// redirected, or an error if the transfer is not allowed.
+type SendRestriction interface {
+	RestrictSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, sdk.Error)
+}
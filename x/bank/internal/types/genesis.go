@@ -2,17 +2,31 @@ package types
 
 // GenesisState is the bank state that must be provided at genesis.
 type GenesisState struct {
-	SendEnabled bool `json:"send_enabled" yaml:"send_enabled"`
+	SendEnabled      bool                 `json:"send_enabled" yaml:"send_enabled"`
+	DenomSendEnabled DenomSendEnabledList `json:"denom_send_enabled" yaml:"denom_send_enabled"`
+	DenomMetadata    []Metadata           `json:"denom_metadata" yaml:"denom_metadata"`
 }
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(sendEnabled bool) GenesisState {
-	return GenesisState{SendEnabled: sendEnabled}
+func NewGenesisState(sendEnabled bool, denomSendEnabled DenomSendEnabledList, denomMetadata []Metadata) GenesisState {
+	return GenesisState{
+		SendEnabled:      sendEnabled,
+		DenomSendEnabled: denomSendEnabled,
+		DenomMetadata:    denomMetadata,
+	}
 }
 
 // DefaultGenesisState returns a default genesis state
-func DefaultGenesisState() GenesisState { return NewGenesisState(true) }
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(true, DenomSendEnabledList{}, []Metadata{})
+}
 
 // ValidateGenesis performs basic validation of bank genesis data returning an
 // error for any failed validation criteria.
-func ValidateGenesis(data GenesisState) error { return nil }
+func ValidateGenesis(data GenesisState) error {
+	if err := data.DenomSendEnabled.Validate(); err != nil {
+		return err
+	}
+
+	return ValidateMetadataList(data.DenomMetadata)
+}
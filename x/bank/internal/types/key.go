@@ -4,4 +4,20 @@ const (
 	// module name
 	ModuleName   = "bank"
 	QuerierRoute = ModuleName
+
+	// StoreKey is the store key string for bank
+	StoreKey = ModuleName
+)
+
+// Keys for bank store
+// Items are stored with the following key: values
+//
+// - 0x00<denom_Bytes>: Metadata
+var (
+	DenomMetadataPrefix = []byte{0x00}
 )
+
+// DenomMetadataKey gets the key of the metadata of a base denom
+func DenomMetadataKey(denom string) []byte {
+	return append(DenomMetadataPrefix, []byte(denom)...)
+}
@@ -0,0 +1,214 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DenomUnit represents a unit of a denomination, worth 10^Exponent base
+// units. It can be referred to by its denom or any of its aliases.
+type DenomUnit struct {
+	Denom    string   `json:"denom" yaml:"denom"`
+	Exponent uint32   `json:"exponent" yaml:"exponent"`
+	Aliases  []string `json:"aliases" yaml:"aliases"`
+}
+
+// NewDenomUnit creates a new DenomUnit instance
+func NewDenomUnit(denom string, exponent uint32, aliases ...string) DenomUnit {
+	return DenomUnit{
+		Denom:    denom,
+		Exponent: exponent,
+		Aliases:  aliases,
+	}
+}
+
+// String implements the Stringer interface.
+func (du DenomUnit) String() string {
+	if len(du.Aliases) == 0 {
+		return fmt.Sprintf("%s (10^%d)", du.Denom, du.Exponent)
+	}
+	return fmt.Sprintf("%s (10^%d, aliases: %s)", du.Denom, du.Exponent, strings.Join(du.Aliases, ", "))
+}
+
+// Metadata describes a denomination and the units it can be displayed in.
+// Base is the denomination coins are held and transferred in, and Display
+// the unit clients should display amounts in.
+type Metadata struct {
+	Description string      `json:"description" yaml:"description"`
+	DenomUnits  []DenomUnit `json:"denom_units" yaml:"denom_units"`
+	Base        string      `json:"base" yaml:"base"`
+	Display     string      `json:"display" yaml:"display"`
+}
+
+// NewMetadata creates a new Metadata instance
+func NewMetadata(description, base, display string, denomUnits ...DenomUnit) Metadata {
+	return Metadata{
+		Description: description,
+		DenomUnits:  denomUnits,
+		Base:        base,
+		Display:     display,
+	}
+}
+
+// String implements the Stringer interface.
+func (m Metadata) String() string {
+	units := make([]string, len(m.DenomUnits))
+	for i, du := range m.DenomUnits {
+		units[i] = du.String()
+	}
+
+	return fmt.Sprintf(`Metadata:
+  Description: %s
+  Base:        %s
+  Display:     %s
+  Denom Units: %s`,
+		m.Description, m.Base, m.Display, strings.Join(units, ", "),
+	)
+}
+
+// Validate checks that the base unit is the first unit with a zero exponent,
+// that the exponents of the units are strictly increasing and at most
+// sdk.Precision, that the denoms and aliases of the units are valid and
+// unique, and that the display unit is one of the units.
+func (m Metadata) Validate() error {
+	if err := sdk.ValidateDenom(m.Base); err != nil {
+		return fmt.Errorf("invalid base denom: %s", err)
+	}
+	if len(m.DenomUnits) == 0 || m.DenomUnits[0].Denom != m.Base || m.DenomUnits[0].Exponent != 0 {
+		return fmt.Errorf("the first denom unit of %s must be the base denom with exponent 0", m.Base)
+	}
+
+	seen := make(map[string]bool)
+	for i, du := range m.DenomUnits {
+		if i > 0 && du.Exponent <= m.DenomUnits[i-1].Exponent {
+			return fmt.Errorf("the denom units of %s must be sorted by increasing exponent", m.Base)
+		}
+		if du.Exponent > sdk.Precision {
+			return fmt.Errorf("the exponent of denom unit %s must not exceed %d", du.Denom, sdk.Precision)
+		}
+
+		for _, denom := range append([]string{du.Denom}, du.Aliases...) {
+			if err := sdk.ValidateDenom(denom); err != nil {
+				return fmt.Errorf("invalid denom unit: %s", err)
+			}
+			if seen[denom] {
+				return fmt.Errorf("duplicate denom unit %s", denom)
+			}
+			seen[denom] = true
+		}
+	}
+
+	if _, ok := m.DenomUnit(m.Display); !ok {
+		return fmt.Errorf("display denom %s is not a denom unit of %s", m.Display, m.Base)
+	}
+
+	return nil
+}
+
+// ValidateMetadataList validates the metadata of several denominations and
+// checks that every denom and alias refers to a unit of a single denomination.
+func ValidateMetadataList(metadata []Metadata) error {
+	seen := make(map[string]string)
+	for _, m := range metadata {
+		if err := m.Validate(); err != nil {
+			return err
+		}
+
+		for _, du := range m.DenomUnits {
+			for _, denom := range append([]string{du.Denom}, du.Aliases...) {
+				if base, ok := seen[denom]; ok {
+					return fmt.Errorf("denom unit %s of %s is already a denom unit of %s", denom, m.Base, base)
+				}
+				seen[denom] = m.Base
+			}
+		}
+	}
+
+	return nil
+}
+
+// DenomUnit returns the unit with the given denom or alias.
+func (m Metadata) DenomUnit(denom string) (DenomUnit, bool) {
+	for _, du := range m.DenomUnits {
+		if du.Denom == denom {
+			return du, true
+		}
+		for _, alias := range du.Aliases {
+			if alias == denom {
+				return du, true
+			}
+		}
+	}
+	return DenomUnit{}, false
+}
+
+// ConvertToBase converts an amount expressed in one of the units of the
+// denomination to base units. It returns an error if the unit is unknown or
+// the amount is not a whole number of base units.
+func (m Metadata) ConvertToBase(coin sdk.DecCoin) (sdk.Coin, error) {
+	du, ok := m.DenomUnit(coin.Denom)
+	if !ok {
+		return sdk.Coin{}, fmt.Errorf("%s is not a denom unit of %s", coin.Denom, m.Base)
+	}
+
+	amount := coin.Amount.Mul(sdk.NewDecFromInt(sdk.NewIntWithDecimal(1, int(du.Exponent))))
+	if !amount.IsInteger() {
+		return sdk.Coin{}, fmt.Errorf("%s is not a whole number of %s", coin, m.Base)
+	}
+
+	return sdk.NewCoin(m.Base, amount.TruncateInt()), nil
+}
+
+// ConvertToDisplay converts an amount of base units to the display unit of
+// the denomination.
+func (m Metadata) ConvertToDisplay(coin sdk.Coin) (sdk.DecCoin, error) {
+	if coin.Denom != m.Base {
+		return sdk.DecCoin{}, fmt.Errorf("%s is not the base denom %s", coin.Denom, m.Base)
+	}
+
+	du, ok := m.DenomUnit(m.Display)
+	if !ok {
+		return sdk.DecCoin{}, fmt.Errorf("display denom %s is not a denom unit of %s", m.Display, m.Base)
+	}
+
+	amount := sdk.NewDecFromIntWithPrec(coin.Amount, int64(du.Exponent))
+	return sdk.NewDecCoinFromDec(du.Denom, amount), nil
+}
+
+// ConvertCoinsToBase converts amounts expressed in any unit of the given
+// denominations to base units. Amounts of denominations without metadata
+// must already be whole numbers of base units.
+func ConvertCoinsToBase(metadata []Metadata, coins sdk.DecCoins) (sdk.Coins, error) {
+	var converted sdk.Coins
+	for _, coin := range coins {
+		c, err := convertCoinToBase(metadata, coin)
+		if err != nil {
+			return nil, err
+		}
+		converted = converted.Add(sdk.NewCoins(c))
+	}
+	return converted, nil
+}
+
+func convertCoinToBase(metadata []Metadata, coin sdk.DecCoin) (sdk.Coin, error) {
+	var found []Metadata
+	for _, m := range metadata {
+		if _, ok := m.DenomUnit(coin.Denom); ok {
+			found = append(found, m)
+		}
+	}
+
+	switch {
+	case len(found) == 1:
+		return found[0].ConvertToBase(coin)
+	case len(found) > 1:
+		return sdk.Coin{}, fmt.Errorf("%s is a denom unit of more than one denomination", coin.Denom)
+	}
+
+	if !coin.Amount.IsInteger() {
+		return sdk.Coin{}, fmt.Errorf("no metadata to convert %s to a base denom", coin)
+	}
+	return sdk.NewCoin(coin.Denom, coin.Amount.TruncateInt()), nil
+}
@@ -0,0 +1,107 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var testMetadata = NewMetadata("The native token", "uatom", "atom",
+	NewDenomUnit("uatom", 0, "microatom"),
+	NewDenomUnit("matom", 3, "milliatom"),
+	NewDenomUnit("atom", 6),
+)
+
+func TestMetadataValidate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		metadata  Metadata
+		expectErr bool
+	}{
+		{"valid", testMetadata, false},
+		{"base only", NewMetadata("", "foo", "foo", NewDenomUnit("foo", 0)), false},
+		{"invalid base", NewMetadata("", "F", "F", NewDenomUnit("F", 0)), true},
+		{"no denom units", NewMetadata("", "foo", "foo"), true},
+		{"base not first", NewMetadata("", "foo", "foo", NewDenomUnit("bar", 0), NewDenomUnit("foo", 1)), true},
+		{"base with exponent", NewMetadata("", "foo", "foo", NewDenomUnit("foo", 1)), true},
+		{"unsorted exponents", NewMetadata("", "foo", "foo", NewDenomUnit("foo", 0), NewDenomUnit("kfoo", 3), NewDenomUnit("mfoo", 3)), true},
+		{"exponent too large", NewMetadata("", "foo", "foo", NewDenomUnit("foo", 0), NewDenomUnit("kfoo", 19)), true},
+		{"duplicate alias", NewMetadata("", "foo", "foo", NewDenomUnit("foo", 0), NewDenomUnit("kfoo", 3, "foo")), true},
+		{"invalid alias", NewMetadata("", "foo", "foo", NewDenomUnit("foo", 0, "F")), true},
+		{"unknown display", NewMetadata("", "foo", "bar", NewDenomUnit("foo", 0)), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expectErr, tc.metadata.Validate() != nil)
+		})
+	}
+}
+
+func TestMetadataConvert(t *testing.T) {
+	coin, err := testMetadata.ConvertToBase(sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(15, 1)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("uatom", 1500000), coin)
+
+	coin, err = testMetadata.ConvertToBase(sdk.NewDecCoinFromDec("milliatom", sdk.NewDec(2)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("uatom", 2000), coin)
+
+	_, err = testMetadata.ConvertToBase(sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(1, 7)))
+	require.Error(t, err)
+	_, err = testMetadata.ConvertToBase(sdk.NewDecCoinFromDec("foo", sdk.NewDec(1)))
+	require.Error(t, err)
+
+	decCoin, err := testMetadata.ConvertToDisplay(sdk.NewInt64Coin("uatom", 1500000))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(15, 1)), decCoin)
+
+	_, err = testMetadata.ConvertToDisplay(sdk.NewInt64Coin("atom", 1))
+	require.Error(t, err)
+}
+
+func TestConvertCoinsToBase(t *testing.T) {
+	decCoins := sdk.DecCoins{
+		sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(15, 1)),
+		sdk.NewDecCoin("foo", sdk.NewInt(10)),
+		sdk.NewDecCoin("microatom", sdk.NewInt(5)),
+	}
+
+	coins, err := ConvertCoinsToBase([]Metadata{testMetadata}, decCoins)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("foo", 10), sdk.NewInt64Coin("uatom", 1500005)), coins)
+
+	decCoins = sdk.DecCoins{sdk.NewDecCoinFromDec("foo", sdk.NewDecWithPrec(15, 1))}
+	_, err = ConvertCoinsToBase([]Metadata{testMetadata}, decCoins)
+	require.Error(t, err)
+	// units of more than one denomination are ambiguous
+	other := NewMetadata("Another token", "uother", "uother", NewDenomUnit("uother", 0), NewDenomUnit("atom", 3))
+	decCoins = sdk.DecCoins{sdk.NewDecCoin("atom", sdk.NewInt(1))}
+	_, err = ConvertCoinsToBase([]Metadata{testMetadata, other}, decCoins)
+	require.Error(t, err)
+}
+
+func TestValidateGenesisDenomMetadata(t *testing.T) {
+	require.NoError(t, ValidateGenesis(NewGenesisState(true, nil, []Metadata{testMetadata})))
+	require.Error(t, ValidateGenesis(NewGenesisState(true, nil, []Metadata{testMetadata, testMetadata})))
+	require.Error(t, ValidateGenesis(NewGenesisState(true, nil, []Metadata{NewMetadata("", "foo", "foo")})))
+
+	testCases := []struct {
+		name  string
+		other Metadata
+	}{
+		{"same denom unit", NewMetadata("", "foo", "foo", NewDenomUnit("foo", 0), NewDenomUnit("atom", 3))},
+		{"alias of a denom unit", NewMetadata("", "foo", "foo", NewDenomUnit("foo", 0), NewDenomUnit("kfoo", 3, "matom"))},
+		{"same alias", NewMetadata("", "foo", "foo", NewDenomUnit("foo", 0, "milliatom"))},
+		{"base of another denom", NewMetadata("", "foo", "foo", NewDenomUnit("foo", 0), NewDenomUnit("uatom", 3))},
+		{"alias of another base", NewMetadata("", "foo", "foo", NewDenomUnit("foo", 0, "uatom"))},
+	}
+
+	for _, tc := range testCases {
+		metadata := []Metadata{testMetadata, tc.other}
+		require.Error(t, ValidateGenesis(NewGenesisState(true, nil, metadata)), tc.name)
+	}
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 // RouterKey is they name of the bank module
@@ -101,6 +102,99 @@ func (msg MsgMultiSend) GetSigners() []sdk.AccAddress {
 	return addrs
 }
 
+// MsgCreateVestingAccount - transaction creating a new vesting account funded
+// by the sender. The account vests continuously from the block time until
+// EndTime, all at once at EndTime if Delayed is set, or according to Periods,
+// which start at the block time, if any are given.
+type MsgCreateVestingAccount struct {
+	FromAddress sdk.AccAddress    `json:"from_address" yaml:"from_address"`
+	ToAddress   sdk.AccAddress    `json:"to_address" yaml:"to_address"`
+	Amount      sdk.Coins         `json:"amount" yaml:"amount"`
+	EndTime     int64             `json:"end_time" yaml:"end_time"`
+	Delayed     bool              `json:"delayed" yaml:"delayed"`
+	Periods     authtypes.Periods `json:"periods" yaml:"periods"`
+}
+
+var _ sdk.Msg = MsgCreateVestingAccount{}
+
+// NewMsgCreateVestingAccount - construct a msg creating a continuous or delayed
+// vesting account.
+func NewMsgCreateVestingAccount(fromAddr, toAddr sdk.AccAddress, amount sdk.Coins,
+	endTime int64, delayed bool) MsgCreateVestingAccount {
+
+	return MsgCreateVestingAccount{
+		FromAddress: fromAddr,
+		ToAddress:   toAddr,
+		Amount:      amount,
+		EndTime:     endTime,
+		Delayed:     delayed,
+	}
+}
+
+// NewMsgCreatePeriodicVestingAccount - construct a msg creating a periodic
+// vesting account which vests the sum of the periods' amounts.
+func NewMsgCreatePeriodicVestingAccount(fromAddr, toAddr sdk.AccAddress,
+	periods authtypes.Periods) MsgCreateVestingAccount {
+
+	return MsgCreateVestingAccount{
+		FromAddress: fromAddr,
+		ToAddress:   toAddr,
+		Amount:      periods.TotalAmount(),
+		Periods:     periods,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgCreateVestingAccount) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgCreateVestingAccount) Type() string { return "create_vesting_account" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreateVestingAccount) ValidateBasic() sdk.Error {
+	if msg.FromAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+	if msg.ToAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing recipient address")
+	}
+	if !msg.Amount.IsValid() {
+		return sdk.ErrInvalidCoins("vesting amount is invalid: " + msg.Amount.String())
+	}
+	if !msg.Amount.IsAllPositive() {
+		return sdk.ErrInsufficientCoins("vesting amount must be positive")
+	}
+
+	if len(msg.Periods) == 0 {
+		if msg.EndTime <= 0 {
+			return ErrInvalidVestingSchedule(DefaultCodespace, "end time must be positive")
+		}
+		return nil
+	}
+
+	if msg.EndTime != 0 || msg.Delayed {
+		return ErrInvalidVestingSchedule(DefaultCodespace, "end time and delayed cannot be set with vesting periods")
+	}
+	if err := msg.Periods.Validate(); err != nil {
+		return ErrInvalidVestingSchedule(DefaultCodespace, err.Error())
+	}
+	total := msg.Periods.TotalAmount()
+	if !total.IsAllGTE(msg.Amount) || !msg.Amount.IsAllGTE(total) {
+		return ErrInvalidVestingSchedule(DefaultCodespace, "vesting amount must equal the sum of the vesting periods")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgCreateVestingAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCreateVestingAccount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
 // Input models transaction input
 type Input struct {
 	Address sdk.AccAddress `json:"address" yaml:"address"`
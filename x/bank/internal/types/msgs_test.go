@@ -2,11 +2,13 @@ package types
 
 import (
 	"fmt"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 func TestMsgSendRoute(t *testing.T) {
@@ -69,6 +71,60 @@ func TestMsgSendGetSigners(t *testing.T) {
 	require.Equal(t, fmt.Sprintf("%v", res), "[696E70757431]")
 }
 
+func TestMsgCreateVestingAccountValidation(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("from"))
+	addr2 := sdk.AccAddress([]byte("to"))
+	atom100 := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+	atom0 := sdk.NewCoins(sdk.NewInt64Coin("atom", 0))
+	periods := authtypes.Periods{
+		authtypes.NewPeriod(3600, sdk.NewCoins(sdk.NewInt64Coin("atom", 40))),
+		authtypes.NewPeriod(7200, sdk.NewCoins(sdk.NewInt64Coin("atom", 60))),
+	}
+
+	var emptyAddr sdk.AccAddress
+
+	withAmount := NewMsgCreatePeriodicVestingAccount(addr1, addr2, periods)
+	withAmount.Amount = sdk.NewCoins(sdk.NewInt64Coin("atom", 90))
+	withEndTime := NewMsgCreatePeriodicVestingAccount(addr1, addr2, periods)
+	withEndTime.EndTime = 1000
+	overflowing := NewMsgCreatePeriodicVestingAccount(addr1, addr2, authtypes.Periods{
+		authtypes.NewPeriod(math.MaxInt64, sdk.NewCoins(sdk.NewInt64Coin("atom", 40))),
+		authtypes.NewPeriod(math.MaxInt64, sdk.NewCoins(sdk.NewInt64Coin("atom", 60))),
+	})
+	negative := NewMsgCreatePeriodicVestingAccount(addr1, addr2, authtypes.Periods{
+		authtypes.NewPeriod(3600, sdk.NewCoins(sdk.NewInt64Coin("atom", 40))),
+		authtypes.NewPeriod(-1800, sdk.NewCoins(sdk.NewInt64Coin("atom", 60))),
+	})
+
+	cases := []struct {
+		valid bool
+		tx    MsgCreateVestingAccount
+	}{
+		{true, NewMsgCreateVestingAccount(addr1, addr2, atom100, 1000, false)},                                        // valid continuous
+		{true, NewMsgCreateVestingAccount(addr1, addr2, atom100, 1000, true)},                                         // valid delayed
+		{true, NewMsgCreatePeriodicVestingAccount(addr1, addr2, periods)},                                             // valid periodic
+		{false, NewMsgCreateVestingAccount(addr1, addr2, atom0, 1000, false)},                                         // non positive coin
+		{false, NewMsgCreateVestingAccount(emptyAddr, addr2, atom100, 1000, false)},                                   // empty from addr
+		{false, NewMsgCreateVestingAccount(addr1, emptyAddr, atom100, 1000, false)},                                   // empty to addr
+		{false, NewMsgCreateVestingAccount(addr1, addr2, atom100, 0, false)},                                          // no end time
+		{false, NewMsgCreatePeriodicVestingAccount(addr1, addr2, periods[:0])},                                        // no amount
+		{false, NewMsgCreatePeriodicVestingAccount(addr1, addr2, authtypes.Periods{authtypes.NewPeriod(0, atom100)})}, // zero length period
+		{false, withAmount},  // amount does not match periods
+		{false, withEndTime}, // end time with periods
+		{false, overflowing}, // total length overflows
+		{false, negative},    // negative length period
+	}
+
+	for i, tc := range cases {
+		err := tc.tx.ValidateBasic()
+		if tc.valid {
+			require.Nil(t, err, "test %d", i)
+		} else {
+			require.NotNil(t, err, "test %d", i)
+		}
+	}
+}
+
 func TestMsgMultiSendRoute(t *testing.T) {
 	// Construct a MsgSend
 	addr1 := sdk.AccAddress([]byte("input"))
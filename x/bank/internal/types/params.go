@@ -1,6 +1,10 @@
 package types
 
 import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
@@ -11,12 +15,99 @@ const (
 	DefaultSendEnabled = true
 )
 
-// ParamStoreKeySendEnabled is store's key for SendEnabled
-var ParamStoreKeySendEnabled = []byte("sendenabled")
+// Parameter store keys
+var (
+	// ParamStoreKeySendEnabled is store's key for SendEnabled
+	ParamStoreKeySendEnabled = []byte("sendenabled")
+	// ParamStoreKeyDenomSendEnabled is store's key for the DenomSendEnabled list
+	ParamStoreKeyDenomSendEnabled = []byte("denomsendenabled")
+)
+
+// DenomSendEnabled overrides the SendEnabled parameter for the transfers of a
+// single denomination.
+type DenomSendEnabled struct {
+	Denom   string `json:"denom" yaml:"denom"`
+	Enabled bool   `json:"enabled" yaml:"enabled"`
+}
+
+// NewDenomSendEnabled creates a new DenomSendEnabled instance
+func NewDenomSendEnabled(denom string, enabled bool) DenomSendEnabled {
+	return DenomSendEnabled{
+		Denom:   denom,
+		Enabled: enabled,
+	}
+}
+
+// String implements the Stringer interface.
+func (dse DenomSendEnabled) String() string {
+	return fmt.Sprintf("%s: %t", dse.Denom, dse.Enabled)
+}
+
+// DenomSendEnabledList is the list of the denominations whose transfers are
+// enabled or disabled regardless of the SendEnabled parameter.
+type DenomSendEnabledList []DenomSendEnabled
+
+// IsSendEnabled returns whether the transfers of a denomination are enabled,
+// and whether the list has an entry for this denomination.
+func (l DenomSendEnabledList) IsSendEnabled(denom string) (enabled, found bool) {
+	for _, dse := range l {
+		if dse.Denom == denom {
+			return dse.Enabled, true
+		}
+	}
+	return false, false
+}
+
+// String implements the Stringer interface.
+func (l DenomSendEnabledList) String() string {
+	if len(l) == 0 {
+		return "[]"
+	}
+
+	out := make([]string, len(l))
+	for i, dse := range l {
+		out[i] = dse.String()
+	}
+	return strings.Join(out, "\n")
+}
+
+// Validate checks that the denominations of the list are valid and unique.
+func (l DenomSendEnabledList) Validate() error {
+	seen := make(map[string]bool, len(l))
+	for _, dse := range l {
+		if err := sdk.ValidateDenom(dse.Denom); err != nil {
+			return err
+		}
+		if seen[dse.Denom] {
+			return fmt.Errorf("duplicate send enabled entry for denom %s", dse.Denom)
+		}
+		seen[dse.Denom] = true
+	}
+	return nil
+}
 
 // ParamKeyTable type declaration for parameters
 func ParamKeyTable() params.KeyTable {
 	return params.NewKeyTable(
-		ParamStoreKeySendEnabled, false,
+		params.NewParamSetPair(ParamStoreKeySendEnabled, false, validateSendEnabled),
+		params.NewParamSetPair(ParamStoreKeyDenomSendEnabled, DenomSendEnabledList{}, validateDenomSendEnabled),
 	)
 }
+
+func validateSendEnabled(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateDenomSendEnabled(i interface{}) error {
+	v, ok := i.(DenomSendEnabledList)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return v.Validate()
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDenomSendEnabledList(t *testing.T) {
+	l := DenomSendEnabledList{NewDenomSendEnabled("foocoin", false), NewDenomSendEnabled("barcoin", true)}
+	require.NoError(t, l.Validate())
+
+	enabled, found := l.IsSendEnabled("foocoin")
+	require.True(t, found)
+	require.False(t, enabled)
+
+	enabled, found = l.IsSendEnabled("barcoin")
+	require.True(t, found)
+	require.True(t, enabled)
+
+	_, found = l.IsSendEnabled("bazcoin")
+	require.False(t, found)
+
+	require.Error(t, append(l, NewDenomSendEnabled("foocoin", true)).Validate())
+	require.Error(t, DenomSendEnabledList{NewDenomSendEnabled("", true)}.Validate())
+	require.Error(t, DenomSendEnabledList{NewDenomSendEnabled("FOO", true)}.Validate())
+
+	require.NoError(t, ValidateGenesis(DefaultGenesisState()))
+	require.Error(t, ValidateGenesis(NewGenesisState(true, append(l, NewDenomSendEnabled("foocoin", true)), nil)))
+}
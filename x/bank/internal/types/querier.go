@@ -4,6 +4,12 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// query endpoints supported by the bank querier
+const (
+	QueryDenomMetadata    = "denom_metadata"
+	QueryAllDenomMetadata = "all_denom_metadata"
+)
+
 // QueryBalanceParams defines the params for querying an account balance.
 type QueryBalanceParams struct {
 	Address sdk.AccAddress
@@ -13,3 +19,14 @@ type QueryBalanceParams struct {
 func NewQueryBalanceParams(addr sdk.AccAddress) QueryBalanceParams {
 	return QueryBalanceParams{Address: addr}
 }
+
+// QueryDenomMetadataParams defines the params for querying the metadata of a
+// base denom.
+type QueryDenomMetadataParams struct {
+	Denom string
+}
+
+// NewQueryDenomMetadataParams creates a new instance of QueryDenomMetadataParams.
+func NewQueryDenomMetadataParams(denom string) QueryDenomMetadataParams {
+	return QueryDenomMetadataParams{Denom: denom}
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ SendRestriction = MultiSendRestriction{}
+
+// MultiSendRestriction combines multiple send restrictions, which are checked
+// in sequence. Every restriction is given the address returned by the previous
+// one, and the first error aborts the transfer.
+type MultiSendRestriction []SendRestriction
+
+// NewMultiSendRestriction creates a new MultiSendRestriction instance
+func NewMultiSendRestriction(restrictions ...SendRestriction) MultiSendRestriction {
+	return restrictions
+}
+
+// RestrictSend implements the SendRestriction interface
+func (r MultiSendRestriction) RestrictSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, sdk.Error) {
+	for i := range r {
+		var err sdk.Error
+		toAddr, err = r[i].RestrictSend(ctx, fromAddr, toAddr, amt)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return toAddr, nil
+}
@@ -7,13 +7,16 @@ import (
 	"math/rand"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/bank/internal/types"
 )
 
 // Simulation parameter constants
 const (
-	SendEnabled = "send_enabled"
+	SendEnabled      = "send_enabled"
+	DenomSendEnabled = "denom_send_enabled"
+	DenomMetadata    = "denom_metadata"
 )
 
 // GenSendEnabled randomized SendEnabled
@@ -21,6 +24,26 @@ func GenSendEnabled(r *rand.Rand) bool {
 	return r.Int63n(2) == 0
 }
 
+// GenDenomSendEnabled randomized DenomSendEnabled
+func GenDenomSendEnabled(r *rand.Rand) types.DenomSendEnabledList {
+	if r.Int63n(2) == 0 {
+		return types.DenomSendEnabledList{}
+	}
+	return types.DenomSendEnabledList{types.NewDenomSendEnabled(sdk.DefaultBondDenom, r.Int63n(2) == 0)}
+}
+
+// GenDenomMetadata randomized DenomMetadata
+func GenDenomMetadata(r *rand.Rand) []types.Metadata {
+	display := "display" + sdk.DefaultBondDenom
+	return []types.Metadata{
+		types.NewMetadata(
+			"The staking token of the simulation", sdk.DefaultBondDenom, display,
+			types.NewDenomUnit(sdk.DefaultBondDenom, 0),
+			types.NewDenomUnit(display, uint32(1+r.Intn(sdk.Precision))),
+		),
+	}
+}
+
 // RandomizedGenState generates a random GenesisState for bank
 func RandomizedGenState(simState *module.SimulationState) {
 	var sendEnabled bool
@@ -29,7 +52,19 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { sendEnabled = GenSendEnabled(r) },
 	)
 
-	bankGenesis := types.NewGenesisState(sendEnabled)
+	var denomSendEnabled types.DenomSendEnabledList
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DenomSendEnabled, &denomSendEnabled, simState.Rand,
+		func(r *rand.Rand) { denomSendEnabled = GenDenomSendEnabled(r) },
+	)
+
+	var denomMetadata []types.Metadata
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DenomMetadata, &denomMetadata, simState.Rand,
+		func(r *rand.Rand) { denomMetadata = GenDenomMetadata(r) },
+	)
+
+	bankGenesis := types.NewGenesisState(sendEnabled, denomSendEnabled, denomMetadata)
 
 	fmt.Printf("Selected randomly generated bank parameters:\n%s\n", codec.MustMarshalJSONIndent(simState.Cdc, bankGenesis))
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(bankGenesis)
@@ -10,7 +10,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 )
 
-const keySendEnabled = "sendenabled"
+const (
+	keySendEnabled      = "sendenabled"
+	keyDenomSendEnabled = "denomsendenabled"
+)
 
 // ParamChanges defines the parameters that can be modified by param change proposals
 // on the simulation
@@ -21,5 +24,10 @@ func ParamChanges(r *rand.Rand) []simulation.ParamChange {
 				return fmt.Sprintf("%v", GenSendEnabled(r))
 			},
 		),
+		simulation.NewSimParamChange(types.ModuleName, keyDenomSendEnabled, "",
+			func(r *rand.Rand) string {
+				return string(types.ModuleCdc.MustMarshalJSON(GenDenomSendEnabled(r)))
+			},
+		),
 	}
 }
@@ -16,5 +16,6 @@ type (
 		Amount      sdk.Coins      `json:"amount" yaml:"amount"`
 		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
 		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+		Class       string         `json:"class" yaml:"class"`
 	}
 )
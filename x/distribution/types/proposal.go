@@ -13,8 +13,8 @@ const (
 	ProposalTypeCommunityPoolSpend = "CommunityPoolSpend"
 )
 
-// Assert CommunityPoolSpendProposal implements govtypes.Content at compile-time
-var _ govtypes.Content = CommunityPoolSpendProposal{}
+// Assert CommunityPoolSpendProposal implements govtypes.AmountContent at compile-time
+var _ govtypes.AmountContent = CommunityPoolSpendProposal{}
 
 func init() {
 	govtypes.RegisterProposalType(ProposalTypeCommunityPoolSpend)
@@ -46,6 +46,9 @@ func (csp CommunityPoolSpendProposal) ProposalRoute() string { return RouterKey
 // ProposalType returns the type of a community pool spend proposal.
 func (csp CommunityPoolSpendProposal) ProposalType() string { return ProposalTypeCommunityPoolSpend }
 
+// GetAmount returns the amount spent by a community pool spend proposal.
+func (csp CommunityPoolSpendProposal) GetAmount() sdk.Coins { return csp.Amount }
+
 // ValidateBasic runs basic stateless validity checks
 func (csp CommunityPoolSpendProposal) ValidateBasic() sdk.Error {
 	err := govtypes.ValidateAbstract(DefaultCodespace, csp)
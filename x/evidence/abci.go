@@ -0,0 +1,26 @@
+package evidence
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlocker submits the evidence of infractions reported by Tendermint, so
+// that the validators which committed them are punished by the Handler
+// registered for their evidence type.
+func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k Keeper) {
+	for _, tmEvidence := range req.ByzantineValidators {
+		evidence, ok := ConvertDuplicateVoteEvidence(tmEvidence)
+		if !ok {
+			k.Logger(ctx).Error(fmt.Sprintf("ignored unknown evidence type: %s", tmEvidence.Type))
+			continue
+		}
+
+		if err := k.SubmitEvidence(ctx, evidence); err != nil {
+			k.Logger(ctx).Info(fmt.Sprintf("ignored evidence %s: %s", evidence.Hash(), err.Result().Log))
+		}
+	}
+}
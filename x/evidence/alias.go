@@ -0,0 +1,75 @@
+// nolint
+// autogenerated code using github.com/rigelrozanski/multitool
+// aliases generated for the following subdirectories:
+// ALIASGEN: github.com/cosmos/cosmos-sdk/x/evidence/exported
+// ALIASGEN: github.com/cosmos/cosmos-sdk/x/evidence/internal/keeper
+// ALIASGEN: github.com/cosmos/cosmos-sdk/x/evidence/internal/types
+package evidence
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+	"github.com/cosmos/cosmos-sdk/x/evidence/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/evidence/internal/types"
+)
+
+const (
+	DefaultCodespace            = types.DefaultCodespace
+	CodeNoEvidenceHandlerExists = types.CodeNoEvidenceHandlerExists
+	CodeInvalidEvidence         = types.CodeInvalidEvidence
+	CodeNoEvidenceExists        = types.CodeNoEvidenceExists
+	CodeEvidenceExists          = types.CodeEvidenceExists
+	EventTypeSubmitEvidence     = types.EventTypeSubmitEvidence
+	AttributeValueCategory      = types.AttributeValueCategory
+	AttributeKeyEvidenceHash    = types.AttributeKeyEvidenceHash
+	RouteEquivocation           = types.RouteEquivocation
+	TypeEquivocation            = types.TypeEquivocation
+	ModuleName                  = types.ModuleName
+	StoreKey                    = types.StoreKey
+	RouterKey                   = types.RouterKey
+	QuerierRoute                = types.QuerierRoute
+	TypeMsgSubmitEvidence       = types.TypeMsgSubmitEvidence
+	QueryEvidence               = types.QueryEvidence
+	QueryAllEvidence            = types.QueryAllEvidence
+)
+
+var (
+	// functions aliases
+	NewKeeper                    = keeper.NewKeeper
+	NewQuerier                   = keeper.NewQuerier
+	RegisterCodec                = types.RegisterCodec
+	RegisterEvidenceTypeCodec    = types.RegisterEvidenceTypeCodec
+	ErrNoEvidenceHandlerExists   = types.ErrNoEvidenceHandlerExists
+	ErrInvalidEvidence           = types.ErrInvalidEvidence
+	ErrNoEvidenceExists          = types.ErrNoEvidenceExists
+	ErrEvidenceExists            = types.ErrEvidenceExists
+	ConvertDuplicateVoteEvidence = types.ConvertDuplicateVoteEvidence
+	NewGenesisState              = types.NewGenesisState
+	DefaultGenesisState          = types.DefaultGenesisState
+	ValidateGenesis              = types.ValidateGenesis
+	GetEvidenceKey               = types.GetEvidenceKey
+	NewMsgSubmitEvidence         = types.NewMsgSubmitEvidence
+	NewQueryEvidenceParams       = types.NewQueryEvidenceParams
+	NewQueryAllEvidenceParams    = types.NewQueryAllEvidenceParams
+	NewRouter                    = types.NewRouter
+
+	// variable aliases
+	ModuleCdc             = types.ModuleCdc
+	DoubleSignJailEndTime = types.DoubleSignJailEndTime
+	KeyPrefixEvidence     = types.KeyPrefixEvidence
+)
+
+type (
+	Evidence               = exported.Evidence
+	ValidatorEvidence      = exported.ValidatorEvidence
+	Keeper                 = keeper.Keeper
+	Equivocation           = types.Equivocation
+	EvidenceList           = types.EvidenceList
+	GenesisState           = types.GenesisState
+	MsgSubmitEvidence      = types.MsgSubmitEvidence
+	QueryEvidenceParams    = types.QueryEvidenceParams
+	QueryAllEvidenceParams = types.QueryAllEvidenceParams
+	Handler                = types.Handler
+	Router                 = types.Router
+	StakingKeeper          = types.StakingKeeper
+	SlashingKeeper         = types.SlashingKeeper
+)
@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+	"github.com/cosmos/cosmos-sdk/x/evidence/internal/types"
+)
+
+// flags of the evidence query command
+const (
+	flagPage  = "page"
+	flagLimit = "limit"
+)
+
+// GetQueryCmd returns the cli query command for the evidence module, which
+// queries an evidence by hash or all the evidence.
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("%s [hash]", types.ModuleName),
+		Short: "Query for evidence by hash or for all (paginated) submitted evidence",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for specific submitted evidence by hash or query for all (paginated) evidence:
+
+Example:
+$ %s query %s DF0C23E8634E480F84B9D5674A7CDC9816466DEC28A3358F73260F68D28D7660
+$ %s query %s --page=2 --limit=50
+`, version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			if len(args) == 1 {
+				return queryEvidence(cdc, cliCtx, args[0])
+			}
+
+			return queryAllEvidence(cdc, cliCtx)
+		},
+	}
+
+	cmd.Flags().Int(flagPage, 1, "pagination page of evidence to to query for")
+	cmd.Flags().Int(flagLimit, 100, "pagination limit of evidence to query for")
+
+	return client.GetCommands(cmd)[0]
+}
+
+func queryEvidence(cdc *codec.Codec, cliCtx context.CLIContext, hash string) error {
+	params := types.NewQueryEvidenceParams(hash)
+	bz, err := cdc.MarshalJSON(params)
+	if err != nil {
+		return err
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryEvidence)
+	res, _, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return err
+	}
+
+	var evidence exported.Evidence
+	if err := cdc.UnmarshalJSON(res, &evidence); err != nil {
+		return err
+	}
+
+	return cliCtx.PrintOutput(evidence)
+}
+
+func queryAllEvidence(cdc *codec.Codec, cliCtx context.CLIContext) error {
+	params := types.NewQueryAllEvidenceParams(viper.GetInt(flagPage), viper.GetInt(flagLimit))
+	bz, err := cdc.MarshalJSON(params)
+	if err != nil {
+		return err
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAllEvidence)
+	res, _, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return err
+	}
+
+	var evidence types.EvidenceList
+	if err := cdc.UnmarshalJSON(res, &evidence); err != nil {
+		return err
+	}
+
+	return cliCtx.PrintOutput(evidence)
+}
@@ -0,0 +1,82 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+	"github.com/cosmos/cosmos-sdk/x/evidence/internal/types"
+)
+
+// GetTxCmd returns the transaction commands for the evidence module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	evidenceTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Evidence transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	evidenceTxCmd.AddCommand(client.PostCommands(
+		GetCmdSubmitEvidence(cdc),
+	)...)
+
+	return evidenceTxCmd
+}
+
+// GetCmdSubmitEvidence returns the command to submit evidence of misbehaviour
+func GetCmdSubmitEvidence(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "submit [submitter_key_or_address] [evidence-file]",
+		Short: "Submit evidence of misbehaviour",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit evidence of misbehaviour of any type registered by the application. The
+evidence is read from a JSON file, in which it is encoded with its type, e.g.:
+
+{
+  "type": "my-module/MyEvidence",
+  "value": {
+    ...
+  }
+}
+
+Example:
+$ %s tx %s submit mykey evidence.json
+`, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithFrom(args[0]).WithCodec(cdc)
+
+			bz, err := ioutil.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			var evidence exported.Evidence
+			if err := cdc.UnmarshalJSON(bz, &evidence); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSubmitEvidence(evidence, cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
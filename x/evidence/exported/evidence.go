@@ -0,0 +1,36 @@
+package exported
+
+import (
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Evidence defines the contract which concrete evidence types of misbehaviour
+// must implement. Evidence is routed by its Route to the Handler registered
+// for it, which verifies it and punishes the misbehaviour.
+type Evidence interface {
+	Route() string
+	Type() string
+	String() string
+	Hash() cmn.HexBytes
+	ValidateBasic() sdk.Error
+
+	// Height at which the infraction occurred
+	GetHeight() int64
+}
+
+// ValidatorEvidence extends Evidence with the validator which committed the
+// infraction.
+type ValidatorEvidence interface {
+	Evidence
+
+	// The consensus address of the malicious validator at time of infraction
+	GetConsensusAddress() sdk.ConsAddress
+
+	// The total power of the malicious validator at time of infraction
+	GetValidatorPower() int64
+
+	// The total validator set power at time of infraction
+	GetTotalPower() int64
+}
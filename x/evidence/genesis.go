@@ -0,0 +1,24 @@
+package evidence
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis stores the evidence of the genesis state. It panics if the
+// genesis state is invalid.
+func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
+	if err := ValidateGenesis(data); err != nil {
+		panic(fmt.Sprintf("failed to validate %s genesis state: %s", ModuleName, err))
+	}
+
+	for _, e := range data.Evidence {
+		k.SetEvidence(ctx, e)
+	}
+}
+
+// ExportGenesis returns a GenesisState with all the stored evidence.
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	return NewGenesisState(k.GetAllEvidence(ctx))
+}
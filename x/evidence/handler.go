@@ -0,0 +1,61 @@
+package evidence
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewHandler returns a handler for evidence messages.
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case MsgSubmitEvidence:
+			return handleMsgSubmitEvidence(ctx, k, msg)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized evidence message type: %T", msg)
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgSubmitEvidence(ctx sdk.Context, k Keeper, msg MsgSubmitEvidence) sdk.Result {
+	// equivocations are only trusted when they are reported by Tendermint
+	if msg.Evidence.Route() == RouteEquivocation {
+		return ErrInvalidEvidence(k.Codespace(), "equivocation evidence can only be submitted by Tendermint").Result()
+	}
+
+	if err := k.SubmitEvidence(ctx, msg.Evidence); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Submitter.String()),
+		),
+	)
+
+	return sdk.Result{
+		Data:   msg.Evidence.Hash(),
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// NewEquivocationHandler returns the Handler of Equivocation evidence, which
+// slashes, jails and tombstones the validator which double signed.
+func NewEquivocationHandler(k Keeper) Handler {
+	return func(ctx sdk.Context, evidence Evidence) sdk.Error {
+		switch e := evidence.(type) {
+		case Equivocation:
+			return k.HandleDoubleSign(ctx, e)
+
+		default:
+			return ErrInvalidEvidence(k.Codespace(), fmt.Sprintf("unrecognized equivocation evidence type: %T", evidence))
+		}
+	}
+}
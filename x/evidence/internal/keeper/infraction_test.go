@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence/internal/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+// Test that a validator is slashed correctly
+// when we discover evidence of infraction
+func TestHandleDoubleSign(t *testing.T) {
+	app, ctx := createTestApp()
+	ctx = ctx.WithBlockHeight(1)
+	power := int64(100)
+	operatorAddr, val := createValidator(t, app, ctx, power)
+	require.Equal(t, sdk.TokensFromConsensusPower(power), app.StakingKeeper.Validator(ctx, operatorAddr).GetBondedTokens())
+
+	oldTokens := app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens()
+
+	// double sign less than max age
+	evidence := types.Equivocation{
+		Height:           1,
+		Time:             time.Unix(0, 0),
+		Power:            power,
+		ConsensusAddress: sdk.ConsAddress(val.Address()),
+	}
+	ctx = ctx.WithBlockHeight(2)
+	require.NoError(t, app.EvidenceKeeper.HandleDoubleSign(ctx, evidence))
+
+	// should be jailed and tombstoned
+	require.True(t, app.StakingKeeper.Validator(ctx, operatorAddr).IsJailed())
+	require.True(t, app.SlashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(val.Address())))
+
+	// tokens should be decreased
+	newTokens := app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens()
+	require.True(t, newTokens.LT(oldTokens))
+
+	// new evidence is ignored
+	evidence.Time = time.Unix(1, 0)
+	require.Error(t, app.EvidenceKeeper.HandleDoubleSign(ctx, evidence))
+
+	// tokens should be the same (capped slash)
+	require.True(t, app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens().Equal(newTokens))
+
+	// jump to past the unbonding period
+	ctx = ctx.WithBlockHeader(abci.Header{Height: 3, Time: time.Unix(1, 0).Add(app.StakingKeeper.GetParams(ctx).UnbondingTime)})
+
+	// still shouldn't be able to unjail
+	require.Error(t, app.SlashingKeeper.Unjail(ctx, operatorAddr))
+
+	// should be able to unbond now
+	del, _ := app.StakingKeeper.GetDelegation(ctx, sdk.AccAddress(operatorAddr), operatorAddr)
+	validator, _ := app.StakingKeeper.GetValidator(ctx, operatorAddr)
+
+	totalBond := validator.TokensFromShares(del.GetShares()).TruncateInt()
+	msgUnbond := staking.NewMsgUndelegate(
+		sdk.AccAddress(operatorAddr), operatorAddr, sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), totalBond),
+	)
+	res := staking.NewHandler(app.StakingKeeper)(ctx, msgUnbond)
+	require.True(t, res.IsOK())
+}
+
+func TestHandleDoubleSign_TooOld(t *testing.T) {
+	app, ctx := createTestApp()
+	// validator added pre-genesis
+	ctx = ctx.WithBlockHeight(-1)
+	power := int64(100)
+	operatorAddr, val := createValidator(t, app, ctx, power)
+
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(1, 0).Add(app.SlashingKeeper.MaxEvidenceAge(ctx))})
+	oldPower := app.StakingKeeper.Validator(ctx, operatorAddr).GetConsensusPower()
+
+	// double sign past max age
+	evidence := types.Equivocation{
+		Height:           0,
+		Time:             time.Unix(0, 0),
+		Power:            power,
+		ConsensusAddress: sdk.ConsAddress(val.Address()),
+	}
+	require.Error(t, app.EvidenceKeeper.HandleDoubleSign(ctx, evidence))
+
+	// should still be bonded with the same power
+	require.True(t, app.StakingKeeper.Validator(ctx, operatorAddr).IsBonded())
+	require.Equal(t, oldPower, app.StakingKeeper.Validator(ctx, operatorAddr).GetConsensusPower())
+	require.False(t, app.SlashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(val.Address())))
+}
+
+func TestHandleDoubleSign_UnknownValidator(t *testing.T) {
+	app, ctx := createTestApp()
+
+	evidence := types.Equivocation{
+		Height:           1,
+		Time:             time.Unix(0, 0),
+		Power:            100,
+		ConsensusAddress: sdk.ConsAddress([]byte("unknown validator")),
+	}
+	require.Error(t, app.EvidenceKeeper.HandleDoubleSign(ctx, evidence))
+}
@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	"fmt"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+	"github.com/cosmos/cosmos-sdk/x/evidence/internal/types"
+)
+
+// Keeper defines the evidence module's keeper. It stores the submitted evidence
+// and routes it to the Handler registered for its type.
+type Keeper struct {
+	cdc            *codec.Codec
+	storeKey       sdk.StoreKey
+	router         types.Router
+	stakingKeeper  types.StakingKeeper
+	slashingKeeper types.SlashingKeeper
+	codespace      sdk.CodespaceType
+}
+
+// NewKeeper creates a new evidence Keeper. The Router must be set with
+// SetRouter before the Keeper can handle evidence.
+func NewKeeper(
+	cdc *codec.Codec, storeKey sdk.StoreKey, stakingKeeper types.StakingKeeper,
+	slashingKeeper types.SlashingKeeper, codespace sdk.CodespaceType,
+) *Keeper {
+
+	return &Keeper{
+		cdc:            cdc,
+		storeKey:       storeKey,
+		stakingKeeper:  stakingKeeper,
+		slashingKeeper: slashingKeeper,
+		codespace:      codespace,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// Codespace returns the evidence keeper's codespace.
+func (k Keeper) Codespace() sdk.CodespaceType {
+	return k.codespace
+}
+
+// SetRouter sets the Evidence Handler router for the x/evidence module. Note,
+// we allow the ability to set the router after the Keeper is constructed as a
+// given Handler may need access the Keeper before being registered. The router
+// is sealed and the router can only be set once.
+func (k *Keeper) SetRouter(rtr types.Router) {
+	// It is vital to seal the Evidence Handler router as to not allow further
+	// handlers to be registered after the keeper is created since this
+	// could create invalid or non-deterministic behavior.
+	rtr.Seal()
+	k.router = rtr
+}
+
+// GetEvidenceHandler returns the Handler registered for a given Evidence route.
+func (k Keeper) GetEvidenceHandler(evidenceRoute string) (types.Handler, sdk.Error) {
+	if k.router == nil || !k.router.HasRoute(evidenceRoute) {
+		return nil, types.ErrNoEvidenceHandlerExists(k.codespace, evidenceRoute)
+	}
+
+	return k.router.GetRoute(evidenceRoute), nil
+}
+
+// SubmitEvidence attempts to match evidence against the keepers router and
+// execute the corresponding registered Evidence Handler. An error is returned
+// if no registered Handler exists, if the Evidence has already been submitted
+// or if the Handler fails. Otherwise, the evidence is persisted.
+func (k Keeper) SubmitEvidence(ctx sdk.Context, evidence exported.Evidence) sdk.Error {
+	if _, ok := k.GetEvidence(ctx, evidence.Hash()); ok {
+		return types.ErrEvidenceExists(k.codespace, evidence.Hash().String())
+	}
+
+	handler, err := k.GetEvidenceHandler(evidence.Route())
+	if err != nil {
+		return err
+	}
+
+	if err := handler(ctx, evidence); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSubmitEvidence,
+			sdk.NewAttribute(types.AttributeKeyEvidenceHash, evidence.Hash().String()),
+		),
+	)
+
+	k.SetEvidence(ctx, evidence)
+	return nil
+}
+
+// SetEvidence sets Evidence by hash in the module's KVStore.
+func (k Keeper) SetEvidence(ctx sdk.Context, evidence exported.Evidence) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(evidence)
+	store.Set(types.GetEvidenceKey(evidence.Hash()), bz)
+}
+
+// GetEvidence retrieves Evidence by hash if it exists. If no Evidence exists for
+// the given hash, (nil, false) is returned.
+func (k Keeper) GetEvidence(ctx sdk.Context, hash cmn.HexBytes) (evidence exported.Evidence, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetEvidenceKey(hash))
+	if bz == nil {
+		return nil, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &evidence)
+	return evidence, true
+}
+
+// IterateEvidence provides an interator over all stored Evidence objects. For
+// each Evidence object, cb will be called. If the cb returns true, the iterator
+// will close and stop.
+func (k Keeper) IterateEvidence(ctx sdk.Context, cb func(exported.Evidence) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixEvidence)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var evidence exported.Evidence
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &evidence)
+
+		if cb(evidence) {
+			break
+		}
+	}
+}
+
+// GetAllEvidence returns all stored Evidence objects.
+func (k Keeper) GetAllEvidence(ctx sdk.Context) []exported.Evidence {
+	evidence := []exported.Evidence{}
+	k.IterateEvidence(ctx, func(e exported.Evidence) bool {
+		evidence = append(evidence, e)
+		return false
+	})
+	return evidence
+}
@@ -0,0 +1,148 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+	"github.com/cosmos/cosmos-sdk/x/evidence/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/evidence/internal/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+const testEvidenceRoute = "test"
+
+// testEvidence is an evidence type which is valid unless it is marked invalid
+type testEvidence struct {
+	Height  int64 `json:"height"`
+	Invalid bool  `json:"invalid"`
+}
+
+var _ exported.Evidence = testEvidence{}
+
+func (e testEvidence) Route() string  { return testEvidenceRoute }
+func (e testEvidence) Type() string   { return "test" }
+func (e testEvidence) String() string { return fmt.Sprintf("test evidence at height %d", e.Height) }
+func (e testEvidence) GetHeight() int64 {
+	return e.Height
+}
+func (e testEvidence) Hash() cmn.HexBytes {
+	return tmhash.Sum([]byte(e.String()))
+}
+func (e testEvidence) ValidateBasic() sdk.Error {
+	if e.Height < 1 {
+		return types.ErrInvalidEvidence(types.DefaultCodespace, "invalid height")
+	}
+	return nil
+}
+
+func testEvidenceHandler(ctx sdk.Context, evidence exported.Evidence) sdk.Error {
+	if evidence.(testEvidence).Invalid {
+		return types.ErrInvalidEvidence(types.DefaultCodespace, "invalid test evidence")
+	}
+	return nil
+}
+
+func createTestApp() (*simapp.SimApp, sdk.Context) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	return app, ctx
+}
+
+// createTestKeeper returns a keeper using the evidence store of the app, with
+// a router and a codec supporting the test evidence.
+func createTestKeeper(app *simapp.SimApp) *keeper.Keeper {
+	cdc := codec.New()
+	types.RegisterCodec(cdc)
+	cdc.RegisterConcrete(testEvidence{}, "test/Evidence", nil)
+
+	k := keeper.NewKeeper(
+		cdc, app.GetKey(types.StoreKey), app.StakingKeeper, app.SlashingKeeper, types.DefaultCodespace,
+	)
+	k.SetRouter(types.NewRouter().AddRoute(testEvidenceRoute, testEvidenceHandler))
+	return k
+}
+
+// createValidator creates and bonds a validator with the given power
+func createValidator(t *testing.T, app *simapp.SimApp, ctx sdk.Context, power int64) (sdk.ValAddress, crypto.PubKey) {
+	pubKey := ed25519.GenPrivKey().PubKey()
+	amt := sdk.TokensFromConsensusPower(power)
+	addr := simapp.AddTestAddrs(app, ctx, 1, amt)[0]
+	valAddr := sdk.ValAddress(addr)
+
+	commission := staking.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	msg := staking.NewMsgCreateValidator(
+		valAddr, pubKey, sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), amt),
+		staking.Description{}, commission, sdk.OneInt(),
+	)
+	res := staking.NewHandler(app.StakingKeeper)(ctx, msg)
+	require.True(t, res.IsOK(), res.Log)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	return valAddr, pubKey
+}
+
+func TestSubmitEvidence(t *testing.T) {
+	app, ctx := createTestApp()
+	k := createTestKeeper(app)
+
+	evidence := testEvidence{Height: 1}
+	require.NoError(t, k.SubmitEvidence(ctx, evidence))
+
+	stored, ok := k.GetEvidence(ctx, evidence.Hash())
+	require.True(t, ok)
+	require.Equal(t, evidence, stored)
+
+	// evidence can only be submitted once
+	err := k.SubmitEvidence(ctx, evidence)
+	require.Error(t, err)
+	require.Equal(t, types.CodeEvidenceExists, err.Code())
+
+	// evidence rejected by its handler is not stored
+	invalid := testEvidence{Height: 2, Invalid: true}
+	require.Error(t, k.SubmitEvidence(ctx, invalid))
+	_, ok = k.GetEvidence(ctx, invalid.Hash())
+	require.False(t, ok)
+}
+
+func TestSubmitEvidenceNoHandler(t *testing.T) {
+	app, ctx := createTestApp()
+	k := createTestKeeper(app)
+
+	evidence := types.Equivocation{Height: 1}
+	err := k.SubmitEvidence(ctx, evidence)
+	require.Error(t, err)
+	require.Equal(t, types.CodeNoEvidenceHandlerExists, err.Code())
+
+	_, ok := k.GetEvidence(ctx, evidence.Hash())
+	require.False(t, ok)
+}
+
+func TestIterateEvidence(t *testing.T) {
+	app, ctx := createTestApp()
+	k := createTestKeeper(app)
+
+	for i := int64(1); i <= 5; i++ {
+		require.NoError(t, k.SubmitEvidence(ctx, testEvidence{Height: i}))
+	}
+
+	require.Len(t, k.GetAllEvidence(ctx), 5)
+
+	var count int
+	k.IterateEvidence(ctx, func(exported.Evidence) bool {
+		count++
+		return count == 3
+	})
+	require.Equal(t, 3, count)
+}
@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/x/evidence/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/evidence/internal/types"
+)
+
+func TestQuerier(t *testing.T) {
+	app, ctx := createTestApp()
+	k := createTestKeeper(app)
+	cdc := app.Codec()
+	cdc.RegisterConcrete(testEvidence{}, "test/Evidence", nil)
+	querier := keeper.NewQuerier(*k)
+
+	_, err := querier(ctx, []string{"foo"}, abci.RequestQuery{})
+	require.Error(t, err)
+
+	for i := int64(1); i <= 5; i++ {
+		require.NoError(t, k.SubmitEvidence(ctx, testEvidence{Height: i}))
+	}
+	evidence := testEvidence{Height: 3}
+
+	// evidence by hash
+	bz := cdc.MustMarshalJSON(types.NewQueryEvidenceParams(evidence.Hash().String()))
+	res, err := querier(ctx, []string{types.QueryEvidence}, abci.RequestQuery{Data: bz})
+	require.NoError(t, err)
+
+	var resEvidence testEvidence
+	require.NoError(t, cdc.UnmarshalJSON(res, &resEvidence))
+	require.Equal(t, evidence, resEvidence)
+
+	bz = cdc.MustMarshalJSON(types.NewQueryEvidenceParams(testEvidence{Height: 6}.Hash().String()))
+	_, err = querier(ctx, []string{types.QueryEvidence}, abci.RequestQuery{Data: bz})
+	require.Error(t, err)
+
+	bz = cdc.MustMarshalJSON(types.NewQueryEvidenceParams("invalid"))
+	_, err = querier(ctx, []string{types.QueryEvidence}, abci.RequestQuery{Data: bz})
+	require.Error(t, err)
+
+	// paginated evidence
+	bz = cdc.MustMarshalJSON(types.NewQueryAllEvidenceParams(1, 3))
+	res, err = querier(ctx, []string{types.QueryAllEvidence}, abci.RequestQuery{Data: bz})
+	require.NoError(t, err)
+
+	var list types.EvidenceList
+	require.NoError(t, cdc.UnmarshalJSON(res, &list))
+	require.Len(t, list, 3)
+
+	bz = cdc.MustMarshalJSON(types.NewQueryAllEvidenceParams(2, 3))
+	res, err = querier(ctx, []string{types.QueryAllEvidence}, abci.RequestQuery{Data: bz})
+	require.NoError(t, err)
+	require.NoError(t, cdc.UnmarshalJSON(res, &list))
+	require.Len(t, list, 2)
+}
@@ -0,0 +1,39 @@
+// nolint
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DONTCOVER
+
+// Local code type
+type CodeType = sdk.CodeType
+
+const (
+	// Default evidence codespace
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeNoEvidenceHandlerExists CodeType = 101
+	CodeInvalidEvidence         CodeType = 102
+	CodeNoEvidenceExists        CodeType = 103
+	CodeEvidenceExists          CodeType = 104
+)
+
+func ErrNoEvidenceHandlerExists(codespace sdk.CodespaceType, route string) sdk.Error {
+	return sdk.NewError(codespace, CodeNoEvidenceHandlerExists, fmt.Sprintf("route '%s' does not have a registered handler", route))
+}
+
+func ErrInvalidEvidence(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidEvidence, fmt.Sprintf("invalid evidence: %s", msg))
+}
+
+func ErrNoEvidenceExists(codespace sdk.CodespaceType, hash string) sdk.Error {
+	return sdk.NewError(codespace, CodeNoEvidenceExists, fmt.Sprintf("evidence with hash %s does not exist", hash))
+}
+
+func ErrEvidenceExists(codespace sdk.CodespaceType, hash string) sdk.Error {
+	return sdk.NewError(codespace, CodeEvidenceExists, fmt.Sprintf("evidence with hash %s already exists", hash))
+}
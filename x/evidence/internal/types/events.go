@@ -0,0 +1,9 @@
+package types
+
+// evidence module event types
+const (
+	EventTypeSubmitEvidence = "submit_evidence"
+
+	AttributeValueCategory   = ModuleName
+	AttributeKeyEvidenceHash = "evidence_hash"
+)
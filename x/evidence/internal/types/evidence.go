@@ -0,0 +1,125 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+)
+
+// Evidence type constants
+const (
+	RouteEquivocation = "equivocation"
+	TypeEquivocation  = "equivocation"
+)
+
+// DoubleSignJailEndTime is the time until which a validator is jailed for an
+// Equivocation, so that it can never be unjailed.
+var DoubleSignJailEndTime = time.Unix(253402300799, 0)
+
+var _ exported.ValidatorEvidence = Equivocation{}
+
+// Equivocation implements the ValidatorEvidence interface for a validator which
+// signed two conflicting votes at the same height, as reported by Tendermint.
+type Equivocation struct {
+	Height           int64           `json:"height" yaml:"height"`
+	Time             time.Time       `json:"time" yaml:"time"`
+	Power            int64           `json:"power" yaml:"power"`
+	ConsensusAddress sdk.ConsAddress `json:"consensus_address" yaml:"consensus_address"`
+}
+
+// Route returns the Evidence Handler route for an Equivocation type.
+func (e Equivocation) Route() string { return RouteEquivocation }
+
+// Type returns the Evidence Handler type for an Equivocation type.
+func (e Equivocation) Type() string { return TypeEquivocation }
+
+func (e Equivocation) String() string {
+	return fmt.Sprintf(`Equivocation:
+  Height:            %d
+  Time:              %s
+  Power:             %d
+  Consensus Address: %s`, e.Height, e.Time, e.Power, e.ConsensusAddress,
+	)
+}
+
+// Hash returns the hash of an Equivocation object.
+func (e Equivocation) Hash() cmn.HexBytes {
+	return tmhash.Sum(ModuleCdc.MustMarshalBinaryBare(e))
+}
+
+// ValidateBasic performs basic stateless validation checks on an Equivocation object.
+func (e Equivocation) ValidateBasic() sdk.Error {
+	if e.Time.IsZero() {
+		return ErrInvalidEvidence(DefaultCodespace, "invalid equivocation time")
+	}
+	if e.Height < 1 {
+		return ErrInvalidEvidence(DefaultCodespace, fmt.Sprintf("invalid equivocation height: %d", e.Height))
+	}
+	if e.Power < 1 {
+		return ErrInvalidEvidence(DefaultCodespace, fmt.Sprintf("invalid equivocation validator power: %d", e.Power))
+	}
+	if e.ConsensusAddress.Empty() {
+		return ErrInvalidEvidence(DefaultCodespace, "missing equivocation validator consensus address")
+	}
+
+	return nil
+}
+
+// GetConsensusAddress returns the validator's consensus address at time of the
+// Equivocation infraction.
+func (e Equivocation) GetConsensusAddress() sdk.ConsAddress {
+	return e.ConsensusAddress
+}
+
+// GetHeight returns the height at time of the Equivocation infraction.
+func (e Equivocation) GetHeight() int64 {
+	return e.Height
+}
+
+// GetTime returns the time at time of the Equivocation infraction.
+func (e Equivocation) GetTime() time.Time {
+	return e.Time
+}
+
+// GetValidatorPower returns the validator's power at time of the Equivocation
+// infraction.
+func (e Equivocation) GetValidatorPower() int64 {
+	return e.Power
+}
+
+// GetTotalPower is a no-op for the Equivocation type.
+func (e Equivocation) GetTotalPower() int64 { return 0 }
+
+// ConvertDuplicateVoteEvidence converts a Tendermint concrete Evidence type to
+// an Equivocation. It returns false if the evidence is not a duplicate vote.
+func ConvertDuplicateVoteEvidence(evidence abci.Evidence) (Equivocation, bool) {
+	if evidence.Type != tmtypes.ABCIEvidenceTypeDuplicateVote {
+		return Equivocation{}, false
+	}
+
+	return Equivocation{
+		Height:           evidence.Height,
+		Time:             evidence.Time,
+		Power:            evidence.Validator.Power,
+		ConsensusAddress: sdk.ConsAddress(evidence.Validator.Address),
+	}, true
+}
+
+// EvidenceList is a list of evidence of any type.
+type EvidenceList []exported.Evidence
+
+func (el EvidenceList) String() string {
+	strs := make([]string, len(el))
+	for i, e := range el {
+		strs[i] = e.String()
+	}
+	return strings.Join(strs, "\n")
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestEquivocationValidateBasic(t *testing.T) {
+	now := time.Now().UTC()
+	consAddr := sdk.ConsAddress("foo_________________")
+
+	testCases := []struct {
+		name      string
+		e         Equivocation
+		expectErr bool
+	}{
+		{"valid", Equivocation{Height: 100, Time: now, Power: 1000000, ConsensusAddress: consAddr}, false},
+		{"invalid time", Equivocation{Height: 100, Power: 1000000, ConsensusAddress: consAddr}, true},
+		{"invalid height", Equivocation{Height: 0, Time: now, Power: 1000000, ConsensusAddress: consAddr}, true},
+		{"invalid power", Equivocation{Height: 100, Time: now, Power: 0, ConsensusAddress: consAddr}, true},
+		{"invalid address", Equivocation{Height: 100, Time: now, Power: 1000000}, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expectErr, tc.e.ValidateBasic() != nil)
+		})
+	}
+}
+
+func TestEquivocationHash(t *testing.T) {
+	e := Equivocation{Height: 100, Time: time.Unix(0, 0), Power: 1000000, ConsensusAddress: sdk.ConsAddress("foo")}
+	require.Len(t, e.Hash(), 32)
+	require.Equal(t, e.Hash(), e.Hash())
+
+	e2 := e
+	e2.Height = 101
+	require.NotEqual(t, e.Hash(), e2.Hash())
+}
+
+func TestConvertDuplicateVoteEvidence(t *testing.T) {
+	now := time.Now().UTC()
+	tmEvidence := abci.Evidence{
+		Type:      tmtypes.ABCIEvidenceTypeDuplicateVote,
+		Validator: abci.Validator{Address: []byte("foo"), Power: 10},
+		Height:    100,
+		Time:      now,
+	}
+
+	e, ok := ConvertDuplicateVoteEvidence(tmEvidence)
+	require.True(t, ok)
+	require.Equal(t, Equivocation{Height: 100, Time: now, Power: 10, ConsensusAddress: sdk.ConsAddress("foo")}, e)
+
+	tmEvidence.Type = "unknown"
+	_, ok = ConvertDuplicateVoteEvidence(tmEvidence)
+	require.False(t, ok)
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"time"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingexported "github.com/cosmos/cosmos-sdk/x/staking/exported"
+)
+
+// StakingKeeper defines the staking module interface contract needed by the
+// evidence module.
+type StakingKeeper interface {
+	ValidatorByConsAddr(sdk.Context, sdk.ConsAddress) stakingexported.ValidatorI
+}
+
+// SlashingKeeper defines the slashing module interface contract needed by the
+// evidence module.
+type SlashingKeeper interface {
+	GetPubkey(sdk.Context, crypto.Address) (crypto.PubKey, error)
+	MaxEvidenceAge(sdk.Context) time.Duration
+	SlashFractionDoubleSign(sdk.Context) sdk.Dec
+
+	HasValidatorSigningInfo(sdk.Context, sdk.ConsAddress) bool
+	IsTombstoned(sdk.Context, sdk.ConsAddress) bool
+	Tombstone(sdk.Context, sdk.ConsAddress)
+	JailUntil(sdk.Context, sdk.ConsAddress, time.Time)
+
+	Slash(sdk.Context, sdk.ConsAddress, sdk.Dec, int64, int64)
+	Jail(sdk.Context, sdk.ConsAddress)
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+)
+
+// GenesisState defines the evidence module's genesis state.
+type GenesisState struct {
+	Evidence []exported.Evidence `json:"evidence" yaml:"evidence"`
+}
+
+// NewGenesisState creates a new genesis state for the evidence module.
+func NewGenesisState(evidence []exported.Evidence) GenesisState {
+	return GenesisState{
+		Evidence: evidence,
+	}
+}
+
+// DefaultGenesisState returns the evidence module's default genesis state.
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		Evidence: []exported.Evidence{},
+	}
+}
+
+// ValidateGenesis performs basic validation of the evidence of a genesis state.
+func ValidateGenesis(data GenesisState) error {
+	seen := make(map[string]bool)
+	for i, e := range data.Evidence {
+		if e == nil {
+			return fmt.Errorf("missing evidence at index %d", i)
+		}
+		if err := e.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid evidence %s: %s", e.Hash(), err.Result().Log)
+		}
+
+		hash := e.Hash().String()
+		if seen[hash] {
+			return fmt.Errorf("duplicate evidence %s", hash)
+		}
+		seen[hash] = true
+	}
+
+	return nil
+}
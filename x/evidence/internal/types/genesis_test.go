@@ -0,0 +1,24 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	e := Equivocation{Height: 100, Time: time.Unix(0, 0), Power: 1000000, ConsensusAddress: sdk.ConsAddress("foo")}
+	e2 := e
+	e2.Height = 101
+
+	require.NoError(t, ValidateGenesis(DefaultGenesisState()))
+	require.NoError(t, ValidateGenesis(NewGenesisState([]exported.Evidence{e, e2})))
+
+	require.Error(t, ValidateGenesis(NewGenesisState([]exported.Evidence{e, e})))
+	require.Error(t, ValidateGenesis(NewGenesisState([]exported.Evidence{nil})))
+	require.Error(t, ValidateGenesis(NewGenesisState([]exported.Evidence{Equivocation{}})))
+}
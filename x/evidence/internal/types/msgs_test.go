@@ -0,0 +1,34 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMsgSubmitEvidence(t *testing.T) {
+	submitter := sdk.AccAddress("test________________")
+	e := Equivocation{Height: 100, Time: time.Now().UTC(), Power: 1000000, ConsensusAddress: sdk.ConsAddress("foo")}
+
+	testCases := []struct {
+		msg       MsgSubmitEvidence
+		expectErr bool
+	}{
+		{NewMsgSubmitEvidence(e, submitter), false},
+		{NewMsgSubmitEvidence(nil, submitter), true},
+		{NewMsgSubmitEvidence(Equivocation{Height: 100}, submitter), true},
+		{NewMsgSubmitEvidence(e, nil), true},
+	}
+
+	for i, tc := range testCases {
+		require.Equal(t, RouterKey, tc.msg.Route(), "test %d", i)
+		require.Equal(t, TypeMsgSubmitEvidence, tc.msg.Type(), "test %d", i)
+		require.Equal(t, tc.expectErr, tc.msg.ValidateBasic() != nil, "test %d", i)
+		require.Equal(t, []sdk.AccAddress{tc.msg.Submitter}, tc.msg.GetSigners(), "test %d", i)
+	}
+
+	require.NotPanics(t, func() { NewMsgSubmitEvidence(e, submitter).GetSignBytes() })
+}
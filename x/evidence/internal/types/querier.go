@@ -0,0 +1,29 @@
+package types
+
+// Querier routes for the evidence module
+const (
+	QueryEvidence    = "evidence"
+	QueryAllEvidence = "all_evidence"
+)
+
+// QueryEvidenceParams defines the parameters necessary for querying Evidence.
+type QueryEvidenceParams struct {
+	EvidenceHash string `json:"evidence_hash" yaml:"evidence_hash"`
+}
+
+// NewQueryEvidenceParams creates a new QueryEvidenceParams
+func NewQueryEvidenceParams(hash string) QueryEvidenceParams {
+	return QueryEvidenceParams{EvidenceHash: hash}
+}
+
+// QueryAllEvidenceParams defines the parameters necessary for querying all
+// Evidence.
+type QueryAllEvidenceParams struct {
+	Page  int `json:"page" yaml:"page"`
+	Limit int `json:"limit" yaml:"limit"`
+}
+
+// NewQueryAllEvidenceParams creates a new QueryAllEvidenceParams
+func NewQueryAllEvidenceParams(page, limit int) QueryAllEvidenceParams {
+	return QueryAllEvidenceParams{Page: page, Limit: limit}
+}
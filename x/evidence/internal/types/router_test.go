@@ -0,0 +1,33 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+)
+
+func testEquivocationHandler(ctx sdk.Context, e exported.Evidence) sdk.Error {
+	return nil
+}
+
+func TestRouterSeal(t *testing.T) {
+	r := NewRouter()
+	r.Seal()
+	require.Panics(t, func() { r.AddRoute("test", nil) })
+	require.Panics(t, func() { r.Seal() })
+}
+
+func TestRouter(t *testing.T) {
+	r := NewRouter()
+	r.AddRoute(RouteEquivocation, testEquivocationHandler)
+	require.True(t, r.HasRoute(RouteEquivocation))
+	require.NotNil(t, r.GetRoute(RouteEquivocation))
+
+	require.Panics(t, func() { r.AddRoute(RouteEquivocation, testEquivocationHandler) })
+	require.Panics(t, func() { r.AddRoute("invalid/route", testEquivocationHandler) })
+	require.False(t, r.HasRoute("test"))
+	require.Panics(t, func() { r.GetRoute("test") })
+}
@@ -0,0 +1,84 @@
+// nolint
+// autogenerated code using github.com/rigelrozanski/multitool
+// aliases generated for the following subdirectories:
+// ALIASGEN: github.com/cosmos/cosmos-sdk/x/feegrant/ante
+// ALIASGEN: github.com/cosmos/cosmos-sdk/x/feegrant/exported
+// ALIASGEN: github.com/cosmos/cosmos-sdk/x/feegrant/internal/keeper
+// ALIASGEN: github.com/cosmos/cosmos-sdk/x/feegrant/internal/types
+package feegrant
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/feegrant/ante"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/exported"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/internal/types"
+)
+
+const (
+	ModuleName            = types.ModuleName
+	StoreKey              = types.StoreKey
+	RouterKey             = types.RouterKey
+	QuerierRoute          = types.QuerierRoute
+	DefaultCodespace      = types.DefaultCodespace
+	CodeFeeLimitExceeded  = types.CodeFeeLimitExceeded
+	CodeFeeLimitExpired   = types.CodeFeeLimitExpired
+	CodeInvalidPeriod     = types.CodeInvalidPeriod
+	CodeNoAllowance       = types.CodeNoAllowance
+	CodeInvalidAllowance  = types.CodeInvalidAllowance
+	CodeInvalidGrant      = types.CodeInvalidGrant
+	QueryGetFeeAllowance  = types.QueryGetFeeAllowance
+	QueryGetFeeAllowances = types.QueryGetFeeAllowances
+)
+
+var (
+	// functions aliases
+	NewAnteHandler               = ante.NewAnteHandler
+	NewDeductGrantedFeeDecorator = ante.NewDeductGrantedFeeDecorator
+	NewKeeper                    = keeper.NewKeeper
+	NewQuerier                   = keeper.NewQuerier
+	NewBasicFeeAllowance         = types.NewBasicFeeAllowance
+	NewPeriodicFeeAllowance      = types.NewPeriodicFeeAllowance
+	NewFeeAllowanceGrant         = types.NewFeeAllowanceGrant
+	RegisterCodec                = types.RegisterCodec
+	ErrFeeLimitExceeded          = types.ErrFeeLimitExceeded
+	ErrFeeLimitExpired           = types.ErrFeeLimitExpired
+	ErrInvalidPeriod             = types.ErrInvalidPeriod
+	ErrNoAllowance               = types.ErrNoAllowance
+	ErrInvalidAllowance          = types.ErrInvalidAllowance
+	ErrInvalidGrant              = types.ErrInvalidGrant
+	NewGenesisState              = types.NewGenesisState
+	DefaultGenesisState          = types.DefaultGenesisState
+	ValidateGenesis              = types.ValidateGenesis
+	FeeAllowanceKey              = types.FeeAllowanceKey
+	FeeAllowancePrefixByGrantee  = types.FeeAllowancePrefixByGrantee
+	NewMsgGrantFeeAllowance      = types.NewMsgGrantFeeAllowance
+	NewMsgRevokeFeeAllowance     = types.NewMsgRevokeFeeAllowance
+	NewQueryFeeAllowanceParams   = types.NewQueryFeeAllowanceParams
+	NewQueryFeeAllowancesParams  = types.NewQueryFeeAllowancesParams
+
+	// variable aliases
+	ModuleCdc               = types.ModuleCdc
+	FeeAllowanceKeyPrefix   = types.FeeAllowanceKeyPrefix
+	EventTypeUseFeeGrant    = types.EventTypeUseFeeGrant
+	EventTypeRevokeFeeGrant = types.EventTypeRevokeFeeGrant
+	EventTypeSetFeeGrant    = types.EventTypeSetFeeGrant
+	AttributeValueCategory  = types.AttributeValueCategory
+	AttributeKeyGranter     = types.AttributeKeyGranter
+	AttributeKeyGrantee     = types.AttributeKeyGrantee
+)
+
+type (
+	FeeAllowance              = exported.FeeAllowance
+	FeeGrantKeeper            = ante.FeeGrantKeeper
+	DeductGrantedFeeDecorator = ante.DeductGrantedFeeDecorator
+	Keeper                    = keeper.Keeper
+	BasicFeeAllowance         = types.BasicFeeAllowance
+	PeriodicFeeAllowance      = types.PeriodicFeeAllowance
+	FeeAllowanceGrant         = types.FeeAllowanceGrant
+	FeeAllowanceGrants        = types.FeeAllowanceGrants
+	GenesisState              = types.GenesisState
+	MsgGrantFeeAllowance      = types.MsgGrantFeeAllowance
+	MsgRevokeFeeAllowance     = types.MsgRevokeFeeAllowance
+	QueryFeeAllowanceParams   = types.QueryFeeAllowanceParams
+	QueryFeeAllowancesParams  = types.QueryFeeAllowancesParams
+)
@@ -0,0 +1,31 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// NewAnteHandler returns the AnteHandler of the auth module in which the fees
+// are deducted by the DeductGrantedFeeDecorator, so that they can be paid by a
+// fee granter.
+func NewAnteHandler(
+	ak authkeeper.AccountKeeper, supplyKeeper authtypes.SupplyKeeper, fk FeeGrantKeeper,
+	sigGasConsumer authante.SignatureVerificationGasConsumer,
+) sdk.AnteHandler {
+
+	return sdk.ChainAnteDecorators(
+		authante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
+		authante.NewMempoolFeeDecorator(),
+		authante.NewValidateSigCountDecorator(ak),
+		authante.NewValidateBasicDecorator(),
+		authante.NewConsumeTxSizeGasDecorator(ak),
+		authante.NewValidateMemoDecorator(ak),
+		NewDeductGrantedFeeDecorator(ak, supplyKeeper, fk),
+		authante.NewSetPubKeyDecorator(ak), // SetPubKeyDecorator must be called before all signature verification decorators
+		authante.NewSigGasConsumeDecorator(ak, sigGasConsumer),
+		authante.NewSigVerificationDecorator(ak),
+		authante.NewIncrementSequenceDecorator(ak), // innermost AnteDecorator
+	)
+}
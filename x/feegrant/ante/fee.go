@@ -0,0 +1,77 @@
+package ante
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+var (
+	_ sdk.AnteDecorator = DeductGrantedFeeDecorator{}
+)
+
+// FeeGrantKeeper defines the expected feegrant keeper
+type FeeGrantKeeper interface {
+	UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins) sdk.Error
+}
+
+// DeductGrantedFeeDecorator deducts the fees of the transaction from the fee
+// granter of the transaction if it has one, after deducting them from the
+// allowance of the first signer from the granter. Otherwise the fees are
+// deducted from the first signer. It replaces the auth DeductFeeDecorator.
+type DeductGrantedFeeDecorator struct {
+	ak           authkeeper.AccountKeeper
+	supplyKeeper authtypes.SupplyKeeper
+	fk           FeeGrantKeeper
+}
+
+// NewDeductGrantedFeeDecorator returns a new DeductGrantedFeeDecorator.
+func NewDeductGrantedFeeDecorator(ak authkeeper.AccountKeeper, supplyKeeper authtypes.SupplyKeeper, fk FeeGrantKeeper) DeductGrantedFeeDecorator {
+	return DeductGrantedFeeDecorator{
+		ak:           ak,
+		supplyKeeper: supplyKeeper,
+		fk:           fk,
+	}
+}
+
+// AnteHandle implements the sdk.AnteDecorator interface.
+func (d DeductGrantedFeeDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, sdk.Result, bool) {
+
+	if addr := d.supplyKeeper.GetModuleAddress(authtypes.FeeCollectorName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", authtypes.FeeCollectorName))
+	}
+
+	stdTx, ok := tx.(authtypes.StdTx)
+	if !ok {
+		return ctx, sdk.ErrInternal("tx must be StdTx").Result(), true
+	}
+
+	feePayer := stdTx.FeePayer()
+	deductFrom := feePayer
+
+	// the fees are paid by the granter if the fee payer has an allowance
+	if granter := stdTx.Fee.Granter; !granter.Empty() && !granter.Equals(feePayer) {
+		if err := d.fk.UseGrantedFees(ctx, granter, feePayer, stdTx.Fee.Amount); err != nil {
+			return ctx, err.Result(), true
+		}
+		deductFrom = granter
+	}
+
+	deductFromAcc, res := authante.GetSignerAcc(ctx, d.ak, deductFrom)
+	if !res.IsOK() {
+		return ctx, res, true
+	}
+
+	if !stdTx.Fee.Amount.IsZero() {
+		if res := authante.DeductFees(d.supplyKeeper, ctx, deductFromAcc, stdTx.Fee.Amount); !res.IsOK() {
+			return ctx, res, true
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
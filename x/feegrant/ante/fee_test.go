@@ -0,0 +1,75 @@
+package ante_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/ante"
+)
+
+func TestDeductGrantedFees(t *testing.T) {
+	// setup
+	app := simapp.Setup(true)
+	ctx := app.BaseApp.NewContext(true, abci.Header{Time: time.Now().UTC()})
+	app.AccountKeeper.SetParams(ctx, authtypes.DefaultParams())
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, app.FeeGrantKeeper, authante.DefaultSigVerificationGasConsumer)
+
+	// keys and addresses
+	priv1, _, addr1 := authtypes.KeyTestPubAddr()
+	_, _, addr2 := authtypes.KeyTestPubAddr()
+	_, _, addr3 := authtypes.KeyTestPubAddr()
+
+	// set the accounts, the grantee has no funds
+	acc1 := app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	app.AccountKeeper.SetAccount(ctx, acc1)
+	acc2 := app.AccountKeeper.NewAccountWithAddress(ctx, addr2)
+	acc2.SetCoins(sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)))
+	app.AccountKeeper.SetAccount(ctx, acc2)
+
+	app.FeeGrantKeeper.GrantFeeAllowance(ctx, feegrant.NewFeeAllowanceGrant(
+		addr2, addr1, feegrant.NewBasicFeeAllowance(sdk.NewCoins(sdk.NewInt64Coin("atom", 200)), time.Time{}),
+	))
+
+	msgs := []sdk.Msg{authtypes.NewTestMsg(addr1)}
+	privs, accnums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	fee := authtypes.NewTestStdFee()
+
+	// the grantee pays the fees without granter
+	tx := authtypes.NewTestTx(ctx, msgs, privs, accnums, seqs, fee)
+	_, res, abort := anteHandler(ctx, tx, false)
+	require.True(t, abort)
+	require.Equal(t, sdk.CodeInsufficientFunds, res.Code)
+
+	// the granter has no allowance for the grantee
+	fee.Granter = addr3
+	tx = authtypes.NewTestTx(ctx, msgs, privs, accnums, seqs, fee)
+	_, res, abort = anteHandler(ctx, tx, false)
+	require.True(t, abort)
+	require.Equal(t, feegrant.CodeNoAllowance, res.Code)
+
+	// the granter pays the fees
+	fee.Granter = addr2
+	tx = authtypes.NewTestTx(ctx, msgs, privs, accnums, seqs, fee)
+	_, res, abort = anteHandler(ctx, tx, false)
+	require.False(t, abort, res.Log)
+	require.True(sdk.IntEq(t, sdk.NewInt(850), app.AccountKeeper.GetAccount(ctx, addr2).GetCoins().AmountOf("atom")))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 50)),
+		app.FeeGrantKeeper.GetFeeAllowance(ctx, addr2, addr1).(*feegrant.BasicFeeAllowance).SpendLimit)
+
+	// the allowance is exceeded
+	seqs = []uint64{1}
+	tx = authtypes.NewTestTx(ctx, msgs, privs, accnums, seqs, fee)
+	_, res, abort = anteHandler(ctx, tx, false)
+	require.True(t, abort)
+	require.Equal(t, feegrant.CodeFeeLimitExceeded, res.Code)
+	require.True(sdk.IntEq(t, sdk.NewInt(850), app.AccountKeeper.GetAccount(ctx, addr2).GetCoins().AmountOf("atom")))
+}
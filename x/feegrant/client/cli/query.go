@@ -0,0 +1,122 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/internal/types"
+)
+
+// GetQueryCmd returns the cli query commands for the feegrant module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	feegrantQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the feegrant module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	feegrantQueryCmd.AddCommand(client.GetCommands(
+		GetCmdQueryFeeGrant(cdc),
+		GetCmdQueryFeeGrants(cdc),
+	)...)
+
+	return feegrantQueryCmd
+}
+
+// GetCmdQueryFeeGrant returns the command to query the fee allowance of a
+// grantee from a granter
+func GetCmdQueryFeeGrant(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "grant [granter] [grantee]",
+		Short: "Query the fee allowance of a grantee from a granter",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the fee allowance granted by a granter to a grantee.
+
+Example:
+$ %s query %s grant cosmos1skjw... cosmos1lwjm...
+`, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			granter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryFeeAllowanceParams(granter, grantee)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryGetFeeAllowance)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var grant types.FeeAllowanceGrant
+			cdc.MustUnmarshalJSON(res, &grant)
+			return cliCtx.PrintOutput(grant)
+		},
+	}
+}
+
+// GetCmdQueryFeeGrants returns the command to query all the fee allowances of
+// a grantee
+func GetCmdQueryFeeGrants(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "grants [grantee]",
+		Short: "Query all the fee allowances of a grantee",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the fee allowances granted to a grantee.
+
+Example:
+$ %s query %s grants cosmos1lwjm...
+`, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryFeeAllowancesParams(grantee)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryGetFeeAllowances)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var grants types.FeeAllowanceGrants
+			cdc.MustUnmarshalJSON(res, &grants)
+			return cliCtx.PrintOutput(grants)
+		},
+	}
+}
@@ -0,0 +1,121 @@
+package rest
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/internal/types"
+)
+
+func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc(
+		"/feegrant/grantees/{grantee}/allowances",
+		grantFeeAllowanceHandlerFn(cliCtx),
+	).Methods("POST")
+
+	r.HandleFunc(
+		"/feegrant/grantees/{grantee}/revoke",
+		revokeFeeAllowanceHandlerFn(cliCtx),
+	).Methods("POST")
+}
+
+// GrantFeeAllowanceReq defines the properties of a grant fee allowance request's
+// body. The allowance is granted by the sender of the request. The period is
+// a duration such as "24h", and the allowance is periodic if it is set.
+type GrantFeeAllowanceReq struct {
+	BaseReq          rest.BaseReq `json:"base_req" yaml:"base_req"`
+	SpendLimit       sdk.Coins    `json:"spend_limit" yaml:"spend_limit"`
+	Expiration       time.Time    `json:"expiration" yaml:"expiration"`
+	Period           string       `json:"period" yaml:"period"`
+	PeriodSpendLimit sdk.Coins    `json:"period_spend_limit" yaml:"period_spend_limit"`
+}
+
+// RevokeFeeAllowanceReq defines the properties of a revoke fee allowance
+// request's body. The allowance granted by the sender of the request is revoked.
+type RevokeFeeAllowanceReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+}
+
+func grantFeeAllowanceHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		grantee, err := sdk.AccAddressFromBech32(mux.Vars(r)["grantee"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req GrantFeeAllowanceReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		granter, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var period time.Duration
+		if req.Period != "" {
+			period, err = time.ParseDuration(req.Period)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		allowance := types.NewFeeAllowance(req.SpendLimit, req.Expiration, period, req.PeriodSpendLimit)
+		msg := types.NewMsgGrantFeeAllowance(granter, grantee, allowance)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func revokeFeeAllowanceHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		grantee, err := sdk.AccAddressFromBech32(mux.Vars(r)["grantee"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req RevokeFeeAllowanceReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		granter, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgRevokeFeeAllowance(granter, grantee)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
@@ -0,0 +1,22 @@
+package exported
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FeeAllowance defines the permission of a grantee to have its transaction
+// fees paid by a granter, with the rules deciding which fees can be paid.
+type FeeAllowance interface {
+	// Accept returns an error if the fee can not be paid with the allowance at
+	// the given block time. Otherwise it deducts the fee from the allowance,
+	// which must then be saved, and returns true if the allowance is used up
+	// and must be removed.
+	//
+	// An expired allowance returns an error and true, so that it is removed.
+	Accept(fee sdk.Coins, blockTime time.Time) (remove bool, err sdk.Error)
+
+	// ValidateBasic performs a stateless validation of the allowance.
+	ValidateBasic() sdk.Error
+}
@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/internal/types"
+)
+
+var (
+	granter  = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	granter2 = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	grantee  = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	grantee2 = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+)
+
+func createTestApp(isCheckTx bool) (*simapp.SimApp, sdk.Context) {
+	app := simapp.Setup(isCheckTx)
+	ctx := app.BaseApp.NewContext(isCheckTx, abci.Header{Time: time.Now().UTC()})
+
+	return app, ctx
+}
+
+func atoms(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin("atom", amount))
+}
+
+func TestKeeperCrud(t *testing.T) {
+	app, ctx := createTestApp(false)
+	keeper := app.FeeGrantKeeper
+
+	basic := types.NewBasicFeeAllowance(atoms(555), time.Time{})
+	basic2 := types.NewBasicFeeAllowance(atoms(334455), time.Time{})
+
+	keeper.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(granter, grantee, basic))
+	keeper.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(granter2, grantee, basic2))
+	keeper.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(granter, grantee2, basic2))
+
+	// a new grant overwrites the previous one
+	keeper.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(granter2, grantee2, basic))
+	keeper.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(granter2, grantee2, basic2))
+
+	require.Equal(t, basic, keeper.GetFeeAllowance(ctx, granter, grantee))
+	require.Equal(t, basic2, keeper.GetFeeAllowance(ctx, granter2, grantee))
+	require.Equal(t, basic2, keeper.GetFeeAllowance(ctx, granter2, grantee2))
+	require.Nil(t, keeper.GetFeeAllowance(ctx, grantee, granter))
+
+	require.NoError(t, keeper.RevokeFeeAllowance(ctx, granter2, grantee2))
+	require.Nil(t, keeper.GetFeeAllowance(ctx, granter2, grantee2))
+	require.Error(t, keeper.RevokeFeeAllowance(ctx, granter2, grantee2))
+
+	var grants []types.FeeAllowanceGrant
+	keeper.IterateAllGranteeFeeAllowances(ctx, grantee, func(grant types.FeeAllowanceGrant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+	require.Len(t, grants, 2)
+	for _, grant := range grants {
+		require.Equal(t, grantee, grant.Grantee)
+	}
+
+	grants = nil
+	keeper.IterateAllFeeAllowances(ctx, func(grant types.FeeAllowanceGrant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+	require.Len(t, grants, 3)
+}
+
+func TestUseGrantedFees(t *testing.T) {
+	app, ctx := createTestApp(false)
+	keeper := app.FeeGrantKeeper
+
+	keeper.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(granter, grantee, types.NewBasicFeeAllowance(atoms(100), time.Time{})))
+	keeper.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(granter2, grantee, types.NewBasicFeeAllowance(atoms(100), ctx.BlockTime())))
+
+	// no grant
+	err := keeper.UseGrantedFees(ctx, grantee, granter, atoms(1))
+	require.Error(t, err)
+	require.Equal(t, types.CodeNoAllowance, err.Code())
+
+	// fee above the limit
+	err = keeper.UseGrantedFees(ctx, granter, grantee, atoms(101))
+	require.Error(t, err)
+	require.Equal(t, atoms(100), keeper.GetFeeAllowance(ctx, granter, grantee).(*types.BasicFeeAllowance).SpendLimit)
+
+	// the allowance is updated
+	require.NoError(t, keeper.UseGrantedFees(ctx, granter, grantee, atoms(60)))
+	require.Equal(t, atoms(40), keeper.GetFeeAllowance(ctx, granter, grantee).(*types.BasicFeeAllowance).SpendLimit)
+
+	// the allowance is removed once spent
+	require.NoError(t, keeper.UseGrantedFees(ctx, granter, grantee, atoms(40)))
+	require.Nil(t, keeper.GetFeeAllowance(ctx, granter, grantee))
+
+	// expired allowances are removed
+	err = keeper.UseGrantedFees(ctx, granter2, grantee, atoms(1))
+	require.Error(t, err)
+	require.Equal(t, types.CodeFeeLimitExpired, err.Code())
+	require.Nil(t, keeper.GetFeeAllowance(ctx, granter2, grantee))
+}
@@ -0,0 +1,50 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	keep "github.com/cosmos/cosmos-sdk/x/feegrant/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/internal/types"
+)
+
+func TestQuerier(t *testing.T) {
+	app, ctx := createTestApp(false)
+	cdc := app.Codec()
+	querier := keep.NewQuerier(app.FeeGrantKeeper)
+
+	_, err := querier(ctx, []string{"foo"}, abci.RequestQuery{})
+	require.Error(t, err)
+
+	grant := types.NewFeeAllowanceGrant(granter, grantee, types.NewBasicFeeAllowance(atoms(555), time.Time{}))
+	app.FeeGrantKeeper.GrantFeeAllowance(ctx, grant)
+	app.FeeGrantKeeper.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(granter2, grantee2, types.NewBasicFeeAllowance(atoms(1), time.Time{})))
+
+	// single grant
+	bz := cdc.MustMarshalJSON(types.NewQueryFeeAllowanceParams(granter, grantee))
+	res, err := querier(ctx, []string{types.QueryGetFeeAllowance}, abci.RequestQuery{Data: bz})
+	require.NoError(t, err)
+
+	var resGrant types.FeeAllowanceGrant
+	cdc.MustUnmarshalJSON(res, &resGrant)
+	require.Equal(t, grant, resGrant)
+
+	bz = cdc.MustMarshalJSON(types.NewQueryFeeAllowanceParams(granter2, grantee))
+	_, err = querier(ctx, []string{types.QueryGetFeeAllowance}, abci.RequestQuery{Data: bz})
+	require.Error(t, err)
+
+	// grants of a grantee
+	bz = cdc.MustMarshalJSON(types.NewQueryFeeAllowancesParams(grantee))
+	res, err = querier(ctx, []string{types.QueryGetFeeAllowances}, abci.RequestQuery{Data: bz})
+	require.NoError(t, err)
+
+	var grants []types.FeeAllowanceGrant
+	cdc.MustUnmarshalJSON(res, &grants)
+	require.Equal(t, []types.FeeAllowanceGrant{grant}, grants)
+
+	_, err = querier(ctx, []string{types.QueryGetFeeAllowances}, abci.RequestQuery{Data: []byte("?")})
+	require.Error(t, err)
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/exported"
+)
+
+var _ exported.FeeAllowance = (*BasicFeeAllowance)(nil)
+
+// BasicFeeAllowance allows a grantee to have its fees paid up to a total spend
+// limit, until an optional expiration time.
+//
+// An empty SpendLimit does not limit the fees, and a zero Expiration never
+// expires.
+type BasicFeeAllowance struct {
+	SpendLimit sdk.Coins `json:"spend_limit" yaml:"spend_limit"`
+	Expiration time.Time `json:"expiration" yaml:"expiration"`
+}
+
+// NewBasicFeeAllowance returns a new BasicFeeAllowance
+func NewBasicFeeAllowance(spendLimit sdk.Coins, expiration time.Time) *BasicFeeAllowance {
+	return &BasicFeeAllowance{
+		SpendLimit: spendLimit,
+		Expiration: expiration,
+	}
+}
+
+// Accept implements the FeeAllowance interface. It deducts the fee from the
+// spend limit and returns true once the spend limit is used up.
+func (a *BasicFeeAllowance) Accept(fee sdk.Coins, blockTime time.Time) (bool, sdk.Error) {
+	if a.IsExpired(blockTime) {
+		return true, ErrFeeLimitExpired(DefaultCodespace)
+	}
+
+	if a.SpendLimit.Empty() {
+		return false, nil
+	}
+
+	left, hasNeg := a.SpendLimit.SafeSub(fee)
+	if hasNeg {
+		return false, ErrFeeLimitExceeded(DefaultCodespace, fmt.Sprintf("%s > %s", fee, a.SpendLimit))
+	}
+
+	a.SpendLimit = left
+	return left.IsZero(), nil
+}
+
+// IsExpired returns true if the allowance has expired at the given block time.
+func (a BasicFeeAllowance) IsExpired(blockTime time.Time) bool {
+	return !a.Expiration.IsZero() && !blockTime.Before(a.Expiration)
+}
+
+// ValidateBasic implements the FeeAllowance interface.
+func (a BasicFeeAllowance) ValidateBasic() sdk.Error {
+	if !a.SpendLimit.IsValid() {
+		return ErrInvalidAllowance(DefaultCodespace, fmt.Sprintf("invalid spend limit %s", a.SpendLimit))
+	}
+	return nil
+}
+
+// String implements the fmt.Stringer interface.
+func (a BasicFeeAllowance) String() string {
+	return fmt.Sprintf(`Basic Fee Allowance:
+  Spend Limit: %s
+  Expiration:  %s`, a.SpendLimit, a.Expiration,
+	)
+}
@@ -0,0 +1,98 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestBasicFeeAllowance(t *testing.T) {
+	now := time.Now().UTC()
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 43))
+	leftAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 512))
+	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 1))
+
+	cases := map[string]struct {
+		allowance *BasicFeeAllowance
+		valid     bool
+		fee       sdk.Coins
+		blockTime time.Time
+		accept    bool
+		remove    bool
+		remains   sdk.Coins
+	}{
+		"empty": {
+			allowance: NewBasicFeeAllowance(nil, time.Time{}),
+			valid:     true,
+			fee:       atom,
+			blockTime: now,
+			accept:    true,
+		},
+		"invalid spend limit": {
+			allowance: NewBasicFeeAllowance(sdk.Coins{sdk.Coin{Denom: "atom", Amount: sdk.NewInt(-1)}}, time.Time{}),
+			valid:     false,
+		},
+		"small fee": {
+			allowance: NewBasicFeeAllowance(atom, time.Time{}),
+			valid:     true,
+			fee:       smallAtom,
+			blockTime: now,
+			accept:    true,
+			remains:   leftAtom,
+		},
+		"all fee": {
+			allowance: NewBasicFeeAllowance(smallAtom, time.Time{}),
+			valid:     true,
+			fee:       smallAtom,
+			blockTime: now,
+			accept:    true,
+			remove:    true,
+		},
+		"wrong fee": {
+			allowance: NewBasicFeeAllowance(smallAtom, time.Time{}),
+			valid:     true,
+			fee:       eth,
+			blockTime: now,
+			accept:    false,
+		},
+		"non-expired": {
+			allowance: NewBasicFeeAllowance(atom, now.Add(time.Hour)),
+			valid:     true,
+			fee:       smallAtom,
+			blockTime: now,
+			accept:    true,
+			remains:   leftAtom,
+		},
+		"expired": {
+			allowance: NewBasicFeeAllowance(atom, now),
+			valid:     true,
+			fee:       smallAtom,
+			blockTime: now,
+			accept:    false,
+			remove:    true,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.allowance.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			remove, err := tc.allowance.Accept(tc.fee, tc.blockTime)
+			require.Equal(t, tc.accept, err == nil, "%v", err)
+			require.Equal(t, tc.remove, remove)
+			if tc.accept && !tc.remove {
+				require.Equal(t, tc.remains, tc.allowance.SpendLimit)
+			}
+		})
+	}
+}
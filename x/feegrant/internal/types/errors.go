@@ -0,0 +1,49 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Codes for feegrant errors
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeFeeLimitExceeded sdk.CodeType = 101
+	CodeFeeLimitExpired  sdk.CodeType = 102
+	CodeInvalidPeriod    sdk.CodeType = 103
+	CodeNoAllowance      sdk.CodeType = 104
+	CodeInvalidAllowance sdk.CodeType = 105
+	CodeInvalidGrant     sdk.CodeType = 106
+)
+
+// ErrFeeLimitExceeded is returned when a fee exceeds the remaining allowance
+func ErrFeeLimitExceeded(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeFeeLimitExceeded, fmt.Sprintf("fee limit exceeded: %s", msg))
+}
+
+// ErrFeeLimitExpired is returned when an allowance has expired
+func ErrFeeLimitExpired(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeFeeLimitExpired, "fee allowance expired")
+}
+
+// ErrInvalidPeriod is returned when the period of an allowance is invalid
+func ErrInvalidPeriod(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidPeriod, fmt.Sprintf("invalid period: %s", msg))
+}
+
+// ErrNoAllowance is returned when a grantee has no allowance from a granter
+func ErrNoAllowance(codespace sdk.CodespaceType, granter, grantee sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeNoAllowance, fmt.Sprintf("no fee allowance from %s to %s", granter, grantee))
+}
+
+// ErrInvalidAllowance is returned when an allowance is invalid
+func ErrInvalidAllowance(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidAllowance, fmt.Sprintf("invalid fee allowance: %s", msg))
+}
+
+// ErrInvalidGrant is returned when the granter or grantee of a grant are invalid
+func ErrInvalidGrant(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidGrant, fmt.Sprintf("invalid fee grant: %s", msg))
+}
@@ -0,0 +1,13 @@
+package types
+
+// feegrant module event types
+var (
+	EventTypeUseFeeGrant    = "use_fee_grant"
+	EventTypeRevokeFeeGrant = "revoke_fee_grant"
+	EventTypeSetFeeGrant    = "set_fee_grant"
+
+	AttributeValueCategory = ModuleName
+
+	AttributeKeyGranter = "granter"
+	AttributeKeyGrantee = "grantee"
+)
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"fmt"
+)
+
+// GenesisState contains the fee allowances of the feegrant module
+type GenesisState struct {
+	FeeAllowances []FeeAllowanceGrant `json:"fee_allowances" yaml:"fee_allowances"`
+}
+
+// NewGenesisState creates a new GenesisState
+func NewGenesisState(feeAllowances []FeeAllowanceGrant) GenesisState {
+	return GenesisState{
+		FeeAllowances: feeAllowances,
+	}
+}
+
+// DefaultGenesisState returns a default genesis state without allowances
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState([]FeeAllowanceGrant{})
+}
+
+// ValidateGenesis validates the allowances of the genesis state
+func ValidateGenesis(data GenesisState) error {
+	seen := make(map[string]bool, len(data.FeeAllowances))
+	for _, grant := range data.FeeAllowances {
+		if err := grant.ValidateBasic(); err != nil {
+			return err
+		}
+
+		key := string(FeeAllowanceKey(grant.Granter, grant.Grantee))
+		if seen[key] {
+			return fmt.Errorf("duplicate fee allowance from %s to %s", grant.Granter, grant.Grantee)
+		}
+		seen[key] = true
+	}
+	return nil
+}
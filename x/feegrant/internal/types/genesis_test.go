@@ -0,0 +1,34 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	addr2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	allowance := NewBasicFeeAllowance(sdk.NewCoins(sdk.NewInt64Coin("atom", 10)), time.Time{})
+
+	require.NoError(t, ValidateGenesis(DefaultGenesisState()))
+	require.NoError(t, ValidateGenesis(NewGenesisState([]FeeAllowanceGrant{
+		NewFeeAllowanceGrant(addr1, addr2, allowance),
+		NewFeeAllowanceGrant(addr2, addr1, allowance),
+	})))
+
+	// duplicate grant
+	require.Error(t, ValidateGenesis(NewGenesisState([]FeeAllowanceGrant{
+		NewFeeAllowanceGrant(addr1, addr2, allowance),
+		NewFeeAllowanceGrant(addr1, addr2, allowance),
+	})))
+
+	// invalid grants
+	require.Error(t, ValidateGenesis(NewGenesisState([]FeeAllowanceGrant{NewFeeAllowanceGrant(addr1, addr1, allowance)})))
+	require.Error(t, ValidateGenesis(NewGenesisState([]FeeAllowanceGrant{NewFeeAllowanceGrant(nil, addr1, allowance)})))
+	require.Error(t, ValidateGenesis(NewGenesisState([]FeeAllowanceGrant{NewFeeAllowanceGrant(addr1, addr2, nil)})))
+}
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/exported"
+)
+
+// FeeAllowanceGrant is the allowance of a grantee to have its fees paid by a
+// granter.
+type FeeAllowanceGrant struct {
+	Granter   sdk.AccAddress        `json:"granter" yaml:"granter"`
+	Grantee   sdk.AccAddress        `json:"grantee" yaml:"grantee"`
+	Allowance exported.FeeAllowance `json:"allowance" yaml:"allowance"`
+}
+
+// NewFeeAllowanceGrant returns a new FeeAllowanceGrant
+func NewFeeAllowanceGrant(granter, grantee sdk.AccAddress, allowance exported.FeeAllowance) FeeAllowanceGrant {
+	return FeeAllowanceGrant{
+		Granter:   granter,
+		Grantee:   grantee,
+		Allowance: allowance,
+	}
+}
+
+// NewFeeAllowance returns a PeriodicFeeAllowance if a period is given, and a
+// BasicFeeAllowance otherwise.
+func NewFeeAllowance(spendLimit sdk.Coins, expiration time.Time, period time.Duration, periodSpendLimit sdk.Coins) exported.FeeAllowance {
+	basic := NewBasicFeeAllowance(spendLimit, expiration)
+	if period == 0 {
+		return basic
+	}
+	return NewPeriodicFeeAllowance(*basic, period, periodSpendLimit)
+}
+
+// ValidateBasic performs a stateless validation of the grant.
+func (g FeeAllowanceGrant) ValidateBasic() sdk.Error {
+	if g.Granter.Empty() {
+		return ErrInvalidGrant(DefaultCodespace, "missing granter address")
+	}
+	if g.Grantee.Empty() {
+		return ErrInvalidGrant(DefaultCodespace, "missing grantee address")
+	}
+	if g.Granter.Equals(g.Grantee) {
+		return ErrInvalidGrant(DefaultCodespace, "cannot self-grant fee allowance")
+	}
+	if g.Allowance == nil {
+		return ErrInvalidAllowance(DefaultCodespace, "missing allowance")
+	}
+	return g.Allowance.ValidateBasic()
+}
+
+// String implements the fmt.Stringer interface.
+func (g FeeAllowanceGrant) String() string {
+	return fmt.Sprintf(`Fee Allowance Grant:
+  Granter:   %s
+  Grantee:   %s
+  Allowance: %s`, g.Granter, g.Grantee, g.Allowance,
+	)
+}
+
+// FeeAllowanceGrants is a list of fee allowance grants
+type FeeAllowanceGrants []FeeAllowanceGrant
+
+// String implements the fmt.Stringer interface.
+func (gs FeeAllowanceGrants) String() string {
+	if len(gs) == 0 {
+		return "[]"
+	}
+
+	out := make([]string, len(gs))
+	for i, g := range gs {
+		out[i] = g.String()
+	}
+	return strings.Join(out, "\n")
+}
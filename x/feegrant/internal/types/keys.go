@@ -0,0 +1,37 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "feegrant"
+
+	// StoreKey is the store key string for the feegrant module
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the feegrant module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the feegrant module
+	QuerierRoute = ModuleName
+)
+
+var (
+	// FeeAllowanceKeyPrefix is the prefix of the allowances, which are keyed by
+	// grantee first so that the allowances of a grantee can be iterated.
+	FeeAllowanceKeyPrefix = []byte{0x00}
+)
+
+// FeeAllowanceKey returns the store key of the allowance of a grantee from a
+// granter.
+func FeeAllowanceKey(granter, grantee sdk.AccAddress) []byte {
+	return append(FeeAllowancePrefixByGrantee(grantee), granter...)
+}
+
+// FeeAllowancePrefixByGrantee returns the store key prefix of the allowances
+// of a grantee.
+func FeeAllowancePrefixByGrantee(grantee sdk.AccAddress) []byte {
+	return append(FeeAllowanceKeyPrefix, grantee...)
+}
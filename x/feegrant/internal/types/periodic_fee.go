@@ -0,0 +1,129 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/exported"
+)
+
+var _ exported.FeeAllowance = (*PeriodicFeeAllowance)(nil)
+
+// PeriodicFeeAllowance extends a BasicFeeAllowance with a limit of the fees
+// which can be paid per period of time.
+//
+// PeriodCanSpend is what is left of the limit of the current period, which ends
+// at PeriodReset. A zero PeriodReset starts the first period with the first fee
+// paid.
+type PeriodicFeeAllowance struct {
+	Basic            BasicFeeAllowance `json:"basic" yaml:"basic"`
+	Period           time.Duration     `json:"period" yaml:"period"`
+	PeriodSpendLimit sdk.Coins         `json:"period_spend_limit" yaml:"period_spend_limit"`
+	PeriodCanSpend   sdk.Coins         `json:"period_can_spend" yaml:"period_can_spend"`
+	PeriodReset      time.Time         `json:"period_reset" yaml:"period_reset"`
+}
+
+// NewPeriodicFeeAllowance returns a new PeriodicFeeAllowance whose first period
+// starts with the first fee paid.
+func NewPeriodicFeeAllowance(basic BasicFeeAllowance, period time.Duration, periodSpendLimit sdk.Coins) *PeriodicFeeAllowance {
+	return &PeriodicFeeAllowance{
+		Basic:            basic,
+		Period:           period,
+		PeriodSpendLimit: periodSpendLimit,
+	}
+}
+
+// Accept implements the FeeAllowance interface. It deducts the fee from the
+// limit of the current period, starting a new period if the current one has
+// ended, and from the total spend limit.
+func (a *PeriodicFeeAllowance) Accept(fee sdk.Coins, blockTime time.Time) (bool, sdk.Error) {
+	if a.Basic.IsExpired(blockTime) {
+		return true, ErrFeeLimitExpired(DefaultCodespace)
+	}
+
+	a.tryResetPeriod(blockTime)
+
+	canSpend, hasNeg := a.PeriodCanSpend.SafeSub(fee)
+	if hasNeg {
+		return false, ErrFeeLimitExceeded(DefaultCodespace, fmt.Sprintf("%s > %s in period", fee, a.PeriodCanSpend))
+	}
+
+	if a.Basic.SpendLimit.Empty() {
+		a.PeriodCanSpend = canSpend
+		return false, nil
+	}
+
+	left, hasNeg := a.Basic.SpendLimit.SafeSub(fee)
+	if hasNeg {
+		return false, ErrFeeLimitExceeded(DefaultCodespace, fmt.Sprintf("%s > %s", fee, a.Basic.SpendLimit))
+	}
+
+	a.PeriodCanSpend = canSpend
+	a.Basic.SpendLimit = left
+	return left.IsZero(), nil
+}
+
+// tryResetPeriod starts a new period if the current one has ended at the given
+// block time. The limit of the new period is capped by the total spend limit.
+// If more than one period has passed, the new period starts at the block time.
+func (a *PeriodicFeeAllowance) tryResetPeriod(blockTime time.Time) {
+	if blockTime.Before(a.PeriodReset) {
+		return
+	}
+
+	a.PeriodCanSpend = a.PeriodSpendLimit
+	if !a.Basic.SpendLimit.Empty() {
+		a.PeriodCanSpend = minCoins(a.PeriodSpendLimit, a.Basic.SpendLimit)
+	}
+
+	a.PeriodReset = a.PeriodReset.Add(a.Period)
+	if blockTime.After(a.PeriodReset) {
+		a.PeriodReset = blockTime.Add(a.Period)
+	}
+}
+
+// ValidateBasic implements the FeeAllowance interface.
+func (a PeriodicFeeAllowance) ValidateBasic() sdk.Error {
+	if err := a.Basic.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if a.Period <= 0 {
+		return ErrInvalidPeriod(DefaultCodespace, fmt.Sprintf("period must be positive, got %s", a.Period))
+	}
+	if a.PeriodSpendLimit.Empty() || !a.PeriodSpendLimit.IsValid() {
+		return ErrInvalidAllowance(DefaultCodespace, fmt.Sprintf("invalid period spend limit %s", a.PeriodSpendLimit))
+	}
+	if !a.PeriodCanSpend.IsValid() {
+		return ErrInvalidAllowance(DefaultCodespace, fmt.Sprintf("invalid period can spend %s", a.PeriodCanSpend))
+	}
+
+	return nil
+}
+
+// String implements the fmt.Stringer interface.
+func (a PeriodicFeeAllowance) String() string {
+	return fmt.Sprintf(`Periodic Fee Allowance:
+  Spend Limit:        %s
+  Expiration:         %s
+  Period:             %s
+  Period Spend Limit: %s
+  Period Can Spend:   %s
+  Period Reset:       %s`,
+		a.Basic.SpendLimit, a.Basic.Expiration, a.Period,
+		a.PeriodSpendLimit, a.PeriodCanSpend, a.PeriodReset,
+	)
+}
+
+// minCoins returns the minimum amount of each denom of a which is also in b.
+func minCoins(a, b sdk.Coins) sdk.Coins {
+	var min sdk.Coins
+	for _, coin := range a {
+		amount := sdk.MinInt(coin.Amount, b.AmountOf(coin.Denom))
+		if amount.IsPositive() {
+			min = append(min, sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+	return min
+}
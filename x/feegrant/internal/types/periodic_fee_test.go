@@ -0,0 +1,76 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestPeriodicFeeValidateBasic(t *testing.T) {
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+
+	require.NoError(t, NewPeriodicFeeAllowance(BasicFeeAllowance{}, time.Hour, atom).ValidateBasic())
+	require.Error(t, NewPeriodicFeeAllowance(BasicFeeAllowance{}, 0, atom).ValidateBasic())
+	require.Error(t, NewPeriodicFeeAllowance(BasicFeeAllowance{}, time.Hour, nil).ValidateBasic())
+	require.Error(t, NewPeriodicFeeAllowance(
+		BasicFeeAllowance{SpendLimit: sdk.Coins{sdk.Coin{Denom: "atom", Amount: sdk.NewInt(-1)}}}, time.Hour, atom,
+	).ValidateBasic())
+}
+
+func TestPeriodicFeeAccept(t *testing.T) {
+	now := time.Now().UTC()
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin("atom", amount))
+	}
+
+	// the first period starts with the first fee
+	allowance := NewPeriodicFeeAllowance(*NewBasicFeeAllowance(coins(25), time.Time{}), time.Hour, coins(10))
+	remove, err := allowance.Accept(coins(4), now)
+	require.NoError(t, err)
+	require.False(t, remove)
+	require.Equal(t, coins(6), allowance.PeriodCanSpend)
+	require.Equal(t, coins(21), allowance.Basic.SpendLimit)
+	require.Equal(t, now.Add(time.Hour), allowance.PeriodReset)
+
+	// the period limit is exceeded
+	_, err = allowance.Accept(coins(7), now.Add(time.Minute))
+	require.Error(t, err)
+	require.Equal(t, CodeFeeLimitExceeded, err.Code())
+
+	remove, err = allowance.Accept(coins(6), now.Add(time.Minute))
+	require.NoError(t, err)
+	require.False(t, remove)
+	require.True(t, allowance.PeriodCanSpend.IsZero())
+
+	// a new period starts, one period after the previous one
+	remove, err = allowance.Accept(coins(10), now.Add(time.Hour))
+	require.NoError(t, err)
+	require.False(t, remove)
+	require.Equal(t, coins(5), allowance.Basic.SpendLimit)
+	require.Equal(t, now.Add(2*time.Hour), allowance.PeriodReset)
+
+	// after several periods, the new period starts at the block time and its
+	// limit is capped by the spend limit
+	remove, err = allowance.Accept(coins(5), now.Add(5*time.Hour))
+	require.NoError(t, err)
+	require.True(t, remove)
+	require.Equal(t, now.Add(6*time.Hour), allowance.PeriodReset)
+
+	// expired allowances are removed
+	allowance = NewPeriodicFeeAllowance(*NewBasicFeeAllowance(nil, now), time.Hour, coins(10))
+	remove, err = allowance.Accept(coins(1), now)
+	require.Error(t, err)
+	require.Equal(t, CodeFeeLimitExpired, err.Code())
+	require.True(t, remove)
+
+	// without spend limit, only the period limit applies
+	allowance = NewPeriodicFeeAllowance(BasicFeeAllowance{}, time.Hour, coins(10))
+	remove, err = allowance.Accept(coins(10), now)
+	require.NoError(t, err)
+	require.False(t, remove)
+	_, err = allowance.Accept(coins(1), now)
+	require.Error(t, err)
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// query endpoints supported by the feegrant Querier
+const (
+	QueryGetFeeAllowance  = "fee_allowance"
+	QueryGetFeeAllowances = "fee_allowances"
+)
+
+// QueryFeeAllowanceParams defines the params for querying the allowance of a
+// grantee from a granter
+type QueryFeeAllowanceParams struct {
+	Granter sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+}
+
+// NewQueryFeeAllowanceParams creates a new QueryFeeAllowanceParams
+func NewQueryFeeAllowanceParams(granter, grantee sdk.AccAddress) QueryFeeAllowanceParams {
+	return QueryFeeAllowanceParams{
+		Granter: granter,
+		Grantee: grantee,
+	}
+}
+
+// QueryFeeAllowancesParams defines the params for querying all the allowances
+// of a grantee
+type QueryFeeAllowancesParams struct {
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+}
+
+// NewQueryFeeAllowancesParams creates a new QueryFeeAllowancesParams
+func NewQueryFeeAllowancesParams(grantee sdk.AccAddress) QueryFeeAllowancesParams {
+	return QueryFeeAllowancesParams{
+		Grantee: grantee,
+	}
+}
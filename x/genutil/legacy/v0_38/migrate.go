@@ -6,15 +6,21 @@ import (
 	v038auth "github.com/cosmos/cosmos-sdk/x/auth/legacy/v0_38"
 	v036genaccounts "github.com/cosmos/cosmos-sdk/x/genaccounts/legacy/v0_36"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
+	v036gov "github.com/cosmos/cosmos-sdk/x/gov/legacy/v0_36"
+	v038gov "github.com/cosmos/cosmos-sdk/x/gov/legacy/v0_38"
+	v037nft "github.com/cosmos/cosmos-sdk/x/nft/legacy/v0_37"
+	v038nft "github.com/cosmos/cosmos-sdk/x/nft/legacy/v0_38"
 )
 
 // Migrate migrates exported state from v0.34 to a v0.36 genesis state.
 func Migrate(appState genutil.AppMap) genutil.AppMap {
 	v036Codec := codec.New()
 	codec.RegisterCrypto(v036Codec)
+	v036gov.RegisterCodec(v036Codec)
 
 	v038Codec := codec.New()
 	codec.RegisterCrypto(v038Codec)
+	v036gov.RegisterCodec(v038Codec)
 
 	if appState[v036genaccounts.ModuleName] != nil {
 		var authGenState v036auth.GenesisState
@@ -28,5 +34,22 @@ func Migrate(appState genutil.AppMap) genutil.AppMap {
 		delete(appState, v036genaccounts.ModuleName)
 	}
 
+	// migrate gov state
+	if appState[v036gov.ModuleName] != nil {
+		var govGenState v036gov.GenesisState
+		v036Codec.MustUnmarshalJSON(appState[v036gov.ModuleName], &govGenState)
+
+		delete(appState, v036gov.ModuleName) // delete old key in case the name changed
+		appState[v038gov.ModuleName] = v038Codec.MustMarshalJSON(v038gov.Migrate(govGenState))
+	}
+
+	if appState[v037nft.ModuleName] != nil {
+		var nftGenState v037nft.GenesisState
+		v036Codec.MustUnmarshalJSON(appState[v037nft.ModuleName], &nftGenState)
+
+		// NFT owners are no longer exported as they are indexed from the collections
+		appState[v038nft.ModuleName] = v038Codec.MustMarshalJSON(v038nft.Migrate(nftGenState))
+	}
+
 	return appState
 }
@@ -28,7 +28,7 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 			fmt.Sprintf("proposal %d (%s) didn't meet minimum deposit of %s (had only %s); deleted",
 				proposal.ProposalID,
 				proposal.GetTitle(),
-				keeper.GetDepositParams(ctx).MinDeposit,
+				keeper.GetProposalClass(ctx, proposal.Class).MinDeposit,
 				proposal.TotalDeposit,
 			),
 		)
@@ -55,6 +55,14 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 			// on the proposal content. If the handler fails, no state mutation
 			// is written and the error message is logged.
 			err := handler(cacheCtx, proposal.Content)
+			if err == nil {
+				// the deposits held in escrow by the governance module account
+				// can not be spent by the proposal
+				balance := keeper.GetGovernanceAccount(cacheCtx).GetCoins()
+				if deposits := keeper.GetDepositsInEscrow(cacheCtx); !balance.IsAllGTE(deposits) {
+					err = types.ErrEscrowSpent(types.DefaultCodespace, balance, deposits)
+				}
+			}
 			if err == nil {
 				proposal.Status = StatusPassed
 				tagValue = types.AttributeValueProposalPassed
@@ -25,7 +25,14 @@ const (
 	CodeInvalidGenesis           = types.CodeInvalidGenesis
 	CodeInvalidProposalStatus    = types.CodeInvalidProposalStatus
 	CodeProposalHandlerNotExists = types.CodeProposalHandlerNotExists
+	CodeInvalidVoteProxy         = types.CodeInvalidVoteProxy
+	CodeVoteProxyNotFound        = types.CodeVoteProxyNotFound
+	CodeInvalidExecMsg           = types.CodeInvalidExecMsg
+	CodeExecMsgFailed            = types.CodeExecMsgFailed
+	CodeInvalidProposalClass     = types.CodeInvalidProposalClass
 	DefaultPeriod                = types.DefaultPeriod
+	DefaultProposalClass         = types.DefaultProposalClass
+	ExpeditedProposalClass       = types.ExpeditedProposalClass
 	ModuleName                   = types.ModuleName
 	StoreKey                     = types.StoreKey
 	RouterKey                    = types.RouterKey
@@ -33,7 +40,10 @@ const (
 	DefaultParamspace            = types.DefaultParamspace
 	TypeMsgDeposit               = types.TypeMsgDeposit
 	TypeMsgVote                  = types.TypeMsgVote
+	TypeMsgVoteWeighted          = types.TypeMsgVoteWeighted
 	TypeMsgSubmitProposal        = types.TypeMsgSubmitProposal
+	TypeMsgSetVoteProxy          = types.TypeMsgSetVoteProxy
+	TypeMsgRemoveVoteProxy       = types.TypeMsgRemoveVoteProxy
 	StatusNil                    = types.StatusNil
 	StatusDepositPeriod          = types.StatusDepositPeriod
 	StatusVotingPeriod           = types.StatusVotingPeriod
@@ -41,7 +51,8 @@ const (
 	StatusRejected               = types.StatusRejected
 	StatusFailed                 = types.StatusFailed
 	ProposalTypeText             = types.ProposalTypeText
-	ProposalTypeSoftwareUpgrade  = types.ProposalTypeSoftwareUpgrade
+	ProposalTypeExec             = types.ProposalTypeExec
+	ExecRouterKey                = types.ExecRouterKey
 	QueryParams                  = types.QueryParams
 	QueryProposals               = types.QueryProposals
 	QueryProposal                = types.QueryProposal
@@ -50,9 +61,13 @@ const (
 	QueryVotes                   = types.QueryVotes
 	QueryVote                    = types.QueryVote
 	QueryTally                   = types.QueryTally
+	QueryVoterPowers             = types.QueryVoterPowers
+	QueryVoterPower              = types.QueryVoterPower
+	QueryVoteProxy               = types.QueryVoteProxy
 	ParamDeposit                 = types.ParamDeposit
 	ParamVoting                  = types.ParamVoting
 	ParamTallying                = types.ParamTallying
+	ParamClasses                 = types.ParamClasses
 	OptionEmpty                  = types.OptionEmpty
 	OptionYes                    = types.OptionYes
 	OptionAbstain                = types.OptionAbstain
@@ -69,8 +84,12 @@ var (
 	NewQuerier                    = keeper.NewQuerier
 	RegisterCodec                 = types.RegisterCodec
 	RegisterProposalTypeCodec     = types.RegisterProposalTypeCodec
+	RegisterExecMsgTypeCodec      = types.RegisterExecMsgTypeCodec
 	ValidateAbstract              = types.ValidateAbstract
 	NewDeposit                    = types.NewDeposit
+	NewProposalClass              = types.NewProposalClass
+	NewDefaultProposalClass       = types.NewDefaultProposalClass
+	NewClassRoute                 = types.NewClassRoute
 	ErrUnknownProposal            = types.ErrUnknownProposal
 	ErrInactiveProposal           = types.ErrInactiveProposal
 	ErrAlreadyActiveProposal      = types.ErrAlreadyActiveProposal
@@ -79,6 +98,12 @@ var (
 	ErrInvalidVote                = types.ErrInvalidVote
 	ErrInvalidGenesis             = types.ErrInvalidGenesis
 	ErrNoProposalHandlerExists    = types.ErrNoProposalHandlerExists
+	ErrInvalidWeightedVote        = types.ErrInvalidWeightedVote
+	ErrInvalidVoteProxy           = types.ErrInvalidVoteProxy
+	ErrVoteProxyNotFound          = types.ErrVoteProxyNotFound
+	ErrInvalidExecMsg             = types.ErrInvalidExecMsg
+	ErrExecMsgFailed              = types.ErrExecMsgFailed
+	ErrInvalidProposalClass       = types.ErrInvalidProposalClass
 	NewGenesisState               = types.NewGenesisState
 	DefaultGenesisState           = types.DefaultGenesisState
 	ValidateGenesis               = types.ValidateGenesis
@@ -93,25 +118,36 @@ var (
 	DepositKey                    = types.DepositKey
 	VotesKey                      = types.VotesKey
 	VoteKey                       = types.VoteKey
+	VoteProxyKey                  = types.VoteProxyKey
+	ProxyDelegatorsKey            = types.ProxyDelegatorsKey
+	ProxyDelegatorKey             = types.ProxyDelegatorKey
 	SplitProposalKey              = types.SplitProposalKey
 	SplitActiveProposalQueueKey   = types.SplitActiveProposalQueueKey
 	SplitInactiveProposalQueueKey = types.SplitInactiveProposalQueueKey
 	SplitKeyDeposit               = types.SplitKeyDeposit
 	SplitKeyVote                  = types.SplitKeyVote
+	SplitVoteProxyKey             = types.SplitVoteProxyKey
+	SplitProxyDelegatorKey        = types.SplitProxyDelegatorKey
 	NewMsgSubmitProposal          = types.NewMsgSubmitProposal
+	NewMsgSubmitProposalWithClass = types.NewMsgSubmitProposalWithClass
 	NewMsgDeposit                 = types.NewMsgDeposit
 	NewMsgVote                    = types.NewMsgVote
+	NewMsgVoteWeighted            = types.NewMsgVoteWeighted
+	NewMsgSetVoteProxy            = types.NewMsgSetVoteProxy
+	NewMsgRemoveVoteProxy         = types.NewMsgRemoveVoteProxy
 	ParamKeyTable                 = types.ParamKeyTable
 	NewDepositParams              = types.NewDepositParams
 	NewTallyParams                = types.NewTallyParams
 	NewVotingParams               = types.NewVotingParams
+	NewClassParams                = types.NewClassParams
+	DefaultClassParams            = types.DefaultClassParams
 	NewParams                     = types.NewParams
 	NewProposal                   = types.NewProposal
 	NewRouter                     = types.NewRouter
 	ProposalStatusFromString      = types.ProposalStatusFromString
 	ValidProposalStatus           = types.ValidProposalStatus
 	NewTextProposal               = types.NewTextProposal
-	NewSoftwareUpgradeProposal    = types.NewSoftwareUpgradeProposal
+	NewExecProposal               = types.NewExecProposal
 	RegisterProposalType          = types.RegisterProposalType
 	ContentFromProposalType       = types.ContentFromProposalType
 	IsValidProposalType           = types.IsValidProposalType
@@ -120,13 +156,20 @@ var (
 	NewQueryDepositParams         = types.NewQueryDepositParams
 	NewQueryVoteParams            = types.NewQueryVoteParams
 	NewQueryProposalsParams       = types.NewQueryProposalsParams
+	NewQueryVoteProxyParams       = types.NewQueryVoteProxyParams
 	NewValidatorGovInfo           = types.NewValidatorGovInfo
+	NewVoterPower                 = types.NewVoterPower
 	NewTallyResult                = types.NewTallyResult
 	NewTallyResultFromMap         = types.NewTallyResultFromMap
 	EmptyTallyResult              = types.EmptyTallyResult
 	NewVote                       = types.NewVote
 	VoteOptionFromString          = types.VoteOptionFromString
 	ValidVoteOption               = types.ValidVoteOption
+	NewWeightedVoteOption         = types.NewWeightedVoteOption
+	NewNonSplitVoteOption         = types.NewNonSplitVoteOption
+	WeightedVoteOptionsFromString = types.WeightedVoteOptionsFromString
+	ValidWeightedVoteOptions      = types.ValidWeightedVoteOptions
+	NewVoteProxy                  = types.NewVoteProxy
 
 	// variable aliases
 	ModuleCdc                   = types.ModuleCdc
@@ -136,38 +179,55 @@ var (
 	ProposalIDKey               = types.ProposalIDKey
 	DepositsKeyPrefix           = types.DepositsKeyPrefix
 	VotesKeyPrefix              = types.VotesKeyPrefix
+	VoteProxyKeyPrefix          = types.VoteProxyKeyPrefix
+	ProxyDelegatorsKeyPrefix    = types.ProxyDelegatorsKeyPrefix
 	ParamStoreKeyDepositParams  = types.ParamStoreKeyDepositParams
 	ParamStoreKeyVotingParams   = types.ParamStoreKeyVotingParams
 	ParamStoreKeyTallyParams    = types.ParamStoreKeyTallyParams
+	ParamStoreKeyClassParams    = types.ParamStoreKeyClassParams
 )
 
 type (
-	Keeper                  = keeper.Keeper
-	Content                 = types.Content
-	Handler                 = types.Handler
-	Deposit                 = types.Deposit
-	Deposits                = types.Deposits
-	GenesisState            = types.GenesisState
-	MsgSubmitProposal       = types.MsgSubmitProposal
-	MsgDeposit              = types.MsgDeposit
-	MsgVote                 = types.MsgVote
-	DepositParams           = types.DepositParams
-	TallyParams             = types.TallyParams
-	VotingParams            = types.VotingParams
-	Params                  = types.Params
-	Proposal                = types.Proposal
-	Proposals               = types.Proposals
-	ProposalQueue           = types.ProposalQueue
-	ProposalStatus          = types.ProposalStatus
-	TextProposal            = types.TextProposal
-	SoftwareUpgradeProposal = types.SoftwareUpgradeProposal
-	QueryProposalParams     = types.QueryProposalParams
-	QueryDepositParams      = types.QueryDepositParams
-	QueryVoteParams         = types.QueryVoteParams
-	QueryProposalsParams    = types.QueryProposalsParams
-	ValidatorGovInfo        = types.ValidatorGovInfo
-	TallyResult             = types.TallyResult
-	Vote                    = types.Vote
-	Votes                   = types.Votes
-	VoteOption              = types.VoteOption
+	Keeper               = keeper.Keeper
+	Content              = types.Content
+	AmountContent        = types.AmountContent
+	Handler              = types.Handler
+	Deposit              = types.Deposit
+	Deposits             = types.Deposits
+	GenesisState         = types.GenesisState
+	MsgSubmitProposal    = types.MsgSubmitProposal
+	MsgDeposit           = types.MsgDeposit
+	MsgVote              = types.MsgVote
+	MsgVoteWeighted      = types.MsgVoteWeighted
+	MsgSetVoteProxy      = types.MsgSetVoteProxy
+	MsgRemoveVoteProxy   = types.MsgRemoveVoteProxy
+	DepositParams        = types.DepositParams
+	TallyParams          = types.TallyParams
+	VotingParams         = types.VotingParams
+	ClassParams          = types.ClassParams
+	ProposalClass        = types.ProposalClass
+	ClassRoute           = types.ClassRoute
+	Params               = types.Params
+	Proposal             = types.Proposal
+	Proposals            = types.Proposals
+	ProposalQueue        = types.ProposalQueue
+	ProposalStatus       = types.ProposalStatus
+	TextProposal         = types.TextProposal
+	ExecProposal         = types.ExecProposal
+	QueryProposalParams  = types.QueryProposalParams
+	QueryDepositParams   = types.QueryDepositParams
+	QueryVoteParams      = types.QueryVoteParams
+	QueryProposalsParams = types.QueryProposalsParams
+	QueryVoteProxyParams = types.QueryVoteProxyParams
+	ValidatorGovInfo     = types.ValidatorGovInfo
+	VoterPower           = types.VoterPower
+	VoterPowers          = types.VoterPowers
+	TallyResult          = types.TallyResult
+	Vote                 = types.Vote
+	Votes                = types.Votes
+	VoteOption           = types.VoteOption
+	WeightedVoteOption   = types.WeightedVoteOption
+	WeightedVoteOptions  = types.WeightedVoteOptions
+	VoteProxy            = types.VoteProxy
+	VoteProxies          = types.VoteProxies
 )
@@ -38,7 +38,10 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryProposer(queryRoute, cdc),
 		GetCmdQueryDeposit(queryRoute, cdc),
 		GetCmdQueryDeposits(queryRoute, cdc),
-		GetCmdQueryTally(queryRoute, cdc))...)
+		GetCmdQueryTally(queryRoute, cdc),
+		GetCmdQueryVoterPowers(queryRoute, cdc),
+		GetCmdQueryVoterPower(queryRoute, cdc),
+		GetCmdQueryVoteProxy(queryRoute, cdc))...)
 
 	return govQueryCmd
 }
@@ -466,6 +469,139 @@ $ %s query gov tally 1
 	}
 }
 
+// GetCmdQueryVoterPowers implements the command to query for the voting power of the voters on a proposal.
+func GetCmdQueryVoterPowers(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "voter-powers [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query how the voting power of each voter on a proposal is counted",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the voting power counted for each voter on a proposal in its
+voting period, split into the power of its own delegations, the power of the
+delegations to the validator it operates, and the power of the delegators it
+votes on behalf of.
+
+Example:
+$ %s query gov voter-powers 1
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			params := types.NewQueryProposalParams(proposalID)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryVoterPowers), bz)
+			if err != nil {
+				return err
+			}
+
+			var voterPowers types.VoterPowers
+			cdc.MustUnmarshalJSON(res, &voterPowers)
+			return cliCtx.PrintOutput(voterPowers)
+		},
+	}
+}
+
+// GetCmdQueryVoterPower implements the command to query for the voting power of a voter on a proposal.
+func GetCmdQueryVoterPower(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "voter-power [proposal-id] [voter-addr]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query how the voting power of a voter on a proposal is counted",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the voting power counted for a voter on a proposal in its
+voting period.
+
+Example:
+$ %s query gov voter-power 1 cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			voterAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryVoteParams(proposalID, voterAddr)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryVoterPower), bz)
+			if err != nil {
+				return err
+			}
+
+			var voterPower types.VoterPower
+			cdc.MustUnmarshalJSON(res, &voterPower)
+			return cliCtx.PrintOutput(voterPower)
+		},
+	}
+}
+
+// GetCmdQueryVoteProxy implements the query vote proxy command.
+func GetCmdQueryVoteProxy(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "vote-proxy [delegator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the account voting on behalf of a delegator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the account voting on behalf of the delegations of a delegator.
+
+Example:
+$ %s query gov vote-proxy cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			delegatorAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryVoteProxyParams(delegatorAddr))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryVoteProxy), bz)
+			if err != nil {
+				return err
+			}
+
+			var proxy types.VoteProxy
+			cdc.MustUnmarshalJSON(res, &proxy)
+			return cliCtx.PrintOutput(proxy)
+		},
+	}
+}
+
 // GetCmdQueryProposal implements the query proposal command.
 func GetCmdQueryParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -495,6 +631,10 @@ $ %s query gov params
 			if err != nil {
 				return err
 			}
+			cp, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/params/classes", queryRoute), nil)
+			if err != nil {
+				return err
+			}
 
 			var tallyParams types.TallyParams
 			cdc.MustUnmarshalJSON(tp, &tallyParams)
@@ -502,8 +642,10 @@ $ %s query gov params
 			cdc.MustUnmarshalJSON(dp, &depositParams)
 			var votingParams types.VotingParams
 			cdc.MustUnmarshalJSON(vp, &votingParams)
+			var classParams types.ClassParams
+			cdc.MustUnmarshalJSON(cp, &classParams)
 
-			return cliCtx.PrintOutput(types.NewParams(votingParams, tallyParams, depositParams))
+			return cliCtx.PrintOutput(types.NewParams(votingParams, tallyParams, depositParams, classParams))
 		},
 	}
 }
@@ -513,7 +655,7 @@ func GetCmdQueryParam(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
 		Use:   "param [param-type]",
 		Args:  cobra.ExactArgs(1),
-		Short: "Query the parameters (voting|tallying|deposit) of the governance process",
+		Short: "Query the parameters (voting|tallying|deposit|classes) of the governance process",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Query the all the parameters for the governance process.
 
@@ -521,8 +663,9 @@ Example:
 $ %s query gov param voting
 $ %s query gov param tallying
 $ %s query gov param deposit
+$ %s query gov param classes
 `,
-				version.ClientName, version.ClientName, version.ClientName,
+				version.ClientName, version.ClientName, version.ClientName, version.ClientName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -547,8 +690,12 @@ $ %s query gov param deposit
 				var param types.DepositParams
 				cdc.MustUnmarshalJSON(res, &param)
 				out = param
+			case "classes":
+				var param types.ClassParams
+				cdc.MustUnmarshalJSON(res, &param)
+				out = param
 			default:
-				return fmt.Errorf("argument must be one of (voting|tallying|deposit), was %s", args[0])
+				return fmt.Errorf("argument must be one of (voting|tallying|deposit|classes), was %s", args[0])
 			}
 
 			return cliCtx.PrintOutput(out)
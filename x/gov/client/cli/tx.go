@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -16,6 +17,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
 	govutils "github.com/cosmos/cosmos-sdk/x/gov/client/utils"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
 )
 
 // Proposal flags
@@ -29,6 +31,7 @@ const (
 	flagStatus       = "status"
 	flagNumLimit     = "limit"
 	FlagProposal     = "proposal"
+	FlagClass        = "class"
 )
 
 type proposal struct {
@@ -70,6 +73,9 @@ func GetTxCmd(storeKey string, cdc *codec.Codec, pcmds []*cobra.Command) *cobra.
 	govTxCmd.AddCommand(client.PostCommands(
 		GetCmdDeposit(cdc),
 		GetCmdVote(cdc),
+		GetCmdWeightedVote(cdc),
+		GetCmdSetVoteProxy(cdc),
+		GetCmdRemoveVoteProxy(cdc),
 		cmdSubmitProp,
 	)...)
 
@@ -120,7 +126,7 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 
 			content := types.ContentFromProposalType(proposal.Title, proposal.Description, proposal.Type)
 
-			msg := types.NewMsgSubmitProposal(content, amount, cliCtx.GetFromAddress())
+			msg := types.NewMsgSubmitProposalWithClass(content, amount, cliCtx.GetFromAddress(), viper.GetString(FlagClass))
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -131,10 +137,92 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 
 	cmd.Flags().String(FlagTitle, "", "title of proposal")
 	cmd.Flags().String(FlagDescription, "", "description of proposal")
-	cmd.Flags().String(flagProposalType, "", "proposalType of proposal, types: text/parameter_change/software_upgrade")
+	cmd.Flags().String(flagProposalType, "", "proposalType of proposal, types: text")
 	cmd.Flags().String(FlagDeposit, "", "deposit of proposal")
 	cmd.Flags().String(FlagProposal, "", "proposal file path (if this path is given, other proposal flags are ignored)")
 
+	// the class flag applies to the proposal type subcommands as well
+	cmd.PersistentFlags().String(FlagClass, "", "class of the proposal, the class its content is routed to by default")
+
+	return cmd
+}
+
+// GetCmdSubmitExecProposal implements a command handler for submitting an exec
+// proposal transaction.
+func GetCmdSubmitExecProposal(cdc *codec.Codec) *cobra.Command {
+	authority := supply.NewModuleAddress(types.ModuleName)
+
+	cmd := &cobra.Command{
+		Use:   "exec [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal executing messages as the governance exec authority",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit an exec proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file. Once the proposal passes,
+its messages are executed in order on behalf of the exec authority %s,
+the governance module account. The messages can spend the coins sent to it, but
+not the deposits it holds in escrow. Either all of the messages succeed or none
+of their changes is applied.
+
+IMPORTANT: Every message must be signed by the exec authority only,
+and its type must be accepted by the application for exec proposals. The
+messages are only validated when the proposal is submitted, and executed once
+it passes.
+
+Example:
+$ %s tx gov submit-proposal exec <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Community Grant",
+  "description": "Send tokens held by the exec authority",
+  "messages": [
+    {
+      "type": "cosmos-sdk/MsgSend",
+      "value": {
+        "from_address": "%s",
+        "to_address": "<recipient_address>",
+        "amount": [
+          {
+            "denom": "stake",
+            "amount": "10000"
+          }
+        ]
+      }
+    }
+  ],
+  "deposit": [
+    {
+      "denom": "stake",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				authority, version.ClientName, authority,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := govutils.ParseExecProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewExecProposal(proposal.Title, proposal.Description, proposal.Messages)
+
+			msg := types.NewMsgSubmitProposalWithClass(content, proposal.Deposit, cliCtx.GetFromAddress(), viper.GetString(FlagClass))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
 	return cmd
 }
 
@@ -233,3 +321,120 @@ $ %s tx gov vote 1 yes --from mykey
 }
 
 // DONTCOVER
+
+// GetCmdWeightedVote implements creating a new weighted vote command.
+func GetCmdWeightedVote(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "weighted-vote [proposal-id] [weighted-options]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Vote for an active proposal, options: yes/no/no_with_veto/abstain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a vote for an active proposal splitting the voting power
+among several options. The weights of the options must add up to 1. You can
+find the proposal-id by running "%s query gov proposals".
+
+
+Example:
+$ %s tx gov weighted-vote 1 yes=0.7,abstain=0.3 --from mykey
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Get voting address
+			from := cliCtx.GetFromAddress()
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			// Find out which weighted vote options user chose
+			options, err := types.WeightedVoteOptionsFromString(govutils.NormalizeWeightedVoteOptions(args[1]))
+			if err != nil {
+				return err
+			}
+
+			// Build vote message and run basic validation
+			msg := types.NewMsgVoteWeighted(from, proposalID, options)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdSetVoteProxy implements setting the vote proxy of a delegator command.
+func GetCmdSetVoteProxy(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-vote-proxy [proxy-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Set the account voting on behalf of your delegations",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the account voting on behalf of your delegations. On the
+proposals you do not vote on, the vote of the proxy is counted for your
+delegations instead of the votes of your validators. The proxy replaces any
+previous one.
+
+Example:
+$ %s tx gov set-vote-proxy cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proxy, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetVoteProxy(cliCtx.GetFromAddress(), proxy)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdRemoveVoteProxy implements removing the vote proxy of a delegator command.
+func GetCmdRemoveVoteProxy(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "remove-vote-proxy",
+		Args:  cobra.NoArgs,
+		Short: "Remove the account voting on behalf of your delegations",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Remove the account voting on behalf of your delegations, so that
+the votes of your validators are counted again on the proposals you do not
+vote on.
+
+Example:
+$ %s tx gov remove-vote-proxy --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			msg := types.NewMsgRemoveVoteProxy(cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
@@ -5,9 +5,13 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/gov/client/rest"
 )
 
+// exec proposal handler
+var ExecProposalHandler = NewProposalHandler(cli.GetCmdSubmitExecProposal, rest.ExecProposalRESTHandler)
+
 // function to create the rest handler
 type RESTHandlerFn func(context.CLIContext) rest.ProposalRESTHandler
 
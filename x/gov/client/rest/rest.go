@@ -17,6 +17,7 @@ const (
 	RestProposalID     = "proposal-id"
 	RestDepositor      = "depositor"
 	RestVoter          = "voter"
+	RestDelegator      = "delegator"
 	RestProposalStatus = "status"
 	RestNumLimit       = "limit"
 )
@@ -39,9 +40,10 @@ type PostProposalReq struct {
 	BaseReq        rest.BaseReq   `json:"base_req" yaml:"base_req"`
 	Title          string         `json:"title" yaml:"title"`                     // Title of the proposal
 	Description    string         `json:"description" yaml:"description"`         // Description of the proposal
-	ProposalType   string         `json:"proposal_type" yaml:"proposal_type"`     // Type of proposal. Initial set {PlainTextProposal, SoftwareUpgradeProposal}
+	ProposalType   string         `json:"proposal_type" yaml:"proposal_type"`     // Type of proposal. Initial set {PlainTextProposal}
 	Proposer       sdk.AccAddress `json:"proposer" yaml:"proposer"`               // Address of the proposer
 	InitialDeposit sdk.Coins      `json:"initial_deposit" yaml:"initial_deposit"` // Coins to add to the proposal's deposit
+	Class          string         `json:"class" yaml:"class"`                     // Class of the proposal, the class its content is routed to if empty
 }
 
 // DepositReq defines the properties of a deposit request's body.
@@ -57,3 +59,21 @@ type VoteReq struct {
 	Voter   sdk.AccAddress `json:"voter" yaml:"voter"`   // address of the voter
 	Option  string         `json:"option" yaml:"option"` // option from OptionSet chosen by the voter
 }
+
+// WeightedVoteReq defines the properties of a weighted vote request's body.
+type WeightedVoteReq struct {
+	BaseReq rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Voter   sdk.AccAddress `json:"voter" yaml:"voter"`     // address of the voter
+	Options string         `json:"options" yaml:"options"` // weighted options chosen by the voter, e.g. "yes=0.7,abstain=0.3"
+}
+
+// SetVoteProxyReq defines the properties of a set vote proxy request's body.
+type SetVoteProxyReq struct {
+	BaseReq rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Proxy   sdk.AccAddress `json:"proxy" yaml:"proxy"` // address of the account voting on behalf of the delegator
+}
+
+// RemoveVoteProxyReq defines the properties of a remove vote proxy request's body.
+type RemoveVoteProxyReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+}
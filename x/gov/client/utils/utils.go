@@ -1,6 +1,53 @@
 package utils
 
-import "github.com/cosmos/cosmos-sdk/x/gov/types"
+import (
+	"io/ioutil"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+type (
+	// ExecProposalJSON defines an ExecProposal with a deposit used to parse exec
+	// proposals from a JSON file.
+	ExecProposalJSON struct {
+		Title       string    `json:"title" yaml:"title"`
+		Description string    `json:"description" yaml:"description"`
+		Messages    []sdk.Msg `json:"messages" yaml:"messages"`
+		Deposit     sdk.Coins `json:"deposit" yaml:"deposit"`
+	}
+
+	// ExecProposalReq defines an exec proposal request body.
+	ExecProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		Messages    []sdk.Msg      `json:"messages" yaml:"messages"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+		Class       string         `json:"class" yaml:"class"`
+	}
+)
+
+// ParseExecProposalJSON reads and parses an ExecProposalJSON from file.
+func ParseExecProposalJSON(cdc *codec.Codec, proposalFile string) (ExecProposalJSON, error) {
+	proposal := ExecProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
 
 // NormalizeVoteOption - normalize user specified vote option
 func NormalizeVoteOption(option string) string {
@@ -22,15 +69,26 @@ func NormalizeVoteOption(option string) string {
 	}
 }
 
+// NormalizeWeightedVoteOptions - normalize the options of user specified
+// weighted vote options, e.g. "yes=0.7,abstain=0.3"
+func NormalizeWeightedVoteOptions(options string) string {
+	weightedOptions := strings.Split(options, ",")
+	for i, weightedOption := range weightedOptions {
+		fields := strings.Split(strings.TrimSpace(weightedOption), "=")
+		if len(fields) == 2 {
+			fields[0] = NormalizeVoteOption(fields[0])
+		}
+		weightedOptions[i] = strings.Join(fields, "=")
+	}
+	return strings.Join(weightedOptions, ",")
+}
+
 //NormalizeProposalType - normalize user specified proposal type
 func NormalizeProposalType(proposalType string) string {
 	switch proposalType {
 	case "Text", "text":
 		return types.ProposalTypeText
 
-	case "SoftwareUpgrade", "software_upgrade":
-		return types.ProposalTypeSoftwareUpgrade
-
 	default:
 		return ""
 	}
@@ -40,6 +40,8 @@ func TestImportExportQueues(t *testing.T) {
 	require.True(t, proposal1.Status == StatusDepositPeriod)
 	require.True(t, proposal2.Status == StatusVotingPeriod)
 
+	input.keeper.SetVoteProxy(ctx, input.addrs[0], input.addrs[1])
+
 	genAccs := input.mApp.AccountKeeper.GetAllAccounts(ctx)
 
 	// Export the state and import it into a new Mock App
@@ -62,6 +64,10 @@ func TestImportExportQueues(t *testing.T) {
 	require.True(t, proposal1.Status == StatusDepositPeriod)
 	require.True(t, proposal2.Status == StatusVotingPeriod)
 
+	proxy, found := input2.keeper.GetVoteProxy(ctx2, input.addrs[0])
+	require.True(t, found)
+	require.Equal(t, input.addrs[1], proxy)
+
 	require.Equal(t, input2.keeper.GetDepositParams(ctx2).MinDeposit, input2.keeper.GetGovernanceAccount(ctx2).GetCoins())
 
 	// Run the endblocker. Check to make sure that proposal1 is removed from state, and proposal2 is finished VotingPeriod.
@@ -115,3 +121,21 @@ func TestEqualProposals(t *testing.T) {
 	require.Equal(t, state1, state2)
 	require.True(t, state1.Equal(state2))
 }
+
+func TestClassParamsNotSet(t *testing.T) {
+	input := getMockApp(t, 2, GenesisState{}, nil, ProposalHandler)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+
+	// chains upgraded in place never set the class params
+	store := ctx.KVStore(input.mApp.KeyParams)
+	store.Delete(append([]byte(DefaultParamspace+"/"), ParamStoreKeyClassParams...))
+
+	require.Equal(t, DefaultClassParams(), input.keeper.GetClassParams(ctx))
+	require.Equal(t, DefaultClassParams(), ExportGenesis(ctx, input.keeper).ClassParams)
+
+	_, err := input.keeper.SubmitProposalWithClass(ctx, keep.TestProposal, ExpeditedProposalClass)
+	require.NoError(t, err)
+}
@@ -19,3 +19,27 @@ func TestInvalidMsg(t *testing.T) {
 	require.False(t, res.IsOK())
 	require.True(t, strings.Contains(res.Log, "unrecognized gov message type"))
 }
+
+func TestHandleMsgVoteProxy(t *testing.T) {
+	input := getMockApp(t, 2, GenesisState{}, nil, ProposalHandler)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+	h := NewHandler(input.keeper)
+
+	res := h(ctx, NewMsgRemoveVoteProxy(input.addrs[0]))
+	require.False(t, res.IsOK())
+
+	res = h(ctx, NewMsgSetVoteProxy(input.addrs[0], input.addrs[1]))
+	require.True(t, res.IsOK(), res.Log)
+	proxy, found := input.keeper.GetVoteProxy(ctx, input.addrs[0])
+	require.True(t, found)
+	require.Equal(t, input.addrs[1], proxy)
+
+	res = h(ctx, NewMsgRemoveVoteProxy(input.addrs[0]))
+	require.True(t, res.IsOK(), res.Log)
+	_, found = input.keeper.GetVoteProxy(ctx, input.addrs[0])
+	require.False(t, found)
+}
@@ -44,6 +44,17 @@ func (keeper Keeper) GetDeposits(ctx sdk.Context, proposalID uint64) (deposits t
 	return
 }
 
+// GetDepositsInEscrow returns the sum of the deposits on all the proposals,
+// which the governance module account holds in escrow
+func (keeper Keeper) GetDepositsInEscrow(ctx sdk.Context) sdk.Coins {
+	var deposits sdk.Coins
+	keeper.IterateAllDeposits(ctx, func(deposit types.Deposit) bool {
+		deposits = deposits.Add(deposit.Amount)
+		return false
+	})
+	return deposits
+}
+
 // DeleteDeposits deletes all the deposits on a specific proposal without refunding them
 func (keeper Keeper) DeleteDeposits(ctx sdk.Context, proposalID uint64) {
 	store := ctx.KVStore(keeper.storeKey)
@@ -117,7 +128,7 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 
 	// Check if deposit has provided sufficient total funds to transition the proposal into the voting period
 	activatedVotingPeriod := false
-	if proposal.Status == types.StatusDepositPeriod && proposal.TotalDeposit.IsAllGTE(keeper.GetDepositParams(ctx).MinDeposit) {
+	if proposal.Status == types.StatusDepositPeriod && proposal.TotalDeposit.IsAllGTE(keeper.GetProposalClass(ctx, proposal.Class).MinDeposit) {
 		keeper.activateVotingPeriod(ctx, proposal)
 		activatedVotingPeriod = true
 	}
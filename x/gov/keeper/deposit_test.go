@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func TestDeposits(t *testing.T) {
@@ -96,3 +97,20 @@ func TestDeposits(t *testing.T) {
 	require.Equal(t, addr0Initial, ak.GetAccount(ctx, TestAddrs[0]).GetCoins())
 	require.Equal(t, addr1Initial, ak.GetAccount(ctx, TestAddrs[1]).GetCoins())
 }
+
+func TestDepositsProposalClass(t *testing.T) {
+	ctx, _, keeper, _, _ := createTestInput(t, false, 100)
+
+	proposal, err := keeper.SubmitProposalWithClass(ctx, TestProposal, types.ExpeditedProposalClass)
+	require.NoError(t, err)
+
+	// the minimum deposit of the default class is not enough
+	err, votingStarted := keeper.AddDeposit(ctx, proposal.ProposalID, TestAddrs[0], types.DefaultDepositParams().MinDeposit)
+	require.NoError(t, err)
+	require.False(t, votingStarted)
+
+	fortyStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(40)))
+	err, votingStarted = keeper.AddDeposit(ctx, proposal.ProposalID, TestAddrs[1], fortyStake)
+	require.NoError(t, err)
+	require.True(t, votingStarted)
+}
@@ -21,19 +21,15 @@ func AllInvariants(keeper Keeper) sdk.Invariant {
 	}
 }
 
-// ModuleAccountInvariant checks that the module account coins reflects the sum of
-// deposit amounts held on store
+// ModuleAccountInvariant checks that the module account coins cover the sum of
+// deposit amounts held on store. The module account may hold more coins, which
+// governance spends through exec proposals.
 func ModuleAccountInvariant(keeper Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
-		var expectedDeposits sdk.Coins
-
-		keeper.IterateAllDeposits(ctx, func(deposit types.Deposit) bool {
-			expectedDeposits = expectedDeposits.Add(deposit.Amount)
-			return false
-		})
+		expectedDeposits := keeper.GetDepositsInEscrow(ctx)
 
 		macc := keeper.GetGovernanceAccount(ctx)
-		broken := !macc.GetCoins().IsEqual(expectedDeposits)
+		broken := !macc.GetCoins().IsAllGTE(expectedDeposits)
 
 		return sdk.FormatInvariant(types.ModuleName, "deposits",
 			fmt.Sprintf("\tgov ModuleAccount coins: %s\n\tsum of deposit amounts:  %s\n",
@@ -26,6 +26,18 @@ func (keeper Keeper) GetTallyParams(ctx sdk.Context) types.TallyParams {
 	return tallyParams
 }
 
+// GetClassParams returns the current ClassParams from the global param store,
+// or the default ClassParams on chains upgraded in place which never set them
+func (keeper Keeper) GetClassParams(ctx sdk.Context) types.ClassParams {
+	if !keeper.paramSpace.Has(ctx, types.ParamStoreKeyClassParams) {
+		return types.DefaultClassParams()
+	}
+
+	var classParams types.ClassParams
+	keeper.paramSpace.Get(ctx, types.ParamStoreKeyClassParams, &classParams)
+	return classParams
+}
+
 // SetDepositParams sets DepositParams to the global param store
 func (keeper Keeper) SetDepositParams(ctx sdk.Context, depositParams types.DepositParams) {
 	keeper.paramSpace.Set(ctx, types.ParamStoreKeyDepositParams, &depositParams)
@@ -40,3 +52,21 @@ func (keeper Keeper) SetVotingParams(ctx sdk.Context, votingParams types.VotingP
 func (keeper Keeper) SetTallyParams(ctx sdk.Context, tallyParams types.TallyParams) {
 	keeper.paramSpace.Set(ctx, types.ParamStoreKeyTallyParams, &tallyParams)
 }
+
+// SetClassParams sets ClassParams to the global param store
+func (keeper Keeper) SetClassParams(ctx sdk.Context, classParams types.ClassParams) {
+	keeper.paramSpace.Set(ctx, types.ParamStoreKeyClassParams, &classParams)
+}
+
+// GetProposalClass returns the proposal class with the given name. The default
+// class is returned for an empty name, and for a class which no longer exists
+// in the class params.
+func (keeper Keeper) GetProposalClass(ctx sdk.Context, name string) types.ProposalClass {
+	if name != types.DefaultProposalClass {
+		if class, ok := keeper.GetClassParams(ctx).GetClass(name); ok {
+			return class
+		}
+	}
+
+	return types.NewDefaultProposalClass(keeper.GetDepositParams(ctx), keeper.GetVotingParams(ctx), keeper.GetTallyParams(ctx))
+}
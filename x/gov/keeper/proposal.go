@@ -7,16 +7,31 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
-// SubmitProposal create new proposal given a content
+// SubmitProposal create new proposal given a content. The proposal belongs to
+// the class its content is routed to.
 func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (types.Proposal, sdk.Error) {
+	return keeper.SubmitProposalWithClass(ctx, content, "")
+}
+
+// SubmitProposalWithClass create new proposal given a content and the name of
+// its class. An empty name selects the class the content is routed to, any
+// other class must be at least as strict as that one.
+func (keeper Keeper) SubmitProposalWithClass(ctx sdk.Context, content types.Content, class string) (types.Proposal, sdk.Error) {
 	if !keeper.router.HasRoute(content.ProposalRoute()) {
 		return types.Proposal{}, types.ErrNoProposalHandlerExists(keeper.codespace, content)
 	}
 
+	class, err := keeper.resolveProposalClass(ctx, content, class)
+	if err != nil {
+		return types.Proposal{}, err
+	}
+
 	// Execute the proposal content in a cache-wrapped context to validate the
 	// actual parameter changes before the proposal proceeds through the
-	// governance process. State is not persisted.
+	// governance process. State is not persisted, and neither are the events
+	// emitted by the handler.
 	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = types.WithSubmission(cacheCtx.WithEventManager(sdk.NewEventManager()))
 	handler := keeper.router.GetRoute(content.ProposalRoute())
 	if err := handler(cacheCtx, content); err != nil {
 		return types.Proposal{}, types.ErrInvalidProposalContent(keeper.codespace, err.Result().Log)
@@ -30,7 +45,7 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (typ
 	submitTime := ctx.BlockHeader().Time
 	depositPeriod := keeper.GetDepositParams(ctx).MaxDepositPeriod
 
-	proposal := types.NewProposal(content, proposalID, submitTime, submitTime.Add(depositPeriod))
+	proposal := types.NewProposal(content, proposalID, class, submitTime, submitTime.Add(depositPeriod))
 
 	keeper.SetProposal(ctx, proposal)
 	keeper.InsertInactiveProposalQueue(ctx, proposalID, proposal.DepositEndTime)
@@ -165,9 +180,30 @@ func (keeper Keeper) SetProposalID(ctx sdk.Context, proposalID uint64) {
 	store.Set(types.ProposalIDKey, types.GetProposalIDBytes(proposalID))
 }
 
+// resolveProposalClass returns the name of the class of a new proposal given
+// its content and the requested class
+func (keeper Keeper) resolveProposalClass(ctx sdk.Context, content types.Content, class string) (string, sdk.Error) {
+	classParams := keeper.GetClassParams(ctx)
+	routed := classParams.Route(content)
+	if class == "" || class == routed {
+		return routed, nil
+	}
+
+	if _, ok := classParams.GetClass(class); !ok && class != types.DefaultProposalClass {
+		return "", types.ErrInvalidProposalClass(keeper.codespace, fmt.Sprintf("unknown class %s", class))
+	}
+
+	if !keeper.GetProposalClass(ctx, class).IsAtLeastAsStrictAs(keeper.GetProposalClass(ctx, routed)) {
+		return "", types.ErrInvalidProposalClass(keeper.codespace,
+			fmt.Sprintf("class %s is less strict than class %s of the proposal content", class, routed))
+	}
+
+	return class, nil
+}
+
 func (keeper Keeper) activateVotingPeriod(ctx sdk.Context, proposal types.Proposal) {
 	proposal.VotingStartTime = ctx.BlockHeader().Time
-	votingPeriod := keeper.GetVotingParams(ctx).VotingPeriod
+	votingPeriod := keeper.GetProposalClass(ctx, proposal.Class).VotingPeriod
 	proposal.VotingEndTime = proposal.VotingStartTime.Add(votingPeriod)
 	proposal.Status = types.StatusVotingPeriod
 	keeper.SetProposal(ctx, proposal)
@@ -84,6 +84,13 @@ func (invalidProposalValidation) ValidateBasic() sdk.Error {
 	return sdk.NewError(sdk.CodespaceUndefined, sdk.CodeInternal, "")
 }
 
+type amountProposal struct {
+	validProposal
+	Amount sdk.Coins
+}
+
+func (ap amountProposal) GetAmount() sdk.Coins { return ap.Amount }
+
 func registerTestCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(validProposal{}, "test/validproposal", nil)
 	cdc.RegisterConcrete(invalidProposalTitle1{}, "test/invalidproposalt1", nil)
@@ -92,6 +99,21 @@ func registerTestCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(invalidProposalDesc2{}, "test/invalidproposald2", nil)
 	cdc.RegisterConcrete(invalidProposalRoute{}, "test/invalidproposalr", nil)
 	cdc.RegisterConcrete(invalidProposalValidation{}, "test/invalidproposalv", nil)
+	cdc.RegisterConcrete(amountProposal{}, "test/amountproposal", nil)
+}
+
+// setSupermajorityClass adds a class needing a two-thirds majority, applying
+// to the text proposals with an amount of at least 100stake
+func setSupermajorityClass(ctx sdk.Context, keeper Keeper) {
+	classParams := types.DefaultClassParams()
+	classParams.Classes = append(classParams.Classes, types.NewProposalClass(
+		"supermajority", types.DefaultDepositParams().MinDeposit, types.DefaultPeriod,
+		types.DefaultQuorum, sdk.NewDecWithPrec(667, 3), types.DefaultVeto,
+	))
+	classParams.Routes = append(classParams.Routes, types.NewClassRoute(
+		types.ProposalTypeText, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), "supermajority",
+	))
+	keeper.SetClassParams(ctx, classParams)
 }
 
 func TestSubmitProposal(t *testing.T) {
@@ -120,3 +142,53 @@ func TestSubmitProposal(t *testing.T) {
 		require.Equal(t, tc.expectedErr, err, "unexpected type of error: %s", err)
 	}
 }
+
+func TestSubmitProposalClass(t *testing.T) {
+	ctx, _, keeper, _, _ := createTestInput(t, false, 100)
+
+	registerTestCodec(keeper.cdc)
+	setSupermajorityClass(ctx, keeper)
+
+	small := amountProposal{Amount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 99))}
+	large := amountProposal{Amount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))}
+
+	testCases := []struct {
+		name       string
+		content    types.Content
+		class      string
+		expClass   string
+		expectPass bool
+	}{
+		{"default class", validProposal{}, "", types.DefaultProposalClass, true},
+		{"amount below route minimum", small, "", types.DefaultProposalClass, true},
+		{"amount above route minimum", large, "", "supermajority", true},
+		{"stricter class", validProposal{}, types.ExpeditedProposalClass, types.ExpeditedProposalClass, true},
+		{"stricter class than routed one", large, types.ExpeditedProposalClass, types.ExpeditedProposalClass, true},
+		{"routed class", large, "supermajority", "supermajority", true},
+		{"less strict class than routed one", large, types.DefaultProposalClass, "", false},
+		{"unknown class", validProposal{}, "unknown", "", false},
+	}
+
+	for _, tc := range testCases {
+		proposal, err := keeper.SubmitProposalWithClass(ctx, tc.content, tc.class)
+		if tc.expectPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.expClass, proposal.Class, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestActivateVotingPeriodClass(t *testing.T) {
+	ctx, _, keeper, _, _ := createTestInput(t, false, 100)
+
+	proposal, err := keeper.SubmitProposalWithClass(ctx, TestProposal, types.ExpeditedProposalClass)
+	require.NoError(t, err)
+
+	keeper.activateVotingPeriod(ctx, proposal)
+
+	proposal, ok := keeper.GetProposal(ctx, proposal.ProposalID)
+	require.True(t, ok)
+	require.Equal(t, ctx.BlockHeader().Time.Add(types.DefaultPeriod/2), proposal.VotingEndTime)
+}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// GetVoteProxy gets the vote proxy of a delegator
+func (keeper Keeper) GetVoteProxy(ctx sdk.Context, delegatorAddr sdk.AccAddress) (proxyAddr sdk.AccAddress, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.VoteProxyKey(delegatorAddr))
+	if bz == nil {
+		return nil, false
+	}
+
+	return sdk.AccAddress(bz), true
+}
+
+// SetVoteProxy sets the vote proxy of a delegator, replacing its previous one
+func (keeper Keeper) SetVoteProxy(ctx sdk.Context, delegatorAddr, proxyAddr sdk.AccAddress) {
+	keeper.deleteVoteProxy(ctx, delegatorAddr)
+
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.VoteProxyKey(delegatorAddr), proxyAddr.Bytes())
+	store.Set(types.ProxyDelegatorKey(proxyAddr, delegatorAddr), []byte{})
+}
+
+// RemoveVoteProxy removes the vote proxy of a delegator
+func (keeper Keeper) RemoveVoteProxy(ctx sdk.Context, delegatorAddr sdk.AccAddress) sdk.Error {
+	if !keeper.deleteVoteProxy(ctx, delegatorAddr) {
+		return types.ErrVoteProxyNotFound(keeper.codespace, delegatorAddr)
+	}
+
+	return nil
+}
+
+// deleteVoteProxy deletes the vote proxy of a delegator and returns whether it
+// had one
+func (keeper Keeper) deleteVoteProxy(ctx sdk.Context, delegatorAddr sdk.AccAddress) bool {
+	proxyAddr, found := keeper.GetVoteProxy(ctx, delegatorAddr)
+	if !found {
+		return false
+	}
+
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.VoteProxyKey(delegatorAddr))
+	store.Delete(types.ProxyDelegatorKey(proxyAddr, delegatorAddr))
+	return true
+}
+
+// GetAllVoteProxies returns all the vote proxies from the store
+func (keeper Keeper) GetAllVoteProxies(ctx sdk.Context) (proxies types.VoteProxies) {
+	keeper.IterateAllVoteProxies(ctx, func(proxy types.VoteProxy) bool {
+		proxies = append(proxies, proxy)
+		return false
+	})
+	return
+}
+
+// IterateAllVoteProxies iterates over all the stored vote proxies and performs
+// a callback function
+func (keeper Keeper) IterateAllVoteProxies(ctx sdk.Context, cb func(proxy types.VoteProxy) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VoteProxyKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		delegatorAddr := types.SplitVoteProxyKey(iterator.Key())
+		proxy := types.NewVoteProxy(delegatorAddr, sdk.AccAddress(iterator.Value()))
+
+		if cb(proxy) {
+			break
+		}
+	}
+}
+
+// IterateProxyDelegators iterates over the delegators who chose an account as
+// their vote proxy and performs a callback function
+func (keeper Keeper) IterateProxyDelegators(ctx sdk.Context, proxyAddr sdk.AccAddress, cb func(delegatorAddr sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ProxyDelegatorsKey(proxyAddr))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		_, delegatorAddr := types.SplitProxyDelegatorKey(iterator.Key())
+
+		if cb(delegatorAddr) {
+			break
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestVoteProxies(t *testing.T) {
+	ctx, _, keeper, _, _ := createTestInput(t, false, 100)
+
+	proxyDelegators := func(proxyAddr sdk.AccAddress) (delegators []sdk.AccAddress) {
+		keeper.IterateProxyDelegators(ctx, proxyAddr, func(delegatorAddr sdk.AccAddress) bool {
+			delegators = append(delegators, delegatorAddr)
+			return false
+		})
+		return
+	}
+
+	_, found := keeper.GetVoteProxy(ctx, TestAddrs[0])
+	require.False(t, found)
+	require.Error(t, keeper.RemoveVoteProxy(ctx, TestAddrs[0]))
+
+	// Test setting proxies
+	keeper.SetVoteProxy(ctx, TestAddrs[0], TestAddrs[1])
+	keeper.SetVoteProxy(ctx, TestAddrs[2], TestAddrs[1])
+	proxy, found := keeper.GetVoteProxy(ctx, TestAddrs[0])
+	require.True(t, found)
+	require.Equal(t, TestAddrs[1], proxy)
+	require.Len(t, proxyDelegators(TestAddrs[1]), 2)
+
+	// Test change of proxy
+	keeper.SetVoteProxy(ctx, TestAddrs[0], TestAddrs[3])
+	proxy, found = keeper.GetVoteProxy(ctx, TestAddrs[0])
+	require.True(t, found)
+	require.Equal(t, TestAddrs[3], proxy)
+	require.Equal(t, []sdk.AccAddress{TestAddrs[2]}, proxyDelegators(TestAddrs[1]))
+	require.Equal(t, []sdk.AccAddress{TestAddrs[0]}, proxyDelegators(TestAddrs[3]))
+
+	proxies := keeper.GetAllVoteProxies(ctx)
+	require.Len(t, proxies, 2)
+	require.Contains(t, proxies, types.NewVoteProxy(TestAddrs[0], TestAddrs[3]))
+	require.Contains(t, proxies, types.NewVoteProxy(TestAddrs[2], TestAddrs[1]))
+
+	// Test removal of proxy
+	require.NoError(t, keeper.RemoveVoteProxy(ctx, TestAddrs[0]))
+	_, found = keeper.GetVoteProxy(ctx, TestAddrs[0])
+	require.False(t, found)
+	require.Empty(t, proxyDelegators(TestAddrs[3]))
+	require.Len(t, keeper.GetAllVoteProxies(ctx), 1)
+}
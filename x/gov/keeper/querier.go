@@ -30,6 +30,12 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryVote(ctx, path[1:], req, keeper)
 		case types.QueryTally:
 			return queryTally(ctx, path[1:], req, keeper)
+		case types.QueryVoterPowers:
+			return queryVoterPowers(ctx, path[1:], req, keeper)
+		case types.QueryVoterPower:
+			return queryVoterPower(ctx, path[1:], req, keeper)
+		case types.QueryVoteProxy:
+			return queryVoteProxy(ctx, path[1:], req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown gov query endpoint")
 		}
@@ -56,6 +62,12 @@ func queryParams(ctx sdk.Context, path []string, req abci.RequestQuery, keeper K
 			return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
 		}
 		return bz, nil
+	case types.ParamClasses:
+		bz, err := codec.MarshalJSONIndent(keeper.cdc, keeper.GetClassParams(ctx))
+		if err != nil {
+			return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+		}
+		return bz, nil
 	default:
 		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("%s is not a valid query request path", req.Path))
 	}
@@ -198,3 +210,89 @@ func queryProposals(ctx sdk.Context, path []string, req abci.RequestQuery, keepe
 	}
 	return bz, nil
 }
+
+// nolint: unparam
+func queryVoterPowers(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryProposalParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	voterPowers, sdkErr := getVoterPowers(ctx, keeper, params.ProposalID)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, voterPowers)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+// nolint: unparam
+func queryVoterPower(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryVoteParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	voterPowers, sdkErr := getVoterPowers(ctx, keeper, params.ProposalID)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	for _, voterPower := range voterPowers {
+		if !voterPower.Voter.Equals(params.Voter) {
+			continue
+		}
+
+		bz, err := codec.MarshalJSONIndent(keeper.cdc, voterPower)
+		if err != nil {
+			return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+		}
+		return bz, nil
+	}
+
+	return nil, sdk.ErrUnknownRequest(fmt.Sprintf("address %s did not vote on proposal %d", params.Voter, params.ProposalID))
+}
+
+// getVoterPowers returns the voter powers of a proposal, whose votes are only
+// stored during its voting period
+func getVoterPowers(ctx sdk.Context, keeper Keeper, proposalID uint64) (types.VoterPowers, sdk.Error) {
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok {
+		return nil, types.ErrUnknownProposal(types.DefaultCodespace, proposalID)
+	}
+
+	switch proposal.Status {
+	case types.StatusDepositPeriod:
+		return types.VoterPowers{}, nil
+	case types.StatusVotingPeriod:
+		return keeper.GetVoterPowers(ctx, proposalID), nil
+	default:
+		return nil, types.ErrInactiveProposal(types.DefaultCodespace, proposalID)
+	}
+}
+
+// nolint: unparam
+func queryVoteProxy(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryVoteProxyParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	proxy, found := keeper.GetVoteProxy(ctx, params.Delegator)
+	if !found {
+		return nil, types.ErrVoteProxyNotFound(types.DefaultCodespace, params.Delegator)
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, types.NewVoteProxy(params.Delegator, proxy))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
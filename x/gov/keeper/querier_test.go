@@ -225,13 +225,13 @@ func TestQueries(t *testing.T) {
 	require.Equal(t, proposal3, proposals[1])
 
 	// Addrs[0] votes on proposals #2 & #3
-	vote1 := types.NewVote(proposal2.ProposalID, TestAddrs[0], types.OptionYes)
-	vote2 := types.NewVote(proposal3.ProposalID, TestAddrs[0], types.OptionYes)
+	vote1 := types.NewVote(proposal2.ProposalID, TestAddrs[0], types.NewNonSplitVoteOption(types.OptionYes))
+	vote2 := types.NewVote(proposal3.ProposalID, TestAddrs[0], types.NewNonSplitVoteOption(types.OptionYes))
 	keeper.SetVote(ctx, vote1)
 	keeper.SetVote(ctx, vote2)
 
 	// Addrs[1] votes on proposal #3
-	vote3 := types.NewVote(proposal3.ProposalID, TestAddrs[1], types.OptionYes)
+	vote3 := types.NewVote(proposal3.ProposalID, TestAddrs[1], types.NewNonSplitVoteOption(types.OptionYes))
 	keeper.SetVote(ctx, vote3)
 
 	// Test query voted by TestAddrs[0]
@@ -276,3 +276,74 @@ func TestQueries(t *testing.T) {
 	proposals = getQueriedProposals(t, ctx, keeper.cdc, querier, TestAddrs[0], TestAddrs[0], types.StatusNil, 0)
 	require.Equal(t, proposal2.ProposalID, proposals[0].ProposalID)
 }
+
+func TestQueryVoterPowers(t *testing.T) {
+	ctx, _, keeper, sk, _ := createTestInput(t, false, 1000)
+	createValidators(ctx, sk, []int64{5, 6, 7})
+	querier := NewQuerier(keeper)
+
+	keeper.SetVoteProxy(ctx, valAccAddr3, TestAddrs[0])
+
+	proposal, err := keeper.SubmitProposal(ctx, TestProposal)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+
+	// no voting power is counted before the voting period
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryVoterPowers}, "/"),
+		Data: keeper.cdc.MustMarshalJSON(types.NewQueryProposalParams(proposalID)),
+	}
+	bz, err := querier(ctx, []string{types.QueryVoterPowers}, query)
+	require.NoError(t, err)
+
+	var voterPowers types.VoterPowers
+	require.NoError(t, keeper.cdc.UnmarshalJSON(bz, &voterPowers))
+	require.Empty(t, voterPowers)
+
+	proposal.Status = types.StatusVotingPeriod
+	keeper.SetProposal(ctx, proposal)
+
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr1, types.OptionYes))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, TestAddrs[0], types.OptionNo))
+
+	bz, err = querier(ctx, []string{types.QueryVoterPowers}, query)
+	require.NoError(t, err)
+	require.NoError(t, keeper.cdc.UnmarshalJSON(bz, &voterPowers))
+	require.Len(t, voterPowers, 2)
+
+	// the proxy votes with the self-delegation of the third validator
+	query = abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryVoterPower}, "/"),
+		Data: keeper.cdc.MustMarshalJSON(types.NewQueryVoteParams(proposalID, TestAddrs[0])),
+	}
+	bz, err = querier(ctx, []string{types.QueryVoterPower}, query)
+	require.NoError(t, err)
+
+	var voterPower types.VoterPower
+	require.NoError(t, keeper.cdc.UnmarshalJSON(bz, &voterPower))
+	require.Equal(t, TestAddrs[0], voterPower.Voter)
+	require.Equal(t, types.NewNonSplitVoteOption(types.OptionNo), voterPower.Options)
+	require.Equal(t, sdk.TokensFromConsensusPower(7).ToDec(), voterPower.Power)
+	require.Equal(t, []sdk.AccAddress{valAccAddr3}, voterPower.ProxiedDelegators)
+
+	// query the power of an address which did not vote
+	query.Data = keeper.cdc.MustMarshalJSON(types.NewQueryVoteParams(proposalID, TestAddrs[1]))
+	_, err = querier(ctx, []string{types.QueryVoterPower}, query)
+	require.Error(t, err)
+
+	// query the vote proxy
+	query = abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryVoteProxy}, "/"),
+		Data: keeper.cdc.MustMarshalJSON(types.NewQueryVoteProxyParams(valAccAddr3)),
+	}
+	bz, err = querier(ctx, []string{types.QueryVoteProxy}, query)
+	require.NoError(t, err)
+
+	var proxy types.VoteProxy
+	require.NoError(t, keeper.cdc.UnmarshalJSON(bz, &proxy))
+	require.Equal(t, types.NewVoteProxy(valAccAddr3, TestAddrs[0]), proxy)
+
+	query.Data = keeper.cdc.MustMarshalJSON(types.NewQueryVoteProxyParams(TestAddrs[1]))
+	_, err = querier(ctx, []string{types.QueryVoteProxy}, query)
+	require.Error(t, err)
+}
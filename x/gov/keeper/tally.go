@@ -11,13 +11,67 @@ import (
 // Tally iterates over the votes and updates the tally of a proposal based on the voting power of the
 // voters
 func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes bool, burnDeposits bool, tallyResults types.TallyResult) {
-	results := make(map[types.VoteOption]sdk.Dec)
+	results, totalVotingPower, voterPowers := keeper.tallyVotes(ctx, proposal.ProposalID)
+
+	for _, voterPower := range voterPowers {
+		keeper.deleteVote(ctx, proposal.ProposalID, voterPower.Voter)
+	}
+
+	tallyParams := keeper.GetProposalClass(ctx, proposal.Class).TallyParams()
+	tallyResults = types.NewTallyResultFromMap(results)
+
+	// TODO: Upgrade the spec to cover all of these cases & remove pseudocode.
+	// If there is no staked coins, the proposal fails
+	if keeper.sk.TotalBondedTokens(ctx).IsZero() {
+		return false, false, tallyResults
+	}
+
+	// If there is not enough quorum of votes, the proposal fails
+	percentVoting := totalVotingPower.Quo(keeper.sk.TotalBondedTokens(ctx).ToDec())
+	if percentVoting.LT(tallyParams.Quorum) {
+		return false, true, tallyResults
+	}
+
+	// If no one votes (everyone abstains), proposal fails
+	if totalVotingPower.Sub(results[types.OptionAbstain]).Equal(sdk.ZeroDec()) {
+		return false, false, tallyResults
+	}
+
+	// If more than 1/3 of voters veto, proposal fails
+	if results[types.OptionNoWithVeto].Quo(totalVotingPower).GT(tallyParams.Veto) {
+		return false, true, tallyResults
+	}
+
+	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
+	if results[types.OptionYes].Quo(totalVotingPower.Sub(results[types.OptionAbstain])).GT(tallyParams.Threshold) {
+		return true, false, tallyResults
+	}
+
+	// If more than 1/2 of non-abstaining voters vote No, proposal fails
+	return false, false, tallyResults
+}
+
+// GetVoterPowers returns how the voting power of each voter of a proposal is
+// counted in its tally, in the order of the votes
+func (keeper Keeper) GetVoterPowers(ctx sdk.Context, proposalID uint64) types.VoterPowers {
+	_, _, voterPowers := keeper.tallyVotes(ctx, proposalID)
+	return voterPowers
+}
+
+// tallyVotes counts the voting power of the voters of a proposal for the
+// options of their votes. The delegations of a delegator are counted for the
+// vote of the delegator, or if the delegator did not vote for the vote of its
+// vote proxy, or else for the vote of the validator they are delegated to.
+func (keeper Keeper) tallyVotes(ctx sdk.Context, proposalID uint64) (
+	results map[types.VoteOption]sdk.Dec, totalVotingPower sdk.Dec, voterPowers types.VoterPowers) {
+
+	results = make(map[types.VoteOption]sdk.Dec)
 	results[types.OptionYes] = sdk.ZeroDec()
 	results[types.OptionAbstain] = sdk.ZeroDec()
 	results[types.OptionNo] = sdk.ZeroDec()
 	results[types.OptionNoWithVeto] = sdk.ZeroDec()
 
-	totalVotingPower := sdk.ZeroDec()
+	totalVotingPower = sdk.ZeroDec()
 	currValidators := make(map[string]types.ValidatorGovInfo)
 
 	// fetch all the bonded validators, insert them into currValidators
@@ -27,46 +81,83 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 			validator.GetBondedTokens(),
 			validator.GetDelegatorShares(),
 			sdk.ZeroDec(),
-			types.OptionEmpty,
+			nil,
 		)
 
 		return false
 	})
 
-	keeper.IterateVotes(ctx, proposal.ProposalID, func(vote types.Vote) bool {
+	voted := make(map[string]bool)
+	keeper.IterateVotes(ctx, proposalID, func(vote types.Vote) bool {
+		voted[vote.Voter.String()] = true
+		voterPowers = append(voterPowers, types.NewVoterPower(vote.Voter, vote.Options))
+		return false
+	})
+
+	addVotingPower := func(options types.WeightedVoteOptions, votingPower sdk.Dec) {
+		for _, option := range options {
+			results[option.Option] = results[option.Option].Add(votingPower.Mul(option.Weight))
+		}
+		totalVotingPower = totalVotingPower.Add(votingPower)
+	}
+
+	// countDelegations counts the voting power of the delegations of a
+	// delegator for a vote and deducts them from the delegated-to validators
+	countDelegations := func(delegatorAddr sdk.AccAddress, options types.WeightedVoteOptions) sdk.Dec {
+		delegationsPower := sdk.ZeroDec()
+		keeper.sk.IterateDelegations(ctx, delegatorAddr, func(index int64, delegation exported.DelegationI) (stop bool) {
+			valAddrStr := delegation.GetValidatorAddr().String()
+
+			if val, ok := currValidators[valAddrStr]; ok {
+				val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
+				currValidators[valAddrStr] = val
+
+				delegatorShare := delegation.GetShares().Quo(val.DelegatorShares)
+				votingPower := delegatorShare.MulInt(val.BondedTokens)
+
+				addVotingPower(options, votingPower)
+				delegationsPower = delegationsPower.Add(votingPower)
+			}
+
+			return false
+		})
+
+		return delegationsPower
+	}
+
+	voterIndexes := make(map[string]int)
+	for i, voterPower := range voterPowers {
+		voterIndexes[voterPower.Voter.String()] = i
+
 		// if validator, just record it in the map
 		// if delegator tally voting power
-		valAddrStr := sdk.ValAddress(vote.Voter).String()
+		valAddrStr := sdk.ValAddress(voterPower.Voter).String()
 		if val, ok := currValidators[valAddrStr]; ok {
-			val.Vote = vote.Option
+			val.Vote = voterPower.Options
 			currValidators[valAddrStr] = val
 		} else {
-			// iterate over all delegations from voter, deduct from any delegated-to validators
-			keeper.sk.IterateDelegations(ctx, vote.Voter, func(index int64, delegation exported.DelegationI) (stop bool) {
-				valAddrStr := delegation.GetValidatorAddr().String()
-
-				if val, ok := currValidators[valAddrStr]; ok {
-					val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
-					currValidators[valAddrStr] = val
-
-					delegatorShare := delegation.GetShares().Quo(val.DelegatorShares)
-					votingPower := delegatorShare.MulInt(val.BondedTokens)
-
-					results[vote.Option] = results[vote.Option].Add(votingPower)
-					totalVotingPower = totalVotingPower.Add(votingPower)
-				}
+			voterPower.DelegationPower = countDelegations(voterPower.Voter, voterPower.Options)
+		}
 
+		// the delegations of the delegators who did not vote are counted for
+		// the vote of their proxy
+		keeper.IterateProxyDelegators(ctx, voterPower.Voter, func(delegatorAddr sdk.AccAddress) (stop bool) {
+			if voted[delegatorAddr.String()] {
 				return false
-			})
-		}
+			}
 
-		keeper.deleteVote(ctx, vote.ProposalID, vote.Voter)
-		return false
-	})
+			proxiedPower := countDelegations(delegatorAddr, voterPower.Options)
+			voterPower.ProxiedPower = voterPower.ProxiedPower.Add(proxiedPower)
+			voterPower.ProxiedDelegators = append(voterPower.ProxiedDelegators, delegatorAddr)
+			return false
+		})
+
+		voterPowers[i] = voterPower
+	}
 
 	// iterate over the validators again to tally their voting power
 	for _, val := range currValidators {
-		if val.Vote == types.OptionEmpty {
+		if len(val.Vote) == 0 {
 			continue
 		}
 
@@ -74,40 +165,15 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 		fractionAfterDeductions := sharesAfterDeductions.Quo(val.DelegatorShares)
 		votingPower := fractionAfterDeductions.MulInt(val.BondedTokens)
 
-		results[val.Vote] = results[val.Vote].Add(votingPower)
-		totalVotingPower = totalVotingPower.Add(votingPower)
-	}
-
-	tallyParams := keeper.GetTallyParams(ctx)
-	tallyResults = types.NewTallyResultFromMap(results)
-
-	// TODO: Upgrade the spec to cover all of these cases & remove pseudocode.
-	// If there is no staked coins, the proposal fails
-	if keeper.sk.TotalBondedTokens(ctx).IsZero() {
-		return false, false, tallyResults
-	}
-
-	// If there is not enough quorum of votes, the proposal fails
-	percentVoting := totalVotingPower.Quo(keeper.sk.TotalBondedTokens(ctx).ToDec())
-	if percentVoting.LT(tallyParams.Quorum) {
-		return false, true, tallyResults
-	}
+		addVotingPower(val.Vote, votingPower)
 
-	// If no one votes (everyone abstains), proposal fails
-	if totalVotingPower.Sub(results[types.OptionAbstain]).Equal(sdk.ZeroDec()) {
-		return false, false, tallyResults
+		i := voterIndexes[sdk.AccAddress(val.Address).String()]
+		voterPowers[i].ValidatorPower = votingPower
 	}
 
-	// If more than 1/3 of voters veto, proposal fails
-	if results[types.OptionNoWithVeto].Quo(totalVotingPower).GT(tallyParams.Veto) {
-		return false, true, tallyResults
+	for i, voterPower := range voterPowers {
+		voterPowers[i].Power = voterPower.DelegationPower.Add(voterPower.ValidatorPower).Add(voterPower.ProxiedPower)
 	}
 
-	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
-	if results[types.OptionYes].Quo(totalVotingPower.Sub(results[types.OptionAbstain])).GT(tallyParams.Threshold) {
-		return true, false, tallyResults
-	}
-
-	// If more than 1/2 of non-abstaining voters vote No, proposal fails
-	return false, false, tallyResults
+	return results, totalVotingPower, voterPowers
 }
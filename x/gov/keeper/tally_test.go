@@ -396,3 +396,175 @@ func TestTallyJailedValidator(t *testing.T) {
 	require.False(t, burnDeposits)
 	require.False(t, tallyResults.Equals(types.EmptyTallyResult()))
 }
+
+func TestTallyWeightedVotes(t *testing.T) {
+	ctx, _, keeper, sk, _ := createTestInput(t, false, 100)
+	createValidators(ctx, sk, []int64{5, 6, 7})
+
+	tp := TestProposal
+	proposal, err := keeper.SubmitProposal(ctx, tp)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+	proposal.Status = types.StatusVotingPeriod
+	keeper.SetProposal(ctx, proposal)
+
+	options1 := types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(5, 1)),
+		types.NewWeightedVoteOption(types.OptionNo, sdk.NewDecWithPrec(5, 1)),
+	}
+	options3 := types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionAbstain, sdk.NewDecWithPrec(6, 1)),
+		types.NewWeightedVoteOption(types.OptionNoWithVeto, sdk.NewDecWithPrec(4, 1)),
+	}
+
+	require.NoError(t, keeper.AddWeightedVote(ctx, proposalID, valAccAddr1, options1))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr2, types.OptionYes))
+	require.NoError(t, keeper.AddWeightedVote(ctx, proposalID, valAccAddr3, options3))
+
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
+
+	require.True(t, passes)
+	require.False(t, burnDeposits)
+	expected := types.NewTallyResult(sdk.NewInt(8500000), sdk.NewInt(4200000), sdk.NewInt(2500000), sdk.NewInt(2800000))
+	require.True(t, tallyResults.Equals(expected), tallyResults.String())
+}
+
+func TestTallyDelegatorWeightedOverride(t *testing.T) {
+	ctx, _, keeper, sk, _ := createTestInput(t, false, 100)
+	createValidators(ctx, sk, []int64{5, 6, 7})
+
+	delTokens := sdk.TokensFromConsensusPower(20)
+	val1, found := sk.GetValidator(ctx, valOpAddr1)
+	require.True(t, found)
+
+	_, err := sk.Delegate(ctx, TestAddrs[0], delTokens, sdk.Unbonded, val1, true)
+	require.NoError(t, err)
+
+	_ = staking.EndBlocker(ctx, sk)
+
+	tp := TestProposal
+	proposal, err := keeper.SubmitProposal(ctx, tp)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+	proposal.Status = types.StatusVotingPeriod
+	keeper.SetProposal(ctx, proposal)
+
+	options := types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(25, 2)),
+		types.NewWeightedVoteOption(types.OptionNo, sdk.NewDecWithPrec(75, 2)),
+	}
+
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr1, types.OptionYes))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr2, types.OptionYes))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr3, types.OptionYes))
+	require.NoError(t, keeper.AddWeightedVote(ctx, proposalID, TestAddrs[0], options))
+
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
+
+	require.True(t, passes)
+	require.False(t, burnDeposits)
+	expected := types.NewTallyResult(sdk.NewInt(23000000), sdk.ZeroInt(), sdk.NewInt(15000000), sdk.ZeroInt())
+	require.True(t, tallyResults.Equals(expected), tallyResults.String())
+}
+
+func TestTallyVoteProxy(t *testing.T) {
+	ctx, _, keeper, sk, _ := createTestInput(t, false, 100)
+	createValidators(ctx, sk, []int64{5, 6, 7})
+
+	delTokens := sdk.TokensFromConsensusPower(20)
+	val1, found := sk.GetValidator(ctx, valOpAddr1)
+	require.True(t, found)
+
+	_, err := sk.Delegate(ctx, TestAddrs[0], delTokens, sdk.Unbonded, val1, true)
+	require.NoError(t, err)
+
+	_ = staking.EndBlocker(ctx, sk)
+
+	keeper.SetVoteProxy(ctx, TestAddrs[0], TestAddrs[1])
+
+	tp := TestProposal
+	proposal, err := keeper.SubmitProposal(ctx, tp)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+	proposal.Status = types.StatusVotingPeriod
+	keeper.SetProposal(ctx, proposal)
+
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr1, types.OptionYes))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr2, types.OptionYes))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr3, types.OptionYes))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, TestAddrs[1], types.OptionNo))
+
+	// the vote of the proxy overrides the vote of the validator
+	voterPowers := keeper.GetVoterPowers(ctx, proposalID)
+	require.Len(t, voterPowers, 4)
+	for _, voterPower := range voterPowers {
+		switch {
+		case voterPower.Voter.Equals(TestAddrs[1]):
+			require.Equal(t, delTokens.ToDec(), voterPower.Power)
+			require.Equal(t, delTokens.ToDec(), voterPower.ProxiedPower)
+			require.True(t, voterPower.DelegationPower.IsZero())
+			require.Equal(t, []sdk.AccAddress{TestAddrs[0]}, voterPower.ProxiedDelegators)
+		case voterPower.Voter.Equals(valAccAddr1):
+			require.Equal(t, sdk.TokensFromConsensusPower(5).ToDec(), voterPower.Power)
+			require.Equal(t, sdk.TokensFromConsensusPower(5).ToDec(), voterPower.ValidatorPower)
+		}
+	}
+
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+
+	ctx2, _ := ctx.CacheContext()
+	passes, burnDeposits, tallyResults := keeper.Tally(ctx2, proposal)
+	require.False(t, passes)
+	require.False(t, burnDeposits)
+	expected := types.NewTallyResult(sdk.TokensFromConsensusPower(18), sdk.ZeroInt(), delTokens, sdk.ZeroInt())
+	require.True(t, tallyResults.Equals(expected), tallyResults.String())
+
+	// the vote of the delegator overrides the vote of the proxy
+	require.NoError(t, keeper.AddVote(ctx, proposalID, TestAddrs[0], types.OptionYes))
+
+	voterPowers = keeper.GetVoterPowers(ctx, proposalID)
+	require.Len(t, voterPowers, 5)
+	for _, voterPower := range voterPowers {
+		if voterPower.Voter.Equals(TestAddrs[1]) {
+			require.True(t, voterPower.Power.IsZero())
+			require.Empty(t, voterPower.ProxiedDelegators)
+		}
+	}
+
+	passes, burnDeposits, tallyResults = keeper.Tally(ctx, proposal)
+	require.True(t, passes)
+	require.False(t, burnDeposits)
+	expected = types.NewTallyResult(sdk.TokensFromConsensusPower(38), sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt())
+	require.True(t, tallyResults.Equals(expected), tallyResults.String())
+}
+
+func TestTallyProposalClass(t *testing.T) {
+	ctx, _, keeper, sk, _ := createTestInput(t, false, 100)
+	createValidators(ctx, sk, []int64{5, 6, 0})
+
+	registerTestCodec(keeper.cdc)
+	setSupermajorityClass(ctx, keeper)
+
+	content := amountProposal{Amount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))}
+	proposal, err := keeper.SubmitProposal(ctx, content)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+	proposal.Status = types.StatusVotingPeriod
+	keeper.SetProposal(ctx, proposal)
+
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr1, types.OptionNo))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr2, types.OptionYes))
+
+	// 6 yes out of 11 passes the default class but not the supermajority class
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	passes, burnDeposits, _ := keeper.Tally(ctx, proposal)
+
+	require.False(t, passes)
+	require.False(t, burnDeposits)
+}
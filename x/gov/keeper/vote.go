@@ -7,8 +7,21 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
-// AddVote adds a vote on a specific proposal
+// AddVote adds a vote on a specific proposal giving all the voting power of
+// the voter to a single option
 func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, option types.VoteOption) sdk.Error {
+	if !types.ValidVoteOption(option) {
+		return types.ErrInvalidVote(keeper.codespace, option)
+	}
+
+	return keeper.AddWeightedVote(ctx, proposalID, voterAddr, types.NewNonSplitVoteOption(option))
+}
+
+// AddWeightedVote adds a vote on a specific proposal splitting the voting power
+// of the voter among several options
+func (keeper Keeper) AddWeightedVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress,
+	options types.WeightedVoteOptions) sdk.Error {
+
 	proposal, ok := keeper.GetProposal(ctx, proposalID)
 	if !ok {
 		return types.ErrUnknownProposal(keeper.codespace, proposalID)
@@ -17,17 +30,17 @@ func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 		return types.ErrInactiveProposal(keeper.codespace, proposalID)
 	}
 
-	if !types.ValidVoteOption(option) {
-		return types.ErrInvalidVote(keeper.codespace, option)
+	if !types.ValidWeightedVoteOptions(options) {
+		return types.ErrInvalidWeightedVote(keeper.codespace, options)
 	}
 
-	vote := types.NewVote(proposalID, voterAddr, option)
+	vote := types.NewVote(proposalID, voterAddr, options)
 	keeper.SetVote(ctx, vote)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeProposalVote,
-			sdk.NewAttribute(types.AttributeKeyOption, option.String()),
+			sdk.NewAttribute(types.AttributeKeyOption, options.String()),
 			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
 		),
 	)
@@ -32,7 +32,7 @@ func TestVotes(t *testing.T) {
 	require.True(t, found)
 	require.Equal(t, TestAddrs[0], vote.Voter)
 	require.Equal(t, proposalID, vote.ProposalID)
-	require.Equal(t, types.OptionAbstain, vote.Option)
+	require.Equal(t, types.NewNonSplitVoteOption(types.OptionAbstain), vote.Options)
 
 	// Test change of vote
 	require.NoError(t, keeper.AddVote(ctx, proposalID, TestAddrs[0], types.OptionYes))
@@ -40,7 +40,7 @@ func TestVotes(t *testing.T) {
 	require.True(t, found)
 	require.Equal(t, TestAddrs[0], vote.Voter)
 	require.Equal(t, proposalID, vote.ProposalID)
-	require.Equal(t, types.OptionYes, vote.Option)
+	require.Equal(t, types.NewNonSplitVoteOption(types.OptionYes), vote.Options)
 
 	// Test second vote
 	require.NoError(t, keeper.AddVote(ctx, proposalID, TestAddrs[1], types.OptionNoWithVeto))
@@ -48,7 +48,7 @@ func TestVotes(t *testing.T) {
 	require.True(t, found)
 	require.Equal(t, TestAddrs[1], vote.Voter)
 	require.Equal(t, proposalID, vote.ProposalID)
-	require.Equal(t, types.OptionNoWithVeto, vote.Option)
+	require.Equal(t, types.NewNonSplitVoteOption(types.OptionNoWithVeto), vote.Options)
 
 	// Test vote iterator
 	// NOTE order of deposits is determined by the addresses
@@ -57,8 +57,8 @@ func TestVotes(t *testing.T) {
 	require.Equal(t, votes, keeper.GetVotes(ctx, proposalID))
 	require.Equal(t, TestAddrs[0], votes[0].Voter)
 	require.Equal(t, proposalID, votes[0].ProposalID)
-	require.Equal(t, types.OptionYes, votes[0].Option)
+	require.Equal(t, types.NewNonSplitVoteOption(types.OptionYes), votes[0].Options)
 	require.Equal(t, TestAddrs[1], votes[1].Voter)
 	require.Equal(t, proposalID, votes[1].ProposalID)
-	require.Equal(t, types.OptionNoWithVeto, votes[1].Option)
+	require.Equal(t, types.NewNonSplitVoteOption(types.OptionNoWithVeto), votes[1].Options)
 }
@@ -0,0 +1,25 @@
+package v0_38
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v036gov "github.com/cosmos/cosmos-sdk/x/gov/legacy/v0_36"
+)
+
+// Migrate accepts exported genesis state from v0.36 and migrates it to v0.38
+// genesis state. This migration replaces the option of every vote with weighted
+// options giving all the voting power of the voter to that option.
+func Migrate(oldGenState v036gov.GenesisState) GenesisState {
+	votes := make(Votes, len(oldGenState.Votes))
+	for i, vote := range oldGenState.Votes {
+		votes[i] = Vote{
+			ProposalID: vote.ProposalID,
+			Voter:      vote.Voter,
+			Options:    WeightedVoteOptions{{Option: vote.Option, Weight: sdk.OneDec()}},
+		}
+	}
+
+	return NewGenesisState(
+		oldGenState.StartingProposalID, oldGenState.Deposits, votes, oldGenState.Proposals,
+		oldGenState.DepositParams, oldGenState.VotingParams, oldGenState.TallyParams,
+	)
+}
@@ -0,0 +1,50 @@
+package v0_38
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v036gov "github.com/cosmos/cosmos-sdk/x/gov/legacy/v0_36"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestMigrate(t *testing.T) {
+	cdc := codec.New()
+	v036gov.RegisterCodec(cdc)
+
+	voter := "cosmos1dfp05pasnts7a4lupn889vptjtrxzkk5f7027f"
+	rawVotes := `[{"proposal_id":"1","voter":"` + voter + `","option":"NoWithVeto"}]`
+	rawProposals := `[{"content":{"type":"cosmos-sdk/TextProposal","value":{"title":"title","description":"description"}},"id":"1","proposal_status":"VotingPeriod","final_tally_result":{"yes":"0","abstain":"0","no":"0","no_with_veto":"0"},"submit_time":"2019-01-01T00:00:00Z","deposit_end_time":"2019-01-02T00:00:00Z","total_deposit":[],"voting_start_time":"2019-01-01T00:00:00Z","voting_end_time":"2019-01-02T00:00:00Z"}]`
+
+	var oldGenState v036gov.GenesisState
+	cdc.MustUnmarshalJSON([]byte(`{"starting_proposal_id":"2","deposits":[],"votes":`+rawVotes+`,"proposals":`+rawProposals+`}`), &oldGenState)
+
+	genState := Migrate(oldGenState)
+	require.Len(t, genState.Votes, 1)
+	require.Len(t, genState.Votes[0].Options, 1)
+	require.Equal(t, oldGenState.Votes[0].Option, genState.Votes[0].Options[0].Option)
+	require.Equal(t, sdk.OneDec(), genState.Votes[0].Options[0].Weight)
+
+	// the migrated genesis state is decoded by the current gov module
+	bz := cdc.MustMarshalJSON(genState)
+	require.JSONEq(t,
+		`[{"proposal_id":"1","voter":"`+voter+`","options":[{"option":"NoWithVeto","weight":"1.000000000000000000"}]}]`,
+		string(cdc.MustMarshalJSON(genState.Votes)),
+	)
+
+	govCdc := codec.New()
+	types.RegisterCodec(govCdc)
+
+	var newGenState types.GenesisState
+	govCdc.MustUnmarshalJSON(bz, &newGenState)
+	require.Equal(t, uint64(2), newGenState.StartingProposalID)
+	require.Len(t, newGenState.Proposals, 1)
+	require.Equal(t, "title", newGenState.Proposals[0].GetTitle())
+
+	voterAddr, err := sdk.AccAddressFromBech32(voter)
+	require.NoError(t, err)
+	require.Equal(t, types.Votes{types.NewVote(1, voterAddr, types.NewNonSplitVoteOption(types.OptionNoWithVeto))}, newGenState.Votes)
+}
@@ -0,0 +1,56 @@
+// DONTCOVER
+// nolint
+package v0_38
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v034gov "github.com/cosmos/cosmos-sdk/x/gov/legacy/v0_34"
+	v036gov "github.com/cosmos/cosmos-sdk/x/gov/legacy/v0_36"
+)
+
+const (
+	ModuleName = "gov"
+)
+
+type (
+	WeightedVoteOption struct {
+		Option v034gov.VoteOption `json:"option"`
+		Weight sdk.Dec            `json:"weight"`
+	}
+
+	WeightedVoteOptions []WeightedVoteOption
+
+	Vote struct {
+		ProposalID uint64              `json:"proposal_id"`
+		Voter      sdk.AccAddress      `json:"voter"`
+		Options    WeightedVoteOptions `json:"options"`
+	}
+
+	Votes []Vote
+
+	GenesisState struct {
+		StartingProposalID uint64                `json:"starting_proposal_id"`
+		Deposits           v034gov.Deposits      `json:"deposits"`
+		Votes              Votes                 `json:"votes"`
+		Proposals          []v036gov.Proposal    `json:"proposals"`
+		DepositParams      v034gov.DepositParams `json:"deposit_params"`
+		VotingParams       v034gov.VotingParams  `json:"voting_params"`
+		TallyParams        v034gov.TallyParams   `json:"tally_params"`
+	}
+)
+
+func NewGenesisState(
+	startingProposalID uint64, deposits v034gov.Deposits, votes Votes, proposals []v036gov.Proposal,
+	depositParams v034gov.DepositParams, votingParams v034gov.VotingParams, tallyParams v034gov.TallyParams,
+) GenesisState {
+
+	return GenesisState{
+		StartingProposalID: startingProposalID,
+		Deposits:           deposits,
+		Votes:              votes,
+		Proposals:          proposals,
+		DepositParams:      depositParams,
+		VotingParams:       votingParams,
+		TallyParams:        tallyParams,
+	}
+}
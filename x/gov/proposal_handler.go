@@ -0,0 +1,82 @@
+package gov
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+)
+
+// ExecAuthority returns the address which executes the messages of
+// ExecProposals: the governance module account. It also holds the deposits in
+// escrow, which the EndBlocker keeps out of reach of the executed messages.
+func ExecAuthority() sdk.AccAddress {
+	return supply.NewModuleAddress(ModuleName)
+}
+
+// NewExecProposalHandler creates a governance handler executing the messages
+// of ExecProposals through a message router, typically the router of the
+// BaseApp. Every message must be signed by the exec authority only. When the
+// proposal is submitted, the messages are only validated.
+func NewExecProposalHandler(router sdk.Router) Handler {
+	authority := ExecAuthority()
+
+	return func(ctx sdk.Context, content Content) sdk.Error {
+		switch c := content.(type) {
+		case ExecProposal:
+			if err := validateExecProposal(router, authority, c); err != nil {
+				return err
+			}
+			if types.IsSubmission(ctx) {
+				return nil
+			}
+			return handleExecProposal(ctx, router, c)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized exec proposal content type: %T", c)
+			return sdk.ErrUnknownRequest(errMsg)
+		}
+	}
+}
+
+// validateExecProposal checks that the messages of an exec proposal are valid,
+// signed by the exec authority only and routed by the router, without
+// executing them.
+func validateExecProposal(router sdk.Router, authority sdk.AccAddress, p ExecProposal) sdk.Error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	for i, msg := range p.Messages {
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(authority) {
+			return types.ErrInvalidExecMsg(types.DefaultCodespace, i, fmt.Sprintf("message must be signed by the exec authority %s only", authority))
+		}
+
+		if router.Route(msg.Route()) == nil {
+			return types.ErrInvalidExecMsg(types.DefaultCodespace, i, fmt.Sprintf("unrecognized message route: %s", msg.Route()))
+		}
+	}
+
+	return nil
+}
+
+// handleExecProposal executes the messages of an exec proposal. The events of
+// the messages are only emitted if all of them succeed, as the caller discards
+// the state changes otherwise.
+func handleExecProposal(ctx sdk.Context, router sdk.Router, p ExecProposal) sdk.Error {
+	events := sdk.EmptyEvents()
+
+	for i, msg := range p.Messages {
+		res := router.Route(msg.Route())(ctx, msg)
+		if !res.IsOK() {
+			return types.ErrExecMsgFailed(types.DefaultCodespace, i, res.Log)
+		}
+
+		events = events.AppendEvents(res.Events)
+	}
+
+	ctx.EventManager().EmitEvents(events)
+	return nil
+}
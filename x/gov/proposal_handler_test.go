@@ -0,0 +1,179 @@
+package gov
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+)
+
+func init() {
+	RegisterExecMsgTypeCodec(bank.MsgSend{}, "cosmos-sdk/MsgSend")
+}
+
+func TestExecProposalHandler(t *testing.T) {
+	input := getMockApp(t, 2, GenesisState{}, nil, ProposalHandler)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+
+	authority := ExecAuthority()
+	require.Equal(t, supply.NewModuleAddress(ModuleName), authority)
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	addAccountCoins(t, input, ctx, authority, coins)
+
+	h := input.router.GetRoute(ExecRouterKey)
+
+	// the messages must be signed by the exec authority
+	p := NewExecProposal("Test", "description", []sdk.Msg{bank.NewMsgSend(input.addrs[0], input.addrs[1], coins)})
+	require.NoError(t, p.ValidateBasic())
+	require.Error(t, h(ctx, p))
+	require.Error(t, h(types.WithSubmission(ctx), p))
+
+	// the messages must have a route
+	p = NewExecProposal("Test", "description", []sdk.Msg{sdk.NewTestMsg(authority)})
+	require.Error(t, h(ctx, p))
+	require.Error(t, h(types.WithSubmission(ctx), p))
+
+	// the messages are only executed once the proposal has passed
+	p = NewExecProposal("Test", "description", []sdk.Msg{bank.NewMsgSend(authority, input.addrs[1], coins.Add(coins))})
+	require.NoError(t, h(types.WithSubmission(ctx), p))
+	require.Error(t, h(ctx, p))
+
+	balance := input.mApp.AccountKeeper.GetAccount(ctx, input.addrs[1]).GetCoins()
+
+	p = NewExecProposal("Test", "description", []sdk.Msg{bank.NewMsgSend(authority, input.addrs[1], coins)})
+	require.NoError(t, h(types.WithSubmission(ctx), p))
+	require.Equal(t, coins, input.mApp.AccountKeeper.GetAccount(ctx, authority).GetCoins())
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, h(ctx, p))
+	require.True(t, input.mApp.AccountKeeper.GetAccount(ctx, authority).GetCoins().Empty())
+	require.Equal(t, balance.Add(coins), input.mApp.AccountKeeper.GetAccount(ctx, input.addrs[1]).GetCoins())
+	require.NotEmpty(t, ctx.EventManager().Events())
+}
+
+func TestExecProposalDepositsInEscrow(t *testing.T) {
+	testCases := []struct {
+		name           string
+		authorityCoins sdk.Coins // coins of the exec authority besides the deposits
+		expStatus      ProposalStatus
+	}{
+		{"messages spend the deposits", sdk.NewCoins(), StatusFailed},
+		{"messages spend the coins sent to the authority", sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)), StatusPassed},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			input := getMockApp(t, 2, GenesisState{}, nil, ProposalHandler)
+			SortAddresses(input.addrs)
+
+			header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+			input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+			ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+
+			createValidators(t, staking.NewHandler(input.sk), ctx, []sdk.ValAddress{sdk.ValAddress(input.addrs[0])}, []int64{10})
+			staking.EndBlocker(ctx, input.sk)
+
+			// the messages are not executed when the proposal is submitted
+			authority := ExecAuthority()
+			amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+			content := NewExecProposal("Test", "description", []sdk.Msg{bank.NewMsgSend(authority, input.addrs[1], amount)})
+			proposal, err := input.keeper.SubmitProposal(ctx, content)
+			require.NoError(t, err)
+
+			deposit := input.keeper.GetDepositParams(ctx).MinDeposit
+			res := NewHandler(input.keeper)(ctx, NewMsgDeposit(input.addrs[0], proposal.ProposalID, deposit))
+			require.True(t, res.IsOK(), res.Log)
+			require.NoError(t, input.keeper.AddVote(ctx, proposal.ProposalID, input.addrs[0], OptionYes))
+
+			newHeader := ctx.BlockHeader()
+			newHeader.Time = ctx.BlockHeader().Time.Add(input.keeper.GetDepositParams(ctx).MaxDepositPeriod).Add(input.keeper.GetVotingParams(ctx).VotingPeriod)
+			ctx = ctx.WithBlockHeader(newHeader)
+
+			// the deposits of another proposal are held in escrow by the
+			// governance module account when the exec proposal is tallied
+			res = NewHandler(input.keeper)(ctx, NewMsgSubmitProposal(NewTextProposal("Test", "description"), deposit, input.addrs[0]))
+			require.True(t, res.IsOK(), res.Log)
+			addAccountCoins(t, input, ctx, authority, tc.authorityCoins)
+
+			EndBlocker(ctx, input.keeper)
+
+			proposal, ok := input.keeper.GetProposal(ctx, proposal.ProposalID)
+			require.True(t, ok)
+			require.Equal(t, tc.expStatus, proposal.Status)
+			require.Equal(t, deposit, input.keeper.GetGovernanceAccount(ctx).GetCoins())
+			_, broken := ModuleAccountInvariant(input.keeper)(ctx)
+			require.False(t, broken)
+		})
+	}
+}
+
+func TestExecProposalEndBlocker(t *testing.T) {
+	testCases := []struct {
+		name           string
+		authorityCoins sdk.Coins // coins of the exec authority besides the deposits at the end of the voting period
+		expStatus      ProposalStatus
+		expReceived    sdk.Coins
+	}{
+		{"all messages succeed", sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)), StatusPassed, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))},
+		{"second message fails", sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 8)), StatusFailed, sdk.NewCoins()},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			input := getMockApp(t, 2, GenesisState{}, nil, ProposalHandler)
+			SortAddresses(input.addrs)
+
+			header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+			input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+			ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+
+			createValidators(t, staking.NewHandler(input.sk), ctx, []sdk.ValAddress{sdk.ValAddress(input.addrs[0])}, []int64{10})
+			staking.EndBlocker(ctx, input.sk)
+
+			authority := ExecAuthority()
+			content := NewExecProposal("Test", "description", []sdk.Msg{
+				bank.NewMsgSend(authority, input.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 6))),
+				bank.NewMsgSend(authority, input.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 4))),
+			})
+			proposal, err := input.keeper.SubmitProposal(ctx, content)
+			require.NoError(t, err)
+
+			res := NewHandler(input.keeper)(ctx, NewMsgDeposit(input.addrs[0], proposal.ProposalID, input.keeper.GetDepositParams(ctx).MinDeposit))
+			require.True(t, res.IsOK(), res.Log)
+			require.NoError(t, input.keeper.AddVote(ctx, proposal.ProposalID, input.addrs[0], OptionYes))
+
+			addAccountCoins(t, input, ctx, authority, tc.authorityCoins)
+			balance := input.mApp.AccountKeeper.GetAccount(ctx, input.addrs[1]).GetCoins()
+
+			newHeader := ctx.BlockHeader()
+			newHeader.Time = ctx.BlockHeader().Time.Add(input.keeper.GetDepositParams(ctx).MaxDepositPeriod).Add(input.keeper.GetVotingParams(ctx).VotingPeriod)
+			ctx = ctx.WithBlockHeader(newHeader)
+
+			EndBlocker(ctx, input.keeper)
+
+			proposal, ok := input.keeper.GetProposal(ctx, proposal.ProposalID)
+			require.True(t, ok)
+			require.Equal(t, tc.expStatus, proposal.Status)
+			require.Equal(t, balance.Add(tc.expReceived), input.mApp.AccountKeeper.GetAccount(ctx, input.addrs[1]).GetCoins())
+			require.Equal(t, tc.authorityCoins.Sub(tc.expReceived), input.mApp.AccountKeeper.GetAccount(ctx, authority).GetCoins())
+		})
+	}
+}
+
+func addAccountCoins(t *testing.T, input testInput, ctx sdk.Context, addr sdk.AccAddress, coins sdk.Coins) {
+	acc := input.mApp.AccountKeeper.GetAccount(ctx, addr)
+	if acc == nil {
+		acc = input.mApp.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	}
+	require.NoError(t, acc.SetCoins(acc.GetCoins().Add(coins)))
+	input.mApp.AccountKeeper.SetAccount(ctx, acc)
+}
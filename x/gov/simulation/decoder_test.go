@@ -19,6 +19,7 @@ import (
 var (
 	delPk1   = ed25519.GenPrivKey().PubKey()
 	delAddr1 = sdk.AccAddress(delPk1.Address())
+	delAddr2 = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
 )
 
 func makeTestCodec() (cdc *codec.Codec) {
@@ -35,17 +36,19 @@ func TestDecodeStore(t *testing.T) {
 	endTime := time.Now().UTC()
 
 	content := types.ContentFromProposalType("test", "test", types.ProposalTypeText)
-	proposal := types.NewProposal(content, 1, endTime, endTime.Add(24*time.Hour))
+	proposal := types.NewProposal(content, 1, types.DefaultProposalClass, endTime, endTime.Add(24*time.Hour))
 	proposalIDBz := make([]byte, 8)
 	binary.LittleEndian.PutUint64(proposalIDBz, 1)
 	deposit := types.NewDeposit(1, delAddr1, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())))
-	vote := types.NewVote(1, delAddr1, types.OptionYes)
+	vote := types.NewVote(1, delAddr1, types.NewNonSplitVoteOption(types.OptionYes))
 
 	kvPairs := cmn.KVPairs{
 		cmn.KVPair{Key: types.ProposalKey(1), Value: cdc.MustMarshalBinaryLengthPrefixed(proposal)},
 		cmn.KVPair{Key: types.InactiveProposalQueueKey(1, endTime), Value: proposalIDBz},
 		cmn.KVPair{Key: types.DepositKey(1, delAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(deposit)},
 		cmn.KVPair{Key: types.VoteKey(1, delAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(vote)},
+		cmn.KVPair{Key: types.VoteProxyKey(delAddr1), Value: delAddr2.Bytes()},
+		cmn.KVPair{Key: types.ProxyDelegatorKey(delAddr2, delAddr1), Value: []byte{}},
 		cmn.KVPair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
 
@@ -57,6 +60,8 @@ func TestDecodeStore(t *testing.T) {
 		{"proposal IDs", "proposalIDA: 1\nProposalIDB: 1"},
 		{"deposits", fmt.Sprintf("%v\n%v", deposit, deposit)},
 		{"votes", fmt.Sprintf("%v\n%v", vote, vote)},
+		{"vote proxies", fmt.Sprintf("%v\n%v", delAddr2, delAddr2)},
+		{"proxy delegators", fmt.Sprintf("%v %v\n%v %v", delAddr2, delAddr1, delAddr2, delAddr1)},
 		{"other", ""},
 	}
 
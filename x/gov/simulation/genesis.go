@@ -23,6 +23,7 @@ const (
 	TallyParamsQuorum          = "tally_params_quorum"
 	TallyParamsThreshold       = "tally_params_threshold"
 	TallyParamsVeto            = "tally_params_veto"
+	ClassParams                = "class_params"
 )
 
 // GenDepositParamsDepositPeriod randomized DepositParamsDepositPeriod
@@ -55,6 +56,32 @@ func GenTallyParamsVeto(r *rand.Rand) sdk.Dec {
 	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 250, 334)), 3)
 }
 
+// GenClassParams randomized ClassParams. The expedited class needs a larger
+// deposit and threshold than the default class, and text proposals are routed
+// to it half of the time.
+func GenClassParams(r *rand.Rand, minDeposit sdk.Coins, votingPeriod time.Duration, quorum, veto sdk.Dec) types.ClassParams {
+	expeditedMinDeposit := sdk.NewCoins()
+	for _, coin := range minDeposit {
+		expeditedMinDeposit = expeditedMinDeposit.Add(sdk.NewCoins(sdk.NewCoin(coin.Denom, coin.Amount.MulRaw(int64(simulation.RandIntBetween(r, 2, 6))))))
+	}
+
+	expedited := types.NewProposalClass(
+		types.ExpeditedProposalClass,
+		expeditedMinDeposit,
+		time.Duration(simulation.RandIntBetween(r, 1, int(votingPeriod/time.Second)+1))*time.Second,
+		quorum,
+		sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 600, 750)), 3),
+		veto,
+	)
+
+	var routes []types.ClassRoute
+	if r.Intn(2) == 0 {
+		routes = append(routes, types.NewClassRoute(types.ProposalTypeText, nil, types.ExpeditedProposalClass))
+	}
+
+	return types.NewClassParams([]types.ProposalClass{expedited}, routes)
+}
+
 // RandomizedGenState generates a random GenesisState for gov
 func RandomizedGenState(simState *module.SimulationState) {
 	startingProposalID := uint64(simState.Rand.Intn(100))
@@ -95,11 +122,18 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { veto = GenTallyParamsVeto(r) },
 	)
 
+	var classParams types.ClassParams
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, ClassParams, &classParams, simState.Rand,
+		func(r *rand.Rand) { classParams = GenClassParams(r, minDeposit, votingPeriod, quorum, veto) },
+	)
+
 	govGenesis := types.NewGenesisState(
 		startingProposalID,
 		types.NewDepositParams(minDeposit, depositPeriod),
 		types.NewVotingParams(votingPeriod),
 		types.NewTallyParams(quorum, threshold, veto),
+		classParams,
 	)
 
 	fmt.Printf("Selected randomly generated governance parameters:\n%s\n", codec.MustMarshalJSONIndent(simState.Cdc, govGenesis))
@@ -81,7 +81,8 @@ func SimulateSubmittingVotingAndSlashingForProposal(k gov.Keeper, contentSim Con
 
 		// didntVote := whoVotes[numVotes:]
 		whoVotes = whoVotes[:numVotes]
-		votingPeriod := k.GetVotingParams(ctx).VotingPeriod
+		proposal, _ := k.GetProposal(ctx, proposalID)
+		votingPeriod := k.GetProposalClass(ctx, proposal.Class).VotingPeriod
 
 		fops := make([]simulation.FutureOperation, numVotes+1)
 		for i := 0; i < numVotes; i++ {
@@ -116,6 +117,12 @@ func SimulateTextProposalContent(r *rand.Rand, _ sdk.Context, _ []simulation.Acc
 
 func simulationCreateMsgSubmitProposal(r *rand.Rand, c gov.Content, s simulation.Account) (msg gov.MsgSubmitProposal, err error) {
 	msg = gov.NewMsgSubmitProposal(c, randomDeposit(r), s.Address)
+
+	// request the expedited class for some of the proposals
+	if r.Intn(4) == 0 {
+		msg.Class = gov.ExpeditedProposalClass
+	}
+
 	if msg.ValidateBasic() != nil {
 		err = fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
 	}
@@ -169,9 +176,41 @@ func operationSimulateMsgVote(k gov.Keeper, acc simulation.Account, proposalID u
 				return simulation.NoOpMsg(gov.ModuleName), nil, nil
 			}
 		}
-		option := randomVotingOption(r)
+		// a quarter of the votes split the voting power among several options
+		var msg sdk.Msg
+		if r.Intn(4) == 0 {
+			msg = gov.NewMsgVoteWeighted(acc.Address, proposalID, randomWeightedVotingOptions(r))
+		} else {
+			msg = gov.NewMsgVote(acc.Address, proposalID, randomVotingOption(r))
+		}
+
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(gov.ModuleName), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		ctx, write := ctx.CacheContext()
+		ok := gov.NewHandler(k)(ctx, msg).IsOK()
+		if ok {
+			write()
+		}
+
+		opMsg = simulation.NewOperationMsg(msg, ok, "")
+		return opMsg, nil, nil
+	}
+}
+
+// SimulateMsgSetVoteProxy generates a MsgSetVoteProxy with random values.
+func SimulateMsgSetVoteProxy(k gov.Keeper) simulation.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		delegator := simulation.RandomAcc(r, accs)
+		proxy := simulation.RandomAcc(r, accs)
+		if delegator.Equals(proxy) {
+			return simulation.NoOpMsg(gov.ModuleName), nil, nil
+		}
 
-		msg := gov.NewMsgVote(acc.Address, proposalID, option)
+		msg := gov.NewMsgSetVoteProxy(delegator.Address, proxy.Address)
 		if msg.ValidateBasic() != nil {
 			return simulation.NoOpMsg(gov.ModuleName), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
 		}
@@ -220,3 +259,25 @@ func randomVotingOption(r *rand.Rand) gov.VoteOption {
 	}
 	panic("should not happen")
 }
+
+// Pick random weighted voting options
+func randomWeightedVotingOptions(r *rand.Rand) gov.WeightedVoteOptions {
+	voteOptions := []gov.VoteOption{gov.OptionYes, gov.OptionAbstain, gov.OptionNo, gov.OptionNoWithVeto}
+
+	// split 100 percent of the voting power among the options in random order
+	var options gov.WeightedVoteOptions
+	remaining := int64(100)
+	for i, j := range r.Perm(len(voteOptions)) {
+		weight := remaining
+		if i < len(voteOptions)-1 {
+			weight = r.Int63n(remaining + 1)
+		}
+
+		if weight > 0 {
+			options = append(options, gov.NewWeightedVoteOption(voteOptions[j], sdk.NewDecWithPrec(weight, 2)))
+		}
+		remaining -= weight
+	}
+
+	return options
+}
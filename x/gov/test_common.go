@@ -50,11 +50,13 @@ func getMockApp(t *testing.T, numGenAccs int, genState types.GenesisState, genAc
 	staking.RegisterCodec(mApp.Cdc)
 	types.RegisterCodec(mApp.Cdc)
 	supply.RegisterCodec(mApp.Cdc)
+	bank.RegisterCodec(mApp.Cdc)
 
 	keyStaking := sdk.NewKVStoreKey(staking.StoreKey)
 	tKeyStaking := sdk.NewTransientStoreKey(staking.TStoreKey)
 	keyGov := sdk.NewKVStoreKey(types.StoreKey)
 	keySupply := sdk.NewKVStoreKey(supply.StoreKey)
+	keyBank := sdk.NewKVStoreKey(bank.StoreKey)
 
 	govAcc := supply.NewEmptyModuleAccount(types.ModuleName, supply.Burner)
 	notBondedPool := supply.NewEmptyModuleAccount(staking.NotBondedPoolName, supply.Burner, supply.Staking)
@@ -68,9 +70,10 @@ func getMockApp(t *testing.T, numGenAccs int, genState types.GenesisState, genAc
 	pk := mApp.ParamsKeeper
 
 	rtr := types.NewRouter().
-		AddRoute(types.RouterKey, handler)
+		AddRoute(types.RouterKey, handler).
+		AddRoute(types.ExecRouterKey, NewExecProposalHandler(mApp.Router()))
 
-	bk := bank.NewBaseKeeper(mApp.AccountKeeper, mApp.ParamsKeeper.Subspace(bank.DefaultParamspace), bank.DefaultCodespace, blacklistedAddrs)
+	bk := bank.NewBaseKeeper(mApp.Cdc, keyBank, mApp.AccountKeeper, mApp.ParamsKeeper.Subspace(bank.DefaultParamspace), bank.DefaultCodespace, blacklistedAddrs, nil)
 
 	maccPerms := map[string][]string{
 		types.ModuleName:          {supply.Burner},
@@ -87,13 +90,14 @@ func getMockApp(t *testing.T, numGenAccs int, genState types.GenesisState, genAc
 	)
 
 	mApp.Router().AddRoute(types.RouterKey, NewHandler(keeper))
+	mApp.Router().AddRoute(bank.RouterKey, bank.NewHandler(bk))
 	mApp.QueryRouter().AddRoute(types.QuerierRoute, keep.NewQuerier(keeper))
 
 	mApp.SetEndBlocker(getEndBlocker(keeper))
-	mApp.SetInitChainer(getInitChainer(mApp, keeper, sk, supplyKeeper, genAccs, genState,
+	mApp.SetInitChainer(getInitChainer(mApp, keeper, sk, bk, supplyKeeper, genAccs, genState,
 		[]supplyexported.ModuleAccountI{govAcc, notBondedPool, bondPool}))
 
-	require.NoError(t, mApp.CompleteSetup(keyStaking, tKeyStaking, keyGov, keySupply))
+	require.NoError(t, mApp.CompleteSetup(keyStaking, tKeyStaking, keyGov, keySupply, keyBank))
 
 	var (
 		addrs    []sdk.AccAddress
@@ -119,7 +123,7 @@ func getEndBlocker(keeper Keeper) sdk.EndBlocker {
 }
 
 // gov and staking initchainer
-func getInitChainer(mapp *mock.App, keeper Keeper, stakingKeeper staking.Keeper, supplyKeeper supply.Keeper, accs []auth.Account, genState GenesisState,
+func getInitChainer(mapp *mock.App, keeper Keeper, stakingKeeper staking.Keeper, bankKeeper bank.Keeper, supplyKeeper supply.Keeper, accs []auth.Account, genState GenesisState,
 	blacklistedAddrs []supplyexported.ModuleAccountI) sdk.InitChainer {
 	return func(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
 		mapp.InitChainer(ctx, req)
@@ -134,6 +138,7 @@ func getInitChainer(mapp *mock.App, keeper Keeper, stakingKeeper staking.Keeper,
 			supplyKeeper.SetModuleAccount(ctx, macc)
 		}
 
+		bank.InitGenesis(ctx, bankKeeper, bank.DefaultGenesisState())
 		validators := staking.InitGenesis(ctx, stakingKeeper, mapp.AccountKeeper, supplyKeeper, stakingGenesis)
 		if genState.IsEmpty() {
 			InitGenesis(ctx, keeper, supplyKeeper, types.DefaultGenesisState())
@@ -196,7 +201,7 @@ const contextKeyBadProposal = "contextKeyBadProposal"
 // for the key contextKeyBadProposal or if the value is false.
 func badProposalHandler(ctx sdk.Context, c types.Content) sdk.Error {
 	switch c.ProposalType() {
-	case types.ProposalTypeText, types.ProposalTypeSoftwareUpgrade:
+	case types.ProposalTypeText:
 		v := ctx.Value(contextKeyBadProposal)
 
 		if v == nil || !v.(bool) {
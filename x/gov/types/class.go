@@ -0,0 +1,133 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Names of the proposal classes
+const (
+	// DefaultProposalClass is the class whose parameters are the deposit, voting
+	// and tally params. It applies to the proposals not routed to any other class.
+	DefaultProposalClass = "default"
+
+	// ExpeditedProposalClass is the name of the class with a short voting period
+	// and a high threshold defined by the default class params.
+	ExpeditedProposalClass = "expedited"
+)
+
+// ProposalClass defines the minimum deposit, voting period and tally
+// parameters applying to a class of proposals
+type ProposalClass struct {
+	Name         string        `json:"name" yaml:"name"`                   //  Name of the class
+	MinDeposit   sdk.Coins     `json:"min_deposit" yaml:"min_deposit"`     //  Minimum deposit for a proposal of the class to enter voting period
+	VotingPeriod time.Duration `json:"voting_period" yaml:"voting_period"` //  Length of the voting period of the proposals of the class
+	Quorum       sdk.Dec       `json:"quorum" yaml:"quorum"`               //  Minimum percentage of total stake needed to vote for a result to be considered valid
+	Threshold    sdk.Dec       `json:"threshold" yaml:"threshold"`         //  Minimum proportion of Yes votes for proposal to pass
+	Veto         sdk.Dec       `json:"veto" yaml:"veto"`                   //  Minimum value of Veto votes to Total votes ratio for proposal to be vetoed
+}
+
+// NewProposalClass creates a new ProposalClass instance
+func NewProposalClass(name string, minDeposit sdk.Coins, votingPeriod time.Duration, quorum, threshold, veto sdk.Dec) ProposalClass {
+	return ProposalClass{
+		Name:         name,
+		MinDeposit:   minDeposit,
+		VotingPeriod: votingPeriod,
+		Quorum:       quorum,
+		Threshold:    threshold,
+		Veto:         veto,
+	}
+}
+
+// NewDefaultProposalClass creates the default proposal class from the deposit,
+// voting and tally params
+func NewDefaultProposalClass(dp DepositParams, vp VotingParams, tp TallyParams) ProposalClass {
+	return NewProposalClass(DefaultProposalClass, dp.MinDeposit, vp.VotingPeriod, tp.Quorum, tp.Threshold, tp.Veto)
+}
+
+// IsAtLeastAsStrictAs returns true if a proposal of the class needs at least
+// the deposit and the votes a proposal of the other class needs to pass. The
+// voting periods are not compared.
+func (pc ProposalClass) IsAtLeastAsStrictAs(other ProposalClass) bool {
+	return pc.MinDeposit.IsAllGTE(other.MinDeposit) &&
+		pc.Quorum.GTE(other.Quorum) &&
+		pc.Threshold.GTE(other.Threshold) &&
+		pc.Veto.LTE(other.Veto)
+}
+
+// TallyParams returns the tally parameters of the class
+func (pc ProposalClass) TallyParams() TallyParams {
+	return NewTallyParams(pc.Quorum, pc.Threshold, pc.Veto)
+}
+
+// String implements stringer interface
+func (pc ProposalClass) String() string {
+	return fmt.Sprintf(`Proposal Class %s:
+  Min Deposit:        %s
+  Voting Period:      %s
+  Quorum:             %s
+  Threshold:          %s
+  Veto:               %s`,
+		pc.Name, pc.MinDeposit, pc.VotingPeriod, pc.Quorum, pc.Threshold, pc.Veto)
+}
+
+func validateProposalClass(pc ProposalClass) error {
+	if !isAlphaNumeric(pc.Name) {
+		return fmt.Errorf("class name must be alphanumeric: %s", pc.Name)
+	}
+	if pc.Name == DefaultProposalClass {
+		return fmt.Errorf("class name is reserved: %s", pc.Name)
+	}
+	if !pc.MinDeposit.IsValid() {
+		return fmt.Errorf("invalid minimum deposit of class %s: %s", pc.Name, pc.MinDeposit)
+	}
+	if err := validateVotingParams(NewVotingParams(pc.VotingPeriod)); err != nil {
+		return fmt.Errorf("invalid class %s: %s", pc.Name, err)
+	}
+	if err := validateTallyParams(pc.TallyParams()); err != nil {
+		return fmt.Errorf("invalid class %s: %s", pc.Name, err)
+	}
+
+	return nil
+}
+
+// ClassRoute routes the proposals of a content type to a proposal class. If
+// MinAmount is set, only the contents implementing AmountContent with an amount
+// of any denomination at least as large are routed.
+type ClassRoute struct {
+	ProposalType string    `json:"proposal_type" yaml:"proposal_type"`               //  Type of the proposal content
+	MinAmount    sdk.Coins `json:"min_amount,omitempty" yaml:"min_amount,omitempty"` //  Minimum amount of the proposal content
+	Class        string    `json:"class" yaml:"class"`                               //  Name of the proposal class
+}
+
+// NewClassRoute creates a new ClassRoute instance
+func NewClassRoute(proposalType string, minAmount sdk.Coins, class string) ClassRoute {
+	return ClassRoute{
+		ProposalType: proposalType,
+		MinAmount:    minAmount,
+		Class:        class,
+	}
+}
+
+// Matches returns true if the route applies to a proposal content
+func (cr ClassRoute) Matches(content Content) bool {
+	if content.ProposalType() != cr.ProposalType {
+		return false
+	}
+	if cr.MinAmount.Empty() {
+		return true
+	}
+
+	ac, ok := content.(AmountContent)
+	return ok && ac.GetAmount().IsAnyGTE(cr.MinAmount)
+}
+
+// String implements stringer interface
+func (cr ClassRoute) String() string {
+	if cr.MinAmount.Empty() {
+		return fmt.Sprintf("%s -> %s", cr.ProposalType, cr.Class)
+	}
+	return fmt.Sprintf("%s (amount >= %s) -> %s", cr.ProposalType, cr.MinAmount, cr.Class)
+}
@@ -0,0 +1,127 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type amountContent struct {
+	TextProposal
+	Amount sdk.Coins
+}
+
+func (ac amountContent) GetAmount() sdk.Coins { return ac.Amount }
+
+func TestValidateClassParams(t *testing.T) {
+	expedited := DefaultClassParams().Classes[0]
+
+	invalidName := expedited
+	invalidName.Name = "expedited class"
+	reservedName := expedited
+	reservedName.Name = DefaultProposalClass
+	invalidPeriod := expedited
+	invalidPeriod.VotingPeriod = 0
+	invalidThreshold := expedited
+	invalidThreshold.Threshold = sdk.NewDec(2)
+
+	route := NewClassRoute(ProposalTypeText, nil, ExpeditedProposalClass)
+
+	tests := []struct {
+		name        string
+		classParams ClassParams
+		expectPass  bool
+	}{
+		{"default", DefaultClassParams(), true},
+		{"empty", ClassParams{}, true},
+		{"route", NewClassParams([]ProposalClass{expedited}, []ClassRoute{route}), true},
+		{"route to default class", NewClassParams(nil, []ClassRoute{NewClassRoute(ProposalTypeText, nil, DefaultProposalClass)}), true},
+		{"invalid name", NewClassParams([]ProposalClass{invalidName}, nil), false},
+		{"reserved name", NewClassParams([]ProposalClass{reservedName}, nil), false},
+		{"invalid voting period", NewClassParams([]ProposalClass{invalidPeriod}, nil), false},
+		{"invalid threshold", NewClassParams([]ProposalClass{invalidThreshold}, nil), false},
+		{"duplicate class", NewClassParams([]ProposalClass{expedited, expedited}, nil), false},
+		{"route to unknown class", NewClassParams(nil, []ClassRoute{route}), false},
+		{"route without type", NewClassParams([]ProposalClass{expedited}, []ClassRoute{NewClassRoute("", nil, ExpeditedProposalClass)}), false},
+	}
+
+	for _, tc := range tests {
+		if tc.expectPass {
+			require.NoError(t, validateClassParams(tc.classParams), tc.name)
+		} else {
+			require.Error(t, validateClassParams(tc.classParams), tc.name)
+		}
+	}
+}
+
+func TestClassParamsRoute(t *testing.T) {
+	text := NewTextProposal("title", "description")
+	minAmount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+
+	classParams := NewClassParams(
+		[]ProposalClass{DefaultClassParams().Classes[0]},
+		[]ClassRoute{NewClassRoute(ProposalTypeText, minAmount, ExpeditedProposalClass)},
+	)
+
+	require.Equal(t, DefaultProposalClass, classParams.Route(text))
+	require.Equal(t, DefaultProposalClass, classParams.Route(amountContent{text.(TextProposal), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 99))}))
+	require.Equal(t, DefaultProposalClass, classParams.Route(amountContent{text.(TextProposal), sdk.NewCoins(sdk.NewInt64Coin("foo", 100))}))
+	require.Equal(t, ExpeditedProposalClass, classParams.Route(amountContent{text.(TextProposal), minAmount}))
+
+	// the first matching route applies
+	classParams.Routes = append([]ClassRoute{NewClassRoute(ProposalTypeText, nil, DefaultProposalClass)}, classParams.Routes...)
+	require.Equal(t, DefaultProposalClass, classParams.Route(amountContent{text.(TextProposal), minAmount}))
+}
+
+func TestProposalClassIsAtLeastAsStrictAs(t *testing.T) {
+	standard := NewDefaultProposalClass(DefaultDepositParams(), DefaultVotingParams(), DefaultTallyParams())
+	expedited := DefaultClassParams().Classes[0]
+
+	require.True(t, standard.IsAtLeastAsStrictAs(standard))
+	require.True(t, expedited.IsAtLeastAsStrictAs(standard))
+	require.False(t, standard.IsAtLeastAsStrictAs(expedited))
+
+	lowVeto := expedited
+	lowVeto.Veto = sdk.NewDecWithPrec(1, 1)
+	require.True(t, lowVeto.IsAtLeastAsStrictAs(expedited))
+	require.False(t, expedited.IsAtLeastAsStrictAs(lowVeto))
+}
+
+// proposalBeforeClasses is the Proposal type stored before proposal classes
+type proposalBeforeClasses struct {
+	Content          `json:"content"`
+	ProposalID       uint64         `json:"id"`
+	Status           ProposalStatus `json:"proposal_status"`
+	FinalTallyResult TallyResult    `json:"final_tally_result"`
+	SubmitTime       time.Time      `json:"submit_time"`
+	DepositEndTime   time.Time      `json:"deposit_end_time"`
+	TotalDeposit     sdk.Coins      `json:"total_deposit"`
+	VotingStartTime  time.Time      `json:"voting_start_time"`
+	VotingEndTime    time.Time      `json:"voting_end_time"`
+}
+
+func TestDecodeProposalBeforeClasses(t *testing.T) {
+	now := time.Now().UTC()
+	old := proposalBeforeClasses{
+		Content:          NewTextProposal("title", "description"),
+		ProposalID:       1,
+		Status:           StatusVotingPeriod,
+		FinalTallyResult: EmptyTallyResult(),
+		SubmitTime:       now,
+		DepositEndTime:   now.Add(time.Hour),
+		TotalDeposit:     sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)),
+		VotingStartTime:  now,
+		VotingEndTime:    now.Add(time.Hour),
+	}
+
+	var proposal Proposal
+	ModuleCdc.MustUnmarshalBinaryLengthPrefixed(ModuleCdc.MustMarshalBinaryLengthPrefixed(old), &proposal)
+	require.Equal(t, old.ProposalID, proposal.ProposalID)
+	require.Equal(t, old.Status, proposal.Status)
+	require.Equal(t, old.TotalDeposit, proposal.TotalDeposit)
+	require.True(t, old.VotingEndTime.Equal(proposal.VotingEndTime))
+	require.Empty(t, proposal.Class)
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // module codec
@@ -15,9 +16,12 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgSubmitProposal{}, "cosmos-sdk/MsgSubmitProposal", nil)
 	cdc.RegisterConcrete(MsgDeposit{}, "cosmos-sdk/MsgDeposit", nil)
 	cdc.RegisterConcrete(MsgVote{}, "cosmos-sdk/MsgVote", nil)
+	cdc.RegisterConcrete(MsgVoteWeighted{}, "cosmos-sdk/MsgVoteWeighted", nil)
+	cdc.RegisterConcrete(MsgSetVoteProxy{}, "cosmos-sdk/MsgSetVoteProxy", nil)
+	cdc.RegisterConcrete(MsgRemoveVoteProxy{}, "cosmos-sdk/MsgRemoveVoteProxy", nil)
 
 	cdc.RegisterConcrete(TextProposal{}, "cosmos-sdk/TextProposal", nil)
-	cdc.RegisterConcrete(SoftwareUpgradeProposal{}, "cosmos-sdk/SoftwareUpgradeProposal", nil)
+	cdc.RegisterConcrete(ExecProposal{}, "cosmos-sdk/ExecProposal", nil)
 }
 
 // RegisterProposalTypeCodec registers an external proposal content type defined
@@ -27,7 +31,16 @@ func RegisterProposalTypeCodec(o interface{}, name string) {
 	ModuleCdc.RegisterConcrete(o, name, nil)
 }
 
+// RegisterExecMsgTypeCodec registers an external message type defined in
+// another module for the internal ModuleCdc. This allows the messages of an
+// ExecProposal to be correctly Amino encoded and decoded, and only registered
+// message types can be executed by governance proposals.
+func RegisterExecMsgTypeCodec(o interface{}, name string) {
+	ModuleCdc.RegisterConcrete(o, name, nil)
+}
+
 // TODO determine a good place to seal this codec
 func init() {
 	RegisterCodec(ModuleCdc)
+	sdk.RegisterCodec(ModuleCdc)
 }
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -26,10 +27,35 @@ type Content interface {
 	String() string
 }
 
+// AmountContent defines an optional interface implemented by proposal contents
+// moving funds. Their amount can be used to route them to a proposal class.
+type AmountContent interface {
+	Content
+	GetAmount() sdk.Coins
+}
+
 // Handler defines a function that handles a proposal after it has passed the
-// governance process.
+// governance process. It is also called when the proposal is submitted, in a
+// context marked by WithSubmission whose state changes are discarded.
 type Handler func(ctx sdk.Context, content Content) sdk.Error
 
+type submissionKey struct{}
+
+// WithSubmission marks the context in which the handler of a proposal is called
+// when the proposal is submitted.
+func WithSubmission(ctx sdk.Context) sdk.Context {
+	return ctx.WithContext(context.WithValue(ctx.Context(), submissionKey{}, true))
+}
+
+// IsSubmission returns true if the handler of a proposal is called when the
+// proposal is submitted rather than once it has passed. Handlers whose effects
+// depend on the state at the end of the voting period only validate the content
+// then.
+func IsSubmission(ctx sdk.Context) bool {
+	submission, _ := ctx.Context().Value(submissionKey{}).(bool)
+	return submission
+}
+
 // ValidateAbstract validates a proposal's abstract contents returning an error
 // if invalid.
 func ValidateAbstract(codespace sdk.CodespaceType, c Content) sdk.Error {
@@ -23,6 +23,12 @@ const (
 	CodeInvalidGenesis           sdk.CodeType = 9
 	CodeInvalidProposalStatus    sdk.CodeType = 10
 	CodeProposalHandlerNotExists sdk.CodeType = 11
+	CodeInvalidVoteProxy         sdk.CodeType = 12
+	CodeVoteProxyNotFound        sdk.CodeType = 13
+	CodeInvalidExecMsg           sdk.CodeType = 14
+	CodeExecMsgFailed            sdk.CodeType = 15
+	CodeInvalidProposalClass     sdk.CodeType = 16
+	CodeEscrowSpent              sdk.CodeType = 17
 )
 
 // ErrUnknownProposal error for unknown proposals
@@ -55,6 +61,11 @@ func ErrInvalidVote(codespace sdk.CodespaceType, voteOption VoteOption) sdk.Erro
 	return sdk.NewError(codespace, CodeInvalidVote, fmt.Sprintf("'%v' is not a valid voting option", voteOption.String()))
 }
 
+// ErrInvalidWeightedVote error for invalid weighted vote options
+func ErrInvalidWeightedVote(codespace sdk.CodespaceType, options WeightedVoteOptions) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidVote, fmt.Sprintf("'%s' are not valid weighted voting options", options))
+}
+
 // ErrInvalidGenesis error for an invalid governance GenesisState
 func ErrInvalidGenesis(codespace sdk.CodespaceType, msg string) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidVote, msg)
@@ -64,3 +75,38 @@ func ErrInvalidGenesis(codespace sdk.CodespaceType, msg string) sdk.Error {
 func ErrNoProposalHandlerExists(codespace sdk.CodespaceType, content interface{}) sdk.Error {
 	return sdk.NewError(codespace, CodeProposalHandlerNotExists, fmt.Sprintf("'%T' does not have a corresponding handler", content))
 }
+
+// ErrInvalidVoteProxy error for an invalid vote proxy
+func ErrInvalidVoteProxy(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidVoteProxy, fmt.Sprintf("invalid vote proxy: %s", msg))
+}
+
+// ErrVoteProxyNotFound error for a delegator without vote proxy
+func ErrVoteProxyNotFound(codespace sdk.CodespaceType, delegator sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeVoteProxyNotFound, fmt.Sprintf("delegator %s has no vote proxy", delegator))
+}
+
+// ErrInvalidExecMsg error for a message of an ExecProposal which cannot be
+// executed
+func ErrInvalidExecMsg(codespace sdk.CodespaceType, index int, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidExecMsg, fmt.Sprintf("invalid message %d to execute: %s", index, msg))
+}
+
+// ErrExecMsgFailed error for a message of an ExecProposal whose execution
+// failed
+func ErrExecMsgFailed(codespace sdk.CodespaceType, index int, log string) sdk.Error {
+	return sdk.NewError(codespace, CodeExecMsgFailed, fmt.Sprintf("execution of message %d failed: %s", index, log))
+}
+
+// ErrInvalidProposalClass error for a proposal class which cannot be applied to
+// a proposal
+func ErrInvalidProposalClass(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidProposalClass, fmt.Sprintf("invalid proposal class: %s", msg))
+}
+
+// ErrEscrowSpent error for a proposal whose execution spent deposits held in
+// escrow by the governance module account
+func ErrEscrowSpent(codespace sdk.CodespaceType, balance, deposits sdk.Coins) sdk.Error {
+	return sdk.NewError(codespace, CodeEscrowSpent,
+		fmt.Sprintf("governance module account balance %s is lower than the deposits held in escrow %s", balance, deposits))
+}
@@ -0,0 +1,100 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ProposalTypeExec defines the type for an ExecProposal
+	ProposalTypeExec = "Exec"
+
+	// ExecRouterKey is the route of the ExecProposal handler in the governance
+	// router
+	ExecRouterKey = "govexec"
+)
+
+// Assert ExecProposal implements Content at compile-time
+var _ Content = ExecProposal{}
+
+func init() {
+	RegisterProposalType(ProposalTypeExec)
+}
+
+// ExecProposal defines a proposal executing messages on behalf of the
+// governance module account once it passes. The messages are executed in order
+// through the message router of the application, and either all of them
+// succeed or none of their state changes is persisted.
+//
+// The concrete types of the messages must be registered on the ModuleCdc with
+// RegisterExecMsgTypeCodec.
+type ExecProposal struct {
+	Title       string    `json:"title" yaml:"title"`
+	Description string    `json:"description" yaml:"description"`
+	Messages    []sdk.Msg `json:"messages" yaml:"messages"`
+}
+
+// NewExecProposal creates a new ExecProposal instance
+func NewExecProposal(title, description string, msgs []sdk.Msg) ExecProposal {
+	return ExecProposal{title, description, msgs}
+}
+
+// GetTitle returns the title of an exec proposal.
+func (ep ExecProposal) GetTitle() string { return ep.Title }
+
+// GetDescription returns the description of an exec proposal.
+func (ep ExecProposal) GetDescription() string { return ep.Description }
+
+// ProposalRoute returns the routing key of an exec proposal.
+func (ep ExecProposal) ProposalRoute() string { return ExecRouterKey }
+
+// ProposalType returns the type of an exec proposal.
+func (ep ExecProposal) ProposalType() string { return ProposalTypeExec }
+
+// ValidateBasic runs basic stateless validity checks. The signers of the
+// messages depend on the state and are checked by the handler.
+func (ep ExecProposal) ValidateBasic() sdk.Error {
+	if err := ValidateAbstract(DefaultCodespace, ep); err != nil {
+		return err
+	}
+
+	if len(ep.Messages) == 0 {
+		return ErrInvalidProposalContent(DefaultCodespace, "no messages to execute")
+	}
+
+	for i, msg := range ep.Messages {
+		if msg == nil {
+			return ErrInvalidExecMsg(DefaultCodespace, i, "empty message")
+		}
+
+		// the messages are part of the sign bytes of MsgSubmitProposal
+		if _, err := ModuleCdc.MarshalJSON([]sdk.Msg{msg}); err != nil {
+			return ErrInvalidExecMsg(DefaultCodespace, i, fmt.Sprintf("message type %T is not registered", msg))
+		}
+
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (ep ExecProposal) String() string {
+	var b strings.Builder
+
+	b.WriteString(fmt.Sprintf(`Exec Proposal:
+  Title:       %s
+  Description: %s
+  Messages:
+`, ep.Title, ep.Description))
+
+	for _, msg := range ep.Messages {
+		b.WriteString(fmt.Sprintf("    %s/%s\n", msg.Route(), msg.Type()))
+	}
+
+	return b.String()
+}
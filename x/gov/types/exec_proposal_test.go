@@ -0,0 +1,34 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestExecProposalValidateBasic(t *testing.T) {
+	msg := NewMsgVote(addrs[0], 1, OptionYes)
+
+	tests := []struct {
+		name       string
+		proposal   ExecProposal
+		expectPass bool
+	}{
+		{"valid", NewExecProposal("Test", "description", []sdk.Msg{msg, msg}), true},
+		{"empty title", NewExecProposal("", "description", []sdk.Msg{msg}), false},
+		{"no messages", NewExecProposal("Test", "description", nil), false},
+		{"nil message", NewExecProposal("Test", "description", []sdk.Msg{msg, nil}), false},
+		{"unregistered message", NewExecProposal("Test", "description", []sdk.Msg{sdk.NewTestMsg(addrs[0])}), false},
+		{"invalid message", NewExecProposal("Test", "description", []sdk.Msg{NewMsgVote(sdk.AccAddress{}, 1, OptionYes)}), false},
+	}
+
+	for _, tc := range tests {
+		if tc.expectPass {
+			require.NoError(t, tc.proposal.ValidateBasic(), tc.name)
+		} else {
+			require.Error(t, tc.proposal.ValidateBasic(), tc.name)
+		}
+	}
+}
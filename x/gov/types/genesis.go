@@ -3,8 +3,6 @@ package types
 import (
 	"bytes"
 	"fmt"
-
-	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // GenesisState - all staking state that must be provided at genesis
@@ -12,19 +10,22 @@ type GenesisState struct {
 	StartingProposalID uint64        `json:"starting_proposal_id" yaml:"starting_proposal_id"`
 	Deposits           Deposits      `json:"deposits" yaml:"deposits"`
 	Votes              Votes         `json:"votes" yaml:"votes"`
+	VoteProxies        VoteProxies   `json:"vote_proxies" yaml:"vote_proxies"`
 	Proposals          Proposals     `json:"proposals" yaml:"proposals"`
 	DepositParams      DepositParams `json:"deposit_params" yaml:"deposit_params"`
 	VotingParams       VotingParams  `json:"voting_params" yaml:"voting_params"`
 	TallyParams        TallyParams   `json:"tally_params" yaml:"tally_params"`
+	ClassParams        ClassParams   `json:"class_params" yaml:"class_params"`
 }
 
 // NewGenesisState creates a new genesis state for the governance module
-func NewGenesisState(startingProposalID uint64, dp DepositParams, vp VotingParams, tp TallyParams) GenesisState {
+func NewGenesisState(startingProposalID uint64, dp DepositParams, vp VotingParams, tp TallyParams, cp ClassParams) GenesisState {
 	return GenesisState{
 		StartingProposalID: startingProposalID,
 		DepositParams:      dp,
 		VotingParams:       vp,
 		TallyParams:        tp,
+		ClassParams:        cp,
 	}
 }
 
@@ -35,6 +36,7 @@ func DefaultGenesisState() GenesisState {
 		DefaultDepositParams(),
 		DefaultVotingParams(),
 		DefaultTallyParams(),
+		DefaultClassParams(),
 	)
 }
 
@@ -52,21 +54,34 @@ func (data GenesisState) IsEmpty() bool {
 
 // ValidateGenesis checks if parameters are within valid ranges
 func ValidateGenesis(data GenesisState) error {
-	threshold := data.TallyParams.Threshold
-	if threshold.IsNegative() || threshold.GT(sdk.OneDec()) {
-		return fmt.Errorf("governance vote threshold should be positive and less or equal to one, is %s",
-			threshold.String())
+	if err := validateTallyParams(data.TallyParams); err != nil {
+		return fmt.Errorf("invalid tally params: %s", err)
+	}
+
+	if err := validateVotingParams(data.VotingParams); err != nil {
+		return fmt.Errorf("invalid voting params: %s", err)
+	}
+
+	if err := validateDepositParams(data.DepositParams); err != nil {
+		return fmt.Errorf("invalid deposit params: %s", err)
 	}
 
-	veto := data.TallyParams.Veto
-	if veto.IsNegative() || veto.GT(sdk.OneDec()) {
-		return fmt.Errorf("governance vote veto threshold should be positive and less or equal to one, is %s",
-			veto.String())
+	if err := validateClassParams(data.ClassParams); err != nil {
+		return fmt.Errorf("invalid class params: %s", err)
 	}
 
-	if !data.DepositParams.MinDeposit.IsValid() {
-		return fmt.Errorf("governance deposit amount must be a valid sdk.Coins amount, is %s",
-			data.DepositParams.MinDeposit.String())
+	delegators := make(map[string]bool)
+	for _, proxy := range data.VoteProxies {
+		if proxy.Delegator.Empty() || proxy.Proxy.Empty() {
+			return fmt.Errorf("invalid vote proxy: %s", proxy)
+		}
+		if proxy.Delegator.Equals(proxy.Proxy) {
+			return fmt.Errorf("delegator %s is its own vote proxy", proxy.Delegator)
+		}
+		if delegators[proxy.Delegator.String()] {
+			return fmt.Errorf("duplicate vote proxy of delegator %s", proxy.Delegator)
+		}
+		delegators[proxy.Delegator.String()] = true
 	}
 
 	return nil
@@ -39,6 +39,10 @@ const (
 // - 0x10<proposalID_Bytes><depositorAddr_Bytes>: Deposit
 //
 // - 0x20<proposalID_Bytes><voterAddr_Bytes>: Voter
+//
+// - 0x30<delegatorAddr_Bytes>: Proxy
+//
+// - 0x31<proxyAddr_Bytes><delegatorAddr_Bytes>: []byte{}
 var (
 	ProposalsKeyPrefix          = []byte{0x00}
 	ActiveProposalQueuePrefix   = []byte{0x01}
@@ -48,6 +52,9 @@ var (
 	DepositsKeyPrefix = []byte{0x10}
 
 	VotesKeyPrefix = []byte{0x20}
+
+	VoteProxyKeyPrefix       = []byte{0x30}
+	ProxyDelegatorsKeyPrefix = []byte{0x31}
 )
 
 var lenTime = len(sdk.FormatTimeBytes(time.Now()))
@@ -109,6 +116,22 @@ func VoteKey(proposalID uint64, voterAddr sdk.AccAddress) []byte {
 	return append(VotesKey(proposalID), voterAddr.Bytes()...)
 }
 
+// VoteProxyKey key of the vote proxy of a delegator from the store
+func VoteProxyKey(delegatorAddr sdk.AccAddress) []byte {
+	return append(VoteProxyKeyPrefix, delegatorAddr.Bytes()...)
+}
+
+// ProxyDelegatorsKey gets the first part of the keys of the delegators of a
+// vote proxy
+func ProxyDelegatorsKey(proxyAddr sdk.AccAddress) []byte {
+	return append(ProxyDelegatorsKeyPrefix, proxyAddr.Bytes()...)
+}
+
+// ProxyDelegatorKey key of a delegator of a vote proxy from the store
+func ProxyDelegatorKey(proxyAddr, delegatorAddr sdk.AccAddress) []byte {
+	return append(ProxyDelegatorsKey(proxyAddr), delegatorAddr.Bytes()...)
+}
+
 // Split keys function; used for iterators
 
 // SplitProposalKey split the proposal key and returns the proposal id
@@ -140,6 +163,27 @@ func SplitKeyVote(key []byte) (proposalID uint64, voterAddr sdk.AccAddress) {
 	return splitKeyWithAddress(key)
 }
 
+// SplitVoteProxyKey split the vote proxy key and returns the delegator address
+func SplitVoteProxyKey(key []byte) (delegatorAddr sdk.AccAddress) {
+	if len(key[1:]) != sdk.AddrLen {
+		panic(fmt.Sprintf("unexpected key length (%d ≠ %d)", len(key[1:]), sdk.AddrLen))
+	}
+
+	return sdk.AccAddress(key[1:])
+}
+
+// SplitProxyDelegatorKey split the proxy delegator key and returns the proxy
+// and delegator addresses
+func SplitProxyDelegatorKey(key []byte) (proxyAddr, delegatorAddr sdk.AccAddress) {
+	if len(key[1:]) != 2*sdk.AddrLen {
+		panic(fmt.Sprintf("unexpected key length (%d ≠ %d)", len(key[1:]), 2*sdk.AddrLen))
+	}
+
+	proxyAddr = sdk.AccAddress(key[1 : 1+sdk.AddrLen])
+	delegatorAddr = sdk.AccAddress(key[1+sdk.AddrLen:])
+	return
+}
+
 // private functions
 
 func splitKeyWithTime(key []byte) (proposalID uint64, endTime time.Time) {
@@ -8,12 +8,18 @@ import (
 
 // Governance message types and routes
 const (
-	TypeMsgDeposit        = "deposit"
-	TypeMsgVote           = "vote"
-	TypeMsgSubmitProposal = "submit_proposal"
+	TypeMsgDeposit         = "deposit"
+	TypeMsgVote            = "vote"
+	TypeMsgVoteWeighted    = "weighted_vote"
+	TypeMsgSubmitProposal  = "submit_proposal"
+	TypeMsgSetVoteProxy    = "set_vote_proxy"
+	TypeMsgRemoveVoteProxy = "remove_vote_proxy"
 )
 
-var _, _, _ sdk.Msg = MsgSubmitProposal{}, MsgDeposit{}, MsgVote{}
+var (
+	_, _, _ sdk.Msg = MsgSubmitProposal{}, MsgDeposit{}, MsgVote{}
+	_, _, _ sdk.Msg = MsgVoteWeighted{}, MsgSetVoteProxy{}, MsgRemoveVoteProxy{}
+)
 
 // MsgSubmitProposal defines a message to create a governance proposal with a
 // given content and initial deposit
@@ -21,11 +27,18 @@ type MsgSubmitProposal struct {
 	Content        Content        `json:"content" yaml:"content"`
 	InitialDeposit sdk.Coins      `json:"initial_deposit" yaml:"initial_deposit"` //  Initial deposit paid by sender. Must be strictly positive
 	Proposer       sdk.AccAddress `json:"proposer" yaml:"proposer"`               //  Address of the proposer
+	Class          string         `json:"class,omitempty" yaml:"class,omitempty"` //  Class of the proposal, the class of its content if empty
 }
 
 // NewMsgSubmitProposal creates a new MsgSubmitProposal instance
 func NewMsgSubmitProposal(content Content, initialDeposit sdk.Coins, proposer sdk.AccAddress) MsgSubmitProposal {
-	return MsgSubmitProposal{content, initialDeposit, proposer}
+	return MsgSubmitProposal{content, initialDeposit, proposer, ""}
+}
+
+// NewMsgSubmitProposalWithClass creates a new MsgSubmitProposal instance
+// requesting a proposal class
+func NewMsgSubmitProposalWithClass(content Content, initialDeposit sdk.Coins, proposer sdk.AccAddress, class string) MsgSubmitProposal {
+	return MsgSubmitProposal{content, initialDeposit, proposer, class}
 }
 
 // Route implements Msg
@@ -39,12 +52,6 @@ func (msg MsgSubmitProposal) ValidateBasic() sdk.Error {
 	if msg.Content == nil {
 		return ErrInvalidProposalContent(DefaultCodespace, "missing content")
 	}
-	if msg.Content.ProposalType() == ProposalTypeSoftwareUpgrade {
-		// Disable software upgrade proposals as they are currently equivalent
-		// to text proposals. Re-enable once a valid software upgrade proposal
-		// handler is implemented.
-		return ErrInvalidProposalType(DefaultCodespace, msg.Content.ProposalType())
-	}
 	if msg.Proposer.Empty() {
 		return sdk.ErrInvalidAddress(msg.Proposer.String())
 	}
@@ -57,6 +64,9 @@ func (msg MsgSubmitProposal) ValidateBasic() sdk.Error {
 	if !IsValidProposalType(msg.Content.ProposalType()) {
 		return ErrInvalidProposalType(DefaultCodespace, msg.Content.ProposalType())
 	}
+	if msg.Class != "" && !isAlphaNumeric(msg.Class) {
+		return ErrInvalidProposalClass(DefaultCodespace, fmt.Sprintf("class name must be alphanumeric: %s", msg.Class))
+	}
 
 	return msg.Content.ValidateBasic()
 }
@@ -66,7 +76,8 @@ func (msg MsgSubmitProposal) String() string {
 	return fmt.Sprintf(`Submit Proposal Message:
   Content:         %s
   Initial Deposit: %s
-`, msg.Content.String(), msg.InitialDeposit)
+  Class:           %s
+`, msg.Content.String(), msg.InitialDeposit, msg.Class)
 }
 
 // GetSignBytes implements Msg
@@ -181,3 +192,149 @@ func (msg MsgVote) GetSignBytes() []byte {
 func (msg MsgVote) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Voter}
 }
+
+// MsgVoteWeighted defines a message to cast a vote splitting the voting power
+// of the voter among several options
+type MsgVoteWeighted struct {
+	ProposalID uint64              `json:"proposal_id" yaml:"proposal_id"` // ID of the proposal
+	Voter      sdk.AccAddress      `json:"voter" yaml:"voter"`             //  address of the voter
+	Options    WeightedVoteOptions `json:"options" yaml:"options"`         //  weighted options from OptionSet chosen by the voter
+}
+
+// NewMsgVoteWeighted creates a message to cast a weighted vote on an active
+// proposal
+func NewMsgVoteWeighted(voter sdk.AccAddress, proposalID uint64, options WeightedVoteOptions) MsgVoteWeighted {
+	return MsgVoteWeighted{proposalID, voter, options}
+}
+
+// Route implements Msg
+func (msg MsgVoteWeighted) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgVoteWeighted) Type() string { return TypeMsgVoteWeighted }
+
+// ValidateBasic implements Msg
+func (msg MsgVoteWeighted) ValidateBasic() sdk.Error {
+	if msg.Voter.Empty() {
+		return sdk.ErrInvalidAddress(msg.Voter.String())
+	}
+	if !ValidWeightedVoteOptions(msg.Options) {
+		return ErrInvalidWeightedVote(DefaultCodespace, msg.Options)
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface
+func (msg MsgVoteWeighted) String() string {
+	return fmt.Sprintf(`Weighted Vote Message:
+  Proposal ID: %d
+  Options:     %s
+`, msg.ProposalID, msg.Options)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgVoteWeighted) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgVoteWeighted) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Voter}
+}
+
+// MsgSetVoteProxy defines a message to set the account voting on behalf of a
+// delegator
+type MsgSetVoteProxy struct {
+	Delegator sdk.AccAddress `json:"delegator" yaml:"delegator"` // Address of the delegator
+	Proxy     sdk.AccAddress `json:"proxy" yaml:"proxy"`         // Address of the proxy voting on behalf of the delegator
+}
+
+// NewMsgSetVoteProxy creates a new MsgSetVoteProxy instance
+func NewMsgSetVoteProxy(delegator, proxy sdk.AccAddress) MsgSetVoteProxy {
+	return MsgSetVoteProxy{delegator, proxy}
+}
+
+// Route implements Msg
+func (msg MsgSetVoteProxy) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgSetVoteProxy) Type() string { return TypeMsgSetVoteProxy }
+
+// ValidateBasic implements Msg
+func (msg MsgSetVoteProxy) ValidateBasic() sdk.Error {
+	if msg.Delegator.Empty() {
+		return sdk.ErrInvalidAddress(msg.Delegator.String())
+	}
+	if msg.Proxy.Empty() {
+		return sdk.ErrInvalidAddress(msg.Proxy.String())
+	}
+	if msg.Delegator.Equals(msg.Proxy) {
+		return ErrInvalidVoteProxy(DefaultCodespace, "a delegator cannot be its own proxy")
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface
+func (msg MsgSetVoteProxy) String() string {
+	return fmt.Sprintf(`Set Vote Proxy Message:
+  Delegator: %s
+  Proxy:     %s
+`, msg.Delegator, msg.Proxy)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgSetVoteProxy) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgSetVoteProxy) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Delegator}
+}
+
+// MsgRemoveVoteProxy defines a message to remove the vote proxy of a delegator
+type MsgRemoveVoteProxy struct {
+	Delegator sdk.AccAddress `json:"delegator" yaml:"delegator"` // Address of the delegator
+}
+
+// NewMsgRemoveVoteProxy creates a new MsgRemoveVoteProxy instance
+func NewMsgRemoveVoteProxy(delegator sdk.AccAddress) MsgRemoveVoteProxy {
+	return MsgRemoveVoteProxy{delegator}
+}
+
+// Route implements Msg
+func (msg MsgRemoveVoteProxy) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgRemoveVoteProxy) Type() string { return TypeMsgRemoveVoteProxy }
+
+// ValidateBasic implements Msg
+func (msg MsgRemoveVoteProxy) ValidateBasic() sdk.Error {
+	if msg.Delegator.Empty() {
+		return sdk.ErrInvalidAddress(msg.Delegator.String())
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface
+func (msg MsgRemoveVoteProxy) String() string {
+	return fmt.Sprintf(`Remove Vote Proxy Message:
+  Delegator: %s
+`, msg.Delegator)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgRemoveVoteProxy) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgRemoveVoteProxy) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Delegator}
+}
@@ -35,7 +35,7 @@ func TestMsgSubmitProposal(t *testing.T) {
 		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeText, addrs[0], coinsPos, true},
 		{"", "the purpose of this proposal is to test", ProposalTypeText, addrs[0], coinsPos, false},
 		{"Test Proposal", "", ProposalTypeText, addrs[0], coinsPos, false},
-		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeSoftwareUpgrade, addrs[0], coinsPos, false},
+		{"Test Proposal", "the purpose of this proposal is to test", "SoftwareUpgrade", addrs[0], coinsPos, false},
 		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeText, sdk.AccAddress{}, coinsPos, false},
 		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeText, addrs[0], coinsZero, true},
 		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeText, addrs[0], coinsMulti, true},
@@ -58,6 +58,14 @@ func TestMsgSubmitProposal(t *testing.T) {
 	}
 }
 
+func TestMsgSubmitProposalClass(t *testing.T) {
+	content := NewTextProposal("Test Proposal", "the purpose of this proposal is to test")
+
+	require.NoError(t, NewMsgSubmitProposalWithClass(content, coinsPos, addrs[0], "").ValidateBasic())
+	require.NoError(t, NewMsgSubmitProposalWithClass(content, coinsPos, addrs[0], ExpeditedProposalClass).ValidateBasic())
+	require.Error(t, NewMsgSubmitProposalWithClass(content, coinsPos, addrs[0], "expedited class").ValidateBasic())
+}
+
 func TestMsgDepositGetSignBytes(t *testing.T) {
 	addr := sdk.AccAddress("addr1")
 	msg := NewMsgDeposit(addr, 0, coinsPos)
@@ -116,3 +124,61 @@ func TestMsgVote(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for MsgVoteWeighted
+func TestMsgVoteWeighted(t *testing.T) {
+	yes := NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(7, 1))
+	abstain := NewWeightedVoteOption(OptionAbstain, sdk.NewDecWithPrec(3, 1))
+
+	tests := []struct {
+		proposalID uint64
+		voterAddr  sdk.AccAddress
+		options    WeightedVoteOptions
+		expectPass bool
+	}{
+		{0, addrs[0], WeightedVoteOptions{yes, abstain}, true},
+		{0, addrs[0], NewNonSplitVoteOption(OptionNoWithVeto), true},
+		{0, sdk.AccAddress{}, WeightedVoteOptions{yes, abstain}, false},
+		{0, addrs[0], WeightedVoteOptions{}, false},
+		{0, addrs[0], WeightedVoteOptions{yes}, false},
+		{0, addrs[0], WeightedVoteOptions{yes, abstain, NewWeightedVoteOption(OptionNo, sdk.ZeroDec())}, false},
+		{0, addrs[0], WeightedVoteOptions{yes, NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(3, 1))}, false},
+		{0, addrs[0], WeightedVoteOptions{yes, NewWeightedVoteOption(VoteOption(0x13), sdk.NewDecWithPrec(3, 1))}, false},
+		{0, addrs[0], WeightedVoteOptions{NewWeightedVoteOption(OptionYes, sdk.NewDec(2)), NewWeightedVoteOption(OptionNo, sdk.NewDec(-1))}, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgVoteWeighted(tc.voterAddr, tc.proposalID, tc.options)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+// test ValidateBasic for MsgSetVoteProxy and MsgRemoveVoteProxy
+func TestMsgVoteProxy(t *testing.T) {
+	tests := []struct {
+		delegatorAddr sdk.AccAddress
+		proxyAddr     sdk.AccAddress
+		expectPass    bool
+	}{
+		{addrs[0], addrs[1], true},
+		{sdk.AccAddress{}, addrs[1], false},
+		{addrs[0], sdk.AccAddress{}, false},
+		{addrs[0], addrs[0], false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgSetVoteProxy(tc.delegatorAddr, tc.proxyAddr)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+
+	require.Nil(t, NewMsgRemoveVoteProxy(addrs[0]).ValidateBasic())
+	require.NotNil(t, NewMsgRemoveVoteProxy(sdk.AccAddress{}).ValidateBasic())
+}
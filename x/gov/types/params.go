@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -19,6 +20,9 @@ var (
 	DefaultQuorum           = sdk.NewDecWithPrec(334, 3)
 	DefaultThreshold        = sdk.NewDecWithPrec(5, 1)
 	DefaultVeto             = sdk.NewDecWithPrec(334, 3)
+
+	DefaultExpeditedMinDepositTokens = sdk.TokensFromConsensusPower(50)
+	DefaultExpeditedThreshold        = sdk.NewDecWithPrec(667, 3)
 )
 
 // Parameter store key
@@ -26,14 +30,16 @@ var (
 	ParamStoreKeyDepositParams = []byte("depositparams")
 	ParamStoreKeyVotingParams  = []byte("votingparams")
 	ParamStoreKeyTallyParams   = []byte("tallyparams")
+	ParamStoreKeyClassParams   = []byte("classparams")
 )
 
 // ParamKeyTable - Key declaration for parameters
 func ParamKeyTable() params.KeyTable {
 	return params.NewKeyTable(
-		ParamStoreKeyDepositParams, DepositParams{},
-		ParamStoreKeyVotingParams, VotingParams{},
-		ParamStoreKeyTallyParams, TallyParams{},
+		params.NewParamSetPair(ParamStoreKeyDepositParams, DepositParams{}, validateDepositParams),
+		params.NewParamSetPair(ParamStoreKeyVotingParams, VotingParams{}, validateVotingParams),
+		params.NewParamSetPair(ParamStoreKeyTallyParams, TallyParams{}, validateTallyParams),
+		params.NewParamSetPair(ParamStoreKeyClassParams, ClassParams{}, validateClassParams),
 	)
 }
 
@@ -71,6 +77,22 @@ func (dp DepositParams) Equal(dp2 DepositParams) bool {
 	return dp.MinDeposit.IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod
 }
 
+func validateDepositParams(i interface{}) error {
+	v, ok := i.(DepositParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if !v.MinDeposit.IsValid() {
+		return fmt.Errorf("invalid minimum deposit: %s", v.MinDeposit)
+	}
+	if v.MaxDepositPeriod <= 0 {
+		return fmt.Errorf("maximum deposit period must be positive: %d", v.MaxDepositPeriod)
+	}
+
+	return nil
+}
+
 // TallyParams defines the params around Tallying votes in governance
 type TallyParams struct {
 	Quorum    sdk.Dec `json:"quorum,omitempty" yaml:"quorum,omitempty"`       //  Minimum percentage of total stake needed to vote for a result to be considered valid
@@ -101,6 +123,34 @@ func (tp TallyParams) String() string {
 		tp.Quorum, tp.Threshold, tp.Veto)
 }
 
+func validateTallyParams(i interface{}) error {
+	v, ok := i.(TallyParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.Quorum.IsNil() || v.Quorum.IsNegative() {
+		return fmt.Errorf("quorum cannot be negative: %s", v.Quorum)
+	}
+	if v.Quorum.GT(sdk.OneDec()) {
+		return fmt.Errorf("quorum too large: %s", v.Quorum)
+	}
+	if v.Threshold.IsNil() || v.Threshold.IsNegative() {
+		return fmt.Errorf("vote threshold cannot be negative: %s", v.Threshold)
+	}
+	if v.Threshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("vote threshold too large: %s", v.Threshold)
+	}
+	if v.Veto.IsNil() || v.Veto.IsNegative() {
+		return fmt.Errorf("veto threshold cannot be negative: %s", v.Veto)
+	}
+	if v.Veto.GT(sdk.OneDec()) {
+		return fmt.Errorf("veto threshold too large: %s", v.Veto)
+	}
+
+	return nil
+}
+
 // VotingParams defines the params around Voting in governance
 type VotingParams struct {
 	VotingPeriod time.Duration `json:"voting_period,omitempty" yaml:"voting_period,omitempty"` //  Length of the voting period.
@@ -124,28 +174,141 @@ func (vp VotingParams) String() string {
   Voting Period:      %s`, vp.VotingPeriod)
 }
 
+func validateVotingParams(i interface{}) error {
+	v, ok := i.(VotingParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.VotingPeriod <= 0 {
+		return fmt.Errorf("voting period must be positive: %s", v.VotingPeriod)
+	}
+
+	return nil
+}
+
+// ClassParams defines the params around proposal classes in governance. The
+// proposals not routed to any class belong to the default class, whose
+// parameters are the deposit, voting and tally params.
+type ClassParams struct {
+	Classes []ProposalClass `json:"classes" yaml:"classes"` //  Proposal classes besides the default class
+	Routes  []ClassRoute    `json:"routes" yaml:"routes"`   //  Routes of the proposal contents to their class, the first matching route applies
+}
+
+// NewClassParams creates a new ClassParams object
+func NewClassParams(classes []ProposalClass, routes []ClassRoute) ClassParams {
+	return ClassParams{
+		Classes: classes,
+		Routes:  routes,
+	}
+}
+
+// DefaultClassParams default parameters for proposal classes
+func DefaultClassParams() ClassParams {
+	return NewClassParams(
+		[]ProposalClass{
+			NewProposalClass(
+				ExpeditedProposalClass,
+				sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultExpeditedMinDepositTokens)),
+				DefaultPeriod/2, DefaultQuorum, DefaultExpeditedThreshold, DefaultVeto,
+			),
+		},
+		nil,
+	)
+}
+
+// GetClass returns the class with the given name, besides the default class
+func (cp ClassParams) GetClass(name string) (ProposalClass, bool) {
+	for _, class := range cp.Classes {
+		if class.Name == name {
+			return class, true
+		}
+	}
+	return ProposalClass{}, false
+}
+
+// Route returns the name of the class of a proposal content
+func (cp ClassParams) Route(content Content) string {
+	for _, route := range cp.Routes {
+		if route.Matches(content) {
+			return route.Class
+		}
+	}
+	return DefaultProposalClass
+}
+
+// String implements stringer interface
+func (cp ClassParams) String() string {
+	var b strings.Builder
+	b.WriteString("Class Params:\n  Classes:\n")
+	for _, class := range cp.Classes {
+		b.WriteString(fmt.Sprintf("    %s: min deposit %s, voting period %s, quorum %s, threshold %s, veto %s\n",
+			class.Name, class.MinDeposit, class.VotingPeriod, class.Quorum, class.Threshold, class.Veto))
+	}
+	b.WriteString("  Routes:")
+	for _, route := range cp.Routes {
+		b.WriteString(fmt.Sprintf("\n    %s", route))
+	}
+	return b.String()
+}
+
+func validateClassParams(i interface{}) error {
+	v, ok := i.(ClassParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	classes := map[string]bool{DefaultProposalClass: true}
+	for _, class := range v.Classes {
+		if err := validateProposalClass(class); err != nil {
+			return err
+		}
+		if classes[class.Name] {
+			return fmt.Errorf("duplicate proposal class: %s", class.Name)
+		}
+		classes[class.Name] = true
+	}
+
+	for _, route := range v.Routes {
+		if len(route.ProposalType) == 0 {
+			return fmt.Errorf("route without proposal type: %s", route)
+		}
+		if !route.MinAmount.IsValid() {
+			return fmt.Errorf("invalid minimum amount of route %s", route)
+		}
+		if !classes[route.Class] {
+			return fmt.Errorf("route to unknown proposal class: %s", route)
+		}
+	}
+
+	return nil
+}
+
 // Params returns all of the governance params
 type Params struct {
 	VotingParams  VotingParams  `json:"voting_params" yaml:"voting_params"`
 	TallyParams   TallyParams   `json:"tally_params" yaml:"tally_params"`
 	DepositParams DepositParams `json:"deposit_params" yaml:"deposit_parmas"`
+	ClassParams   ClassParams   `json:"class_params" yaml:"class_params"`
 }
 
 func (gp Params) String() string {
 	return gp.VotingParams.String() + "\n" +
-		gp.TallyParams.String() + "\n" + gp.DepositParams.String()
+		gp.TallyParams.String() + "\n" + gp.DepositParams.String() + "\n" +
+		gp.ClassParams.String()
 }
 
 // NewParams creates a new gov Params instance
-func NewParams(vp VotingParams, tp TallyParams, dp DepositParams) Params {
+func NewParams(vp VotingParams, tp TallyParams, dp DepositParams, cp ClassParams) Params {
 	return Params{
 		VotingParams:  vp,
 		DepositParams: dp,
 		TallyParams:   tp,
+		ClassParams:   cp,
 	}
 }
 
 // DefaultParams default governance params
 func DefaultParams() Params {
-	return NewParams(DefaultVotingParams(), DefaultTallyParams(), DefaultDepositParams())
+	return NewParams(DefaultVotingParams(), DefaultTallyParams(), DefaultDepositParams(), DefaultClassParams())
 }
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestValidateTallyParams(t *testing.T) {
+	require.NoError(t, validateTallyParams(DefaultTallyParams()))
+	require.NoError(t, validateTallyParams(NewTallyParams(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())))
+	require.NoError(t, validateTallyParams(NewTallyParams(sdk.OneDec(), sdk.OneDec(), sdk.OneDec())))
+
+	tooLarge := sdk.NewDecWithPrec(11, 1)
+	negative := sdk.NewDecWithPrec(-1, 1)
+	half := sdk.NewDecWithPrec(5, 1)
+	tests := []struct {
+		params TallyParams
+		errMsg string
+	}{
+		{NewTallyParams(negative, half, half), "quorum cannot be negative: -0.100000000000000000"},
+		{NewTallyParams(tooLarge, half, half), "quorum too large: 1.100000000000000000"},
+		{NewTallyParams(half, negative, half), "vote threshold cannot be negative: -0.100000000000000000"},
+		{NewTallyParams(half, tooLarge, half), "vote threshold too large: 1.100000000000000000"},
+		{NewTallyParams(half, half, negative), "veto threshold cannot be negative: -0.100000000000000000"},
+		{NewTallyParams(half, half, tooLarge), "veto threshold too large: 1.100000000000000000"},
+		{TallyParams{Quorum: half, Threshold: half}, "veto threshold cannot be negative: <nil>"},
+	}
+	for _, tc := range tests {
+		err := validateTallyParams(tc.params)
+		require.Error(t, err)
+		require.Equal(t, tc.errMsg, err.Error())
+	}
+
+	require.Error(t, validateTallyParams(DefaultVotingParams()))
+}
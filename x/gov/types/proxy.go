@@ -0,0 +1,38 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// VoteProxy defines the account voting on behalf of a delegator. The vote of
+// the proxy is counted for the delegations of the delegator on the proposals
+// the delegator does not vote on, instead of the votes of the validators.
+type VoteProxy struct {
+	Delegator sdk.AccAddress `json:"delegator" yaml:"delegator"` //  Address of the delegator
+	Proxy     sdk.AccAddress `json:"proxy" yaml:"proxy"`         //  Address of the proxy voting on behalf of the delegator
+}
+
+// NewVoteProxy creates a new VoteProxy instance
+func NewVoteProxy(delegator, proxy sdk.AccAddress) VoteProxy {
+	return VoteProxy{delegator, proxy}
+}
+
+func (p VoteProxy) String() string {
+	return fmt.Sprintf("%s votes on behalf of %s", p.Proxy, p.Delegator)
+}
+
+// VoteProxies is a collection of VoteProxy objects
+type VoteProxies []VoteProxy
+
+func (p VoteProxies) String() string {
+	if len(p) == 0 {
+		return "[]"
+	}
+	out := "Vote Proxies:"
+	for _, proxy := range p {
+		out += fmt.Sprintf("\n  %s: %s", proxy.Delegator, proxy.Proxy)
+	}
+	return out
+}
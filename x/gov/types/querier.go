@@ -8,18 +8,22 @@ import (
 
 // query endpoints supported by the governance Querier
 const (
-	QueryParams    = "params"
-	QueryProposals = "proposals"
-	QueryProposal  = "proposal"
-	QueryDeposits  = "deposits"
-	QueryDeposit   = "deposit"
-	QueryVotes     = "votes"
-	QueryVote      = "vote"
-	QueryTally     = "tally"
+	QueryParams      = "params"
+	QueryProposals   = "proposals"
+	QueryProposal    = "proposal"
+	QueryDeposits    = "deposits"
+	QueryDeposit     = "deposit"
+	QueryVotes       = "votes"
+	QueryVote        = "vote"
+	QueryTally       = "tally"
+	QueryVoterPowers = "voter_powers"
+	QueryVoterPower  = "voter_power"
+	QueryVoteProxy   = "vote_proxy"
 
 	ParamDeposit  = "deposit"
 	ParamVoting   = "voting"
 	ParamTallying = "tallying"
+	ParamClasses  = "classes"
 )
 
 // QueryProposalParams Params for queries:
@@ -27,6 +31,7 @@ const (
 // - 'custom/gov/deposits'
 // - 'custom/gov/tally'
 // - 'custom/gov/votes'
+// - 'custom/gov/voter_powers'
 type QueryProposalParams struct {
 	ProposalID uint64
 }
@@ -52,7 +57,9 @@ func NewQueryDepositParams(proposalID uint64, depositor sdk.AccAddress) QueryDep
 	}
 }
 
-// QueryVoteParams Params for query 'custom/gov/vote'
+// QueryVoteParams Params for queries:
+// - 'custom/gov/vote'
+// - 'custom/gov/voter_power'
 type QueryVoteParams struct {
 	ProposalID uint64
 	Voter      sdk.AccAddress
@@ -83,3 +90,15 @@ func NewQueryProposalsParams(status ProposalStatus, limit uint64, voter, deposit
 		Limit:          limit,
 	}
 }
+
+// QueryVoteProxyParams params for query 'custom/gov/vote_proxy'
+type QueryVoteProxyParams struct {
+	Delegator sdk.AccAddress
+}
+
+// NewQueryVoteProxyParams creates a new instance of QueryVoteProxyParams
+func NewQueryVoteProxyParams(delegator sdk.AccAddress) QueryVoteProxyParams {
+	return QueryVoteProxyParams{
+		Delegator: delegator,
+	}
+}
@@ -8,16 +8,16 @@ import (
 
 // ValidatorGovInfo used for tallying
 type ValidatorGovInfo struct {
-	Address             sdk.ValAddress // address of the validator operator
-	BondedTokens        sdk.Int        // Power of a Validator
-	DelegatorShares     sdk.Dec        // Total outstanding delegator shares
-	DelegatorDeductions sdk.Dec        // Delegator deductions from validator's delegators voting independently
-	Vote                VoteOption     // Vote of the validator
+	Address             sdk.ValAddress      // address of the validator operator
+	BondedTokens        sdk.Int             // Power of a Validator
+	DelegatorShares     sdk.Dec             // Total outstanding delegator shares
+	DelegatorDeductions sdk.Dec             // Delegator deductions from validator's delegators voting independently
+	Vote                WeightedVoteOptions // Vote of the validator
 }
 
 // NewValidatorGovInfo creates a ValidatorGovInfo instance
 func NewValidatorGovInfo(address sdk.ValAddress, bondedTokens sdk.Int, delegatorShares,
-	delegatorDeductions sdk.Dec, vote VoteOption) ValidatorGovInfo {
+	delegatorDeductions sdk.Dec, vote WeightedVoteOptions) ValidatorGovInfo {
 
 	return ValidatorGovInfo{
 		Address:             address,
@@ -28,6 +28,61 @@ func NewValidatorGovInfo(address sdk.ValAddress, bondedTokens sdk.Int, delegator
 	}
 }
 
+// VoterPower describes how the voting power of a voter was counted in the tally
+// of a proposal. The power of a voter is the sum of the power of its own
+// delegations, or of the delegations to the validator it operates which are
+// not counted for the votes of their delegators, and of the power of the
+// delegations of the delegators who did not vote and chose the voter as their
+// vote proxy.
+type VoterPower struct {
+	Voter             sdk.AccAddress      `json:"voter" yaml:"voter"`                           // address of the voter
+	Options           WeightedVoteOptions `json:"options" yaml:"options"`                       // weighted options of the vote
+	Power             sdk.Dec             `json:"power" yaml:"power"`                           // total voting power counted for the vote
+	DelegationPower   sdk.Dec             `json:"delegation_power" yaml:"delegation_power"`     // voting power of the delegations of the voter
+	ValidatorPower    sdk.Dec             `json:"validator_power" yaml:"validator_power"`       // voting power inherited by the validator operated by the voter
+	ProxiedPower      sdk.Dec             `json:"proxied_power" yaml:"proxied_power"`           // voting power of the delegators of the voter as vote proxy
+	ProxiedDelegators []sdk.AccAddress    `json:"proxied_delegators" yaml:"proxied_delegators"` // delegators whose voting power was counted for the vote of the voter as vote proxy
+}
+
+// NewVoterPower creates a VoterPower instance without any voting power
+func NewVoterPower(voter sdk.AccAddress, options WeightedVoteOptions) VoterPower {
+	return VoterPower{
+		Voter:           voter,
+		Options:         options,
+		Power:           sdk.ZeroDec(),
+		DelegationPower: sdk.ZeroDec(),
+		ValidatorPower:  sdk.ZeroDec(),
+		ProxiedPower:    sdk.ZeroDec(),
+	}
+}
+
+// String implements stringer interface
+func (vp VoterPower) String() string {
+	return fmt.Sprintf(`Voter Power:
+  Voter:              %s
+  Options:            %s
+  Power:              %s
+  Delegation Power:   %s
+  Validator Power:    %s
+  Proxied Power:      %s
+  Proxied Delegators: %v`, vp.Voter, vp.Options, vp.Power, vp.DelegationPower,
+		vp.ValidatorPower, vp.ProxiedPower, vp.ProxiedDelegators)
+}
+
+// VoterPowers is a collection of VoterPower objects
+type VoterPowers []VoterPower
+
+func (vp VoterPowers) String() string {
+	if len(vp) == 0 {
+		return "[]"
+	}
+	out := "Voter Powers:"
+	for _, p := range vp {
+		out += fmt.Sprintf("\n  %s: %s (%s)", p.Voter, p.Power, p.Options)
+	}
+	return out
+}
+
 // TallyResult defines a standard tally for a proposal
 type TallyResult struct {
 	Yes        sdk.Int `json:"yes" yaml:"yes"`
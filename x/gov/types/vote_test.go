@@ -0,0 +1,28 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestWeightedVoteOptionsFromString(t *testing.T) {
+	options, err := WeightedVoteOptionsFromString("Yes=0.7, Abstain=0.3")
+	require.NoError(t, err)
+	require.Equal(t, WeightedVoteOptions{
+		NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(7, 1)),
+		NewWeightedVoteOption(OptionAbstain, sdk.NewDecWithPrec(3, 1)),
+	}, options)
+	require.True(t, ValidWeightedVoteOptions(options))
+
+	parsed, err := WeightedVoteOptionsFromString(options.String())
+	require.NoError(t, err)
+	require.True(t, options.Equals(parsed))
+
+	for _, str := range []string{"", "Yes", "Yes=0.5=0.5", "Maybe=1", "Yes=one"} {
+		_, err := WeightedVoteOptionsFromString(str)
+		require.Error(t, err, str)
+	}
+}
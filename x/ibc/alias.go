@@ -0,0 +1,216 @@
+// nolint
+// autogenerated code using github.com/rigelrozanski/multitool
+// aliases generated for the following subdirectories:
+// ALIASGEN: github.com/cosmos/cosmos-sdk/x/ibc/internal/keeper
+// ALIASGEN: github.com/cosmos/cosmos-sdk/x/ibc/internal/types
+package ibc
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/ibc/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/ibc/internal/types"
+)
+
+const (
+	ModuleName                       = types.ModuleName
+	StoreKey                         = types.StoreKey
+	RouterKey                        = types.RouterKey
+	QuerierRoute                     = types.QuerierRoute
+	DefaultPrefix                    = types.DefaultPrefix
+	DefaultCodespace                 = types.DefaultCodespace
+	CodeInvalidIdentifier            = types.CodeInvalidIdentifier
+	CodeClientExists                 = types.CodeClientExists
+	CodeClientNotFound               = types.CodeClientNotFound
+	CodeInvalidHeader                = types.CodeInvalidHeader
+	CodeConsensusStateNotFound       = types.CodeConsensusStateNotFound
+	CodeConnectionExists             = types.CodeConnectionExists
+	CodeConnectionNotFound           = types.CodeConnectionNotFound
+	CodeInvalidConnection            = types.CodeInvalidConnection
+	CodeChannelExists                = types.CodeChannelExists
+	CodeChannelNotFound              = types.CodeChannelNotFound
+	CodeInvalidChannel               = types.CodeInvalidChannel
+	CodePortNotBound                 = types.CodePortNotBound
+	CodeInvalidPacket                = types.CodeInvalidPacket
+	CodePacketTimeout                = types.CodePacketTimeout
+	CodeInvalidProof                 = types.CodeInvalidProof
+	EventTypeCreateClient            = types.EventTypeCreateClient
+	EventTypeUpdateClient            = types.EventTypeUpdateClient
+	EventTypeConnectionOpenInit      = types.EventTypeConnectionOpenInit
+	EventTypeConnectionOpenTry       = types.EventTypeConnectionOpenTry
+	EventTypeConnectionOpenAck       = types.EventTypeConnectionOpenAck
+	EventTypeConnectionOpenConfirm   = types.EventTypeConnectionOpenConfirm
+	EventTypeChannelOpenInit         = types.EventTypeChannelOpenInit
+	EventTypeChannelOpenTry          = types.EventTypeChannelOpenTry
+	EventTypeChannelOpenAck          = types.EventTypeChannelOpenAck
+	EventTypeChannelOpenConfirm      = types.EventTypeChannelOpenConfirm
+	EventTypeChannelClosed           = types.EventTypeChannelClosed
+	EventTypeSendPacket              = types.EventTypeSendPacket
+	EventTypeRecvPacket              = types.EventTypeRecvPacket
+	EventTypeAcknowledgePacket       = types.EventTypeAcknowledgePacket
+	EventTypeTimeoutPacket           = types.EventTypeTimeoutPacket
+	AttributeKeyClientID             = types.AttributeKeyClientID
+	AttributeKeyHeight               = types.AttributeKeyHeight
+	AttributeKeyConnectionID         = types.AttributeKeyConnectionID
+	AttributeKeyCounterpartyClientID = types.AttributeKeyCounterpartyClientID
+	AttributeKeyPortID               = types.AttributeKeyPortID
+	AttributeKeyChannelID            = types.AttributeKeyChannelID
+	AttributeKeyCounterpartyPortID   = types.AttributeKeyCounterpartyPortID
+	AttributeKeyCounterpartyChannel  = types.AttributeKeyCounterpartyChannel
+	AttributeKeySequence             = types.AttributeKeySequence
+	AttributeKeySrcPort              = types.AttributeKeySrcPort
+	AttributeKeySrcChannel           = types.AttributeKeySrcChannel
+	AttributeKeyDstPort              = types.AttributeKeyDstPort
+	AttributeKeyDstChannel           = types.AttributeKeyDstChannel
+	AttributeKeyTimeoutHeight        = types.AttributeKeyTimeoutHeight
+	AttributeKeyData                 = types.AttributeKeyData
+	AttributeKeyAck                  = types.AttributeKeyAck
+	AttributeValueCategory           = types.AttributeValueCategory
+	StateUninitialized               = types.StateUninitialized
+	StateInit                        = types.StateInit
+	StateTryOpen                     = types.StateTryOpen
+	StateOpen                        = types.StateOpen
+	StateClosed                      = types.StateClosed
+	OrderNone                        = types.OrderNone
+	OrderUnordered                   = types.OrderUnordered
+	OrderOrdered                     = types.OrderOrdered
+	TypeMsgCreateClient              = types.TypeMsgCreateClient
+	TypeMsgUpdateClient              = types.TypeMsgUpdateClient
+	TypeMsgConnectionOpenInit        = types.TypeMsgConnectionOpenInit
+	TypeMsgConnectionOpenTry         = types.TypeMsgConnectionOpenTry
+	TypeMsgConnectionOpenAck         = types.TypeMsgConnectionOpenAck
+	TypeMsgConnectionOpenConfirm     = types.TypeMsgConnectionOpenConfirm
+	TypeMsgChannelOpenInit           = types.TypeMsgChannelOpenInit
+	TypeMsgChannelOpenTry            = types.TypeMsgChannelOpenTry
+	TypeMsgChannelOpenAck            = types.TypeMsgChannelOpenAck
+	TypeMsgChannelOpenConfirm        = types.TypeMsgChannelOpenConfirm
+	TypeMsgRecvPacket                = types.TypeMsgRecvPacket
+	TypeMsgAcknowledgement           = types.TypeMsgAcknowledgement
+	TypeMsgTimeout                   = types.TypeMsgTimeout
+	QueryClient                      = types.QueryClient
+	QueryClients                     = types.QueryClients
+	QueryConsensusState              = types.QueryConsensusState
+	QueryConnection                  = types.QueryConnection
+	QueryConnections                 = types.QueryConnections
+	QueryChannel                     = types.QueryChannel
+	QueryChannels                    = types.QueryChannels
+)
+
+var (
+	// functions aliases
+	NewKeeper                   = keeper.NewKeeper
+	NewQuerier                  = keeper.NewQuerier
+	ValidateIdentifier          = types.ValidateIdentifier
+	ClientStateKey              = types.ClientStateKey
+	ConsensusStateKey           = types.ConsensusStateKey
+	ConnectionKey               = types.ConnectionKey
+	ChannelKey                  = types.ChannelKey
+	NextSequenceSendKey         = types.NextSequenceSendKey
+	NextSequenceRecvKey         = types.NextSequenceRecvKey
+	PacketCommitmentKey         = types.PacketCommitmentKey
+	PacketAcknowledgementKey    = types.PacketAcknowledgementKey
+	NewMerkleProof              = types.NewMerkleProof
+	NewClientState              = types.NewClientState
+	NewConsensusState           = types.NewConsensusState
+	NewHeader                   = types.NewHeader
+	NewClientConsensusState     = types.NewClientConsensusState
+	NewConnectionEnd            = types.NewConnectionEnd
+	NewConnectionCounterparty   = types.NewConnectionCounterparty
+	NewIdentifiedConnection     = types.NewIdentifiedConnection
+	NewChannel                  = types.NewChannel
+	NewChannelCounterparty      = types.NewChannelCounterparty
+	NewIdentifiedChannel        = types.NewIdentifiedChannel
+	StateFromString             = types.StateFromString
+	OrderFromString             = types.OrderFromString
+	ValidOrder                  = types.ValidOrder
+	NewPacket                   = types.NewPacket
+	CommitPacket                = types.CommitPacket
+	CommitAcknowledgement       = types.CommitAcknowledgement
+	NewPacketSequence           = types.NewPacketSequence
+	NewPacketCommitment         = types.NewPacketCommitment
+	NewRouter                   = types.NewRouter
+	NewMsgCreateClient          = types.NewMsgCreateClient
+	NewMsgUpdateClient          = types.NewMsgUpdateClient
+	NewMsgConnectionOpenInit    = types.NewMsgConnectionOpenInit
+	NewMsgConnectionOpenTry     = types.NewMsgConnectionOpenTry
+	NewMsgConnectionOpenAck     = types.NewMsgConnectionOpenAck
+	NewMsgConnectionOpenConfirm = types.NewMsgConnectionOpenConfirm
+	NewMsgChannelOpenInit       = types.NewMsgChannelOpenInit
+	NewMsgChannelOpenTry        = types.NewMsgChannelOpenTry
+	NewMsgChannelOpenAck        = types.NewMsgChannelOpenAck
+	NewMsgChannelOpenConfirm    = types.NewMsgChannelOpenConfirm
+	NewMsgRecvPacket            = types.NewMsgRecvPacket
+	NewMsgAcknowledgement       = types.NewMsgAcknowledgement
+	NewMsgTimeout               = types.NewMsgTimeout
+	DefaultGenesisState         = types.DefaultGenesisState
+	ValidateGenesis             = types.ValidateGenesis
+	NewQueryClientParams        = types.NewQueryClientParams
+	NewQueryConnectionParams    = types.NewQueryConnectionParams
+	NewQueryChannelParams       = types.NewQueryChannelParams
+	RegisterCodec               = types.RegisterCodec
+	ErrInvalidIdentifier        = types.ErrInvalidIdentifier
+	ErrClientExists             = types.ErrClientExists
+	ErrClientNotFound           = types.ErrClientNotFound
+	ErrInvalidHeader            = types.ErrInvalidHeader
+	ErrConsensusStateNotFound   = types.ErrConsensusStateNotFound
+	ErrConnectionExists         = types.ErrConnectionExists
+	ErrConnectionNotFound       = types.ErrConnectionNotFound
+	ErrInvalidConnection        = types.ErrInvalidConnection
+	ErrChannelExists            = types.ErrChannelExists
+	ErrChannelNotFound          = types.ErrChannelNotFound
+	ErrInvalidChannel           = types.ErrInvalidChannel
+	ErrPortNotBound             = types.ErrPortNotBound
+	ErrInvalidPacket            = types.ErrInvalidPacket
+	ErrPacketTimeout            = types.ErrPacketTimeout
+	ErrInvalidProof             = types.ErrInvalidProof
+
+	// variable aliases
+	ModuleCdc                   = types.ModuleCdc
+	ClientPrefix                = types.ClientPrefix
+	ConnectionPrefix            = types.ConnectionPrefix
+	ChannelPrefix               = types.ChannelPrefix
+	NextSequenceSendPrefix      = types.NextSequenceSendPrefix
+	NextSequenceRecvPrefix      = types.NextSequenceRecvPrefix
+	PacketCommitmentPrefix      = types.PacketCommitmentPrefix
+	PacketAcknowledgementPrefix = types.PacketAcknowledgementPrefix
+)
+
+type (
+	Keeper                   = keeper.Keeper
+	MerkleProof              = types.MerkleProof
+	ClientState              = types.ClientState
+	ClientStates             = types.ClientStates
+	ConsensusState           = types.ConsensusState
+	Header                   = types.Header
+	ClientConsensusState     = types.ClientConsensusState
+	State                    = types.State
+	Order                    = types.Order
+	ConnectionEnd            = types.ConnectionEnd
+	ConnectionCounterparty   = types.ConnectionCounterparty
+	IdentifiedConnection     = types.IdentifiedConnection
+	IdentifiedConnections    = types.IdentifiedConnections
+	Channel                  = types.Channel
+	ChannelCounterparty      = types.ChannelCounterparty
+	IdentifiedChannel        = types.IdentifiedChannel
+	IdentifiedChannels       = types.IdentifiedChannels
+	Packet                   = types.Packet
+	PacketSequence           = types.PacketSequence
+	PacketCommitment         = types.PacketCommitment
+	Module                   = types.Module
+	Router                   = types.Router
+	MsgCreateClient          = types.MsgCreateClient
+	MsgUpdateClient          = types.MsgUpdateClient
+	MsgConnectionOpenInit    = types.MsgConnectionOpenInit
+	MsgConnectionOpenTry     = types.MsgConnectionOpenTry
+	MsgConnectionOpenAck     = types.MsgConnectionOpenAck
+	MsgConnectionOpenConfirm = types.MsgConnectionOpenConfirm
+	MsgChannelOpenInit       = types.MsgChannelOpenInit
+	MsgChannelOpenTry        = types.MsgChannelOpenTry
+	MsgChannelOpenAck        = types.MsgChannelOpenAck
+	MsgChannelOpenConfirm    = types.MsgChannelOpenConfirm
+	MsgRecvPacket            = types.MsgRecvPacket
+	MsgAcknowledgement       = types.MsgAcknowledgement
+	MsgTimeout               = types.MsgTimeout
+	GenesisState             = types.GenesisState
+	QueryClientParams        = types.QueryClientParams
+	QueryConnectionParams    = types.QueryConnectionParams
+	QueryChannelParams       = types.QueryChannelParams
+)
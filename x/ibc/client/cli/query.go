@@ -0,0 +1,238 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/ibc/internal/types"
+)
+
+// flags of the consensus-state query command
+const (
+	FlagHeight = "client-height"
+)
+
+// GetQueryCmd returns the cli query commands for the ibc module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	ibcQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the ibc module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	ibcQueryCmd.AddCommand(client.GetCommands(
+		GetCmdQueryClient(cdc),
+		GetCmdQueryClients(cdc),
+		GetCmdQueryConsensusState(cdc),
+		GetCmdQueryConnection(cdc),
+		GetCmdQueryConnections(cdc),
+		GetCmdQueryChannel(cdc),
+		GetCmdQueryChannels(cdc),
+	)...)
+
+	return ibcQueryCmd
+}
+
+// GetCmdQueryClient returns the command to query a light client
+func GetCmdQueryClient(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "client [client_id]",
+		Short: "Query a light client of a counterparty chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the state of a light client tracking the headers of a counterparty chain.
+
+Example:
+$ %s query %s client gaiaclient
+`, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := types.NewQueryClientParams(args[0], 0)
+			res, err := queryWithParams(cliCtx, types.QueryClient, params)
+			if err != nil {
+				return err
+			}
+
+			var clientState types.ClientState
+			cdc.MustUnmarshalJSON(res, &clientState)
+			return cliCtx.PrintOutput(clientState)
+		},
+	}
+}
+
+// GetCmdQueryClients returns the command to query all the light clients
+func GetCmdQueryClients(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "clients",
+		Short: "Query all the light clients of counterparty chains",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryClients)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var clients types.ClientStates
+			cdc.MustUnmarshalJSON(res, &clients)
+			return cliCtx.PrintOutput(clients)
+		},
+	}
+}
+
+// GetCmdQueryConsensusState returns the command to query a consensus state of
+// a light client
+func GetCmdQueryConsensusState(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consensus-state [client_id]",
+		Short: "Query a consensus state of a light client",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the consensus state of a light client at a height of the counterparty
+chain, or at the latest height of the client if no height is given.
+
+Example:
+$ %s query %s consensus-state gaiaclient --client-height 42
+`, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := types.NewQueryClientParams(args[0], viper.GetUint64(FlagHeight))
+			res, err := queryWithParams(cliCtx, types.QueryConsensusState, params)
+			if err != nil {
+				return err
+			}
+
+			var consensusState types.ConsensusState
+			cdc.MustUnmarshalJSON(res, &consensusState)
+			return cliCtx.PrintOutput(consensusState)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagHeight, 0, "Height of the counterparty chain of the consensus state")
+	return cmd
+}
+
+// GetCmdQueryConnection returns the command to query a connection
+func GetCmdQueryConnection(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "connection [connection_id]",
+		Short: "Query a connection to a counterparty chain",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := types.NewQueryConnectionParams(args[0])
+			res, err := queryWithParams(cliCtx, types.QueryConnection, params)
+			if err != nil {
+				return err
+			}
+
+			var connection types.ConnectionEnd
+			cdc.MustUnmarshalJSON(res, &connection)
+			return cliCtx.PrintOutput(connection)
+		},
+	}
+}
+
+// GetCmdQueryConnections returns the command to query all the connections
+func GetCmdQueryConnections(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "connections",
+		Short: "Query all the connections to counterparty chains",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryConnections)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var connections types.IdentifiedConnections
+			cdc.MustUnmarshalJSON(res, &connections)
+			return cliCtx.PrintOutput(connections)
+		},
+	}
+}
+
+// GetCmdQueryChannel returns the command to query a channel end
+func GetCmdQueryChannel(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "channel [port_id] [channel_id]",
+		Short: "Query a channel end of a port",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the channel end of a port, and the channel end of the counterparty
+chain it is connected to.
+
+Example:
+$ %s query %s channel transfer gaiachannel
+`, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := types.NewQueryChannelParams(args[0], args[1])
+			res, err := queryWithParams(cliCtx, types.QueryChannel, params)
+			if err != nil {
+				return err
+			}
+
+			var channel types.Channel
+			cdc.MustUnmarshalJSON(res, &channel)
+			return cliCtx.PrintOutput(channel)
+		},
+	}
+}
+
+// GetCmdQueryChannels returns the command to query all the channel ends
+func GetCmdQueryChannels(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "channels",
+		Short: "Query all the channel ends of all the ports",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryChannels)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var channels types.IdentifiedChannels
+			cdc.MustUnmarshalJSON(res, &channels)
+			return cliCtx.PrintOutput(channels)
+		},
+	}
+}
+
+func queryWithParams(cliCtx context.CLIContext, query string, params interface{}) ([]byte, error) {
+	bz, err := cliCtx.Codec.MarshalJSON(params)
+	if err != nil {
+		return nil, err
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, query)
+	res, _, err := cliCtx.QueryWithData(route, bz)
+	return res, err
+}
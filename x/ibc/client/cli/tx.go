@@ -0,0 +1,204 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/ibc/internal/types"
+)
+
+// flags of the handshake commands
+const (
+	FlagPrefix  = "prefix"
+	FlagOrder   = "order"
+	FlagVersion = "version"
+)
+
+// GetTxCmd returns the transaction commands for the ibc module. The messages
+// carrying proofs of the state of a counterparty chain are submitted by
+// relayers and have no commands.
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	ibcTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "IBC transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	ibcTxCmd.AddCommand(client.PostCommands(
+		GetCmdCreateClient(cdc),
+		GetCmdUpdateClient(cdc),
+		GetCmdConnectionOpenInit(cdc),
+		GetCmdChannelOpenInit(cdc),
+	)...)
+
+	return ibcTxCmd
+}
+
+// GetCmdCreateClient returns the command to create a light client
+func GetCmdCreateClient(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "create-client [client_id] [header_file]",
+		Short: "Create a light client of a counterparty chain from a trusted header",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a light client tracking the headers of a counterparty chain. The client
+trusts the validator set of the signed header, given as JSON in a file.
+
+Example:
+$ %s tx %s create-client gaiaclient header.json --from mykey
+`, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			header, err := readHeader(cdc, args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateClient(args[0], header, cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdUpdateClient returns the command to update a light client
+func GetCmdUpdateClient(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "update-client [client_id] [header_file]",
+		Short: "Update a light client with a new header of the counterparty chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Update a light client with a signed header of the counterparty chain, given as
+JSON in a file. The header must be signed by the validator set committed to by the
+latest header of the client.
+
+Example:
+$ %s tx %s update-client gaiaclient header.json --from mykey
+`, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			header, err := readHeader(cdc, args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateClient(args[0], header, cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdConnectionOpenInit returns the command to initialize a connection
+func GetCmdConnectionOpenInit(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "connection-open-init [connection_id] [client_id] " +
+			"[counterparty_client_id] [counterparty_connection_id]",
+		Short: "Initialize a connection to a counterparty chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Initialize a connection to a counterparty chain, verified by a light client of
+the counterparty chain and by a light client of this chain on the counterparty chain.
+
+Example:
+$ %s tx %s connection-open-init gaiaconn gaiaclient hubclient hubconn --from mykey
+`, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			counterparty := types.NewConnectionCounterparty(args[2], args[3], viper.GetString(FlagPrefix))
+			msg := types.NewMsgConnectionOpenInit(args[0], args[1], counterparty, cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(FlagPrefix, types.DefaultPrefix, "Name of the store of the ibc module of the counterparty chain")
+	return cmd
+}
+
+// GetCmdChannelOpenInit returns the command to initialize a channel
+func GetCmdChannelOpenInit(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "channel-open-init [port_id] [channel_id] [connection_id] " +
+			"[counterparty_port_id] [counterparty_channel_id]",
+		Short: "Initialize a channel of a port over a connection",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Initialize a channel of a port over an open connection. The module bound to the
+port may reject the --order or --version of the channel.
+
+Example:
+$ %s tx %s channel-open-init transfer gaiachannel gaiaconn transfer hubchannel --version ics20-1 --from mykey
+`, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			order, err := types.OrderFromString(strings.ToUpper(viper.GetString(FlagOrder)))
+			if err != nil {
+				return err
+			}
+
+			counterparty := types.NewChannelCounterparty(args[3], args[4])
+			channel := types.NewChannel(types.StateInit, order, counterparty,
+				[]string{args[2]}, viper.GetString(FlagVersion))
+
+			msg := types.NewMsgChannelOpenInit(args[0], args[1], channel, cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(FlagOrder, types.OrderUnordered.String(), "Ordering of the channel (ordered|unordered)")
+	cmd.Flags().String(FlagVersion, "", "Version of the channel, agreed on by the modules bound to its ports")
+	return cmd
+}
+
+func readHeader(cdc *codec.Codec, path string) (header types.Header, err error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return header, err
+	}
+
+	err = cdc.UnmarshalJSON(bz, &header)
+	return header, err
+}
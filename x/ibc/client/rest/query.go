@@ -0,0 +1,112 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/ibc/internal/types"
+)
+
+func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc(
+		"/ibc/clients",
+		queryHandlerFn(cliCtx, types.QueryClients, nil),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/ibc/clients/{clientID}",
+		queryHandlerFn(cliCtx, types.QueryClient, clientParams),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/ibc/clients/{clientID}/consensus_state",
+		queryHandlerFn(cliCtx, types.QueryConsensusState, clientParams),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/ibc/connections",
+		queryHandlerFn(cliCtx, types.QueryConnections, nil),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/ibc/connections/{connectionID}",
+		queryHandlerFn(cliCtx, types.QueryConnection, connectionParams),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/ibc/channels",
+		queryHandlerFn(cliCtx, types.QueryChannels, nil),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/ibc/ports/{portID}/channels/{channelID}",
+		queryHandlerFn(cliCtx, types.QueryChannel, channelParams),
+	).Methods("GET")
+}
+
+// paramsFn parses the query params of a request
+type paramsFn func(r *http.Request) (interface{}, error)
+
+// clientParams parses the client of a request, and the height of its
+// consensus state from the optional height query parameter
+func clientParams(r *http.Request) (interface{}, error) {
+	var height uint64
+	if h := r.URL.Query().Get("height"); h != "" {
+		var err error
+		height, err = strconv.ParseUint(h, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid height %s: %s", h, err)
+		}
+	}
+	return types.NewQueryClientParams(mux.Vars(r)["clientID"], height), nil
+}
+
+func connectionParams(r *http.Request) (interface{}, error) {
+	return types.NewQueryConnectionParams(mux.Vars(r)["connectionID"]), nil
+}
+
+func channelParams(r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	return types.NewQueryChannelParams(vars["portID"], vars["channelID"]), nil
+}
+
+// http request handler to query an ibc querier endpoint with the params
+// parsed from the request, if any
+func queryHandlerFn(cliCtx context.CLIContext, query string, params paramsFn) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		var bz []byte
+		if params != nil {
+			p, err := params(r)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+
+			bz, err = cliCtx.Codec.MarshalJSON(p)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, query)
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
@@ -0,0 +1,75 @@
+package ibc
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis sets the clients, connections, channels and packet state of the
+// genesis state.
+func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
+	for _, client := range data.Clients {
+		k.SetClientState(ctx, client)
+	}
+	for _, cs := range data.ConsensusStates {
+		k.SetConsensusState(ctx, cs.ClientID, cs.Height, cs.ConsensusState)
+	}
+	for _, connection := range data.Connections {
+		k.SetConnection(ctx, connection.ID, connection.Connection)
+	}
+	for _, channel := range data.Channels {
+		k.SetChannel(ctx, channel.PortID, channel.ChannelID, channel.Channel)
+	}
+	for _, seq := range data.NextSequenceSends {
+		k.SetNextSequenceSend(ctx, seq.PortID, seq.ChannelID, seq.Sequence)
+	}
+	for _, seq := range data.NextSequenceRecvs {
+		k.SetNextSequenceRecv(ctx, seq.PortID, seq.ChannelID, seq.Sequence)
+	}
+	for _, commitment := range data.Commitments {
+		k.SetPacketCommitment(ctx, commitment.PortID, commitment.ChannelID, commitment.Sequence, commitment.Commitment)
+	}
+	for _, ack := range data.Acknowledgements {
+		k.SetPacketAcknowledgement(ctx, ack.PortID, ack.ChannelID, ack.Sequence, ack.Commitment)
+	}
+}
+
+// ExportGenesis returns a GenesisState with the clients, connections, channels
+// and packet state of the ibc module.
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	data := DefaultGenesisState()
+
+	k.IterateClients(ctx, func(client ClientState) bool {
+		data.Clients = append(data.Clients, client)
+		return false
+	})
+	k.IterateConsensusStates(ctx, func(cs ClientConsensusState) bool {
+		data.ConsensusStates = append(data.ConsensusStates, cs)
+		return false
+	})
+	k.IterateConnections(ctx, func(connection IdentifiedConnection) bool {
+		data.Connections = append(data.Connections, connection)
+		return false
+	})
+	k.IterateChannels(ctx, func(channel IdentifiedChannel) bool {
+		data.Channels = append(data.Channels, channel)
+		return false
+	})
+	k.IteratePacketSequences(ctx, NextSequenceSendPrefix, func(seq PacketSequence) bool {
+		data.NextSequenceSends = append(data.NextSequenceSends, seq)
+		return false
+	})
+	k.IteratePacketSequences(ctx, NextSequenceRecvPrefix, func(seq PacketSequence) bool {
+		data.NextSequenceRecvs = append(data.NextSequenceRecvs, seq)
+		return false
+	})
+	k.IteratePacketCommitments(ctx, PacketCommitmentPrefix, func(commitment PacketCommitment) bool {
+		data.Commitments = append(data.Commitments, commitment)
+		return false
+	})
+	k.IteratePacketCommitments(ctx, PacketAcknowledgementPrefix, func(ack PacketCommitment) bool {
+		data.Acknowledgements = append(data.Acknowledgements, ack)
+		return false
+	})
+
+	return data
+}
@@ -0,0 +1,74 @@
+package ibc
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewHandler returns a handler for ibc messages.
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		var err sdk.Error
+		switch msg := msg.(type) {
+		case MsgCreateClient:
+			err = k.CreateClient(ctx, msg.ClientID, msg.Header)
+
+		case MsgUpdateClient:
+			err = k.UpdateClient(ctx, msg.ClientID, msg.Header)
+
+		case MsgConnectionOpenInit:
+			err = k.ConnOpenInit(ctx, msg.ConnectionID, msg.ClientID, msg.Counterparty)
+
+		case MsgConnectionOpenTry:
+			err = k.ConnOpenTry(ctx, msg.ConnectionID, msg.ClientID, msg.Counterparty, msg.ProofInit, msg.ProofHeight)
+
+		case MsgConnectionOpenAck:
+			err = k.ConnOpenAck(ctx, msg.ConnectionID, msg.ProofTry, msg.ProofHeight)
+
+		case MsgConnectionOpenConfirm:
+			err = k.ConnOpenConfirm(ctx, msg.ConnectionID, msg.ProofAck, msg.ProofHeight)
+
+		case MsgChannelOpenInit:
+			err = k.ChanOpenInit(ctx, msg.PortID, msg.ChannelID, msg.Channel)
+
+		case MsgChannelOpenTry:
+			err = k.ChanOpenTry(ctx, msg.PortID, msg.ChannelID, msg.Channel, msg.ProofInit, msg.ProofHeight)
+
+		case MsgChannelOpenAck:
+			err = k.ChanOpenAck(ctx, msg.PortID, msg.ChannelID, msg.ProofTry, msg.ProofHeight)
+
+		case MsgChannelOpenConfirm:
+			err = k.ChanOpenConfirm(ctx, msg.PortID, msg.ChannelID, msg.ProofAck, msg.ProofHeight)
+
+		case MsgRecvPacket:
+			err = k.RecvPacket(ctx, msg.Packet, msg.Proof, msg.ProofHeight)
+
+		case MsgAcknowledgement:
+			err = k.AcknowledgePacket(ctx, msg.Packet, msg.Acknowledgement, msg.Proof, msg.ProofHeight)
+
+		case MsgTimeout:
+			err = k.TimeoutPacket(ctx, msg.Packet, msg.NextSequenceRecv, msg.Proof, msg.ProofHeight)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized ibc message type: %T", msg)
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+
+		if err != nil {
+			return err.Result()
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				sdk.EventTypeMessage,
+				sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+				sdk.NewAttribute(sdk.AttributeKeySender, msg.GetSigners()[0].String()),
+			),
+		)
+
+		return sdk.Result{Events: ctx.EventManager().Events()}
+	}
+}
@@ -0,0 +1,224 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/internal/types"
+)
+
+// ChanOpenInit initializes a channel of a port over a connection, if the
+// module bound to the port accepts it.
+func (k Keeper) ChanOpenInit(ctx sdk.Context, portID, channelID string, channel types.Channel) sdk.Error {
+	module, err := k.getModule(portID)
+	if err != nil {
+		return err
+	}
+	if _, found := k.GetChannel(ctx, portID, channelID); found {
+		return types.ErrChannelExists(k.codespace, portID, channelID)
+	}
+	if _, found := k.GetConnection(ctx, channel.ConnectionHops[0]); !found {
+		return types.ErrConnectionNotFound(k.codespace, channel.ConnectionHops[0])
+	}
+
+	if err := module.OnChanOpenInit(ctx, channel.Ordering, channel.ConnectionHops, portID, channelID,
+		channel.Counterparty, channel.Version); err != nil {
+		return err
+	}
+
+	channel.State = types.StateInit
+	k.initChannel(ctx, portID, channelID, channel)
+
+	k.emitChannelEvent(ctx, types.EventTypeChannelOpenInit, portID, channelID, channel)
+	return nil
+}
+
+// ChanOpenTry opens a channel of a port in response to the initialization of
+// its counterparty, if the module bound to the port accepts it.
+func (k Keeper) ChanOpenTry(ctx sdk.Context, portID, channelID string, channel types.Channel,
+	proofInit types.MerkleProof, proofHeight uint64) sdk.Error {
+
+	module, err := k.getModule(portID)
+	if err != nil {
+		return err
+	}
+	if _, found := k.GetChannel(ctx, portID, channelID); found {
+		return types.ErrChannelExists(k.codespace, portID, channelID)
+	}
+	connection, err := k.getOpenConnection(ctx, channel.ConnectionHops[0])
+	if err != nil {
+		return err
+	}
+
+	expected := types.NewChannel(types.StateInit, channel.Ordering, types.NewChannelCounterparty(portID, channelID),
+		[]string{connection.Counterparty.ConnectionID}, channel.Version)
+	if err := k.verifyChannelState(ctx, connection, proofHeight, proofInit, channel.Counterparty, expected); err != nil {
+		return err
+	}
+
+	if err := module.OnChanOpenTry(ctx, channel.Ordering, channel.ConnectionHops, portID, channelID,
+		channel.Counterparty, channel.Version); err != nil {
+		return err
+	}
+
+	channel.State = types.StateTryOpen
+	k.initChannel(ctx, portID, channelID, channel)
+
+	k.emitChannelEvent(ctx, types.EventTypeChannelOpenTry, portID, channelID, channel)
+	return nil
+}
+
+// ChanOpenAck opens an initialized channel once its counterparty has been
+// opened in response.
+func (k Keeper) ChanOpenAck(ctx sdk.Context, portID, channelID string, proofTry types.MerkleProof,
+	proofHeight uint64) sdk.Error {
+
+	module, err := k.getModule(portID)
+	if err != nil {
+		return err
+	}
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return types.ErrChannelNotFound(k.codespace, portID, channelID)
+	}
+	if channel.State != types.StateInit {
+		return types.ErrInvalidChannel(k.codespace, "channel is not in INIT state")
+	}
+	connection, err := k.getOpenConnection(ctx, channel.ConnectionHops[0])
+	if err != nil {
+		return err
+	}
+
+	expected := types.NewChannel(types.StateTryOpen, channel.Ordering, types.NewChannelCounterparty(portID, channelID),
+		[]string{connection.Counterparty.ConnectionID}, channel.Version)
+	if err := k.verifyChannelState(ctx, connection, proofHeight, proofTry, channel.Counterparty, expected); err != nil {
+		return err
+	}
+
+	if err := module.OnChanOpenAck(ctx, portID, channelID); err != nil {
+		return err
+	}
+
+	channel.State = types.StateOpen
+	k.SetChannel(ctx, portID, channelID, channel)
+
+	k.emitChannelEvent(ctx, types.EventTypeChannelOpenAck, portID, channelID, channel)
+	return nil
+}
+
+// ChanOpenConfirm completes the handshake of a channel opened in response to
+// its counterparty, once the counterparty is open.
+func (k Keeper) ChanOpenConfirm(ctx sdk.Context, portID, channelID string, proofAck types.MerkleProof,
+	proofHeight uint64) sdk.Error {
+
+	module, err := k.getModule(portID)
+	if err != nil {
+		return err
+	}
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return types.ErrChannelNotFound(k.codespace, portID, channelID)
+	}
+	if channel.State != types.StateTryOpen {
+		return types.ErrInvalidChannel(k.codespace, "channel is not in TRYOPEN state")
+	}
+	connection, err := k.getOpenConnection(ctx, channel.ConnectionHops[0])
+	if err != nil {
+		return err
+	}
+
+	expected := types.NewChannel(types.StateOpen, channel.Ordering, types.NewChannelCounterparty(portID, channelID),
+		[]string{connection.Counterparty.ConnectionID}, channel.Version)
+	if err := k.verifyChannelState(ctx, connection, proofHeight, proofAck, channel.Counterparty, expected); err != nil {
+		return err
+	}
+
+	if err := module.OnChanOpenConfirm(ctx, portID, channelID); err != nil {
+		return err
+	}
+
+	channel.State = types.StateOpen
+	k.SetChannel(ctx, portID, channelID, channel)
+
+	k.emitChannelEvent(ctx, types.EventTypeChannelOpenConfirm, portID, channelID, channel)
+	return nil
+}
+
+// GetChannel returns a channel end.
+func (k Keeper) GetChannel(ctx sdk.Context, portID, channelID string) (channel types.Channel, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ChannelKey(portID, channelID))
+	if bz == nil {
+		return channel, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &channel)
+	return channel, true
+}
+
+// SetChannel sets a channel end.
+func (k Keeper) SetChannel(ctx sdk.Context, portID, channelID string, channel types.Channel) {
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(channel)
+	ctx.KVStore(k.storeKey).Set(types.ChannelKey(portID, channelID), bz)
+}
+
+// IterateChannels iterates over all the channel ends, in the order of their
+// port and identifier, until the callback returns true.
+func (k Keeper) IterateChannels(ctx sdk.Context, cb func(types.IdentifiedChannel) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ChannelPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var channel types.Channel
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &channel)
+
+		portID, channelID := parseChannelPath(iter.Key())
+		if cb(types.NewIdentifiedChannel(portID, channelID, channel)) {
+			break
+		}
+	}
+}
+
+// initChannel sets a new channel end and the sequences of its first packets.
+func (k Keeper) initChannel(ctx sdk.Context, portID, channelID string, channel types.Channel) {
+	k.SetChannel(ctx, portID, channelID, channel)
+	k.SetNextSequenceSend(ctx, portID, channelID, 1)
+	k.SetNextSequenceRecv(ctx, portID, channelID, 1)
+}
+
+// getOpenChannel returns a channel which must be open, along with its open
+// connection.
+func (k Keeper) getOpenChannel(ctx sdk.Context, portID, channelID string) (types.Channel, types.ConnectionEnd, sdk.Error) {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return channel, types.ConnectionEnd{}, types.ErrChannelNotFound(k.codespace, portID, channelID)
+	}
+	if channel.State != types.StateOpen {
+		return channel, types.ConnectionEnd{}, types.ErrInvalidChannel(k.codespace, "channel is not open")
+	}
+
+	connection, err := k.getOpenConnection(ctx, channel.ConnectionHops[0])
+	return channel, connection, err
+}
+
+// parseChannelPath returns the port and channel identifiers of a key
+// containing the path of a channel: {prefix}/ports/{portID}/channels/{channelID}[/...]
+func parseChannelPath(key []byte) (portID, channelID string) {
+	parts := strings.Split(string(key), "/")
+	if len(parts) < 5 || parts[1] != "ports" || parts[3] != "channels" {
+		panic("invalid channel path " + string(key))
+	}
+	return parts[2], parts[4]
+}
+
+func (k Keeper) emitChannelEvent(ctx sdk.Context, eventType, portID, channelID string, channel types.Channel) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyPortID, channel.Counterparty.PortID),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyChannel, channel.Counterparty.ChannelID),
+			sdk.NewAttribute(types.AttributeKeyConnectionID, channel.ConnectionHops[0]),
+		),
+	)
+}
@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/internal/types"
+)
+
+// CreateClient creates a client of the chain of a header, which is trusted as
+// the initial state of the client.
+func (k Keeper) CreateClient(ctx sdk.Context, clientID string, header types.Header) sdk.Error {
+	if _, found := k.GetClientState(ctx, clientID); found {
+		return types.ErrClientExists(k.codespace, clientID)
+	}
+
+	chainID := header.SignedHeader.ChainID
+	if err := header.ValidateBasic(chainID); err != nil {
+		return types.ErrInvalidHeader(k.codespace, err.Error())
+	}
+
+	k.SetClientState(ctx, types.NewClientState(clientID, chainID, header.GetHeight()))
+	k.SetConsensusState(ctx, clientID, header.GetHeight(), header.ConsensusState())
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateClient,
+			sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", header.GetHeight())),
+		),
+	)
+	return nil
+}
+
+// UpdateClient updates a client with a new header of its chain, which must be
+// trusted given the latest consensus state of the client.
+func (k Keeper) UpdateClient(ctx sdk.Context, clientID string, header types.Header) sdk.Error {
+	client, found := k.GetClientState(ctx, clientID)
+	if !found {
+		return types.ErrClientNotFound(k.codespace, clientID)
+	}
+
+	latest, found := k.GetConsensusState(ctx, clientID, client.LatestHeight)
+	if !found {
+		return types.ErrConsensusStateNotFound(k.codespace, clientID, client.LatestHeight)
+	}
+
+	if err := client.CheckHeader(latest, header); err != nil {
+		return types.ErrInvalidHeader(k.codespace, err.Error())
+	}
+
+	client.LatestHeight = header.GetHeight()
+	k.SetClientState(ctx, client)
+	k.SetConsensusState(ctx, clientID, header.GetHeight(), header.ConsensusState())
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateClient,
+			sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", header.GetHeight())),
+		),
+	)
+	return nil
+}
+
+// GetClientState returns the state of a client.
+func (k Keeper) GetClientState(ctx sdk.Context, clientID string) (client types.ClientState, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ClientStateKey(clientID))
+	if bz == nil {
+		return client, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &client)
+	return client, true
+}
+
+// SetClientState sets the state of a client.
+func (k Keeper) SetClientState(ctx sdk.Context, client types.ClientState) {
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(client)
+	ctx.KVStore(k.storeKey).Set(types.ClientStateKey(client.ID), bz)
+}
+
+// GetConsensusState returns the consensus state of a client at a height.
+func (k Keeper) GetConsensusState(ctx sdk.Context, clientID string, height uint64) (cs types.ConsensusState, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ConsensusStateKey(clientID, height))
+	if bz == nil {
+		return cs, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &cs)
+	return cs, true
+}
+
+// SetConsensusState sets the consensus state of a client at a height.
+func (k Keeper) SetConsensusState(ctx sdk.Context, clientID string, height uint64, cs types.ConsensusState) {
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(cs)
+	ctx.KVStore(k.storeKey).Set(types.ConsensusStateKey(clientID, height), bz)
+}
+
+// IterateClients iterates over all the client states, in the order of their
+// identifiers, until the callback returns true.
+func (k Keeper) IterateClients(ctx sdk.Context, cb func(types.ClientState) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ClientPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if !strings.HasSuffix(string(iter.Key()), "/clientState") {
+			continue
+		}
+
+		var client types.ClientState
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &client)
+		if cb(client) {
+			break
+		}
+	}
+}
+
+// IterateConsensusStates iterates over the consensus states of all the
+// clients until the callback returns true.
+func (k Keeper) IterateConsensusStates(ctx sdk.Context, cb func(types.ClientConsensusState) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ClientPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		// clients/{clientID}/consensusState/{height}
+		parts := strings.Split(string(iter.Key()), "/")
+		if len(parts) != 4 || parts[2] != "consensusState" {
+			continue
+		}
+
+		height, err := strconv.ParseUint(parts[3], 10, 64)
+		if err != nil {
+			panic(err)
+		}
+
+		var cs types.ConsensusState
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &cs)
+		if cb(types.NewClientConsensusState(parts[1], height, cs)) {
+			break
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/internal/types"
+)
+
+// ConnOpenInit initializes a connection with a counterparty chain tracked by
+// a client.
+func (k Keeper) ConnOpenInit(ctx sdk.Context, connectionID, clientID string,
+	counterparty types.ConnectionCounterparty) sdk.Error {
+
+	if _, found := k.GetConnection(ctx, connectionID); found {
+		return types.ErrConnectionExists(k.codespace, connectionID)
+	}
+	if _, found := k.GetClientState(ctx, clientID); !found {
+		return types.ErrClientNotFound(k.codespace, clientID)
+	}
+
+	connection := types.NewConnectionEnd(types.StateInit, clientID, counterparty)
+	k.SetConnection(ctx, connectionID, connection)
+
+	k.emitConnectionEvent(ctx, types.EventTypeConnectionOpenInit, connectionID, connection)
+	return nil
+}
+
+// ConnOpenTry opens a connection in response to the initialization of its
+// counterparty, which must refer to the connection and its client.
+func (k Keeper) ConnOpenTry(ctx sdk.Context, connectionID, clientID string, counterparty types.ConnectionCounterparty,
+	proofInit types.MerkleProof, proofHeight uint64) sdk.Error {
+
+	if _, found := k.GetConnection(ctx, connectionID); found {
+		return types.ErrConnectionExists(k.codespace, connectionID)
+	}
+	if _, found := k.GetClientState(ctx, clientID); !found {
+		return types.ErrClientNotFound(k.codespace, clientID)
+	}
+
+	connection := types.NewConnectionEnd(types.StateTryOpen, clientID, counterparty)
+	expected := types.NewConnectionEnd(types.StateInit, counterparty.ClientID,
+		types.NewConnectionCounterparty(clientID, connectionID, k.Prefix()))
+	if err := k.verifyConnectionState(ctx, connection, proofHeight, proofInit, expected); err != nil {
+		return err
+	}
+
+	k.SetConnection(ctx, connectionID, connection)
+
+	k.emitConnectionEvent(ctx, types.EventTypeConnectionOpenTry, connectionID, connection)
+	return nil
+}
+
+// ConnOpenAck opens an initialized connection once its counterparty has been
+// opened in response.
+func (k Keeper) ConnOpenAck(ctx sdk.Context, connectionID string, proofTry types.MerkleProof,
+	proofHeight uint64) sdk.Error {
+
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		return types.ErrConnectionNotFound(k.codespace, connectionID)
+	}
+	if connection.State != types.StateInit {
+		return types.ErrInvalidConnection(k.codespace, "connection is not in INIT state")
+	}
+
+	expected := types.NewConnectionEnd(types.StateTryOpen, connection.Counterparty.ClientID,
+		types.NewConnectionCounterparty(connection.ClientID, connectionID, k.Prefix()))
+	if err := k.verifyConnectionState(ctx, connection, proofHeight, proofTry, expected); err != nil {
+		return err
+	}
+
+	connection.State = types.StateOpen
+	k.SetConnection(ctx, connectionID, connection)
+
+	k.emitConnectionEvent(ctx, types.EventTypeConnectionOpenAck, connectionID, connection)
+	return nil
+}
+
+// ConnOpenConfirm completes the handshake of a connection opened in response
+// to its counterparty, once the counterparty is open.
+func (k Keeper) ConnOpenConfirm(ctx sdk.Context, connectionID string, proofAck types.MerkleProof,
+	proofHeight uint64) sdk.Error {
+
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		return types.ErrConnectionNotFound(k.codespace, connectionID)
+	}
+	if connection.State != types.StateTryOpen {
+		return types.ErrInvalidConnection(k.codespace, "connection is not in TRYOPEN state")
+	}
+
+	expected := types.NewConnectionEnd(types.StateOpen, connection.Counterparty.ClientID,
+		types.NewConnectionCounterparty(connection.ClientID, connectionID, k.Prefix()))
+	if err := k.verifyConnectionState(ctx, connection, proofHeight, proofAck, expected); err != nil {
+		return err
+	}
+
+	connection.State = types.StateOpen
+	k.SetConnection(ctx, connectionID, connection)
+
+	k.emitConnectionEvent(ctx, types.EventTypeConnectionOpenConfirm, connectionID, connection)
+	return nil
+}
+
+// GetConnection returns a connection end.
+func (k Keeper) GetConnection(ctx sdk.Context, connectionID string) (connection types.ConnectionEnd, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ConnectionKey(connectionID))
+	if bz == nil {
+		return connection, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &connection)
+	return connection, true
+}
+
+// SetConnection sets a connection end.
+func (k Keeper) SetConnection(ctx sdk.Context, connectionID string, connection types.ConnectionEnd) {
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(connection)
+	ctx.KVStore(k.storeKey).Set(types.ConnectionKey(connectionID), bz)
+}
+
+// IterateConnections iterates over all the connection ends, in the order of
+// their identifiers, until the callback returns true.
+func (k Keeper) IterateConnections(ctx sdk.Context, cb func(types.IdentifiedConnection) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ConnectionPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var connection types.ConnectionEnd
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &connection)
+
+		id := string(iter.Key()[len(types.ConnectionPrefix):])
+		if cb(types.NewIdentifiedConnection(id, connection)) {
+			break
+		}
+	}
+}
+
+// getOpenConnection returns a connection which must be open.
+func (k Keeper) getOpenConnection(ctx sdk.Context, connectionID string) (types.ConnectionEnd, sdk.Error) {
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		return connection, types.ErrConnectionNotFound(k.codespace, connectionID)
+	}
+	if connection.State != types.StateOpen {
+		return connection, types.ErrInvalidConnection(k.codespace, "connection is not open")
+	}
+	return connection, nil
+}
+
+func (k Keeper) emitConnectionEvent(ctx sdk.Context, eventType, connectionID string, connection types.ConnectionEnd) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(types.AttributeKeyClientID, connection.ClientID),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyClientID, connection.Counterparty.ClientID),
+		),
+	)
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/internal/types"
+)
+
+// Keeper defines the ibc module's keeper. It stores the clients, connections,
+// channels and packet commitments under the paths proven to counterparty
+// chains, and routes the channels and packets of a port to the module bound
+// to it.
+type Keeper struct {
+	cdc       *codec.Codec
+	storeKey  sdk.StoreKey
+	router    types.Router
+	codespace sdk.CodespaceType
+}
+
+// NewKeeper creates a new ibc Keeper. The Router must be set with SetRouter
+// before the Keeper can open channels.
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, codespace sdk.CodespaceType) *Keeper {
+	return &Keeper{
+		cdc:       cdc,
+		storeKey:  storeKey,
+		codespace: codespace,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// Codespace returns the ibc keeper's codespace.
+func (k Keeper) Codespace() sdk.CodespaceType {
+	return k.codespace
+}
+
+// SetRouter sets the port router of the ibc module. The router is set after
+// the Keeper is constructed as the modules bound to ports need access to the
+// Keeper to send packets. The router is sealed and can only be set once.
+func (k *Keeper) SetRouter(rtr types.Router) {
+	rtr.Seal()
+	k.router = rtr
+}
+
+// Prefix returns the name of the store of the ibc module, under which its
+// state is proven to counterparty chains.
+func (k Keeper) Prefix() string {
+	return k.storeKey.Name()
+}
+
+// getModule returns the module bound to a port.
+func (k Keeper) getModule(portID string) (types.Module, sdk.Error) {
+	if k.router == nil || !k.router.HasRoute(portID) {
+		return nil, types.ErrPortNotBound(k.codespace, portID)
+	}
+	return k.router.GetRoute(portID), nil
+}
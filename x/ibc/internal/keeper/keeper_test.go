@@ -0,0 +1,318 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/ibc"
+	"github.com/cosmos/cosmos-sdk/x/ibc/internal/types"
+	"github.com/cosmos/cosmos-sdk/x/transfer"
+)
+
+var sender = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+// setupEndpoints creates two chains, the first of which has a funded sender,
+// and the endpoints of a transfer channel between them
+func setupEndpoints(t *testing.T) (a, b *simapp.TestEndpoint) {
+	acc := auth.NewBaseAccountWithAddress(sender)
+	require.NoError(t, acc.SetCoins(sdk.NewCoins(sdk.NewInt64Coin("atom", 100))))
+
+	a = simapp.NewTestEndpoint(simapp.NewTestChain(t, "chaina", &acc), "clientb", "connab", transfer.PortID, "chanab")
+	b = simapp.NewTestEndpoint(simapp.NewTestChain(t, "chainb"), "clienta", "connba", transfer.PortID, "chanba")
+	return a, b
+}
+
+// sendPacket sends a transfer packet over the channel of an endpoint, commits
+// it and returns it
+func sendPacket(t *testing.T, src, dst *simapp.TestEndpoint, timeoutHeight uint64) types.Packet {
+	sequence := src.Chain.App.IBCKeeper.GetNextSequenceSend(src.Chain.Context(), src.PortID, src.ChannelID)
+	token := sdk.NewInt64Coin("atom", 10)
+
+	src.Chain.DeliverOK(transfer.NewMsgTransfer(src.ChannelID, token, sender, sender.String(), timeoutHeight))
+	src.Chain.NextBlock()
+
+	data := transfer.NewFungibleTokenPacketData(token.Denom, token.Amount, sender.String(), sender.String())
+	return types.NewPacket(data.GetBytes(), sequence, src.PortID, src.ChannelID, dst.PortID, dst.ChannelID,
+		timeoutHeight)
+}
+
+func TestCreateClient(t *testing.T) {
+	a, b := setupEndpoints(t)
+	header := b.Chain.IBCHeader()
+
+	a.Chain.CreateClient(b.Chain, a.ClientID)
+
+	ctx := a.Chain.Context()
+	client, found := a.Chain.App.IBCKeeper.GetClientState(ctx, a.ClientID)
+	require.True(t, found)
+	require.Equal(t, types.NewClientState(a.ClientID, b.Chain.ChainID, header.GetHeight()), client)
+
+	cs, found := a.Chain.App.IBCKeeper.GetConsensusState(ctx, a.ClientID, header.GetHeight())
+	require.True(t, found)
+	require.Equal(t, header.ConsensusState(), cs)
+
+	// a client cannot be created twice
+	res := a.Chain.Deliver(ibc.NewMsgCreateClient(a.ClientID, header, a.Chain.Relayer))
+	require.Equal(t, ibc.CodeClientExists, res.Code, res.Log)
+
+	// the header must be signed by its validator set
+	header.SignedHeader.AppHash = []byte("forged")
+	res = a.Chain.Deliver(ibc.NewMsgCreateClient("forged", header, a.Chain.Relayer))
+	require.Equal(t, ibc.CodeInvalidHeader, res.Code, res.Log)
+}
+
+func TestUpdateClient(t *testing.T) {
+	a, b := setupEndpoints(t)
+	a.Chain.CreateClient(b.Chain, a.ClientID)
+	oldHeader := b.Chain.IBCHeader()
+
+	b.Chain.NextBlock()
+	a.Chain.UpdateClient(b.Chain, a.ClientID)
+
+	ctx := a.Chain.Context()
+	client, found := a.Chain.App.IBCKeeper.GetClientState(ctx, a.ClientID)
+	require.True(t, found)
+	require.Equal(t, uint64(b.Chain.Header.Height), client.LatestHeight)
+
+	cs, found := a.Chain.App.IBCKeeper.GetConsensusState(ctx, a.ClientID, client.LatestHeight)
+	require.True(t, found)
+	require.Equal(t, b.Chain.Header.AppHash, []byte(cs.Root))
+
+	// the client cannot be updated with an older header
+	res := a.Chain.Deliver(ibc.NewMsgUpdateClient(a.ClientID, oldHeader, a.Chain.Relayer))
+	require.Equal(t, ibc.CodeInvalidHeader, res.Code, res.Log)
+
+	// the client cannot be updated with a header signed by other validators
+	impostor := simapp.NewTestChain(t, b.Chain.ChainID)
+	for impostor.Header.Height <= b.Chain.Header.Height {
+		impostor.NextBlock()
+	}
+	res = a.Chain.Deliver(ibc.NewMsgUpdateClient(a.ClientID, impostor.IBCHeader(), a.Chain.Relayer))
+	require.Equal(t, ibc.CodeInvalidHeader, res.Code, res.Log)
+
+	// unknown client
+	res = a.Chain.Deliver(ibc.NewMsgUpdateClient("unknown", b.Chain.IBCHeader(), a.Chain.Relayer))
+	require.Equal(t, ibc.CodeClientNotFound, res.Code, res.Log)
+}
+
+func TestConnectionHandshake(t *testing.T) {
+	a, b := setupEndpoints(t)
+	simapp.CreateClients(a, b)
+
+	// the client of the connection must exist
+	res := a.Chain.Deliver(ibc.NewMsgConnectionOpenInit(a.ConnectionID, "unknown",
+		ibc.NewConnectionCounterparty(b.ClientID, b.ConnectionID, ibc.DefaultPrefix), a.Chain.Relayer))
+	require.Equal(t, ibc.CodeClientNotFound, res.Code, res.Log)
+
+	a.Chain.DeliverOK(ibc.NewMsgConnectionOpenInit(a.ConnectionID, a.ClientID,
+		ibc.NewConnectionCounterparty(b.ClientID, b.ConnectionID, ibc.DefaultPrefix), a.Chain.Relayer))
+	a.Chain.NextBlock()
+
+	// the try must match the initialized connection of the counterparty
+	b.Chain.UpdateClient(a.Chain, b.ClientID)
+	proof, proofHeight := a.Chain.QueryProof(ibc.ConnectionKey(a.ConnectionID))
+	res = b.Chain.Deliver(ibc.NewMsgConnectionOpenTry(b.ConnectionID, b.ClientID,
+		ibc.NewConnectionCounterparty(a.ClientID, "other", ibc.DefaultPrefix), proof, proofHeight, b.Chain.Relayer))
+	require.Equal(t, ibc.CodeInvalidProof, res.Code, res.Log)
+
+	// the proof must be of a height tracked by the client
+	res = b.Chain.Deliver(ibc.NewMsgConnectionOpenTry(b.ConnectionID, b.ClientID,
+		ibc.NewConnectionCounterparty(a.ClientID, a.ConnectionID, ibc.DefaultPrefix), proof, proofHeight+1,
+		b.Chain.Relayer))
+	require.Equal(t, ibc.CodeConsensusStateNotFound, res.Code, res.Log)
+
+	b.Chain.DeliverOK(ibc.NewMsgConnectionOpenTry(b.ConnectionID, b.ClientID,
+		ibc.NewConnectionCounterparty(a.ClientID, a.ConnectionID, ibc.DefaultPrefix), proof, proofHeight,
+		b.Chain.Relayer))
+	b.Chain.NextBlock()
+
+	connection, found := b.Chain.App.IBCKeeper.GetConnection(b.Chain.Context(), b.ConnectionID)
+	require.True(t, found)
+	require.Equal(t, ibc.StateTryOpen, connection.State)
+
+	// the ack must prove the try of the counterparty
+	a.Chain.UpdateClient(b.Chain, a.ClientID)
+	proof, proofHeight = b.Chain.QueryProof(ibc.ConnectionKey(a.ConnectionID))
+	res = a.Chain.Deliver(ibc.NewMsgConnectionOpenAck(a.ConnectionID, proof, proofHeight, a.Chain.Relayer))
+	require.Equal(t, ibc.CodeInvalidProof, res.Code, res.Log)
+
+	proof, proofHeight = b.Chain.QueryProof(ibc.ConnectionKey(b.ConnectionID))
+	a.Chain.DeliverOK(ibc.NewMsgConnectionOpenAck(a.ConnectionID, proof, proofHeight, a.Chain.Relayer))
+	a.Chain.NextBlock()
+
+	b.Chain.UpdateClient(a.Chain, b.ClientID)
+	proof, proofHeight = a.Chain.QueryProof(ibc.ConnectionKey(a.ConnectionID))
+	b.Chain.DeliverOK(ibc.NewMsgConnectionOpenConfirm(b.ConnectionID, proof, proofHeight, b.Chain.Relayer))
+	b.Chain.NextBlock()
+
+	for _, e := range []*simapp.TestEndpoint{a, b} {
+		connection, found := e.Chain.App.IBCKeeper.GetConnection(e.Chain.Context(), e.ConnectionID)
+		require.True(t, found)
+		require.Equal(t, ibc.StateOpen, connection.State)
+		require.Equal(t, e.ClientID, connection.ClientID)
+	}
+
+	// a connection cannot be initialized twice
+	res = a.Chain.Deliver(ibc.NewMsgConnectionOpenInit(a.ConnectionID, a.ClientID,
+		ibc.NewConnectionCounterparty(b.ClientID, b.ConnectionID, ibc.DefaultPrefix), a.Chain.Relayer))
+	require.Equal(t, ibc.CodeConnectionExists, res.Code, res.Log)
+}
+
+func TestChannelHandshake(t *testing.T) {
+	a, b := setupEndpoints(t)
+	simapp.CreateClients(a, b)
+	simapp.OpenConnection(a, b)
+
+	// the module bound to the port rejects the version and ordering
+	res := a.Chain.Deliver(ibc.NewMsgChannelOpenInit(a.PortID, a.ChannelID,
+		ibc.NewChannel(ibc.StateInit, ibc.OrderUnordered, ibc.NewChannelCounterparty(b.PortID, b.ChannelID),
+			[]string{a.ConnectionID}, "other"), a.Chain.Relayer))
+	require.Equal(t, transfer.CodeInvalidChannel, res.Code, res.Log)
+
+	res = a.Chain.Deliver(ibc.NewMsgChannelOpenInit(a.PortID, a.ChannelID,
+		ibc.NewChannel(ibc.StateInit, ibc.OrderOrdered, ibc.NewChannelCounterparty(b.PortID, b.ChannelID),
+			[]string{a.ConnectionID}, transfer.Version), a.Chain.Relayer))
+	require.Equal(t, transfer.CodeInvalidChannel, res.Code, res.Log)
+
+	// the port must be bound
+	res = a.Chain.Deliver(ibc.NewMsgChannelOpenInit("unbound", a.ChannelID,
+		ibc.NewChannel(ibc.StateInit, ibc.OrderUnordered, ibc.NewChannelCounterparty(b.PortID, b.ChannelID),
+			[]string{a.ConnectionID}, transfer.Version), a.Chain.Relayer))
+	require.Equal(t, ibc.CodePortNotBound, res.Code, res.Log)
+
+	simapp.OpenChannel(a, b, ibc.OrderUnordered, transfer.Version)
+
+	for _, e := range []*simapp.TestEndpoint{a, b} {
+		ctx := e.Chain.Context()
+		channel, found := e.Chain.App.IBCKeeper.GetChannel(ctx, e.PortID, e.ChannelID)
+		require.True(t, found)
+		require.Equal(t, ibc.StateOpen, channel.State)
+		require.Equal(t, []string{e.ConnectionID}, channel.ConnectionHops)
+		require.Equal(t, uint64(1), e.Chain.App.IBCKeeper.GetNextSequenceSend(ctx, e.PortID, e.ChannelID))
+		require.Equal(t, uint64(1), e.Chain.App.IBCKeeper.GetNextSequenceRecv(ctx, e.PortID, e.ChannelID))
+	}
+}
+
+func TestUnorderedPackets(t *testing.T) {
+	a, b := setupEndpoints(t)
+	simapp.Connect(a, b, ibc.OrderUnordered, transfer.Version)
+
+	packet1 := sendPacket(t, a, b, 1000)
+	packet2 := sendPacket(t, a, b, 1000)
+	require.Equal(t, uint64(3), a.Chain.App.IBCKeeper.GetNextSequenceSend(a.Chain.Context(), a.PortID, a.ChannelID))
+
+	// the packet must match its commitment
+	forged := packet1
+	forged.Data = packet2.Data[:len(packet2.Data)-1]
+	res := simapp.RelayPacket(a, b, forged)
+	require.Equal(t, ibc.CodeInvalidProof, res.Code, res.Log)
+
+	// packets of unordered channels can be received in any order, but only once
+	res = simapp.RelayPacket(a, b, packet2)
+	require.True(t, res.IsOK(), res.Log)
+	res = simapp.RelayPacket(a, b, packet1)
+	require.True(t, res.IsOK(), res.Log)
+	res = simapp.RelayPacket(a, b, packet1)
+	require.Equal(t, ibc.CodeInvalidPacket, res.Code, res.Log)
+	b.Chain.NextBlock()
+
+	ack := transfer.NewSuccessAcknowledgement().GetBytes()
+	_, found := b.Chain.App.IBCKeeper.GetPacketAcknowledgement(b.Chain.Context(), b.PortID, b.ChannelID, 1)
+	require.True(t, found)
+
+	// the acknowledgement must match its commitment
+	res = simapp.RelayAcknowledgement(a, b, packet1, []byte("forged"))
+	require.Equal(t, ibc.CodeInvalidProof, res.Code, res.Log)
+
+	res = simapp.RelayAcknowledgement(a, b, packet1, ack)
+	require.True(t, res.IsOK(), res.Log)
+	a.Chain.NextBlock()
+
+	_, found = a.Chain.App.IBCKeeper.GetPacketCommitment(a.Chain.Context(), a.PortID, a.ChannelID, 1)
+	require.False(t, found)
+
+	// an acknowledged packet cannot time out
+	res = simapp.RelayTimeout(a, b, packet1)
+	require.Equal(t, ibc.CodeInvalidPacket, res.Code, res.Log)
+}
+
+func TestOrderedPackets(t *testing.T) {
+	a, b := setupEndpoints(t)
+	simapp.CreateClients(a, b)
+	simapp.OpenConnection(a, b)
+
+	// the transfer module only opens unordered channels, so the ordered
+	// channel is set directly
+	for _, e := range [][2]*simapp.TestEndpoint{{a, b}, {b, a}} {
+		ctx := e[0].Chain.Context()
+		k := e[0].Chain.App.IBCKeeper
+		k.SetChannel(ctx, e[0].PortID, e[0].ChannelID, ibc.NewChannel(ibc.StateOpen, ibc.OrderOrdered,
+			ibc.NewChannelCounterparty(e[1].PortID, e[1].ChannelID), []string{e[0].ConnectionID}, transfer.Version))
+		k.SetNextSequenceSend(ctx, e[0].PortID, e[0].ChannelID, 1)
+		k.SetNextSequenceRecv(ctx, e[0].PortID, e[0].ChannelID, 1)
+		e[0].Chain.NextBlock()
+	}
+
+	packet1 := sendPacket(t, a, b, 1000)
+	timeoutHeight := uint64(b.Chain.Header.Height + 1)
+	packet2 := sendPacket(t, a, b, timeoutHeight)
+
+	// packets of ordered channels must be received in order
+	res := simapp.RelayPacket(a, b, packet2)
+	require.Equal(t, ibc.CodeInvalidPacket, res.Code, res.Log)
+	res = simapp.RelayPacket(a, b, packet1)
+	require.True(t, res.IsOK(), res.Log)
+	b.Chain.NextBlock()
+	require.Equal(t, uint64(2), b.Chain.App.IBCKeeper.GetNextSequenceRecv(b.Chain.Context(), b.PortID, b.ChannelID))
+
+	// a packet cannot time out before its timeout height
+	res = simapp.RelayTimeout(a, b, packet1)
+	require.Equal(t, ibc.CodePacketTimeout, res.Code, res.Log)
+
+	// the timeout of a packet closes the channel
+	res = simapp.RelayTimeout(a, b, packet2)
+	require.True(t, res.IsOK(), res.Log)
+	a.Chain.NextBlock()
+
+	channel, found := a.Chain.App.IBCKeeper.GetChannel(a.Chain.Context(), a.PortID, a.ChannelID)
+	require.True(t, found)
+	require.Equal(t, ibc.StateClosed, channel.State)
+
+	res = a.Chain.Deliver(transfer.NewMsgTransfer(a.ChannelID, sdk.NewInt64Coin("atom", 10), sender,
+		sender.String(), 1000))
+	require.Equal(t, ibc.CodeInvalidChannel, res.Code, res.Log)
+}
+
+func TestGenesis(t *testing.T) {
+	a, b := setupEndpoints(t)
+	simapp.Connect(a, b, ibc.OrderUnordered, transfer.Version)
+
+	packet := sendPacket(t, a, b, 1000)
+	res := simapp.RelayPacket(a, b, packet)
+	require.True(t, res.IsOK(), res.Log)
+	sendPacket(t, a, b, 1000)
+
+	for _, e := range []*simapp.TestEndpoint{a, b} {
+		ctx := e.Chain.Context()
+		exported := ibc.ExportGenesis(ctx, e.Chain.App.IBCKeeper)
+		require.NoError(t, ibc.ValidateGenesis(exported))
+		require.NotEmpty(t, exported.Clients)
+		require.NotEmpty(t, exported.ConsensusStates)
+		require.Len(t, exported.Connections, 1)
+		require.Len(t, exported.Channels, 1)
+
+		chain := simapp.NewTestChain(t, e.Chain.ChainID)
+		ibc.InitGenesis(chain.Context(), chain.App.IBCKeeper, exported)
+		require.Equal(t, exported, ibc.ExportGenesis(chain.Context(), chain.App.IBCKeeper))
+	}
+
+	exported := ibc.ExportGenesis(a.Chain.Context(), a.Chain.App.IBCKeeper)
+	require.Len(t, exported.Commitments, 2)
+	exported = ibc.ExportGenesis(b.Chain.Context(), b.Chain.App.IBCKeeper)
+	require.Len(t, exported.Acknowledgements, 1)
+}
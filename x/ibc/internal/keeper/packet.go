@@ -0,0 +1,339 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/internal/types"
+)
+
+// SendPacket commits to a packet sent over an open channel by the module bound
+// to its source port. The packet must have the next send sequence of the
+// channel, and a timeout height above the latest height of the destination
+// chain known to the client of the channel.
+func (k Keeper) SendPacket(ctx sdk.Context, packet types.Packet) sdk.Error {
+	if err := packet.ValidateBasic(); err != nil {
+		return types.ErrInvalidPacket(k.codespace, err.Error())
+	}
+
+	channel, connection, err := k.getOpenChannel(ctx, packet.SourcePort, packet.SourceChannel)
+	if err != nil {
+		return err
+	}
+	if packet.DestinationPort != channel.Counterparty.PortID || packet.DestinationChannel != channel.Counterparty.ChannelID {
+		return types.ErrInvalidPacket(k.codespace, "packet destination does not match the channel counterparty")
+	}
+
+	client, found := k.GetClientState(ctx, connection.ClientID)
+	if !found {
+		return types.ErrClientNotFound(k.codespace, connection.ClientID)
+	}
+	if packet.TimeoutHeight <= client.LatestHeight {
+		return types.ErrPacketTimeout(k.codespace,
+			fmt.Sprintf("destination chain is already at height %d, past the packet timeout height %d",
+				client.LatestHeight, packet.TimeoutHeight))
+	}
+
+	nextSequence := k.GetNextSequenceSend(ctx, packet.SourcePort, packet.SourceChannel)
+	if packet.Sequence != nextSequence {
+		return types.ErrInvalidPacket(k.codespace,
+			fmt.Sprintf("packet sequence %d is not the next send sequence %d", packet.Sequence, nextSequence))
+	}
+
+	k.SetNextSequenceSend(ctx, packet.SourcePort, packet.SourceChannel, nextSequence+1)
+	k.SetPacketCommitment(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence, types.CommitPacket(packet))
+
+	k.emitPacketEvent(ctx, types.EventTypeSendPacket, packet,
+		sdk.NewAttribute(types.AttributeKeyData, string(packet.Data)))
+	return nil
+}
+
+// RecvPacket receives a packet committed to by the source chain on an open
+// channel, before its timeout height, and passes it to the module bound to its
+// destination port. The acknowledgement returned by the module is committed
+// to, so that it can be relayed back to the source chain. Packets of ordered
+// channels must be received in the order of their sequences, and packets of
+// unordered channels can only be received once.
+func (k Keeper) RecvPacket(ctx sdk.Context, packet types.Packet, proof types.MerkleProof, proofHeight uint64) sdk.Error {
+	module, err := k.getModule(packet.DestinationPort)
+	if err != nil {
+		return err
+	}
+
+	channel, connection, err := k.getOpenChannel(ctx, packet.DestinationPort, packet.DestinationChannel)
+	if err != nil {
+		return err
+	}
+	if packet.SourcePort != channel.Counterparty.PortID || packet.SourceChannel != channel.Counterparty.ChannelID {
+		return types.ErrInvalidPacket(k.codespace, "packet source does not match the channel counterparty")
+	}
+
+	if uint64(ctx.BlockHeight()) >= packet.TimeoutHeight {
+		return types.ErrPacketTimeout(k.codespace, fmt.Sprintf("packet timed out at height %d", packet.TimeoutHeight))
+	}
+
+	key := types.PacketCommitmentKey(packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	if err := k.verifyMembership(ctx, connection, proofHeight, proof, key, types.CommitPacket(packet)); err != nil {
+		return err
+	}
+
+	switch channel.Ordering {
+	case types.OrderOrdered:
+		nextSequence := k.GetNextSequenceRecv(ctx, packet.DestinationPort, packet.DestinationChannel)
+		if packet.Sequence != nextSequence {
+			return types.ErrInvalidPacket(k.codespace,
+				fmt.Sprintf("packet sequence %d is not the next receive sequence %d", packet.Sequence, nextSequence))
+		}
+		k.SetNextSequenceRecv(ctx, packet.DestinationPort, packet.DestinationChannel, nextSequence+1)
+
+	default:
+		if _, found := k.GetPacketAcknowledgement(ctx, packet.DestinationPort, packet.DestinationChannel, packet.Sequence); found {
+			return types.ErrInvalidPacket(k.codespace, fmt.Sprintf("packet %d has already been received", packet.Sequence))
+		}
+	}
+
+	ack, err := module.OnRecvPacket(ctx, packet)
+	if err != nil {
+		return err
+	}
+	if len(ack) == 0 {
+		return types.ErrInvalidPacket(k.codespace, "empty acknowledgement")
+	}
+
+	k.SetPacketAcknowledgement(ctx, packet.DestinationPort, packet.DestinationChannel, packet.Sequence,
+		types.CommitAcknowledgement(ack))
+
+	k.emitPacketEvent(ctx, types.EventTypeRecvPacket, packet,
+		sdk.NewAttribute(types.AttributeKeyData, string(packet.Data)),
+		sdk.NewAttribute(types.AttributeKeyAck, string(ack)))
+	return nil
+}
+
+// AcknowledgePacket removes the commitment of a sent packet once the
+// destination chain has written its acknowledgement, and passes the
+// acknowledgement to the module bound to the source port.
+func (k Keeper) AcknowledgePacket(ctx sdk.Context, packet types.Packet, ack []byte, proof types.MerkleProof,
+	proofHeight uint64) sdk.Error {
+
+	module, err := k.getModule(packet.SourcePort)
+	if err != nil {
+		return err
+	}
+
+	connection, err := k.checkSentPacket(ctx, packet)
+	if err != nil {
+		return err
+	}
+
+	key := types.PacketAcknowledgementKey(packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+	if err := k.verifyMembership(ctx, connection, proofHeight, proof, key, types.CommitAcknowledgement(ack)); err != nil {
+		return err
+	}
+
+	k.DeletePacketCommitment(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+
+	if err := module.OnAcknowledgementPacket(ctx, packet, ack); err != nil {
+		return err
+	}
+
+	k.emitPacketEvent(ctx, types.EventTypeAcknowledgePacket, packet,
+		sdk.NewAttribute(types.AttributeKeyAck, string(ack)))
+	return nil
+}
+
+// TimeoutPacket removes the commitment of a sent packet which the destination
+// chain has not received before its timeout height, and notifies the module
+// bound to the source port. The proof height must be at least the timeout
+// height, so that the proven state of the destination chain is final up to
+// the timeout. On ordered channels the proof is of the next receive sequence
+// of the destination chain, and the channel is closed as no later packet can
+// be received; on unordered channels it is of the absence of the
+// acknowledgement of the packet.
+func (k Keeper) TimeoutPacket(ctx sdk.Context, packet types.Packet, nextSequenceRecv uint64,
+	proof types.MerkleProof, proofHeight uint64) sdk.Error {
+
+	module, err := k.getModule(packet.SourcePort)
+	if err != nil {
+		return err
+	}
+
+	connection, err := k.checkSentPacket(ctx, packet)
+	if err != nil {
+		return err
+	}
+
+	if proofHeight < packet.TimeoutHeight {
+		return types.ErrPacketTimeout(k.codespace,
+			fmt.Sprintf("proof height %d is below the packet timeout height %d", proofHeight, packet.TimeoutHeight))
+	}
+
+	channel, _ := k.GetChannel(ctx, packet.SourcePort, packet.SourceChannel)
+	switch channel.Ordering {
+	case types.OrderOrdered:
+		if nextSequenceRecv > packet.Sequence {
+			return types.ErrInvalidPacket(k.codespace, fmt.Sprintf("packet %d has been received", packet.Sequence))
+		}
+
+		key := types.NextSequenceRecvKey(packet.DestinationPort, packet.DestinationChannel)
+		value := sdk.Uint64ToBigEndian(nextSequenceRecv)
+		if err := k.verifyMembership(ctx, connection, proofHeight, proof, key, value); err != nil {
+			return err
+		}
+
+	default:
+		key := types.PacketAcknowledgementKey(packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+		if err := k.verifyNonMembership(ctx, connection, proofHeight, proof, key); err != nil {
+			return err
+		}
+	}
+
+	k.DeletePacketCommitment(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+
+	if err := module.OnTimeoutPacket(ctx, packet); err != nil {
+		return err
+	}
+
+	k.emitPacketEvent(ctx, types.EventTypeTimeoutPacket, packet)
+
+	if channel.Ordering == types.OrderOrdered {
+		channel.State = types.StateClosed
+		k.SetChannel(ctx, packet.SourcePort, packet.SourceChannel, channel)
+		k.emitChannelEvent(ctx, types.EventTypeChannelClosed, packet.SourcePort, packet.SourceChannel, channel)
+	}
+	return nil
+}
+
+// checkSentPacket checks that a packet has been sent over an open channel and
+// not yet acknowledged or timed out, and returns the connection of the
+// channel.
+func (k Keeper) checkSentPacket(ctx sdk.Context, packet types.Packet) (types.ConnectionEnd, sdk.Error) {
+	channel, connection, err := k.getOpenChannel(ctx, packet.SourcePort, packet.SourceChannel)
+	if err != nil {
+		return connection, err
+	}
+	if packet.DestinationPort != channel.Counterparty.PortID || packet.DestinationChannel != channel.Counterparty.ChannelID {
+		return connection, types.ErrInvalidPacket(k.codespace, "packet destination does not match the channel counterparty")
+	}
+
+	commitment, found := k.GetPacketCommitment(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return connection, types.ErrInvalidPacket(k.codespace, fmt.Sprintf("packet %d has no commitment", packet.Sequence))
+	}
+	if !bytes.Equal(commitment, types.CommitPacket(packet)) {
+		return connection, types.ErrInvalidPacket(k.codespace, "packet does not match its commitment")
+	}
+	return connection, nil
+}
+
+// GetNextSequenceSend returns the sequence of the next packet sent on a
+// channel.
+func (k Keeper) GetNextSequenceSend(ctx sdk.Context, portID, channelID string) uint64 {
+	return k.getSequence(ctx, types.NextSequenceSendKey(portID, channelID))
+}
+
+// SetNextSequenceSend sets the sequence of the next packet sent on a channel.
+func (k Keeper) SetNextSequenceSend(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextSequenceSendKey(portID, channelID), sdk.Uint64ToBigEndian(sequence))
+}
+
+// GetNextSequenceRecv returns the sequence of the next packet received on an
+// ordered channel.
+func (k Keeper) GetNextSequenceRecv(ctx sdk.Context, portID, channelID string) uint64 {
+	return k.getSequence(ctx, types.NextSequenceRecvKey(portID, channelID))
+}
+
+// SetNextSequenceRecv sets the sequence of the next packet received on an
+// ordered channel.
+func (k Keeper) SetNextSequenceRecv(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextSequenceRecvKey(portID, channelID), sdk.Uint64ToBigEndian(sequence))
+}
+
+func (k Keeper) getSequence(ctx sdk.Context, key []byte) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// GetPacketCommitment returns the commitment of a packet sent on a channel.
+func (k Keeper) GetPacketCommitment(ctx sdk.Context, portID, channelID string, sequence uint64) ([]byte, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.PacketCommitmentKey(portID, channelID, sequence))
+	return bz, bz != nil
+}
+
+// SetPacketCommitment sets the commitment of a packet sent on a channel.
+func (k Keeper) SetPacketCommitment(ctx sdk.Context, portID, channelID string, sequence uint64, commitment []byte) {
+	ctx.KVStore(k.storeKey).Set(types.PacketCommitmentKey(portID, channelID, sequence), commitment)
+}
+
+// DeletePacketCommitment removes the commitment of a packet sent on a channel.
+func (k Keeper) DeletePacketCommitment(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.PacketCommitmentKey(portID, channelID, sequence))
+}
+
+// GetPacketAcknowledgement returns the commitment of the acknowledgement of a
+// packet received on a channel.
+func (k Keeper) GetPacketAcknowledgement(ctx sdk.Context, portID, channelID string, sequence uint64) ([]byte, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.PacketAcknowledgementKey(portID, channelID, sequence))
+	return bz, bz != nil
+}
+
+// SetPacketAcknowledgement sets the commitment of the acknowledgement of a
+// packet received on a channel.
+func (k Keeper) SetPacketAcknowledgement(ctx sdk.Context, portID, channelID string, sequence uint64, commitment []byte) {
+	ctx.KVStore(k.storeKey).Set(types.PacketAcknowledgementKey(portID, channelID, sequence), commitment)
+}
+
+// IteratePacketSequences iterates over the next send or receive sequences of
+// all the channels, depending on the prefix, until the callback returns true.
+func (k Keeper) IteratePacketSequences(ctx sdk.Context, prefix []byte, cb func(types.PacketSequence) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		portID, channelID := parseChannelPath(iter.Key())
+		if cb(types.NewPacketSequence(portID, channelID, binary.BigEndian.Uint64(iter.Value()))) {
+			break
+		}
+	}
+}
+
+// IteratePacketCommitments iterates over the packet or acknowledgement
+// commitments of all the channels, depending on the prefix, until the callback
+// returns true.
+func (k Keeper) IteratePacketCommitments(ctx sdk.Context, prefix []byte, cb func(types.PacketCommitment) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		// {prefix}/ports/{portID}/channels/{channelID}/{packets|acknowledgements}/{sequence}
+		portID, channelID := parseChannelPath(iter.Key())
+		parts := strings.Split(string(iter.Key()), "/")
+		sequence, err := strconv.ParseUint(parts[len(parts)-1], 10, 64)
+		if err != nil {
+			panic(err)
+		}
+
+		if cb(types.NewPacketCommitment(portID, channelID, sequence, iter.Value())) {
+			break
+		}
+	}
+}
+
+func (k Keeper) emitPacketEvent(ctx sdk.Context, eventType string, packet types.Packet, attrs ...sdk.Attribute) {
+	attrs = append([]sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.Sequence)),
+		sdk.NewAttribute(types.AttributeKeySrcPort, packet.SourcePort),
+		sdk.NewAttribute(types.AttributeKeySrcChannel, packet.SourceChannel),
+		sdk.NewAttribute(types.AttributeKeyDstPort, packet.DestinationPort),
+		sdk.NewAttribute(types.AttributeKeyDstChannel, packet.DestinationChannel),
+		sdk.NewAttribute(types.AttributeKeyTimeoutHeight, fmt.Sprintf("%d", packet.TimeoutHeight)),
+	}, attrs...)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(eventType, attrs...))
+}
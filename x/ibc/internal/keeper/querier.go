@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/internal/types"
+)
+
+// NewQuerier creates a querier for ibc cli and REST endpoints
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryClient:
+			return queryClient(ctx, req, k)
+
+		case types.QueryClients:
+			return queryClients(ctx, k)
+
+		case types.QueryConsensusState:
+			return queryConsensusState(ctx, req, k)
+
+		case types.QueryConnection:
+			return queryConnection(ctx, req, k)
+
+		case types.QueryConnections:
+			return queryConnections(ctx, k)
+
+		case types.QueryChannel:
+			return queryChannel(ctx, req, k)
+
+		case types.QueryChannels:
+			return queryChannels(ctx, k)
+
+		default:
+			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("unknown ibc query endpoint: %s", path[0]))
+		}
+	}
+}
+
+func queryClient(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryClientParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	client, found := k.GetClientState(ctx, params.ClientID)
+	if !found {
+		return nil, types.ErrClientNotFound(k.codespace, params.ClientID)
+	}
+
+	return marshalJSON(k.cdc, client)
+}
+
+func queryClients(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	clients := []types.ClientState{}
+	k.IterateClients(ctx, func(client types.ClientState) bool {
+		clients = append(clients, client)
+		return false
+	})
+
+	return marshalJSON(k.cdc, clients)
+}
+
+func queryConsensusState(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryClientParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	height := params.Height
+	if height == 0 {
+		client, found := k.GetClientState(ctx, params.ClientID)
+		if !found {
+			return nil, types.ErrClientNotFound(k.codespace, params.ClientID)
+		}
+		height = client.LatestHeight
+	}
+
+	cs, found := k.GetConsensusState(ctx, params.ClientID, height)
+	if !found {
+		return nil, types.ErrConsensusStateNotFound(k.codespace, params.ClientID, height)
+	}
+
+	return marshalJSON(k.cdc, cs)
+}
+
+func queryConnection(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryConnectionParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	connection, found := k.GetConnection(ctx, params.ConnectionID)
+	if !found {
+		return nil, types.ErrConnectionNotFound(k.codespace, params.ConnectionID)
+	}
+
+	return marshalJSON(k.cdc, connection)
+}
+
+func queryConnections(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	connections := []types.IdentifiedConnection{}
+	k.IterateConnections(ctx, func(connection types.IdentifiedConnection) bool {
+		connections = append(connections, connection)
+		return false
+	})
+
+	return marshalJSON(k.cdc, connections)
+}
+
+func queryChannel(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryChannelParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	channel, found := k.GetChannel(ctx, params.PortID, params.ChannelID)
+	if !found {
+		return nil, types.ErrChannelNotFound(k.codespace, params.PortID, params.ChannelID)
+	}
+
+	return marshalJSON(k.cdc, channel)
+}
+
+func queryChannels(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	channels := []types.IdentifiedChannel{}
+	k.IterateChannels(ctx, func(channel types.IdentifiedChannel) bool {
+		channels = append(channels, channel)
+		return false
+	})
+
+	return marshalJSON(k.cdc, channels)
+}
+
+func marshalJSON(cdc *codec.Codec, o interface{}) ([]byte, sdk.Error) {
+	res, err := codec.MarshalJSONIndent(cdc, o)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+	return res, nil
+}
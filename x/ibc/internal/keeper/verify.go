@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/internal/types"
+)
+
+// The state of a counterparty chain is proven against the consensus state of
+// the client of the connection at the proof height. As the app hash of a
+// header commits to the state of the previous height, a proof of the state of
+// the counterparty at height h is verified at proof height h+1, once the
+// client has been updated with the header at that height.
+
+func (k Keeper) verifyMembership(ctx sdk.Context, connection types.ConnectionEnd, proofHeight uint64,
+	proof types.MerkleProof, key, value []byte) sdk.Error {
+
+	cs, found := k.GetConsensusState(ctx, connection.ClientID, proofHeight)
+	if !found {
+		return types.ErrConsensusStateNotFound(k.codespace, connection.ClientID, proofHeight)
+	}
+
+	if err := proof.VerifyMembership(cs.Root, connection.Counterparty.Prefix, key, value); err != nil {
+		return types.ErrInvalidProof(k.codespace, err.Error())
+	}
+	return nil
+}
+
+func (k Keeper) verifyNonMembership(ctx sdk.Context, connection types.ConnectionEnd, proofHeight uint64,
+	proof types.MerkleProof, key []byte) sdk.Error {
+
+	cs, found := k.GetConsensusState(ctx, connection.ClientID, proofHeight)
+	if !found {
+		return types.ErrConsensusStateNotFound(k.codespace, connection.ClientID, proofHeight)
+	}
+
+	if err := proof.VerifyNonMembership(cs.Root, connection.Counterparty.Prefix, key); err != nil {
+		return types.ErrInvalidProof(k.codespace, err.Error())
+	}
+	return nil
+}
+
+// verifyConnectionState verifies the connection end of the counterparty of a
+// connection.
+func (k Keeper) verifyConnectionState(ctx sdk.Context, connection types.ConnectionEnd, proofHeight uint64,
+	proof types.MerkleProof, expected types.ConnectionEnd) sdk.Error {
+
+	key := types.ConnectionKey(connection.Counterparty.ConnectionID)
+	return k.verifyMembership(ctx, connection, proofHeight, proof, key, k.cdc.MustMarshalBinaryLengthPrefixed(expected))
+}
+
+// verifyChannelState verifies the channel end of the counterparty of a channel.
+func (k Keeper) verifyChannelState(ctx sdk.Context, connection types.ConnectionEnd, proofHeight uint64,
+	proof types.MerkleProof, counterparty types.ChannelCounterparty, expected types.Channel) sdk.Error {
+
+	key := types.ChannelKey(counterparty.PortID, counterparty.ChannelID)
+	return k.verifyMembership(ctx, connection, proofHeight, proof, key, k.cdc.MustMarshalBinaryLengthPrefixed(expected))
+}
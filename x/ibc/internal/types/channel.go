@@ -0,0 +1,117 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Channel is the end of a channel between the modules bound to a port of two
+// chains. Packets are sent over a single connection.
+type Channel struct {
+	State          State               `json:"state"`
+	Ordering       Order               `json:"ordering"`
+	Counterparty   ChannelCounterparty `json:"counterparty"`
+	ConnectionHops []string            `json:"connection_hops"`
+	Version        string              `json:"version"`
+}
+
+// NewChannel creates a new Channel instance
+func NewChannel(state State, ordering Order, counterparty ChannelCounterparty,
+	connectionHops []string, version string) Channel {
+
+	return Channel{
+		State:          state,
+		Ordering:       ordering,
+		Counterparty:   counterparty,
+		ConnectionHops: connectionHops,
+		Version:        version,
+	}
+}
+
+// String implements the Stringer interface
+func (ch Channel) String() string {
+	return fmt.Sprintf(`Channel:
+  State:                 %s
+  Ordering:              %s
+  Counterparty Port:     %s
+  Counterparty Channel:  %s
+  Connection Hops:       %v
+  Version:               %s`,
+		ch.State, ch.Ordering, ch.Counterparty.PortID, ch.Counterparty.ChannelID, ch.ConnectionHops, ch.Version,
+	)
+}
+
+// ValidateBasic checks the ordering, counterparty and connection of the channel
+func (ch Channel) ValidateBasic() error {
+	if !ValidOrder(ch.Ordering) {
+		return fmt.Errorf("invalid channel ordering %d", ch.Ordering)
+	}
+	if len(ch.ConnectionHops) != 1 {
+		return errors.New("channels must use a single connection")
+	}
+	if err := ValidateIdentifier(ch.ConnectionHops[0]); err != nil {
+		return err
+	}
+	return ch.Counterparty.ValidateBasic()
+}
+
+// ChannelCounterparty identifies the end of a channel on the counterparty
+// chain.
+type ChannelCounterparty struct {
+	PortID    string `json:"port_id"`
+	ChannelID string `json:"channel_id"`
+}
+
+// NewChannelCounterparty creates a new ChannelCounterparty instance
+func NewChannelCounterparty(portID, channelID string) ChannelCounterparty {
+	return ChannelCounterparty{
+		PortID:    portID,
+		ChannelID: channelID,
+	}
+}
+
+// ValidateBasic checks the identifiers of the counterparty
+func (c ChannelCounterparty) ValidateBasic() error {
+	if err := ValidateIdentifier(c.PortID); err != nil {
+		return err
+	}
+	return ValidateIdentifier(c.ChannelID)
+}
+
+// IdentifiedChannel is a channel end along with its port and identifier
+type IdentifiedChannel struct {
+	PortID    string  `json:"port_id"`
+	ChannelID string  `json:"channel_id"`
+	Channel   Channel `json:"channel"`
+}
+
+// NewIdentifiedChannel creates a new IdentifiedChannel instance
+func NewIdentifiedChannel(portID, channelID string, channel Channel) IdentifiedChannel {
+	return IdentifiedChannel{
+		PortID:    portID,
+		ChannelID: channelID,
+		Channel:   channel,
+	}
+}
+
+// String implements the Stringer interface
+func (ic IdentifiedChannel) String() string {
+	return fmt.Sprintf("Port ID: %s\nChannel ID: %s\n%s", ic.PortID, ic.ChannelID, ic.Channel)
+}
+
+// IdentifiedChannels is a list of identified channels
+type IdentifiedChannels []IdentifiedChannel
+
+// String implements the Stringer interface
+func (ics IdentifiedChannels) String() string {
+	if len(ics) == 0 {
+		return "[]"
+	}
+
+	out := make([]string, len(ics))
+	for i, ic := range ics {
+		out[i] = ic.String()
+	}
+	return strings.Join(out, "\n")
+}
@@ -0,0 +1,175 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// ClientState is the state of a light client of a counterparty chain. The
+// client tracks the headers of the chain, each of which must be signed by more
+// than two thirds of the next validator set of the latest trusted header.
+type ClientState struct {
+	ID           string `json:"id"`
+	ChainID      string `json:"chain_id"`
+	LatestHeight uint64 `json:"latest_height"`
+}
+
+// NewClientState creates a new ClientState instance
+func NewClientState(id, chainID string, latestHeight uint64) ClientState {
+	return ClientState{
+		ID:           id,
+		ChainID:      chainID,
+		LatestHeight: latestHeight,
+	}
+}
+
+// String implements the Stringer interface
+func (cs ClientState) String() string {
+	return fmt.Sprintf(`Client:
+  ID:            %s
+  Chain ID:      %s
+  Latest Height: %d`, cs.ID, cs.ChainID, cs.LatestHeight)
+}
+
+// ClientStates is a list of client states
+type ClientStates []ClientState
+
+// String implements the Stringer interface
+func (css ClientStates) String() string {
+	if len(css) == 0 {
+		return "[]"
+	}
+
+	out := make([]string, len(css))
+	for i, cs := range css {
+		out[i] = cs.String()
+	}
+	return strings.Join(out, "\n")
+}
+
+// ConsensusState is the state of a counterparty chain trusted by a client at
+// the height of a header. Root is the app hash of the header, which commits to
+// the state of the counterparty at the previous height.
+type ConsensusState struct {
+	Timestamp          time.Time    `json:"timestamp"`
+	Root               cmn.HexBytes `json:"root"`
+	NextValidatorsHash cmn.HexBytes `json:"next_validators_hash"`
+}
+
+// NewConsensusState creates a new ConsensusState instance
+func NewConsensusState(timestamp time.Time, root, nextValidatorsHash []byte) ConsensusState {
+	return ConsensusState{
+		Timestamp:          timestamp,
+		Root:               root,
+		NextValidatorsHash: nextValidatorsHash,
+	}
+}
+
+// String implements the Stringer interface
+func (cs ConsensusState) String() string {
+	return fmt.Sprintf(`Consensus State:
+  Timestamp:            %s
+  Root:                 %s
+  Next Validators Hash: %s`, cs.Timestamp, cs.Root, cs.NextValidatorsHash)
+}
+
+// Header is a signed header of a counterparty chain along with the validator
+// set which signed it.
+type Header struct {
+	SignedHeader tmtypes.SignedHeader  `json:"signed_header"`
+	ValidatorSet *tmtypes.ValidatorSet `json:"validator_set"`
+}
+
+// NewHeader creates a new Header instance
+func NewHeader(signedHeader tmtypes.SignedHeader, validatorSet *tmtypes.ValidatorSet) Header {
+	return Header{
+		SignedHeader: signedHeader,
+		ValidatorSet: validatorSet,
+	}
+}
+
+// GetHeight returns the height of the header.
+func (h Header) GetHeight() uint64 {
+	if h.SignedHeader.Header == nil {
+		return 0
+	}
+	return uint64(h.SignedHeader.Height)
+}
+
+// ConsensusState returns the consensus state of the chain at the height of the
+// header.
+func (h Header) ConsensusState() ConsensusState {
+	return NewConsensusState(h.SignedHeader.Time, h.SignedHeader.AppHash, h.SignedHeader.NextValidatorsHash)
+}
+
+// ValidateBasic checks that the header belongs to the chain and is signed by
+// its validator set, without checking that the validator set can be trusted.
+func (h Header) ValidateBasic(chainID string) error {
+	if h.SignedHeader.Header == nil || h.SignedHeader.Commit == nil {
+		return errors.New("missing header or commit")
+	}
+	if h.ValidatorSet == nil || h.ValidatorSet.Size() == 0 {
+		return errors.New("missing validator set")
+	}
+	if err := h.SignedHeader.ValidateBasic(chainID); err != nil {
+		return err
+	}
+	if !bytes.Equal(h.ValidatorSet.Hash(), h.SignedHeader.ValidatorsHash) {
+		return errors.New("validator set does not match the header")
+	}
+	return h.ValidatorSet.VerifyCommit(chainID, h.SignedHeader.Commit.BlockID, h.SignedHeader.Height, h.SignedHeader.Commit)
+}
+
+// CheckHeader returns an error if the client can't trust a header given its
+// latest consensus state. The header must be more recent than the latest
+// height of the client and be signed by the next validator set of the latest
+// trusted header, so that heights can be skipped as long as the validator set
+// does not change.
+func (cs ClientState) CheckHeader(latest ConsensusState, header Header) error {
+	if header.GetHeight() <= cs.LatestHeight {
+		return fmt.Errorf("header height %d is not above the latest height %d", header.GetHeight(), cs.LatestHeight)
+	}
+	if err := header.ValidateBasic(cs.ChainID); err != nil {
+		return err
+	}
+	if !bytes.Equal(header.ValidatorSet.Hash(), latest.NextValidatorsHash) {
+		return errors.New("header is not signed by the trusted validator set")
+	}
+	return nil
+}
+
+// ValidateBasic checks the client state is consistent
+func (cs ClientState) ValidateBasic() error {
+	if err := ValidateIdentifier(cs.ID); err != nil {
+		return err
+	}
+	if strings.TrimSpace(cs.ChainID) == "" {
+		return errors.New("chain id cannot be blank")
+	}
+	if cs.LatestHeight == 0 {
+		return errors.New("latest height cannot be zero")
+	}
+	return nil
+}
+
+// ClientConsensusState is a consensus state of a client along with its height
+type ClientConsensusState struct {
+	ClientID       string         `json:"client_id"`
+	Height         uint64         `json:"height"`
+	ConsensusState ConsensusState `json:"consensus_state"`
+}
+
+// NewClientConsensusState creates a new ClientConsensusState instance
+func NewClientConsensusState(clientID string, height uint64, consensusState ConsensusState) ClientConsensusState {
+	return ClientConsensusState{
+		ClientID:       clientID,
+		Height:         height,
+		ConsensusState: consensusState,
+	}
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// RegisterCodec registers the ibc messages on the codec
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgCreateClient{}, "cosmos-sdk/MsgCreateClient", nil)
+	cdc.RegisterConcrete(MsgUpdateClient{}, "cosmos-sdk/MsgUpdateClient", nil)
+	cdc.RegisterConcrete(MsgConnectionOpenInit{}, "cosmos-sdk/MsgConnectionOpenInit", nil)
+	cdc.RegisterConcrete(MsgConnectionOpenTry{}, "cosmos-sdk/MsgConnectionOpenTry", nil)
+	cdc.RegisterConcrete(MsgConnectionOpenAck{}, "cosmos-sdk/MsgConnectionOpenAck", nil)
+	cdc.RegisterConcrete(MsgConnectionOpenConfirm{}, "cosmos-sdk/MsgConnectionOpenConfirm", nil)
+	cdc.RegisterConcrete(MsgChannelOpenInit{}, "cosmos-sdk/MsgChannelOpenInit", nil)
+	cdc.RegisterConcrete(MsgChannelOpenTry{}, "cosmos-sdk/MsgChannelOpenTry", nil)
+	cdc.RegisterConcrete(MsgChannelOpenAck{}, "cosmos-sdk/MsgChannelOpenAck", nil)
+	cdc.RegisterConcrete(MsgChannelOpenConfirm{}, "cosmos-sdk/MsgChannelOpenConfirm", nil)
+	cdc.RegisterConcrete(MsgRecvPacket{}, "cosmos-sdk/MsgRecvPacket", nil)
+	cdc.RegisterConcrete(MsgAcknowledgement{}, "cosmos-sdk/MsgAcknowledgement", nil)
+	cdc.RegisterConcrete(MsgTimeout{}, "cosmos-sdk/MsgTimeout", nil)
+}
+
+// ModuleCdc is the generic sealed codec to be used throughout the module
+var ModuleCdc *codec.Codec
+
+func init() {
+	ModuleCdc = codec.New()
+	RegisterCodec(ModuleCdc)
+	codec.RegisterCrypto(ModuleCdc)
+	ModuleCdc.Seal()
+}
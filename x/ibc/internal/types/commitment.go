@@ -0,0 +1,57 @@
+package types
+
+import (
+	"errors"
+
+	"github.com/tendermint/tendermint/crypto/merkle"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+)
+
+// DefaultPrefix is the store name under which the counterparty state is
+// proven when a connection does not set its own prefix.
+const DefaultPrefix = StoreKey
+
+// MerkleProof is a proof of a key of the ibc store of a counterparty chain,
+// as returned by a rootmulti store query with Prove set. It proves either the
+// value of the key or its absence against the app hash of the chain.
+type MerkleProof struct {
+	Proof *merkle.Proof `json:"proof"`
+}
+
+// NewMerkleProof creates a new MerkleProof instance
+func NewMerkleProof(proof *merkle.Proof) MerkleProof {
+	return MerkleProof{Proof: proof}
+}
+
+// Empty returns true if the proof has no proof operations.
+func (p MerkleProof) Empty() bool {
+	return p.Proof == nil || len(p.Proof.Ops) == 0
+}
+
+// VerifyMembership verifies that the key stored under the prefix has the given
+// value in the state committed to by root.
+func (p MerkleProof) VerifyMembership(root []byte, prefix string, key, value []byte) error {
+	if p.Empty() {
+		return errors.New("empty proof")
+	}
+	return rootmulti.DefaultProofRuntime().VerifyValue(p.Proof, root, keyPath(prefix, key), value)
+}
+
+// VerifyNonMembership verifies that the key stored under the prefix is absent
+// from the state committed to by root.
+func (p MerkleProof) VerifyNonMembership(root []byte, prefix string, key []byte) error {
+	if p.Empty() {
+		return errors.New("empty proof")
+	}
+	return rootmulti.DefaultProofRuntime().VerifyAbsence(p.Proof, root, keyPath(prefix, key))
+}
+
+// keyPath returns the merkle key path of a key of the store named prefix. The
+// key is hex encoded as ICS 24 paths contain slashes.
+func keyPath(prefix string, key []byte) string {
+	return merkle.KeyPath{}.
+		AppendKey([]byte(prefix), merkle.KeyEncodingURL).
+		AppendKey(key, merkle.KeyEncodingHex).
+		String()
+}
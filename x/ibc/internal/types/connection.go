@@ -0,0 +1,102 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ConnectionEnd is the end of a connection between the ibc modules of two
+// chains. Each end tracks the other chain with one of its clients.
+type ConnectionEnd struct {
+	State        State                  `json:"state"`
+	ClientID     string                 `json:"client_id"`
+	Counterparty ConnectionCounterparty `json:"counterparty"`
+}
+
+// NewConnectionEnd creates a new ConnectionEnd instance
+func NewConnectionEnd(state State, clientID string, counterparty ConnectionCounterparty) ConnectionEnd {
+	return ConnectionEnd{
+		State:        state,
+		ClientID:     clientID,
+		Counterparty: counterparty,
+	}
+}
+
+// String implements the Stringer interface
+func (c ConnectionEnd) String() string {
+	return fmt.Sprintf(`Connection:
+  State:                    %s
+  Client ID:                %s
+  Counterparty Client ID:   %s
+  Counterparty Connection:  %s
+  Counterparty Prefix:      %s`,
+		c.State, c.ClientID, c.Counterparty.ClientID, c.Counterparty.ConnectionID, c.Counterparty.Prefix,
+	)
+}
+
+// ConnectionCounterparty identifies the end of a connection on the
+// counterparty chain. Prefix is the name of the store of the counterparty ibc
+// module, under which its state is proven.
+type ConnectionCounterparty struct {
+	ClientID     string `json:"client_id"`
+	ConnectionID string `json:"connection_id"`
+	Prefix       string `json:"prefix"`
+}
+
+// NewConnectionCounterparty creates a new ConnectionCounterparty instance
+func NewConnectionCounterparty(clientID, connectionID, prefix string) ConnectionCounterparty {
+	return ConnectionCounterparty{
+		ClientID:     clientID,
+		ConnectionID: connectionID,
+		Prefix:       prefix,
+	}
+}
+
+// ValidateBasic checks the identifiers of the counterparty
+func (c ConnectionCounterparty) ValidateBasic() error {
+	if err := ValidateIdentifier(c.ClientID); err != nil {
+		return err
+	}
+	if err := ValidateIdentifier(c.ConnectionID); err != nil {
+		return err
+	}
+	if c.Prefix == "" {
+		return fmt.Errorf("counterparty prefix cannot be empty")
+	}
+	return nil
+}
+
+// IdentifiedConnection is a connection end along with its identifier
+type IdentifiedConnection struct {
+	ID         string        `json:"id"`
+	Connection ConnectionEnd `json:"connection"`
+}
+
+// NewIdentifiedConnection creates a new IdentifiedConnection instance
+func NewIdentifiedConnection(id string, connection ConnectionEnd) IdentifiedConnection {
+	return IdentifiedConnection{
+		ID:         id,
+		Connection: connection,
+	}
+}
+
+// String implements the Stringer interface
+func (ic IdentifiedConnection) String() string {
+	return fmt.Sprintf("ID: %s\n%s", ic.ID, ic.Connection)
+}
+
+// IdentifiedConnections is a list of identified connections
+type IdentifiedConnections []IdentifiedConnection
+
+// String implements the Stringer interface
+func (ics IdentifiedConnections) String() string {
+	if len(ics) == 0 {
+		return "[]"
+	}
+
+	out := make([]string, len(ics))
+	for i, ic := range ics {
+		out[i] = ic.String()
+	}
+	return strings.Join(out, "\n")
+}
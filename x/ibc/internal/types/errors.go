@@ -0,0 +1,116 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Codes for ibc errors
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeInvalidIdentifier      sdk.CodeType = 101
+	CodeClientExists           sdk.CodeType = 102
+	CodeClientNotFound         sdk.CodeType = 103
+	CodeInvalidHeader          sdk.CodeType = 104
+	CodeConsensusStateNotFound sdk.CodeType = 105
+	CodeConnectionExists       sdk.CodeType = 106
+	CodeConnectionNotFound     sdk.CodeType = 107
+	CodeInvalidConnection      sdk.CodeType = 108
+	CodeChannelExists          sdk.CodeType = 109
+	CodeChannelNotFound        sdk.CodeType = 110
+	CodeInvalidChannel         sdk.CodeType = 111
+	CodePortNotBound           sdk.CodeType = 112
+	CodeInvalidPacket          sdk.CodeType = 113
+	CodePacketTimeout          sdk.CodeType = 114
+	CodeInvalidProof           sdk.CodeType = 115
+)
+
+// ErrInvalidIdentifier is returned when the identifier of a client,
+// connection, port or channel is invalid
+func ErrInvalidIdentifier(codespace sdk.CodespaceType, err error) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidIdentifier, err.Error())
+}
+
+// ErrClientExists is returned when creating a client with an identifier which
+// is already used
+func ErrClientExists(codespace sdk.CodespaceType, clientID string) sdk.Error {
+	return sdk.NewError(codespace, CodeClientExists, fmt.Sprintf("client %s already exists", clientID))
+}
+
+// ErrClientNotFound is returned when a client does not exist
+func ErrClientNotFound(codespace sdk.CodespaceType, clientID string) sdk.Error {
+	return sdk.NewError(codespace, CodeClientNotFound, fmt.Sprintf("client %s not found", clientID))
+}
+
+// ErrInvalidHeader is returned when a header can't be trusted by a client
+func ErrInvalidHeader(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidHeader, fmt.Sprintf("invalid header: %s", msg))
+}
+
+// ErrConsensusStateNotFound is returned when a client has no consensus state
+// at a height
+func ErrConsensusStateNotFound(codespace sdk.CodespaceType, clientID string, height uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeConsensusStateNotFound,
+		fmt.Sprintf("client %s has no consensus state at height %d", clientID, height))
+}
+
+// ErrConnectionExists is returned when opening a connection with an identifier
+// which is already used
+func ErrConnectionExists(codespace sdk.CodespaceType, connectionID string) sdk.Error {
+	return sdk.NewError(codespace, CodeConnectionExists, fmt.Sprintf("connection %s already exists", connectionID))
+}
+
+// ErrConnectionNotFound is returned when a connection does not exist
+func ErrConnectionNotFound(codespace sdk.CodespaceType, connectionID string) sdk.Error {
+	return sdk.NewError(codespace, CodeConnectionNotFound, fmt.Sprintf("connection %s not found", connectionID))
+}
+
+// ErrInvalidConnection is returned when a connection is not in the expected
+// state or does not match its counterparty
+func ErrInvalidConnection(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidConnection, fmt.Sprintf("invalid connection: %s", msg))
+}
+
+// ErrChannelExists is returned when opening a channel with an identifier
+// which is already used by the port
+func ErrChannelExists(codespace sdk.CodespaceType, portID, channelID string) sdk.Error {
+	return sdk.NewError(codespace, CodeChannelExists,
+		fmt.Sprintf("channel %s of port %s already exists", channelID, portID))
+}
+
+// ErrChannelNotFound is returned when a channel does not exist
+func ErrChannelNotFound(codespace sdk.CodespaceType, portID, channelID string) sdk.Error {
+	return sdk.NewError(codespace, CodeChannelNotFound,
+		fmt.Sprintf("channel %s of port %s not found", channelID, portID))
+}
+
+// ErrInvalidChannel is returned when a channel is not in the expected state or
+// does not match its counterparty
+func ErrInvalidChannel(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidChannel, fmt.Sprintf("invalid channel: %s", msg))
+}
+
+// ErrPortNotBound is returned when no module is bound to a port
+func ErrPortNotBound(codespace sdk.CodespaceType, portID string) sdk.Error {
+	return sdk.NewError(codespace, CodePortNotBound, fmt.Sprintf("no module is bound to port %s", portID))
+}
+
+// ErrInvalidPacket is returned when a packet does not match its channel or
+// its commitment
+func ErrInvalidPacket(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidPacket, fmt.Sprintf("invalid packet: %s", msg))
+}
+
+// ErrPacketTimeout is returned when a packet is received after its timeout
+// height, or timed out before it
+func ErrPacketTimeout(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodePacketTimeout, msg)
+}
+
+// ErrInvalidProof is returned when a proof of the counterparty state can't be
+// verified
+func ErrInvalidProof(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidProof, fmt.Sprintf("invalid proof: %s", msg))
+}
@@ -0,0 +1,39 @@
+package types
+
+// ibc module event types
+const (
+	EventTypeCreateClient          = "create_client"
+	EventTypeUpdateClient          = "update_client"
+	EventTypeConnectionOpenInit    = "connection_open_init"
+	EventTypeConnectionOpenTry     = "connection_open_try"
+	EventTypeConnectionOpenAck     = "connection_open_ack"
+	EventTypeConnectionOpenConfirm = "connection_open_confirm"
+	EventTypeChannelOpenInit       = "channel_open_init"
+	EventTypeChannelOpenTry        = "channel_open_try"
+	EventTypeChannelOpenAck        = "channel_open_ack"
+	EventTypeChannelOpenConfirm    = "channel_open_confirm"
+	EventTypeChannelClosed         = "channel_closed"
+	EventTypeSendPacket            = "send_packet"
+	EventTypeRecvPacket            = "recv_packet"
+	EventTypeAcknowledgePacket     = "acknowledge_packet"
+	EventTypeTimeoutPacket         = "timeout_packet"
+
+	AttributeKeyClientID             = "client_id"
+	AttributeKeyHeight               = "height"
+	AttributeKeyConnectionID         = "connection_id"
+	AttributeKeyCounterpartyClientID = "counterparty_client_id"
+	AttributeKeyPortID               = "port_id"
+	AttributeKeyChannelID            = "channel_id"
+	AttributeKeyCounterpartyPortID   = "counterparty_port_id"
+	AttributeKeyCounterpartyChannel  = "counterparty_channel_id"
+	AttributeKeySequence             = "packet_sequence"
+	AttributeKeySrcPort              = "packet_src_port"
+	AttributeKeySrcChannel           = "packet_src_channel"
+	AttributeKeyDstPort              = "packet_dst_port"
+	AttributeKeyDstChannel           = "packet_dst_channel"
+	AttributeKeyTimeoutHeight        = "packet_timeout_height"
+	AttributeKeyData                 = "packet_data"
+	AttributeKeyAck                  = "packet_ack"
+
+	AttributeValueCategory = ModuleName
+)
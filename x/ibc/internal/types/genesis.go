@@ -0,0 +1,103 @@
+package types
+
+import (
+	"fmt"
+)
+
+// GenesisState contains the clients, connections, channels and packet state
+// of the ibc module
+type GenesisState struct {
+	Clients           []ClientState          `json:"clients" yaml:"clients"`
+	ConsensusStates   []ClientConsensusState `json:"consensus_states" yaml:"consensus_states"`
+	Connections       []IdentifiedConnection `json:"connections" yaml:"connections"`
+	Channels          []IdentifiedChannel    `json:"channels" yaml:"channels"`
+	NextSequenceSends []PacketSequence       `json:"next_sequence_sends" yaml:"next_sequence_sends"`
+	NextSequenceRecvs []PacketSequence       `json:"next_sequence_recvs" yaml:"next_sequence_recvs"`
+	Commitments       []PacketCommitment     `json:"commitments" yaml:"commitments"`
+	Acknowledgements  []PacketCommitment     `json:"acknowledgements" yaml:"acknowledgements"`
+}
+
+// DefaultGenesisState returns a default genesis state without any client
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		Clients:           []ClientState{},
+		ConsensusStates:   []ClientConsensusState{},
+		Connections:       []IdentifiedConnection{},
+		Channels:          []IdentifiedChannel{},
+		NextSequenceSends: []PacketSequence{},
+		NextSequenceRecvs: []PacketSequence{},
+		Commitments:       []PacketCommitment{},
+		Acknowledgements:  []PacketCommitment{},
+	}
+}
+
+// ValidateGenesis checks that the clients are valid and that the consensus
+// states and connections refer to them, and that the channels and packet state
+// refer to valid channel identifiers
+func ValidateGenesis(data GenesisState) error {
+	clients := make(map[string]bool, len(data.Clients))
+	for _, client := range data.Clients {
+		if err := client.ValidateBasic(); err != nil {
+			return err
+		}
+		if clients[client.ID] {
+			return fmt.Errorf("duplicate client %s", client.ID)
+		}
+		clients[client.ID] = true
+	}
+
+	for _, cs := range data.ConsensusStates {
+		if !clients[cs.ClientID] {
+			return fmt.Errorf("consensus state of unknown client %s", cs.ClientID)
+		}
+	}
+
+	for _, conn := range data.Connections {
+		if err := ValidateIdentifier(conn.ID); err != nil {
+			return err
+		}
+		if !clients[conn.Connection.ClientID] {
+			return fmt.Errorf("connection %s of unknown client %s", conn.ID, conn.Connection.ClientID)
+		}
+		if err := conn.Connection.Counterparty.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	for _, ch := range data.Channels {
+		if err := validateChannelIdentifiers(ch.PortID, ch.ChannelID); err != nil {
+			return err
+		}
+		if err := ch.Channel.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	for _, seqs := range [][]PacketSequence{data.NextSequenceSends, data.NextSequenceRecvs} {
+		for _, seq := range seqs {
+			if err := validateChannelIdentifiers(seq.PortID, seq.ChannelID); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, commitments := range [][]PacketCommitment{data.Commitments, data.Acknowledgements} {
+		for _, commitment := range commitments {
+			if err := validateChannelIdentifiers(commitment.PortID, commitment.ChannelID); err != nil {
+				return err
+			}
+			if len(commitment.Commitment) == 0 {
+				return fmt.Errorf("empty commitment for packet %d", commitment.Sequence)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateChannelIdentifiers(portID, channelID string) error {
+	if err := ValidateIdentifier(portID); err != nil {
+		return err
+	}
+	return ValidateIdentifier(channelID)
+}
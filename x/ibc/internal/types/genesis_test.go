@@ -0,0 +1,58 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	require.NoError(t, ValidateGenesis(DefaultGenesisState()))
+
+	cs := NewConsensusState(time.Now(), []byte("root"), []byte("vals"))
+	channel := NewChannel(StateOpen, OrderUnordered, NewChannelCounterparty("transfer", "chanba"),
+		[]string{"connab"}, "ics20-1")
+	valid := func() GenesisState {
+		return GenesisState{
+			Clients:         []ClientState{NewClientState("clientb", "chainb", 5)},
+			ConsensusStates: []ClientConsensusState{NewClientConsensusState("clientb", 5, cs)},
+			Connections: []IdentifiedConnection{NewIdentifiedConnection("connab", NewConnectionEnd(StateOpen,
+				"clientb", NewConnectionCounterparty("clienta", "connba", DefaultPrefix)))},
+			Channels:          []IdentifiedChannel{NewIdentifiedChannel("transfer", "chanab", channel)},
+			NextSequenceSends: []PacketSequence{NewPacketSequence("transfer", "chanab", 2)},
+			NextSequenceRecvs: []PacketSequence{NewPacketSequence("transfer", "chanab", 1)},
+			Commitments:       []PacketCommitment{NewPacketCommitment("transfer", "chanab", 1, []byte("hash"))},
+			Acknowledgements:  []PacketCommitment{},
+		}
+	}
+	require.NoError(t, ValidateGenesis(valid()))
+
+	for name, malleate := range map[string]func(*GenesisState){
+		"duplicate client": func(gs *GenesisState) {
+			gs.Clients = append(gs.Clients, gs.Clients[0])
+		},
+		"invalid client": func(gs *GenesisState) {
+			gs.Clients[0].ChainID = ""
+		},
+		"consensus state of unknown client": func(gs *GenesisState) {
+			gs.ConsensusStates[0].ClientID = "clientc"
+		},
+		"connection of unknown client": func(gs *GenesisState) {
+			gs.Connections[0].Connection.ClientID = "clientc"
+		},
+		"invalid channel": func(gs *GenesisState) {
+			gs.Channels[0].Channel.ConnectionHops = nil
+		},
+		"invalid sequence identifier": func(gs *GenesisState) {
+			gs.NextSequenceSends[0].ChannelID = "x"
+		},
+		"empty commitment": func(gs *GenesisState) {
+			gs.Commitments[0].Commitment = nil
+		},
+	} {
+		gs := valid()
+		malleate(&gs)
+		require.Error(t, ValidateGenesis(gs), name)
+	}
+}
@@ -0,0 +1,106 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+)
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "ibc"
+
+	// StoreKey is the store key string for the ibc module. Counterparty chains
+	// prove the state of the ibc module under this store name.
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the ibc module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the ibc module
+	QuerierRoute = ModuleName
+)
+
+var isValidIdentifier = regexp.MustCompile(`^[a-z0-9]{2,20}$`).MatchString
+
+// ValidateIdentifier returns an error if the identifier of a client,
+// connection, port or channel is not 2 to 20 lowercase alphanumeric
+// characters.
+func ValidateIdentifier(id string) error {
+	if !isValidIdentifier(id) {
+		return fmt.Errorf("identifier %q must be 2 to 20 lowercase alphanumeric characters", id)
+	}
+	return nil
+}
+
+// The keys of the ibc store are the paths defined by ICS 24, so that the
+// counterparty chain can build the path of the values it verifies.
+
+// ClientStateKey returns the store key of the state of a client
+func ClientStateKey(clientID string) []byte {
+	return []byte(fmt.Sprintf("clients/%s/clientState", clientID))
+}
+
+// ConsensusStateKey returns the store key of the consensus state of a client
+// at a height
+func ConsensusStateKey(clientID string, height uint64) []byte {
+	return []byte(fmt.Sprintf("clients/%s/consensusState/%d", clientID, height))
+}
+
+// ClientPrefix is the prefix of the client states and consensus states
+var ClientPrefix = []byte("clients/")
+
+// ConnectionKey returns the store key of a connection end
+func ConnectionKey(connectionID string) []byte {
+	return []byte(fmt.Sprintf("connections/%s", connectionID))
+}
+
+// ConnectionPrefix is the prefix of the connection ends
+var ConnectionPrefix = []byte("connections/")
+
+// ChannelKey returns the store key of a channel end
+func ChannelKey(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("channelEnds/%s", channelPath(portID, channelID)))
+}
+
+// ChannelPrefix is the prefix of the channel ends
+var ChannelPrefix = []byte("channelEnds/")
+
+// NextSequenceSendKey returns the store key of the sequence of the next packet
+// sent on a channel
+func NextSequenceSendKey(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("seqSends/%s/nextSequenceSend", channelPath(portID, channelID)))
+}
+
+// NextSequenceSendPrefix is the prefix of the next send sequences
+var NextSequenceSendPrefix = []byte("seqSends/")
+
+// NextSequenceRecvKey returns the store key of the sequence of the next packet
+// received on an ordered channel
+func NextSequenceRecvKey(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("seqRecvs/%s/nextSequenceRecv", channelPath(portID, channelID)))
+}
+
+// NextSequenceRecvPrefix is the prefix of the next receive sequences
+var NextSequenceRecvPrefix = []byte("seqRecvs/")
+
+// PacketCommitmentKey returns the store key of the commitment of a packet sent
+// on a channel
+func PacketCommitmentKey(portID, channelID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("commitments/%s/packets/%d", channelPath(portID, channelID), sequence))
+}
+
+// PacketCommitmentPrefix is the prefix of the packet commitments
+var PacketCommitmentPrefix = []byte("commitments/")
+
+// PacketAcknowledgementKey returns the store key of the commitment of the
+// acknowledgement of a packet received on a channel
+func PacketAcknowledgementKey(portID, channelID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("acks/%s/acknowledgements/%d", channelPath(portID, channelID), sequence))
+}
+
+// PacketAcknowledgementPrefix is the prefix of the acknowledgement commitments
+var PacketAcknowledgementPrefix = []byte("acks/")
+
+func channelPath(portID, channelID string) string {
+	return fmt.Sprintf("ports/%s/channels/%s", portID, channelID)
+}
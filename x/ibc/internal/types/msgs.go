@@ -0,0 +1,704 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ibc message types
+const (
+	TypeMsgCreateClient          = "create_client"
+	TypeMsgUpdateClient          = "update_client"
+	TypeMsgConnectionOpenInit    = "connection_open_init"
+	TypeMsgConnectionOpenTry     = "connection_open_try"
+	TypeMsgConnectionOpenAck     = "connection_open_ack"
+	TypeMsgConnectionOpenConfirm = "connection_open_confirm"
+	TypeMsgChannelOpenInit       = "channel_open_init"
+	TypeMsgChannelOpenTry        = "channel_open_try"
+	TypeMsgChannelOpenAck        = "channel_open_ack"
+	TypeMsgChannelOpenConfirm    = "channel_open_confirm"
+	TypeMsgRecvPacket            = "recv_packet"
+	TypeMsgAcknowledgement       = "acknowledge_packet"
+	TypeMsgTimeout               = "timeout_packet"
+)
+
+var (
+	_ sdk.Msg = MsgCreateClient{}
+	_ sdk.Msg = MsgUpdateClient{}
+	_ sdk.Msg = MsgConnectionOpenInit{}
+	_ sdk.Msg = MsgConnectionOpenTry{}
+	_ sdk.Msg = MsgConnectionOpenAck{}
+	_ sdk.Msg = MsgConnectionOpenConfirm{}
+	_ sdk.Msg = MsgChannelOpenInit{}
+	_ sdk.Msg = MsgChannelOpenTry{}
+	_ sdk.Msg = MsgChannelOpenAck{}
+	_ sdk.Msg = MsgChannelOpenConfirm{}
+	_ sdk.Msg = MsgRecvPacket{}
+	_ sdk.Msg = MsgAcknowledgement{}
+	_ sdk.Msg = MsgTimeout{}
+)
+
+// MsgCreateClient creates a client of a counterparty chain which trusts an
+// initial header of the chain.
+type MsgCreateClient struct {
+	ClientID string         `json:"client_id"`
+	Header   Header         `json:"header"`
+	Signer   sdk.AccAddress `json:"signer"`
+}
+
+// NewMsgCreateClient creates a new MsgCreateClient instance
+func NewMsgCreateClient(clientID string, header Header, signer sdk.AccAddress) MsgCreateClient {
+	return MsgCreateClient{
+		ClientID: clientID,
+		Header:   header,
+		Signer:   signer,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgCreateClient) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgCreateClient) Type() string { return TypeMsgCreateClient }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgCreateClient) ValidateBasic() sdk.Error {
+	if err := ValidateIdentifier(msg.ClientID); err != nil {
+		return ErrInvalidIdentifier(DefaultCodespace, err)
+	}
+	if err := msg.Header.ValidateBasic(msg.Header.SignedHeader.ChainID); err != nil {
+		return ErrInvalidHeader(DefaultCodespace, err.Error())
+	}
+	return validateSigner(msg.Signer)
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgCreateClient) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgCreateClient) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+// MsgUpdateClient updates a client with a new header of its chain.
+type MsgUpdateClient struct {
+	ClientID string         `json:"client_id"`
+	Header   Header         `json:"header"`
+	Signer   sdk.AccAddress `json:"signer"`
+}
+
+// NewMsgUpdateClient creates a new MsgUpdateClient instance
+func NewMsgUpdateClient(clientID string, header Header, signer sdk.AccAddress) MsgUpdateClient {
+	return MsgUpdateClient{
+		ClientID: clientID,
+		Header:   header,
+		Signer:   signer,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgUpdateClient) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgUpdateClient) Type() string { return TypeMsgUpdateClient }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgUpdateClient) ValidateBasic() sdk.Error {
+	if err := ValidateIdentifier(msg.ClientID); err != nil {
+		return ErrInvalidIdentifier(DefaultCodespace, err)
+	}
+	if msg.Header.SignedHeader.Header == nil {
+		return ErrInvalidHeader(DefaultCodespace, "missing header")
+	}
+	return validateSigner(msg.Signer)
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgUpdateClient) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgUpdateClient) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+// MsgConnectionOpenInit starts the handshake of a connection with a
+// counterparty chain.
+type MsgConnectionOpenInit struct {
+	ConnectionID string                 `json:"connection_id"`
+	ClientID     string                 `json:"client_id"`
+	Counterparty ConnectionCounterparty `json:"counterparty"`
+	Signer       sdk.AccAddress         `json:"signer"`
+}
+
+// NewMsgConnectionOpenInit creates a new MsgConnectionOpenInit instance
+func NewMsgConnectionOpenInit(connectionID, clientID string, counterparty ConnectionCounterparty,
+	signer sdk.AccAddress) MsgConnectionOpenInit {
+
+	return MsgConnectionOpenInit{
+		ConnectionID: connectionID,
+		ClientID:     clientID,
+		Counterparty: counterparty,
+		Signer:       signer,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgConnectionOpenInit) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgConnectionOpenInit) Type() string { return TypeMsgConnectionOpenInit }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgConnectionOpenInit) ValidateBasic() sdk.Error {
+	if err := validateIdentifiers(msg.ConnectionID, msg.ClientID); err != nil {
+		return err
+	}
+	if err := msg.Counterparty.ValidateBasic(); err != nil {
+		return ErrInvalidConnection(DefaultCodespace, err.Error())
+	}
+	return validateSigner(msg.Signer)
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgConnectionOpenInit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgConnectionOpenInit) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+// MsgConnectionOpenTry opens a connection in response to the initialization
+// of its counterparty, proven at ProofHeight.
+type MsgConnectionOpenTry struct {
+	ConnectionID string                 `json:"connection_id"`
+	ClientID     string                 `json:"client_id"`
+	Counterparty ConnectionCounterparty `json:"counterparty"`
+	ProofInit    MerkleProof            `json:"proof_init"`
+	ProofHeight  uint64                 `json:"proof_height"`
+	Signer       sdk.AccAddress         `json:"signer"`
+}
+
+// NewMsgConnectionOpenTry creates a new MsgConnectionOpenTry instance
+func NewMsgConnectionOpenTry(connectionID, clientID string, counterparty ConnectionCounterparty,
+	proofInit MerkleProof, proofHeight uint64, signer sdk.AccAddress) MsgConnectionOpenTry {
+
+	return MsgConnectionOpenTry{
+		ConnectionID: connectionID,
+		ClientID:     clientID,
+		Counterparty: counterparty,
+		ProofInit:    proofInit,
+		ProofHeight:  proofHeight,
+		Signer:       signer,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgConnectionOpenTry) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgConnectionOpenTry) Type() string { return TypeMsgConnectionOpenTry }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgConnectionOpenTry) ValidateBasic() sdk.Error {
+	if err := validateIdentifiers(msg.ConnectionID, msg.ClientID); err != nil {
+		return err
+	}
+	if err := msg.Counterparty.ValidateBasic(); err != nil {
+		return ErrInvalidConnection(DefaultCodespace, err.Error())
+	}
+	if err := validateProof(msg.ProofInit, msg.ProofHeight); err != nil {
+		return err
+	}
+	return validateSigner(msg.Signer)
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgConnectionOpenTry) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgConnectionOpenTry) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+// MsgConnectionOpenAck opens an initialized connection once its counterparty
+// is opened in response, as proven at ProofHeight.
+type MsgConnectionOpenAck struct {
+	ConnectionID string         `json:"connection_id"`
+	ProofTry     MerkleProof    `json:"proof_try"`
+	ProofHeight  uint64         `json:"proof_height"`
+	Signer       sdk.AccAddress `json:"signer"`
+}
+
+// NewMsgConnectionOpenAck creates a new MsgConnectionOpenAck instance
+func NewMsgConnectionOpenAck(connectionID string, proofTry MerkleProof, proofHeight uint64,
+	signer sdk.AccAddress) MsgConnectionOpenAck {
+
+	return MsgConnectionOpenAck{
+		ConnectionID: connectionID,
+		ProofTry:     proofTry,
+		ProofHeight:  proofHeight,
+		Signer:       signer,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgConnectionOpenAck) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgConnectionOpenAck) Type() string { return TypeMsgConnectionOpenAck }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgConnectionOpenAck) ValidateBasic() sdk.Error {
+	if err := validateIdentifiers(msg.ConnectionID); err != nil {
+		return err
+	}
+	if err := validateProof(msg.ProofTry, msg.ProofHeight); err != nil {
+		return err
+	}
+	return validateSigner(msg.Signer)
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgConnectionOpenAck) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgConnectionOpenAck) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+// MsgConnectionOpenConfirm completes the handshake of a connection opened in
+// response to its counterparty, once the counterparty is open as proven at
+// ProofHeight.
+type MsgConnectionOpenConfirm struct {
+	ConnectionID string         `json:"connection_id"`
+	ProofAck     MerkleProof    `json:"proof_ack"`
+	ProofHeight  uint64         `json:"proof_height"`
+	Signer       sdk.AccAddress `json:"signer"`
+}
+
+// NewMsgConnectionOpenConfirm creates a new MsgConnectionOpenConfirm instance
+func NewMsgConnectionOpenConfirm(connectionID string, proofAck MerkleProof, proofHeight uint64,
+	signer sdk.AccAddress) MsgConnectionOpenConfirm {
+
+	return MsgConnectionOpenConfirm{
+		ConnectionID: connectionID,
+		ProofAck:     proofAck,
+		ProofHeight:  proofHeight,
+		Signer:       signer,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgConnectionOpenConfirm) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgConnectionOpenConfirm) Type() string { return TypeMsgConnectionOpenConfirm }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgConnectionOpenConfirm) ValidateBasic() sdk.Error {
+	if err := validateIdentifiers(msg.ConnectionID); err != nil {
+		return err
+	}
+	if err := validateProof(msg.ProofAck, msg.ProofHeight); err != nil {
+		return err
+	}
+	return validateSigner(msg.Signer)
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgConnectionOpenConfirm) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgConnectionOpenConfirm) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+// MsgChannelOpenInit starts the handshake of a channel of a port with a
+// counterparty chain.
+type MsgChannelOpenInit struct {
+	PortID    string         `json:"port_id"`
+	ChannelID string         `json:"channel_id"`
+	Channel   Channel        `json:"channel"`
+	Signer    sdk.AccAddress `json:"signer"`
+}
+
+// NewMsgChannelOpenInit creates a new MsgChannelOpenInit instance
+func NewMsgChannelOpenInit(portID, channelID string, channel Channel, signer sdk.AccAddress) MsgChannelOpenInit {
+	return MsgChannelOpenInit{
+		PortID:    portID,
+		ChannelID: channelID,
+		Channel:   channel,
+		Signer:    signer,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgChannelOpenInit) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgChannelOpenInit) Type() string { return TypeMsgChannelOpenInit }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgChannelOpenInit) ValidateBasic() sdk.Error {
+	if err := validateIdentifiers(msg.PortID, msg.ChannelID); err != nil {
+		return err
+	}
+	if err := msg.Channel.ValidateBasic(); err != nil {
+		return ErrInvalidChannel(DefaultCodespace, err.Error())
+	}
+	return validateSigner(msg.Signer)
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgChannelOpenInit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgChannelOpenInit) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+// MsgChannelOpenTry opens a channel in response to the initialization of its
+// counterparty, proven at ProofHeight.
+type MsgChannelOpenTry struct {
+	PortID      string         `json:"port_id"`
+	ChannelID   string         `json:"channel_id"`
+	Channel     Channel        `json:"channel"`
+	ProofInit   MerkleProof    `json:"proof_init"`
+	ProofHeight uint64         `json:"proof_height"`
+	Signer      sdk.AccAddress `json:"signer"`
+}
+
+// NewMsgChannelOpenTry creates a new MsgChannelOpenTry instance
+func NewMsgChannelOpenTry(portID, channelID string, channel Channel, proofInit MerkleProof,
+	proofHeight uint64, signer sdk.AccAddress) MsgChannelOpenTry {
+
+	return MsgChannelOpenTry{
+		PortID:      portID,
+		ChannelID:   channelID,
+		Channel:     channel,
+		ProofInit:   proofInit,
+		ProofHeight: proofHeight,
+		Signer:      signer,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgChannelOpenTry) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgChannelOpenTry) Type() string { return TypeMsgChannelOpenTry }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgChannelOpenTry) ValidateBasic() sdk.Error {
+	if err := validateIdentifiers(msg.PortID, msg.ChannelID); err != nil {
+		return err
+	}
+	if err := msg.Channel.ValidateBasic(); err != nil {
+		return ErrInvalidChannel(DefaultCodespace, err.Error())
+	}
+	if err := validateProof(msg.ProofInit, msg.ProofHeight); err != nil {
+		return err
+	}
+	return validateSigner(msg.Signer)
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgChannelOpenTry) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgChannelOpenTry) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+// MsgChannelOpenAck opens an initialized channel once its counterparty is
+// opened in response, as proven at ProofHeight.
+type MsgChannelOpenAck struct {
+	PortID      string         `json:"port_id"`
+	ChannelID   string         `json:"channel_id"`
+	ProofTry    MerkleProof    `json:"proof_try"`
+	ProofHeight uint64         `json:"proof_height"`
+	Signer      sdk.AccAddress `json:"signer"`
+}
+
+// NewMsgChannelOpenAck creates a new MsgChannelOpenAck instance
+func NewMsgChannelOpenAck(portID, channelID string, proofTry MerkleProof, proofHeight uint64,
+	signer sdk.AccAddress) MsgChannelOpenAck {
+
+	return MsgChannelOpenAck{
+		PortID:      portID,
+		ChannelID:   channelID,
+		ProofTry:    proofTry,
+		ProofHeight: proofHeight,
+		Signer:      signer,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgChannelOpenAck) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgChannelOpenAck) Type() string { return TypeMsgChannelOpenAck }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgChannelOpenAck) ValidateBasic() sdk.Error {
+	if err := validateIdentifiers(msg.PortID, msg.ChannelID); err != nil {
+		return err
+	}
+	if err := validateProof(msg.ProofTry, msg.ProofHeight); err != nil {
+		return err
+	}
+	return validateSigner(msg.Signer)
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgChannelOpenAck) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgChannelOpenAck) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+// MsgChannelOpenConfirm completes the handshake of a channel opened in
+// response to its counterparty, once the counterparty is open as proven at
+// ProofHeight.
+type MsgChannelOpenConfirm struct {
+	PortID      string         `json:"port_id"`
+	ChannelID   string         `json:"channel_id"`
+	ProofAck    MerkleProof    `json:"proof_ack"`
+	ProofHeight uint64         `json:"proof_height"`
+	Signer      sdk.AccAddress `json:"signer"`
+}
+
+// NewMsgChannelOpenConfirm creates a new MsgChannelOpenConfirm instance
+func NewMsgChannelOpenConfirm(portID, channelID string, proofAck MerkleProof, proofHeight uint64,
+	signer sdk.AccAddress) MsgChannelOpenConfirm {
+
+	return MsgChannelOpenConfirm{
+		PortID:      portID,
+		ChannelID:   channelID,
+		ProofAck:    proofAck,
+		ProofHeight: proofHeight,
+		Signer:      signer,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgChannelOpenConfirm) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgChannelOpenConfirm) Type() string { return TypeMsgChannelOpenConfirm }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgChannelOpenConfirm) ValidateBasic() sdk.Error {
+	if err := validateIdentifiers(msg.PortID, msg.ChannelID); err != nil {
+		return err
+	}
+	if err := validateProof(msg.ProofAck, msg.ProofHeight); err != nil {
+		return err
+	}
+	return validateSigner(msg.Signer)
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgChannelOpenConfirm) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgChannelOpenConfirm) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+// MsgRecvPacket receives a packet committed to by the source chain, as proven
+// at ProofHeight.
+type MsgRecvPacket struct {
+	Packet      Packet         `json:"packet"`
+	Proof       MerkleProof    `json:"proof"`
+	ProofHeight uint64         `json:"proof_height"`
+	Signer      sdk.AccAddress `json:"signer"`
+}
+
+// NewMsgRecvPacket creates a new MsgRecvPacket instance
+func NewMsgRecvPacket(packet Packet, proof MerkleProof, proofHeight uint64, signer sdk.AccAddress) MsgRecvPacket {
+	return MsgRecvPacket{
+		Packet:      packet,
+		Proof:       proof,
+		ProofHeight: proofHeight,
+		Signer:      signer,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgRecvPacket) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgRecvPacket) Type() string { return TypeMsgRecvPacket }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgRecvPacket) ValidateBasic() sdk.Error {
+	if err := msg.Packet.ValidateBasic(); err != nil {
+		return ErrInvalidPacket(DefaultCodespace, err.Error())
+	}
+	if err := validateProof(msg.Proof, msg.ProofHeight); err != nil {
+		return err
+	}
+	return validateSigner(msg.Signer)
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgRecvPacket) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgRecvPacket) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+// MsgAcknowledgement acknowledges a sent packet with the acknowledgement
+// written by the destination chain, as proven at ProofHeight.
+type MsgAcknowledgement struct {
+	Packet          Packet         `json:"packet"`
+	Acknowledgement []byte         `json:"acknowledgement"`
+	Proof           MerkleProof    `json:"proof"`
+	ProofHeight     uint64         `json:"proof_height"`
+	Signer          sdk.AccAddress `json:"signer"`
+}
+
+// NewMsgAcknowledgement creates a new MsgAcknowledgement instance
+func NewMsgAcknowledgement(packet Packet, ack []byte, proof MerkleProof, proofHeight uint64,
+	signer sdk.AccAddress) MsgAcknowledgement {
+
+	return MsgAcknowledgement{
+		Packet:          packet,
+		Acknowledgement: ack,
+		Proof:           proof,
+		ProofHeight:     proofHeight,
+		Signer:          signer,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgAcknowledgement) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgAcknowledgement) Type() string { return TypeMsgAcknowledgement }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgAcknowledgement) ValidateBasic() sdk.Error {
+	if err := msg.Packet.ValidateBasic(); err != nil {
+		return ErrInvalidPacket(DefaultCodespace, err.Error())
+	}
+	if len(msg.Acknowledgement) == 0 {
+		return ErrInvalidPacket(DefaultCodespace, "acknowledgement cannot be empty")
+	}
+	if err := validateProof(msg.Proof, msg.ProofHeight); err != nil {
+		return err
+	}
+	return validateSigner(msg.Signer)
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgAcknowledgement) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgAcknowledgement) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+// MsgTimeout times out a sent packet which the destination chain has not
+// received before its timeout height, as proven at ProofHeight. On ordered
+// channels, the proof is of the next sequence to be received by the
+// destination chain, NextSequenceRecv.
+type MsgTimeout struct {
+	Packet           Packet         `json:"packet"`
+	NextSequenceRecv uint64         `json:"next_sequence_recv"`
+	Proof            MerkleProof    `json:"proof"`
+	ProofHeight      uint64         `json:"proof_height"`
+	Signer           sdk.AccAddress `json:"signer"`
+}
+
+// NewMsgTimeout creates a new MsgTimeout instance
+func NewMsgTimeout(packet Packet, nextSequenceRecv uint64, proof MerkleProof, proofHeight uint64,
+	signer sdk.AccAddress) MsgTimeout {
+
+	return MsgTimeout{
+		Packet:           packet,
+		NextSequenceRecv: nextSequenceRecv,
+		Proof:            proof,
+		ProofHeight:      proofHeight,
+		Signer:           signer,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgTimeout) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgTimeout) Type() string { return TypeMsgTimeout }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgTimeout) ValidateBasic() sdk.Error {
+	if err := msg.Packet.ValidateBasic(); err != nil {
+		return ErrInvalidPacket(DefaultCodespace, err.Error())
+	}
+	if err := validateProof(msg.Proof, msg.ProofHeight); err != nil {
+		return err
+	}
+	return validateSigner(msg.Signer)
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgTimeout) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgTimeout) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+func validateIdentifiers(ids ...string) sdk.Error {
+	for _, id := range ids {
+		if err := ValidateIdentifier(id); err != nil {
+			return ErrInvalidIdentifier(DefaultCodespace, err)
+		}
+	}
+	return nil
+}
+
+func validateProof(proof MerkleProof, height uint64) sdk.Error {
+	if proof.Empty() {
+		return ErrInvalidProof(DefaultCodespace, "proof cannot be empty")
+	}
+	if height == 0 {
+		return ErrInvalidProof(DefaultCodespace, "proof height cannot be zero")
+	}
+	return nil
+}
+
+func validateSigner(signer sdk.AccAddress) sdk.Error {
+	if signer.Empty() {
+		return sdk.ErrInvalidAddress("missing signer address")
+	}
+	return nil
+}
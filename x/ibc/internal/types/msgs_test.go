@@ -0,0 +1,82 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/merkle"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	signer = sdk.AccAddress([]byte("signer"))
+	proof  = NewMerkleProof(&merkle.Proof{Ops: []merkle.ProofOp{{Type: "iavl:v", Key: []byte("key")}}})
+)
+
+func TestMsgConnectionValidation(t *testing.T) {
+	counterparty := NewConnectionCounterparty("clienta", "connba", DefaultPrefix)
+
+	require.NoError(t, NewMsgConnectionOpenInit("connab", "clientb", counterparty, signer).ValidateBasic())
+	require.Error(t, NewMsgConnectionOpenInit("c", "clientb", counterparty, signer).ValidateBasic())
+	require.Error(t, NewMsgConnectionOpenInit("connab", "Client", counterparty, signer).ValidateBasic())
+	require.Error(t, NewMsgConnectionOpenInit("connab", "clientb", counterparty, nil).ValidateBasic())
+	require.Error(t, NewMsgConnectionOpenInit("connab", "clientb",
+		NewConnectionCounterparty("clienta", "connba", ""), signer).ValidateBasic())
+
+	require.NoError(t, NewMsgConnectionOpenTry("connab", "clientb", counterparty, proof, 5, signer).ValidateBasic())
+	require.Error(t, NewMsgConnectionOpenTry("connab", "clientb", counterparty, MerkleProof{}, 5, signer).ValidateBasic())
+	require.Error(t, NewMsgConnectionOpenTry("connab", "clientb", counterparty, proof, 0, signer).ValidateBasic())
+
+	require.NoError(t, NewMsgConnectionOpenAck("connab", proof, 5, signer).ValidateBasic())
+	require.Error(t, NewMsgConnectionOpenAck("connab", MerkleProof{}, 5, signer).ValidateBasic())
+	require.NoError(t, NewMsgConnectionOpenConfirm("connab", proof, 5, signer).ValidateBasic())
+	require.Error(t, NewMsgConnectionOpenConfirm("connab", proof, 5, nil).ValidateBasic())
+}
+
+func TestMsgChannelValidation(t *testing.T) {
+	counterparty := NewChannelCounterparty("transfer", "chanba")
+	channel := NewChannel(StateInit, OrderUnordered, counterparty, []string{"connab"}, "ics20-1")
+
+	require.NoError(t, NewMsgChannelOpenInit("transfer", "chanab", channel, signer).ValidateBasic())
+	require.Error(t, NewMsgChannelOpenInit("transfer", "", channel, signer).ValidateBasic())
+	require.Error(t, NewMsgChannelOpenInit("transfer", "chanab",
+		NewChannel(StateInit, OrderUnordered, counterparty, []string{"connab", "connac"}, "ics20-1"),
+		signer).ValidateBasic())
+	require.Error(t, NewMsgChannelOpenInit("transfer", "chanab",
+		NewChannel(StateInit, OrderNone, counterparty, []string{"connab"}, "ics20-1"), signer).ValidateBasic())
+
+	require.NoError(t, NewMsgChannelOpenTry("transfer", "chanab", channel, proof, 5, signer).ValidateBasic())
+	require.Error(t, NewMsgChannelOpenTry("transfer", "chanab", channel, MerkleProof{}, 5, signer).ValidateBasic())
+	require.NoError(t, NewMsgChannelOpenAck("transfer", "chanab", proof, 5, signer).ValidateBasic())
+	require.Error(t, NewMsgChannelOpenAck("transfer", "chanab", proof, 0, signer).ValidateBasic())
+	require.NoError(t, NewMsgChannelOpenConfirm("transfer", "chanab", proof, 5, signer).ValidateBasic())
+	require.Error(t, NewMsgChannelOpenConfirm("transfer", "chanab", proof, 5, nil).ValidateBasic())
+}
+
+func TestMsgPacketValidation(t *testing.T) {
+	packet := NewPacket([]byte("data"), 1, "transfer", "chanab", "transfer", "chanba", 100)
+	require.NoError(t, packet.ValidateBasic())
+
+	require.NoError(t, NewMsgRecvPacket(packet, proof, 5, signer).ValidateBasic())
+	require.Error(t, NewMsgRecvPacket(packet, MerkleProof{}, 5, signer).ValidateBasic())
+	require.Error(t, NewMsgRecvPacket(packet, proof, 5, nil).ValidateBasic())
+
+	for _, invalid := range []Packet{
+		NewPacket(nil, 1, "transfer", "chanab", "transfer", "chanba", 100),
+		NewPacket([]byte("data"), 0, "transfer", "chanab", "transfer", "chanba", 100),
+		NewPacket([]byte("data"), 1, "transfer", "chanab", "transfer", "chanba", 0),
+		NewPacket([]byte("data"), 1, "transfer", "chanab", "transfer", "c", 100),
+	} {
+		require.Error(t, NewMsgRecvPacket(invalid, proof, 5, signer).ValidateBasic())
+	}
+
+	require.NoError(t, NewMsgAcknowledgement(packet, []byte("ack"), proof, 5, signer).ValidateBasic())
+	require.Error(t, NewMsgAcknowledgement(packet, nil, proof, 5, signer).ValidateBasic())
+	require.NoError(t, NewMsgTimeout(packet, 1, proof, 5, signer).ValidateBasic())
+	require.Error(t, NewMsgTimeout(packet, 1, proof, 0, signer).ValidateBasic())
+
+	msg := NewMsgRecvPacket(packet, proof, 5, signer)
+	require.Equal(t, []sdk.AccAddress{signer}, msg.GetSigners())
+	require.NotPanics(t, func() { msg.GetSignBytes() })
+}
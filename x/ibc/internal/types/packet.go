@@ -0,0 +1,120 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Packet is the data sent by a module over a channel to the module bound to
+// the port of its counterparty. The packet times out if it has not been
+// received before the destination chain reaches the timeout height.
+type Packet struct {
+	Sequence           uint64 `json:"sequence"`
+	TimeoutHeight      uint64 `json:"timeout_height"`
+	SourcePort         string `json:"source_port"`
+	SourceChannel      string `json:"source_channel"`
+	DestinationPort    string `json:"destination_port"`
+	DestinationChannel string `json:"destination_channel"`
+	Data               []byte `json:"data"`
+}
+
+// NewPacket creates a new Packet instance
+func NewPacket(data []byte, sequence uint64, sourcePort, sourceChannel,
+	destinationPort, destinationChannel string, timeoutHeight uint64) Packet {
+
+	return Packet{
+		Sequence:           sequence,
+		TimeoutHeight:      timeoutHeight,
+		SourcePort:         sourcePort,
+		SourceChannel:      sourceChannel,
+		DestinationPort:    destinationPort,
+		DestinationChannel: destinationChannel,
+		Data:               data,
+	}
+}
+
+// String implements the Stringer interface
+func (p Packet) String() string {
+	return fmt.Sprintf(`Packet:
+  Sequence:            %d
+  Timeout Height:      %d
+  Source Port:         %s
+  Source Channel:      %s
+  Destination Port:    %s
+  Destination Channel: %s
+  Data:                %X`,
+		p.Sequence, p.TimeoutHeight, p.SourcePort, p.SourceChannel,
+		p.DestinationPort, p.DestinationChannel, p.Data,
+	)
+}
+
+// ValidateBasic checks the identifiers, sequence, timeout and data of the
+// packet
+func (p Packet) ValidateBasic() error {
+	for _, id := range []string{p.SourcePort, p.SourceChannel, p.DestinationPort, p.DestinationChannel} {
+		if err := ValidateIdentifier(id); err != nil {
+			return err
+		}
+	}
+	if p.Sequence == 0 {
+		return errors.New("packet sequence cannot be zero")
+	}
+	if p.TimeoutHeight == 0 {
+		return errors.New("packet timeout height cannot be zero")
+	}
+	if len(p.Data) == 0 {
+		return errors.New("packet data cannot be empty")
+	}
+	return nil
+}
+
+// CommitPacket returns the commitment of a packet stored by the source chain,
+// which commits to its timeout height and data.
+func CommitPacket(packet Packet) []byte {
+	return tmhash.Sum(append(sdk.Uint64ToBigEndian(packet.TimeoutHeight), tmhash.Sum(packet.Data)...))
+}
+
+// CommitAcknowledgement returns the commitment of the acknowledgement of a
+// packet stored by the destination chain.
+func CommitAcknowledgement(ack []byte) []byte {
+	return tmhash.Sum(ack)
+}
+
+// PacketSequence is a packet sequence of a channel
+type PacketSequence struct {
+	PortID    string `json:"port_id"`
+	ChannelID string `json:"channel_id"`
+	Sequence  uint64 `json:"sequence"`
+}
+
+// NewPacketSequence creates a new PacketSequence instance
+func NewPacketSequence(portID, channelID string, sequence uint64) PacketSequence {
+	return PacketSequence{
+		PortID:    portID,
+		ChannelID: channelID,
+		Sequence:  sequence,
+	}
+}
+
+// PacketCommitment is the commitment of a packet, or of its acknowledgement,
+// stored for a packet sequence of a channel
+type PacketCommitment struct {
+	PortID     string `json:"port_id"`
+	ChannelID  string `json:"channel_id"`
+	Sequence   uint64 `json:"sequence"`
+	Commitment []byte `json:"commitment"`
+}
+
+// NewPacketCommitment creates a new PacketCommitment instance
+func NewPacketCommitment(portID, channelID string, sequence uint64, commitment []byte) PacketCommitment {
+	return PacketCommitment{
+		PortID:     portID,
+		ChannelID:  channelID,
+		Sequence:   sequence,
+		Commitment: commitment,
+	}
+}
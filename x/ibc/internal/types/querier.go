@@ -0,0 +1,54 @@
+package types
+
+// query endpoints supported by the ibc Querier
+const (
+	QueryClient         = "client"
+	QueryClients        = "clients"
+	QueryConsensusState = "consensus_state"
+	QueryConnection     = "connection"
+	QueryConnections    = "connections"
+	QueryChannel        = "channel"
+	QueryChannels       = "channels"
+)
+
+// QueryClientParams defines the params for querying a client, or its
+// consensus state at a height. A zero height is the latest height of the
+// client.
+type QueryClientParams struct {
+	ClientID string `json:"client_id" yaml:"client_id"`
+	Height   uint64 `json:"height" yaml:"height"`
+}
+
+// NewQueryClientParams creates a new QueryClientParams
+func NewQueryClientParams(clientID string, height uint64) QueryClientParams {
+	return QueryClientParams{
+		ClientID: clientID,
+		Height:   height,
+	}
+}
+
+// QueryConnectionParams defines the params for querying a connection
+type QueryConnectionParams struct {
+	ConnectionID string `json:"connection_id" yaml:"connection_id"`
+}
+
+// NewQueryConnectionParams creates a new QueryConnectionParams
+func NewQueryConnectionParams(connectionID string) QueryConnectionParams {
+	return QueryConnectionParams{
+		ConnectionID: connectionID,
+	}
+}
+
+// QueryChannelParams defines the params for querying a channel
+type QueryChannelParams struct {
+	PortID    string `json:"port_id" yaml:"port_id"`
+	ChannelID string `json:"channel_id" yaml:"channel_id"`
+}
+
+// NewQueryChannelParams creates a new QueryChannelParams
+func NewQueryChannelParams(portID, channelID string) QueryChannelParams {
+	return QueryChannelParams{
+		PortID:    portID,
+		ChannelID: channelID,
+	}
+}
@@ -0,0 +1,85 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ Router = (*router)(nil)
+
+// Module defines the callbacks of a module bound to a port. The channel
+// handshake callbacks may reject a channel, e.g. because of its ordering or
+// version, which is the same at both ends of the channel. The packet callbacks
+// handle the packets of the channels of the port. OnRecvPacket returns the
+// acknowledgement of a received packet, and only returns an error if the
+// packet must not be received at all.
+type Module interface {
+	OnChanOpenInit(ctx sdk.Context, order Order, connectionHops []string, portID, channelID string,
+		counterparty ChannelCounterparty, version string) sdk.Error
+	OnChanOpenTry(ctx sdk.Context, order Order, connectionHops []string, portID, channelID string,
+		counterparty ChannelCounterparty, version string) sdk.Error
+	OnChanOpenAck(ctx sdk.Context, portID, channelID string) sdk.Error
+	OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) sdk.Error
+	OnRecvPacket(ctx sdk.Context, packet Packet) (acknowledgement []byte, err sdk.Error)
+	OnAcknowledgementPacket(ctx sdk.Context, packet Packet, acknowledgement []byte) sdk.Error
+	OnTimeoutPacket(ctx sdk.Context, packet Packet) sdk.Error
+}
+
+// Router binds the ports of the ibc module to the modules handling their
+// channels and packets.
+type Router interface {
+	AddRoute(portID string, m Module) (rtr Router)
+	HasRoute(portID string) bool
+	GetRoute(portID string) (m Module)
+	Seal()
+}
+
+type router struct {
+	routes map[string]Module
+	sealed bool
+}
+
+// NewRouter creates a new Router interface instance
+func NewRouter() Router {
+	return &router{
+		routes: make(map[string]Module),
+	}
+}
+
+// Seal seals the router which prohibits any subsequent port to be bound. Seal
+// will panic if called more than once.
+func (rtr *router) Seal() {
+	if rtr.sealed {
+		panic("router already sealed")
+	}
+	rtr.sealed = true
+}
+
+// AddRoute binds a port to a module. It returns the Router so AddRoute calls
+// can be linked. It will panic if the router is sealed.
+func (rtr *router) AddRoute(portID string, m Module) Router {
+	if rtr.sealed {
+		panic("router sealed; cannot bind port")
+	}
+
+	if err := ValidateIdentifier(portID); err != nil {
+		panic(err.Error())
+	}
+	if rtr.HasRoute(portID) {
+		panic(fmt.Sprintf("port %s has already been bound", portID))
+	}
+
+	rtr.routes[portID] = m
+	return rtr
+}
+
+// HasRoute returns true if a module is bound to the port or false otherwise.
+func (rtr *router) HasRoute(portID string) bool {
+	return rtr.routes[portID] != nil
+}
+
+// GetRoute returns the module bound to a port, or nil if there is none.
+func (rtr *router) GetRoute(portID string) Module {
+	return rtr.routes[portID]
+}
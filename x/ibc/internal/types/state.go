@@ -0,0 +1,145 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// State defines the state of a connection or channel handshake
+type State byte
+
+// Connection and channel states. Connections are never closed.
+const (
+	StateUninitialized State = 0x00
+	StateInit          State = 0x01
+	StateTryOpen       State = 0x02
+	StateOpen          State = 0x03
+	StateClosed        State = 0x04
+)
+
+// StateFromString returns a State from a string. It returns an error if the
+// string is invalid.
+func StateFromString(str string) (State, error) {
+	switch str {
+	case "UNINITIALIZED":
+		return StateUninitialized, nil
+
+	case "INIT":
+		return StateInit, nil
+
+	case "TRYOPEN":
+		return StateTryOpen, nil
+
+	case "OPEN":
+		return StateOpen, nil
+
+	case "CLOSED":
+		return StateClosed, nil
+
+	default:
+		return State(0xff), fmt.Errorf("'%s' is not a valid state", str)
+	}
+}
+
+// MarshalJSON marshals the state to JSON using its string.
+func (s State) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// UnmarshalJSON decodes the state from its string.
+func (s *State) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+
+	state, err := StateFromString(str)
+	if err != nil {
+		return err
+	}
+
+	*s = state
+	return nil
+}
+
+// String implements the Stringer interface.
+func (s State) String() string {
+	switch s {
+	case StateUninitialized:
+		return "UNINITIALIZED"
+	case StateInit:
+		return "INIT"
+	case StateTryOpen:
+		return "TRYOPEN"
+	case StateOpen:
+		return "OPEN"
+	case StateClosed:
+		return "CLOSED"
+	default:
+		return ""
+	}
+}
+
+// Order defines the ordering of the packets of a channel
+type Order byte
+
+// Channel orderings
+const (
+	OrderNone      Order = 0x00
+	OrderUnordered Order = 0x01
+	OrderOrdered   Order = 0x02
+)
+
+// OrderFromString returns an Order from a string. It returns an error if the
+// string is invalid.
+func OrderFromString(str string) (Order, error) {
+	switch str {
+	case "UNORDERED":
+		return OrderUnordered, nil
+
+	case "ORDERED":
+		return OrderOrdered, nil
+
+	default:
+		return Order(0xff), fmt.Errorf("'%s' is not a valid channel ordering", str)
+	}
+}
+
+// ValidOrder returns true if the channel ordering is valid and false
+// otherwise.
+func ValidOrder(order Order) bool {
+	return order == OrderUnordered || order == OrderOrdered
+}
+
+// MarshalJSON marshals the ordering to JSON using its string.
+func (o Order) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.String())
+}
+
+// UnmarshalJSON decodes the ordering from its string.
+func (o *Order) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+
+	order, err := OrderFromString(str)
+	if err != nil {
+		return err
+	}
+
+	*o = order
+	return nil
+}
+
+// String implements the Stringer interface.
+func (o Order) String() string {
+	switch o {
+	case OrderUnordered:
+		return "UNORDERED"
+	case OrderOrdered:
+		return "ORDERED"
+	default:
+		return ""
+	}
+}
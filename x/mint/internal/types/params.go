@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
@@ -59,18 +60,27 @@ func DefaultParams() Params {
 
 // validate params
 func ValidateParams(params Params) error {
-	if params.GoalBonded.LT(sdk.ZeroDec()) {
-		return fmt.Errorf("mint parameter GoalBonded should be positive, is %s ", params.GoalBonded.String())
+	if err := validateMintDenom(params.MintDenom); err != nil {
+		return err
 	}
-	if params.GoalBonded.GT(sdk.OneDec()) {
-		return fmt.Errorf("mint parameter GoalBonded must be <= 1, is %s", params.GoalBonded.String())
+	if err := validateInflationRateChange(params.InflationRateChange); err != nil {
+		return err
+	}
+	if err := validateInflationMax(params.InflationMax); err != nil {
+		return err
+	}
+	if err := validateInflationMin(params.InflationMin); err != nil {
+		return err
+	}
+	if err := validateGoalBonded(params.GoalBonded); err != nil {
+		return err
+	}
+	if err := validateBlocksPerYear(params.BlocksPerYear); err != nil {
+		return err
 	}
 	if params.InflationMax.LT(params.InflationMin) {
 		return fmt.Errorf("mint parameter Max inflation must be greater than or equal to min inflation")
 	}
-	if params.MintDenom == "" {
-		return fmt.Errorf("mint parameter MintDenom can't be an empty string")
-	}
 	return nil
 }
 
@@ -91,11 +101,70 @@ func (p Params) String() string {
 // Implements params.ParamSet
 func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
-		{Key: KeyMintDenom, Value: &p.MintDenom},
-		{Key: KeyInflationRateChange, Value: &p.InflationRateChange},
-		{Key: KeyInflationMax, Value: &p.InflationMax},
-		{Key: KeyInflationMin, Value: &p.InflationMin},
-		{Key: KeyGoalBonded, Value: &p.GoalBonded},
-		{Key: KeyBlocksPerYear, Value: &p.BlocksPerYear},
+		params.NewParamSetPair(KeyMintDenom, &p.MintDenom, validateMintDenom),
+		params.NewParamSetPair(KeyInflationRateChange, &p.InflationRateChange, validateInflationRateChange),
+		params.NewParamSetPair(KeyInflationMax, &p.InflationMax, validateInflationMax),
+		params.NewParamSetPair(KeyInflationMin, &p.InflationMin, validateInflationMin),
+		params.NewParamSetPair(KeyGoalBonded, &p.GoalBonded, validateGoalBonded),
+		params.NewParamSetPair(KeyBlocksPerYear, &p.BlocksPerYear, validateBlocksPerYear),
+	}
+}
+
+func validateMintDenom(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
 	}
+	if strings.TrimSpace(v) == "" {
+		return fmt.Errorf("mint parameter MintDenom can't be an empty string")
+	}
+	if err := sdk.ValidateDenom(v); err != nil {
+		return err
+	}
+	return nil
+}
+
+func validateInflationRateChange(i interface{}) error {
+	return validateRate("InflationRateChange", i)
+}
+
+func validateInflationMax(i interface{}) error {
+	return validateRate("InflationMax", i)
+}
+
+func validateInflationMin(i interface{}) error {
+	return validateRate("InflationMin", i)
+}
+
+func validateGoalBonded(i interface{}) error {
+	return validateRate("GoalBonded", i)
+}
+
+func validateBlocksPerYear(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("mint parameter BlocksPerYear must be positive: %d", v)
+	}
+	return nil
+}
+
+// validateRate ensures the given mint parameter is a decimal in the range [0, 1].
+func validateRate(name string, i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() {
+		return fmt.Errorf("mint parameter %s cannot be nil", name)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("mint parameter %s should be positive, is %s", name, v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("mint parameter %s must be <= 1, is %s", name, v)
+	}
+	return nil
 }
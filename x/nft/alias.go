@@ -17,6 +17,10 @@ const (
 	QueryCollection       = keeper.QueryCollection
 	QueryDenoms           = keeper.QueryDenoms
 	QueryNFT              = keeper.QueryNFT
+	QueryDenom            = keeper.QueryDenom
+	QueryNFTsByAttr       = keeper.QueryNFTsByAttr
+	QueryApproved         = keeper.QueryApproved
+	QueryOperators        = keeper.QueryOperators
 	DefaultCodespace      = types.DefaultCodespace
 	CodeInvalidCollection = types.CodeInvalidCollection
 	CodeUnknownCollection = types.CodeUnknownCollection
@@ -24,83 +28,169 @@ const (
 	CodeUnknownNFT        = types.CodeUnknownNFT
 	CodeNFTAlreadyExists  = types.CodeNFTAlreadyExists
 	CodeEmptyMetadata     = types.CodeEmptyMetadata
+	CodeUnknownDenom      = types.CodeUnknownDenom
+	CodeDenomExists       = types.CodeDenomExists
+	CodeInvalidAttributes = types.CodeInvalidAttributes
+	CodeInvalidEditPolicy = types.CodeInvalidEditPolicy
+	CodeInvalidApproval   = types.CodeInvalidApproval
+	CodeUnknownApproval   = types.CodeUnknownApproval
 	ModuleName            = types.ModuleName
 	StoreKey              = types.StoreKey
 	QuerierRoute          = types.QuerierRoute
 	RouterKey             = types.RouterKey
+	AttributeTypeString   = types.AttributeTypeString
+	AttributeTypeInt      = types.AttributeTypeInt
+	AttributeTypeBool     = types.AttributeTypeBool
+	AttributeTypeDec      = types.AttributeTypeDec
+	EditPolicyOwner       = types.EditPolicyOwner
+	EditPolicyCreator     = types.EditPolicyCreator
+	DefaultEditPolicy     = types.DefaultEditPolicy
+	DefaultQueryLimit     = types.DefaultQueryLimit
 )
 
 var (
 	// functions aliases
-	RegisterInvariants       = keeper.RegisterInvariants
-	AllInvariants            = keeper.AllInvariants
-	SupplyInvariant          = keeper.SupplyInvariant
-	NewKeeper                = keeper.NewKeeper
-	NewQuerier               = keeper.NewQuerier
-	RegisterCodec            = types.RegisterCodec
-	NewCollection            = types.NewCollection
-	EmptyCollection          = types.EmptyCollection
-	NewCollections           = types.NewCollections
-	ErrInvalidCollection     = types.ErrInvalidCollection
-	ErrUnknownCollection     = types.ErrUnknownCollection
-	ErrInvalidNFT            = types.ErrInvalidNFT
-	ErrNFTAlreadyExists      = types.ErrNFTAlreadyExists
-	ErrUnknownNFT            = types.ErrUnknownNFT
-	ErrEmptyMetadata         = types.ErrEmptyMetadata
-	NewGenesisState          = types.NewGenesisState
-	DefaultGenesisState      = types.DefaultGenesisState
-	ValidateGenesis          = types.ValidateGenesis
-	GetCollectionKey         = types.GetCollectionKey
-	SplitOwnerKey            = types.SplitOwnerKey
-	GetOwnersKey             = types.GetOwnersKey
-	GetOwnerKey              = types.GetOwnerKey
-	NewMsgTransferNFT        = types.NewMsgTransferNFT
-	NewMsgEditNFTMetadata    = types.NewMsgEditNFTMetadata
-	NewMsgMintNFT            = types.NewMsgMintNFT
-	NewMsgBurnNFT            = types.NewMsgBurnNFT
-	NewBaseNFT               = types.NewBaseNFT
-	NewNFTs                  = types.NewNFTs
-	NewIDCollection          = types.NewIDCollection
-	NewOwner                 = types.NewOwner
-	NewQueryCollectionParams = types.NewQueryCollectionParams
-	NewQueryBalanceParams    = types.NewQueryBalanceParams
-	NewQueryNFTParams        = types.NewQueryNFTParams
+	RegisterInvariants           = keeper.RegisterInvariants
+	AllInvariants                = keeper.AllInvariants
+	SupplyInvariant              = keeper.SupplyInvariant
+	NewKeeper                    = keeper.NewKeeper
+	NewQuerier                   = keeper.NewQuerier
+	NewApproval                  = types.NewApproval
+	NewOperatorApproval          = types.NewOperatorApproval
+	RegisterCodec                = types.RegisterCodec
+	NewAttribute                 = types.NewAttribute
+	NewAttributes                = types.NewAttributes
+	NewAttributesFromMap         = types.NewAttributesFromMap
+	ParseAttributes              = types.ParseAttributes
+	NewCollection                = types.NewCollection
+	EmptyCollection              = types.EmptyCollection
+	NewCollections               = types.NewCollections
+	ErrInvalidCollection         = types.ErrInvalidCollection
+	ErrUnknownCollection         = types.ErrUnknownCollection
+	ErrInvalidNFT                = types.ErrInvalidNFT
+	ErrNFTAlreadyExists          = types.ErrNFTAlreadyExists
+	ErrUnknownNFT                = types.ErrUnknownNFT
+	ErrEmptyMetadata             = types.ErrEmptyMetadata
+	ErrUnknownDenom              = types.ErrUnknownDenom
+	ErrDenomExists               = types.ErrDenomExists
+	ErrInvalidAttributes         = types.ErrInvalidAttributes
+	ErrInvalidEditPolicy         = types.ErrInvalidEditPolicy
+	ErrInvalidApproval           = types.ErrInvalidApproval
+	ErrUnknownApproval           = types.ErrUnknownApproval
+	NewGenesisState              = types.NewGenesisState
+	DefaultGenesisState          = types.DefaultGenesisState
+	ValidateGenesis              = types.ValidateGenesis
+	GetCollectionKey             = types.GetCollectionKey
+	SplitOwnerKey                = types.SplitOwnerKey
+	GetOwnersKey                 = types.GetOwnersKey
+	GetOwnerKey                  = types.GetOwnerKey
+	GetDenomKey                  = types.GetDenomKey
+	GetDenomHash                 = types.GetDenomHash
+	GetNFTsKey                   = types.GetNFTsKey
+	GetNFTKey                    = types.GetNFTKey
+	SplitNFTKey                  = types.SplitNFTKey
+	GetSupplyKey                 = types.GetSupplyKey
+	GetOwnerNFTKey               = types.GetOwnerNFTKey
+	GetApprovalKey               = types.GetApprovalKey
+	SplitApprovalKey             = types.SplitApprovalKey
+	GetOwnerOperatorsKey         = types.GetOwnerOperatorsKey
+	GetOperatorsKey              = types.GetOperatorsKey
+	GetOperatorKey               = types.GetOperatorKey
+	SplitOperatorKey             = types.SplitOperatorKey
+	NewMsgTransferNFT            = types.NewMsgTransferNFT
+	NewMsgEditNFTMetadata        = types.NewMsgEditNFTMetadata
+	NewMsgMintNFT                = types.NewMsgMintNFT
+	NewMsgBurnNFT                = types.NewMsgBurnNFT
+	NewMsgIssueDenom             = types.NewMsgIssueDenom
+	NewMsgTransferDenom          = types.NewMsgTransferDenom
+	NewMsgApproveNFT             = types.NewMsgApproveNFT
+	NewMsgSetApprovalForAll      = types.NewMsgSetApprovalForAll
+	NewMsgRevokeApproval         = types.NewMsgRevokeApproval
+	NewBaseNFT                   = types.NewBaseNFT
+	NewNFTs                      = types.NewNFTs
+	NewIDCollection              = types.NewIDCollection
+	NewOwner                     = types.NewOwner
+	NewQueryCollectionParams     = types.NewQueryCollectionParams
+	NewQueryCollectionPageParams = types.NewQueryCollectionPageParams
+	NewQueryBalanceParams        = types.NewQueryBalanceParams
+	NewQueryBalancePageParams    = types.NewQueryBalancePageParams
+	NewQueryCollectionResponse   = types.NewQueryCollectionResponse
+	NewQueryOwnerResponse        = types.NewQueryOwnerResponse
+	NewQueryNFTParams            = types.NewQueryNFTParams
+	NewQueryOperatorsParams      = types.NewQueryOperatorsParams
+	NewQueryAttributeParams      = types.NewQueryAttributeParams
+	ValidAttributeType           = types.ValidAttributeType
+	NewAttributeDefinition       = types.NewAttributeDefinition
+	NewSchema                    = types.NewSchema
+	NewDenom                     = types.NewDenom
+	ValidEditPolicy              = types.ValidEditPolicy
+	ValidateMinters              = types.ValidateMinters
 
 	// variable aliases
-	ModuleCdc                = types.ModuleCdc
-	EventTypeTransfer        = types.EventTypeTransfer
-	EventTypeEditNFTMetadata = types.EventTypeEditNFTMetadata
-	EventTypeMintNFT         = types.EventTypeMintNFT
-	EventTypeBurnNFT         = types.EventTypeBurnNFT
-	AttributeValueCategory   = types.AttributeValueCategory
-	AttributeKeySender       = types.AttributeKeySender
-	AttributeKeyRecipient    = types.AttributeKeyRecipient
-	AttributeKeyOwner        = types.AttributeKeyOwner
-	AttributeKeyNFTID        = types.AttributeKeyNFTID
-	AttributeKeyNFTTokenURI  = types.AttributeKeyNFTTokenURI
-	AttributeKeyDenom        = types.AttributeKeyDenom
-	CollectionsKeyPrefix     = types.CollectionsKeyPrefix
-	OwnersKeyPrefix          = types.OwnersKeyPrefix
+	ModuleCdc                  = types.ModuleCdc
+	EventTypeTransfer          = types.EventTypeTransfer
+	EventTypeEditNFTMetadata   = types.EventTypeEditNFTMetadata
+	EventTypeMintNFT           = types.EventTypeMintNFT
+	EventTypeBurnNFT           = types.EventTypeBurnNFT
+	EventTypeIssueDenom        = types.EventTypeIssueDenom
+	EventTypeTransferDenom     = types.EventTypeTransferDenom
+	EventTypeApproveNFT        = types.EventTypeApproveNFT
+	EventTypeRevokeApproval    = types.EventTypeRevokeApproval
+	EventTypeSetApprovalForAll = types.EventTypeSetApprovalForAll
+	AttributeValueCategory     = types.AttributeValueCategory
+	AttributeKeySender         = types.AttributeKeySender
+	AttributeKeyRecipient      = types.AttributeKeyRecipient
+	AttributeKeyOwner          = types.AttributeKeyOwner
+	AttributeKeyNFTID          = types.AttributeKeyNFTID
+	AttributeKeyNFTTokenURI    = types.AttributeKeyNFTTokenURI
+	AttributeKeyDenom          = types.AttributeKeyDenom
+	AttributeKeyCreator        = types.AttributeKeyCreator
+	AttributeKeyApproved       = types.AttributeKeyApproved
+	AttributeKeyOperator       = types.AttributeKeyOperator
+	CollectionsKeyPrefix       = types.CollectionsKeyPrefix
+	OwnersKeyPrefix            = types.OwnersKeyPrefix
+	DenomsKeyPrefix            = types.DenomsKeyPrefix
+	NFTsKeyPrefix              = types.NFTsKeyPrefix
+	SupplyKeyPrefix            = types.SupplyKeyPrefix
+	ApprovalsKeyPrefix         = types.ApprovalsKeyPrefix
+	OperatorsKeyPrefix         = types.OperatorsKeyPrefix
 )
 
 type (
-	Keeper                = keeper.Keeper
-	Collection            = types.Collection
-	Collections           = types.Collections
-	CollectionJSON        = types.CollectionJSON
-	CodeType              = types.CodeType
-	GenesisState          = types.GenesisState
-	MsgTransferNFT        = types.MsgTransferNFT
-	MsgEditNFTMetadata    = types.MsgEditNFTMetadata
-	MsgMintNFT            = types.MsgMintNFT
-	MsgBurnNFT            = types.MsgBurnNFT
-	BaseNFT               = types.BaseNFT
-	NFTs                  = types.NFTs
-	NFTJSON               = types.NFTJSON
-	IDCollection          = types.IDCollection
-	IDCollections         = types.IDCollections
-	Owner                 = types.Owner
-	QueryCollectionParams = types.QueryCollectionParams
-	QueryBalanceParams    = types.QueryBalanceParams
-	QueryNFTParams        = types.QueryNFTParams
+	Keeper                  = keeper.Keeper
+	Approval                = types.Approval
+	OperatorApproval        = types.OperatorApproval
+	OperatorApprovals       = types.OperatorApprovals
+	Attribute               = types.Attribute
+	Attributes              = types.Attributes
+	Collection              = types.Collection
+	Collections             = types.Collections
+	CollectionJSON          = types.CollectionJSON
+	CodeType                = types.CodeType
+	GenesisState            = types.GenesisState
+	MsgTransferNFT          = types.MsgTransferNFT
+	MsgEditNFTMetadata      = types.MsgEditNFTMetadata
+	MsgMintNFT              = types.MsgMintNFT
+	MsgBurnNFT              = types.MsgBurnNFT
+	MsgIssueDenom           = types.MsgIssueDenom
+	MsgTransferDenom        = types.MsgTransferDenom
+	MsgApproveNFT           = types.MsgApproveNFT
+	MsgSetApprovalForAll    = types.MsgSetApprovalForAll
+	MsgRevokeApproval       = types.MsgRevokeApproval
+	BaseNFT                 = types.BaseNFT
+	NFTs                    = types.NFTs
+	NFTJSON                 = types.NFTJSON
+	IDCollection            = types.IDCollection
+	IDCollections           = types.IDCollections
+	Owner                   = types.Owner
+	QueryCollectionParams   = types.QueryCollectionParams
+	QueryBalanceParams      = types.QueryBalanceParams
+	QueryNFTParams          = types.QueryNFTParams
+	QueryOperatorsParams    = types.QueryOperatorsParams
+	QueryAttributeParams    = types.QueryAttributeParams
+	QueryCollectionResponse = types.QueryCollectionResponse
+	QueryOwnerResponse      = types.QueryOwnerResponse
+	AttributeDefinition     = types.AttributeDefinition
+	Schema                  = types.Schema
+	Denom                   = types.Denom
 )
@@ -1,12 +1,14 @@
 package cli
 
 import (
+	"encoding/hex"
 	"fmt"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -16,6 +18,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/nft/internal/types"
 )
 
+// Pagination flags
+const (
+	flagPage     = "page"
+	flagLimit    = "limit"
+	flagStartKey = "start-key"
+)
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	nftQueryCmd := &cobra.Command{
@@ -29,6 +38,10 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryCollection(queryRoute, cdc),
 		GetCmdQueryDenoms(queryRoute, cdc),
 		GetCmdQueryNFT(queryRoute, cdc),
+		GetCmdQueryDenom(queryRoute, cdc),
+		GetCmdQueryNFTsByAttribute(queryRoute, cdc),
+		GetCmdQueryApproved(queryRoute, cdc),
+		GetCmdQueryOperators(queryRoute, cdc),
 	)...)
 
 	return nftQueryCmd
@@ -76,15 +89,17 @@ $ %s query %s supply crypto-kitties
 
 // GetCmdQueryOwner queries all the NFTs owned by an account
 func GetCmdQueryOwner(queryRoute string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "owner [accountAddress] [denom]",
 		Short: "get the NFTs owned by an account address",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Get the NFTs owned by an account address optionally filtered by the denom of the NFTs.
+			fmt.Sprintf(`Get a page of the NFTs owned by an account address optionally filtered by the
+denom of the NFTs. The next page can be requested by passing the returned next key
+to the --start-key flag.
 
 Example:
 $ %s query %s owner cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
-$ %s query %s owner cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p cripto-kitties
+$ %s query %s owner cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p cripto-kitties --limit 50
 `, version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
 			),
 		),
@@ -101,7 +116,14 @@ $ %s query %s owner cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p cripto-kitties
 				denom = args[1]
 			}
 
-			params := types.NewQueryBalanceParams(address, denom)
+			startKey, err := hex.DecodeString(viper.GetString(flagStartKey))
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryBalancePageParams(
+				address, denom, viper.GetInt(flagPage), viper.GetInt(flagLimit), startKey,
+			)
 			bz, err := cdc.MarshalJSON(params)
 			if err != nil {
 				return err
@@ -118,7 +140,7 @@ $ %s query %s owner cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p cripto-kitties
 				return err
 			}
 
-			var out types.Owner
+			var out types.QueryOwnerResponse
 			err = cdc.UnmarshalJSON(res, &out)
 			if err != nil {
 				return err
@@ -127,18 +149,22 @@ $ %s query %s owner cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p cripto-kitties
 			return cliCtx.PrintOutput(out)
 		},
 	}
+
+	addPaginationFlags(cmd)
+	return cmd
 }
 
-// GetCmdQueryCollection queries all the NFTs from a collection
+// GetCmdQueryCollection queries a page of the NFTs from a collection
 func GetCmdQueryCollection(queryRoute string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "collection [denom]",
-		Short: "get all the NFTs from a given collection",
+		Short: "get the NFTs from a given collection",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Get a list of all NFTs from a given collection.
+			fmt.Sprintf(`Get a page of the NFTs from a given collection, sorted by ID. The next page
+can be requested by passing the returned next key to the --start-key flag.
 
 Example:
-$ %s query %s collection cripto-kitties
+$ %s query %s collection cripto-kitties --limit 50
 `, version.ClientName, types.ModuleName,
 			),
 		),
@@ -147,7 +173,14 @@ $ %s query %s collection cripto-kitties
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 			denom := args[0]
 
-			params := types.NewQueryCollectionParams(denom)
+			startKey, err := hex.DecodeString(viper.GetString(flagStartKey))
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryCollectionPageParams(
+				denom, viper.GetInt(flagPage), viper.GetInt(flagLimit), startKey,
+			)
 			bz, err := cdc.MarshalJSON(params)
 			if err != nil {
 				return err
@@ -158,7 +191,7 @@ $ %s query %s collection cripto-kitties
 				return err
 			}
 
-			var out types.Collection
+			var out types.QueryCollectionResponse
 			err = cdc.UnmarshalJSON(res, &out)
 			if err != nil {
 				return err
@@ -167,6 +200,15 @@ $ %s query %s collection cripto-kitties
 			return cliCtx.PrintOutput(out)
 		},
 	}
+
+	addPaginationFlags(cmd)
+	return cmd
+}
+
+func addPaginationFlags(cmd *cobra.Command) {
+	cmd.Flags().Int(flagPage, 1, "Query a specific page of paginated results, ignored if a start key is provided")
+	cmd.Flags().Int(flagLimit, types.DefaultQueryLimit, "Maximum number of NFTs returned per page")
+	cmd.Flags().String(flagStartKey, "", "Hex encoded key the page starts at, as returned by the previous page")
 }
 
 type stringArray []string
@@ -247,3 +289,183 @@ $ %s query %s token cripto-kitties d04b98f48e8f8bcc15c6ae5ac050801cd6dcfd428fb5f
 		},
 	}
 }
+
+// GetCmdQueryDenom queries the creator and attribute schema of an issued denom
+func GetCmdQueryDenom(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "denom [denom]",
+		Short: "query the creator and attribute schema of an issued denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the creator and the attribute schema of an issued denom.
+
+Example:
+$ %s query %s denom cripto-kitties
+`, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			denom := args[0]
+
+			params := types.NewQueryCollectionParams(denom)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/denom", queryRoute), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.Denom
+			err = cdc.UnmarshalJSON(res, &out)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdQueryNFTsByAttribute queries the NFTs of a collection that have a given attribute value
+func GetCmdQueryNFTsByAttribute(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attribute [denom] [key] [value]",
+		Short: "get the NFTs of a collection that have a given attribute value",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get a page of the NFTs from a given collection whose on-chain attribute
+matches the given value, sorted by ID. The next page can be requested by passing the
+returned next key to the --start-key flag.
+
+Example:
+$ %s query %s attribute cripto-kitties color red --limit 50
+`, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			startKey, err := hex.DecodeString(viper.GetString(flagStartKey))
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryAttributePageParams(
+				args[0], args[1], args[2], viper.GetInt(flagPage), viper.GetInt(flagLimit), startKey,
+			)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/nftsByAttribute", queryRoute), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryCollectionResponse
+			err = cdc.UnmarshalJSON(res, &out)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	addPaginationFlags(cmd)
+	return cmd
+}
+
+// GetCmdQueryApproved queries the address approved to transfer an NFT
+func GetCmdQueryApproved(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "approved [denom] [tokenID]",
+		Short: "query the address approved to transfer an NFT",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the address approved to transfer an NFT on behalf of its owner.
+
+Example:
+$ %s query %s approved cripto-kitties d04b98f48e8f8bcc15c6ae5ac050801cd6dcfd428fb5f9e65c4e16e7807340fa
+`, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := types.NewQueryNFTParams(args[0], args[1])
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/approved", queryRoute), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.Approval
+			err = cdc.UnmarshalJSON(res, &out)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdQueryOperators queries the operators of an account address
+func GetCmdQueryOperators(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "operators [accountAddress] [denom]",
+		Short: "query the operators of the NFTs owned by an account address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the operators allowed to transfer and approve the NFTs owned by an
+account address, optionally filtered by denom.
+
+Example:
+$ %s query %s operators cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+$ %s query %s operators cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p cripto-kitties
+`, version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			denom := ""
+			if len(args) == 2 {
+				denom = args[1]
+			}
+
+			params := types.NewQueryOperatorsParams(owner, denom)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/operators", queryRoute), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.OperatorApprovals
+			err = cdc.UnmarshalJSON(res, &out)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
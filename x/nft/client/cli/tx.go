@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"io/ioutil"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -20,7 +21,13 @@ import (
 
 // Edit metadata flags
 const (
-	flagTokenURI = "tokenURI"
+	flagTokenURI      = "tokenURI"
+	flagClearTokenURI = "clear-tokenURI"
+	flagAttributes    = "attributes"
+	flagSchema        = "schema"
+	flagMinters       = "minters"
+	flagEditPolicy    = "edit-policy"
+	flagRevoke        = "revoke"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -35,6 +42,11 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 		GetCmdEditNFTMetadata(cdc),
 		GetCmdMintNFT(cdc),
 		GetCmdBurnNFT(cdc),
+		GetCmdIssueDenom(cdc),
+		GetCmdTransferDenom(cdc),
+		GetCmdApproveNFT(cdc),
+		GetCmdSetApprovalForAll(cdc),
+		GetCmdRevokeApproval(cdc),
 	)...)
 
 	return nftTxCmd
@@ -93,7 +105,7 @@ func GetCmdEditNFTMetadata(cdc *codec.Codec) *cobra.Command {
 
 Example:
 $ %s tx %s edit-metadata cripto-kitties d04b98f48e8f8bcc15c6ae5ac050801cd6dcfd428fb5f9e65c4e16e7807340fa \
---tokenURI path_to_token_URI_JSON --from mykey
+--tokenURI path_to_token_URI_JSON --attributes color=blue,level=5 --from mykey
 `,
 				version.ClientName, types.ModuleName,
 			),
@@ -107,12 +119,20 @@ $ %s tx %s edit-metadata cripto-kitties d04b98f48e8f8bcc15c6ae5ac050801cd6dcfd42
 			tokenID := args[1]
 			tokenURI := viper.GetString(flagTokenURI)
 
-			msg := types.NewMsgEditNFTMetadata(cliCtx.GetFromAddress(), tokenID, denom, tokenURI)
+			attributes, err := types.ParseAttributes(viper.GetString(flagAttributes))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgEditNFTMetadata(cliCtx.GetFromAddress(), tokenID, denom, tokenURI, attributes)
+			msg.ClearTokenURI = viper.GetBool(flagClearTokenURI)
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
 
-	cmd.Flags().String(flagTokenURI, "", "Extra properties available for querying")
+	cmd.Flags().String(flagTokenURI, "", "New URI for supplemental off-chain metadata, the current one is kept if empty")
+	cmd.Flags().Bool(flagClearTokenURI, false, "Remove the token URI of the NFT")
+	cmd.Flags().String(flagAttributes, "", "Mutable on-chain attributes to add or replace, as comma separated key=value pairs")
 	return cmd
 }
 
@@ -127,7 +147,7 @@ func GetCmdMintNFT(cdc *codec.Codec) *cobra.Command {
 
 Example:
 $ %s tx %s mint cripto-kitties d04b98f48e8f8bcc15c6ae5ac050801cd6dcfd428fb5f9e65c4e16e7807340fa \
-cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --from mykey
+cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --attributes color=red,level=4 --from mykey
 `,
 				version.ClientName, types.ModuleName,
 			),
@@ -147,12 +167,18 @@ cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --from mykey
 
 			tokenURI := viper.GetString(flagTokenURI)
 
-			msg := types.NewMsgMintNFT(cliCtx.GetFromAddress(), recipient, tokenID, denom, tokenURI)
+			attributes, err := types.ParseAttributes(viper.GetString(flagAttributes))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMintNFT(cliCtx.GetFromAddress(), recipient, tokenID, denom, tokenURI, attributes)
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
 
 	cmd.Flags().String(flagTokenURI, "", "URI for supplemental off-chain metadata (should return a JSON object)")
+	cmd.Flags().String(flagAttributes, "", "On-chain attributes declared by the denom schema, as comma separated key=value pairs")
 
 	return cmd
 }
@@ -186,3 +212,231 @@ $ %s tx %s burn cripto-kitties d04b98f48e8f8bcc15c6ae5ac050801cd6dcfd428fb5f9e65
 		},
 	}
 }
+
+// GetCmdIssueDenom is the CLI command for sending an IssueDenom transaction
+func GetCmdIssueDenom(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "issue-denom [denom]",
+		Short: "issue a new NFT denom along with its attribute schema",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Issue a new NFT denom owned by the sender and register the schema
+of the on-chain attributes that its NFTs can hold. The schema is read from a JSON file.
+
+Only the creator of the denom and the accounts listed in --minters can mint NFTs
+of the denom. The --edit-policy flag defines who can edit the metadata of the NFTs:
+either their "owner" (default) or the denom "creator".
+
+Example:
+$ %s tx %s issue-denom cripto-kitties --schema path/to/schema.json \
+--minters cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --edit-policy creator --from mykey
+
+Where schema.json contains:
+
+[
+  {
+    "name": "color",
+    "type": "string",
+    "mutable": false,
+    "required": true
+  },
+  {
+    "name": "level",
+    "type": "int",
+    "mutable": true,
+    "required": false
+  }
+]
+
+Supported attribute types are: string, int, bool and dec.
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := authtypes.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			schema := types.NewSchema()
+			if schemaFile := viper.GetString(flagSchema); schemaFile != "" {
+				contents, err := ioutil.ReadFile(schemaFile)
+				if err != nil {
+					return err
+				}
+
+				if err := cdc.UnmarshalJSON(contents, &schema); err != nil {
+					return err
+				}
+			}
+
+			var minters []sdk.AccAddress
+			for _, minterStr := range viper.GetStringSlice(flagMinters) {
+				minter, err := sdk.AccAddressFromBech32(minterStr)
+				if err != nil {
+					return err
+				}
+				minters = append(minters, minter)
+			}
+
+			msg := types.NewMsgIssueDenom(
+				cliCtx.GetFromAddress(), args[0], schema, minters, viper.GetString(flagEditPolicy),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagSchema, "", "Path to a JSON file containing the attribute schema of the denom")
+	cmd.Flags().StringSlice(flagMinters, []string{}, "Comma separated addresses allowed to mint NFTs of the denom besides the creator")
+	cmd.Flags().String(flagEditPolicy, types.DefaultEditPolicy, "Who can edit the metadata of the NFTs, either owner or creator")
+	return cmd
+}
+
+// GetCmdTransferDenom is the CLI command for sending a TransferDenom transaction
+func GetCmdTransferDenom(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "transfer-denom [recipient] [denom]",
+		Short: "transfer the ownership of an NFT denom to a recipient",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Transfer the ownership of an issued NFT denom to a recipient.
+Only the current creator of the denom can transfer it.
+
+Example:
+$ %s tx %s transfer-denom cosmos1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm cripto-kitties \
+--from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := authtypes.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			recipient, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferDenom(cliCtx.GetFromAddress(), recipient, args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdApproveNFT is the CLI command for sending an ApproveNFT transaction
+func GetCmdApproveNFT(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "approve [approved] [denom] [tokenID]",
+		Short: "approve an address to transfer an NFT",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Approve an address to transfer an NFT on behalf of its owner. An NFT
+has a single approved address which is cleared when the NFT is transferred. The
+owner of the NFT or one of its operators can approve it.
+
+Example:
+$ %s tx %s approve cosmos1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm cripto-kitties \
+d04b98f48e8f8bcc15c6ae5ac050801cd6dcfd428fb5f9e65c4e16e7807340fa --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := authtypes.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			approved, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgApproveNFT(cliCtx.GetFromAddress(), approved, args[1], args[2])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdSetApprovalForAll is the CLI command for sending a SetApprovalForAll
+// transaction
+func GetCmdSetApprovalForAll(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-all [operator] [denom]",
+		Short: "add or remove an operator of all your NFTs of a collection",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Allow an operator to transfer and approve all the NFTs of a collection
+owned by the sender, including the ones received later. Pass --revoke to remove
+the operator.
+
+Example:
+$ %s tx %s approve-all cosmos1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm cripto-kitties --from mykey
+$ %s tx %s approve-all cosmos1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm cripto-kitties --revoke --from mykey
+`,
+				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := authtypes.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			operator, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetApprovalForAll(cliCtx.GetFromAddress(), operator, args[1], !viper.GetBool(flagRevoke))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Bool(flagRevoke, false, "remove the operator instead of adding it")
+	return cmd
+}
+
+// GetCmdRevokeApproval is the CLI command for sending a RevokeApproval
+// transaction
+func GetCmdRevokeApproval(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke-approval [denom] [tokenID]",
+		Short: "clear the address approved to transfer an NFT",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Clear the address approved to transfer an NFT. The owner of the NFT or
+one of its operators can revoke the approval.
+
+Example:
+$ %s tx %s revoke-approval cripto-kitties \
+d04b98f48e8f8bcc15c6ae5ac050801cd6dcfd428fb5f9e65c4e16e7807340fa --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := authtypes.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgRevokeApproval(cliCtx.GetFromAddress(), args[0], args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
@@ -1,6 +1,7 @@
 package rest
 
 import (
+	"encoding/hex"
 	"fmt"
 	"net/http"
 
@@ -20,17 +21,17 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Co
 		"/nft/supply/{denom}", getSupply(cdc, cliCtx, queryRoute),
 	).Methods("GET")
 
-	// Get the collections of NFTs owned by an address
+	// Get a page of the collections of NFTs owned by an address
 	r.HandleFunc(
 		"/nft/owner/{delegatorAddr}", getOwner(cdc, cliCtx, queryRoute),
 	).Methods("GET")
 
-	// Get the NFTs owned by an address from a given collection
+	// Get a page of the NFTs owned by an address from a given collection
 	r.HandleFunc(
 		"/nft/owner/{delegatorAddr}/collection/{denom}", getOwnerByDenom(cdc, cliCtx, queryRoute),
 	).Methods("GET")
 
-	// Get all the NFT from a given collection
+	// Get a page of the NFTs from a given collection
 	r.HandleFunc(
 		"/nft/collection/{denom}", getCollection(cdc, cliCtx, queryRoute),
 	).Methods("GET")
@@ -44,6 +45,26 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Co
 	r.HandleFunc(
 		"/nft/collection/{denom}/nft/{id}", getNFT(cdc, cliCtx, queryRoute),
 	).Methods("GET")
+
+	// Query the creator and attribute schema of an issued denom
+	r.HandleFunc(
+		"/nft/denom/{denom}", getDenom(cdc, cliCtx, queryRoute),
+	).Methods("GET")
+
+	// Get the NFTs from a given collection that have a given attribute value
+	r.HandleFunc(
+		"/nft/collection/{denom}/attribute/{key}/{value}", getNFTsByAttribute(cdc, cliCtx, queryRoute),
+	).Methods("GET")
+
+	// Get the address approved to transfer an NFT
+	r.HandleFunc(
+		"/nft/collection/{denom}/nft/{id}/approved", getApproved(cdc, cliCtx, queryRoute),
+	).Methods("GET")
+
+	// Get the operators of an address, optionally filtered by the denom query param
+	r.HandleFunc(
+		"/nft/operators/{delegatorAddr}", getOperators(cdc, cliCtx, queryRoute),
+	).Methods("GET")
 }
 
 func getSupply(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
@@ -75,7 +96,12 @@ func getOwner(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) ht
 			return
 		}
 
-		params := types.NewQueryBalanceParams(address, "")
+		page, limit, startKey, ok := parsePagination(w, r)
+		if !ok {
+			return
+		}
+
+		params := types.NewQueryBalancePageParams(address, "", page, limit, startKey)
 		bz, err := cdc.MarshalJSON(params)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -102,7 +128,12 @@ func getOwnerByDenom(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute str
 			return
 		}
 
-		params := types.NewQueryBalanceParams(address, denom)
+		page, limit, startKey, ok := parsePagination(w, r)
+		if !ok {
+			return
+		}
+
+		params := types.NewQueryBalancePageParams(address, denom, page, limit, startKey)
 		bz, err := cdc.MarshalJSON(params)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -123,7 +154,12 @@ func getCollection(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute strin
 	return func(w http.ResponseWriter, r *http.Request) {
 		denom := mux.Vars(r)["denom"]
 
-		params := types.NewQueryCollectionParams(denom)
+		page, limit, startKey, ok := parsePagination(w, r)
+		if !ok {
+			return
+		}
+
+		params := types.NewQueryCollectionPageParams(denom, page, limit, startKey)
 		bz, err := cdc.MarshalJSON(params)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -175,3 +211,118 @@ func getNFT(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
+
+func getDenom(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		denom := mux.Vars(r)["denom"]
+
+		params := types.NewQueryCollectionParams(denom)
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/denom", queryRoute), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func getNFTsByAttribute(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		page, limit, startKey, ok := parsePagination(w, r)
+		if !ok {
+			return
+		}
+
+		params := types.NewQueryAttributePageParams(vars["denom"], vars["key"], vars["value"], page, limit, startKey)
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/nftsByAttribute", queryRoute), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// parsePagination parses the page, limit and hex encoded start_key query
+// parameters of a paginated request
+func parsePagination(w http.ResponseWriter, r *http.Request) (page, limit int, startKey []byte, ok bool) {
+	if err := r.ParseForm(); err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return 0, 0, nil, false
+	}
+
+	_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, types.DefaultQueryLimit)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return 0, 0, nil, false
+	}
+
+	startKey, err = hex.DecodeString(r.FormValue("start_key"))
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return 0, 0, nil, false
+	}
+	return page, limit, startKey, true
+}
+
+func getApproved(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		params := types.NewQueryNFTParams(vars["denom"], vars["id"])
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/approved", queryRoute), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func getOperators(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		owner, err := sdk.AccAddressFromBech32(mux.Vars(r)["delegatorAddr"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		params := types.NewQueryOperatorsParams(owner, r.URL.Query().Get("denom"))
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/operators", queryRoute), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
@@ -39,6 +39,36 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router,
 		"/nfts/collection/{denom}/nft/{id}/burn",
 		burnNFTHandler(cdc, cliCtx),
 	).Methods("PUT")
+
+	// Issue a new denom along with its attribute schema
+	r.HandleFunc(
+		"/nfts/denoms/issue",
+		issueDenomHandler(cdc, cliCtx),
+	).Methods("POST")
+
+	// Transfer the ownership of a denom to an address
+	r.HandleFunc(
+		"/nfts/denoms/transfer",
+		transferDenomHandler(cdc, cliCtx),
+	).Methods("POST")
+
+	// Approve an address to transfer an NFT
+	r.HandleFunc(
+		"/nfts/collection/{denom}/nft/{id}/approve",
+		approveNFTHandler(cdc, cliCtx),
+	).Methods("POST")
+
+	// Clear the address approved to transfer an NFT
+	r.HandleFunc(
+		"/nfts/collection/{denom}/nft/{id}/revoke",
+		revokeApprovalHandler(cdc, cliCtx),
+	).Methods("POST")
+
+	// Add or remove an operator of the NFTs of a collection
+	r.HandleFunc(
+		"/nfts/operators",
+		setApprovalForAllHandler(cdc, cliCtx),
+	).Methods("POST")
 }
 
 type transferNFTReq struct {
@@ -72,10 +102,13 @@ func transferNFTHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.Handle
 }
 
 type editNFTMetadataReq struct {
-	BaseReq  rest.BaseReq `json:"base_req"`
-	Denom    string       `json:"denom"`
-	ID       string       `json:"id"`
-	TokenURI string       `json:"tokenURI"`
+	BaseReq    rest.BaseReq     `json:"base_req"`
+	Denom      string           `json:"denom"`
+	ID         string           `json:"id"`
+	TokenURI   string           `json:"tokenURI"`
+	Attributes types.Attributes `json:"attributes"`
+	// ClearTokenURI removes the token URI, which is otherwise kept when TokenURI is empty
+	ClearTokenURI bool `json:"clear_tokenURI"`
 }
 
 func editNFTMetadataHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
@@ -91,18 +124,20 @@ func editNFTMetadataHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.Ha
 		}
 
 		// create the message
-		msg := types.NewMsgEditNFTMetadata(cliCtx.GetFromAddress(), req.ID, req.Denom, req.TokenURI)
+		msg := types.NewMsgEditNFTMetadata(cliCtx.GetFromAddress(), req.ID, req.Denom, req.TokenURI, types.NewAttributes(req.Attributes...))
+		msg.ClearTokenURI = req.ClearTokenURI
 
 		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
 	}
 }
 
 type mintNFTReq struct {
-	BaseReq   rest.BaseReq   `json:"base_req"`
-	Recipient sdk.AccAddress `json:"recipient"`
-	Denom     string         `json:"denom"`
-	ID        string         `json:"id"`
-	TokenURI  string         `json:"tokenURI"`
+	BaseReq    rest.BaseReq     `json:"base_req"`
+	Recipient  sdk.AccAddress   `json:"recipient"`
+	Denom      string           `json:"denom"`
+	ID         string           `json:"id"`
+	TokenURI   string           `json:"tokenURI"`
+	Attributes types.Attributes `json:"attributes"`
 }
 
 func mintNFTHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
@@ -118,7 +153,7 @@ func mintNFTHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFun
 		}
 
 		// create the message
-		msg := types.NewMsgMintNFT(cliCtx.GetFromAddress(), req.Recipient, req.ID, req.Denom, req.TokenURI)
+		msg := types.NewMsgMintNFT(cliCtx.GetFromAddress(), req.Recipient, req.ID, req.Denom, req.TokenURI, types.NewAttributes(req.Attributes...))
 
 		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
 	}
@@ -147,3 +182,157 @@ func burnNFTHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFun
 		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
 	}
 }
+
+type issueDenomReq struct {
+	BaseReq    rest.BaseReq `json:"base_req"`
+	Denom      string       `json:"denom"`
+	Schema     types.Schema `json:"schema"`
+	Minters    []string     `json:"minters"`
+	EditPolicy string       `json:"edit_policy"`
+}
+
+func issueDenomHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req issueDenomReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		var minters []sdk.AccAddress
+		for _, minterStr := range req.Minters {
+			minter, err := sdk.AccAddressFromBech32(minterStr)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			minters = append(minters, minter)
+		}
+
+		editPolicy := req.EditPolicy
+		if editPolicy == "" {
+			editPolicy = types.DefaultEditPolicy
+		}
+
+		// create the message
+		msg := types.NewMsgIssueDenom(cliCtx.GetFromAddress(), req.Denom, req.Schema, minters, editPolicy)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type transferDenomReq struct {
+	BaseReq   rest.BaseReq `json:"base_req"`
+	Denom     string       `json:"denom"`
+	Recipient string       `json:"recipient"`
+}
+
+func transferDenomHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req transferDenomReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+		recipient, err := sdk.AccAddressFromBech32(req.Recipient)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgTransferDenom(cliCtx.GetFromAddress(), recipient, req.Denom)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type approveNFTReq struct {
+	BaseReq  rest.BaseReq `json:"base_req"`
+	Approved string       `json:"approved"`
+}
+
+func approveNFTHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		var req approveNFTReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+		approved, err := sdk.AccAddressFromBech32(req.Approved)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgApproveNFT(cliCtx.GetFromAddress(), approved, vars["denom"], vars["id"])
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type revokeApprovalReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+}
+
+func revokeApprovalHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		var req revokeApprovalReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgRevokeApproval(cliCtx.GetFromAddress(), vars["denom"], vars["id"])
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type setApprovalForAllReq struct {
+	BaseReq  rest.BaseReq `json:"base_req"`
+	Denom    string       `json:"denom"`
+	Operator string       `json:"operator"`
+	Approved bool         `json:"approved"`
+}
+
+func setApprovalForAllHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req setApprovalForAllReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+		operator, err := sdk.AccAddressFromBech32(req.Operator)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgSetApprovalForAll(cliCtx.GetFromAddress(), operator, req.Denom, req.Approved)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
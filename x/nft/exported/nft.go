@@ -11,5 +11,8 @@ type NFT interface {
 	SetOwner(address sdk.AccAddress)
 	GetTokenURI() string
 	EditMetadata(tokenURI string)
+	GetAttribute(key string) (value string, found bool)
+	GetAttributes() map[string]string
+	EditAttributes(attributes map[string]string)
 	String() string
 }
@@ -4,17 +4,31 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// InitGenesis sets nft information for genesis.
+// InitGenesis sets nft information for genesis. The owners of the NFTs are
+// indexed while storing the collections.
 func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
-	k.SetOwners(ctx, data.Owners)
-
 	for _, c := range data.Collections {
 		k.SetCollection(ctx, c.Denom, c)
 	}
 
+	for _, d := range data.Denoms {
+		k.SetDenom(ctx, d)
+	}
+
+	for _, a := range data.Approvals {
+		k.SetApproval(ctx, a)
+	}
+
+	for _, o := range data.Operators {
+		if err := k.SetApprovalForAll(ctx, o.Owner, o.Denom, o.Operator, true); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
-	return NewGenesisState(k.GetOwners(ctx), k.GetCollections(ctx))
+	return NewGenesisState(
+		k.GetCollections(ctx), k.GetIssuedDenoms(ctx), k.GetApprovals(ctx), k.GetAllOperators(ctx),
+	)
 }
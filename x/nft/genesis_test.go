@@ -5,13 +5,13 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/nft"
 )
 
 func TestInitGenesis(t *testing.T) {
 	app, ctx := createTestApp(false)
 	genesisState := nft.DefaultGenesisState()
-	require.Equal(t, 0, len(genesisState.Owners))
 	require.Equal(t, 0, len(genesisState.Collections))
 
 	ids := []string{id, id2, id3}
@@ -37,12 +37,20 @@ func TestInitGenesis(t *testing.T) {
 
 	collections := nft.NewCollections(collection, collection2)
 
-	genesisState = nft.NewGenesisState(owners, collections)
+	schema := nft.NewSchema(nft.NewAttributeDefinition("color", nft.AttributeTypeString, false, false))
+	denoms := []nft.Denom{nft.NewDenom(denom3, address, schema, []sdk.AccAddress{address2}, nft.EditPolicyCreator)}
+
+	approvals := []nft.Approval{nft.NewApproval(denom, id, address3)}
+	operators := []nft.OperatorApproval{nft.NewOperatorApproval(address2, denom2, address3)}
+
+	genesisState = nft.NewGenesisState(collections, denoms, approvals, operators)
+	require.NoError(t, nft.ValidateGenesis(genesisState))
 
 	nft.InitGenesis(ctx, app.NFTKeeper, genesisState)
 
+	// the owners are indexed from the collections
 	returnedOwners := app.NFTKeeper.GetOwners(ctx)
-	require.Equal(t, 2, len(owners))
+	require.Equal(t, 2, len(returnedOwners))
 	require.Equal(t, returnedOwners[0].String(), owners[0].String())
 	require.Equal(t, returnedOwners[1].String(), owners[1].String())
 
@@ -52,11 +60,57 @@ func TestInitGenesis(t *testing.T) {
 	require.Equal(t, returnedCollections[1].String(), collections[1].String())
 
 	exportedGenesisState := nft.ExportGenesis(ctx, app.NFTKeeper)
-	require.Equal(t, len(genesisState.Owners), len(exportedGenesisState.Owners))
-	require.Equal(t, genesisState.Owners[0].String(), exportedGenesisState.Owners[0].String())
-	require.Equal(t, genesisState.Owners[1].String(), exportedGenesisState.Owners[1].String())
-
 	require.Equal(t, len(genesisState.Collections), len(exportedGenesisState.Collections))
 	require.Equal(t, genesisState.Collections[0].String(), exportedGenesisState.Collections[0].String())
 	require.Equal(t, genesisState.Collections[1].String(), exportedGenesisState.Collections[1].String())
+
+	require.Equal(t, genesisState.Denoms, exportedGenesisState.Denoms)
+	require.Equal(t, genesisState.Approvals, exportedGenesisState.Approvals)
+	require.Equal(t, genesisState.Operators, exportedGenesisState.Operators)
+}
+
+func TestValidateGenesis(t *testing.T) {
+	nft1 := nft.NewBaseNFT(id, address, tokenURI1)
+	nft2 := nft.NewBaseNFT(id, address2, tokenURI1)
+	unowned := nft.NewBaseNFT(id2, nil, tokenURI1)
+
+	require.NoError(t, nft.ValidateGenesis(nft.DefaultGenesisState()))
+
+	// duplicate NFT IDs within a collection
+	genesisState := nft.NewGenesisState(nft.NewCollections(nft.NewCollection(denom, nft.NewNFTs(&nft1, &nft2))), nil, nil, nil)
+	require.Error(t, nft.ValidateGenesis(genesisState))
+
+	// duplicate collections
+	genesisState = nft.NewGenesisState(nft.Collections{
+		nft.NewCollection(denom, nft.NewNFTs(&nft1)), nft.NewCollection(denom, nft.NewNFTs(&nft2)),
+	}, nil, nil, nil)
+	require.Error(t, nft.ValidateGenesis(genesisState))
+
+	// NFT without owner
+	genesisState = nft.NewGenesisState(nft.NewCollections(nft.NewCollection(denom, nft.NewNFTs(&unowned))), nil, nil, nil)
+	require.Error(t, nft.ValidateGenesis(genesisState))
+
+	collections := nft.NewCollections(nft.NewCollection(denom, nft.NewNFTs(&nft1)))
+
+	// approval of an unknown NFT
+	genesisState = nft.NewGenesisState(collections, nil, []nft.Approval{nft.NewApproval(denom, id2, address2)}, nil)
+	require.Error(t, nft.ValidateGenesis(genesisState))
+
+	// the owner approved to transfer its own NFT
+	genesisState = nft.NewGenesisState(collections, nil, []nft.Approval{nft.NewApproval(denom, id, address)}, nil)
+	require.Error(t, nft.ValidateGenesis(genesisState))
+
+	// duplicate operators
+	operator := nft.NewOperatorApproval(address, denom, address2)
+	genesisState = nft.NewGenesisState(collections, nil, nil, []nft.OperatorApproval{operator, operator})
+	require.Error(t, nft.ValidateGenesis(genesisState))
+
+	// an owner can't be its own operator
+	genesisState = nft.NewGenesisState(collections, nil, nil, []nft.OperatorApproval{nft.NewOperatorApproval(address, denom, address)})
+	require.Error(t, nft.ValidateGenesis(genesisState))
+
+	genesisState = nft.NewGenesisState(collections, nil,
+		[]nft.Approval{nft.NewApproval(denom, id, address2)}, []nft.OperatorApproval{operator},
+	)
+	require.NoError(t, nft.ValidateGenesis(genesisState))
 }
@@ -22,6 +22,16 @@ func GenericHandler(k keeper.Keeper) sdk.Handler {
 			return HandleMsgMintNFT(ctx, msg, k)
 		case types.MsgBurnNFT:
 			return HandleMsgBurnNFT(ctx, msg, k)
+		case types.MsgIssueDenom:
+			return HandleMsgIssueDenom(ctx, msg, k)
+		case types.MsgTransferDenom:
+			return HandleMsgTransferDenom(ctx, msg, k)
+		case types.MsgApproveNFT:
+			return HandleMsgApproveNFT(ctx, msg, k)
+		case types.MsgSetApprovalForAll:
+			return HandleMsgSetApprovalForAll(ctx, msg, k)
+		case types.MsgRevokeApproval:
+			return HandleMsgRevokeApproval(ctx, msg, k)
 		default:
 			errMsg := fmt.Sprintf("unrecognized nft message type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -37,9 +47,16 @@ func HandleMsgTransferNFT(ctx sdk.Context, msg types.MsgTransferNFT, k keeper.Ke
 	if err != nil {
 		return err.Result()
 	}
+
+	if !k.IsApprovedOrOwner(ctx, msg.Denom, nft, msg.Sender) {
+		return sdk.ErrUnauthorized(
+			fmt.Sprintf("%s is not the owner, approved address or operator of NFT #%s in collection %s", msg.Sender, msg.ID, msg.Denom),
+		).Result()
+	}
+
 	// update NFT owner
 	nft.SetOwner(msg.Recipient)
-	// update the NFT (owners are updated within the keeper)
+	// update the NFT (owners and approvals are updated within the keeper)
 	err = k.UpdateNFT(ctx, msg.Denom, nft)
 	if err != nil {
 		return err.Result()
@@ -70,8 +87,24 @@ func HandleMsgEditNFTMetadata(ctx sdk.Context, msg types.MsgEditNFTMetadata, k k
 		return err.Result()
 	}
 
-	// update NFT
-	nft.EditMetadata(msg.TokenURI)
+	err = k.ValidateEditor(ctx, msg.Denom, msg.Sender, nft.GetOwner())
+	if err != nil {
+		return err.Result()
+	}
+
+	err = k.ValidateEditAttributes(ctx, msg.Denom, msg.Attributes)
+	if err != nil {
+		return err.Result()
+	}
+
+	// update NFT, keeping the token URI unless a new one is given or it is cleared
+	switch {
+	case msg.ClearTokenURI:
+		nft.EditMetadata("")
+	case msg.TokenURI != "":
+		nft.EditMetadata(msg.TokenURI)
+	}
+	nft.EditAttributes(msg.Attributes.Map())
 	err = k.UpdateNFT(ctx, msg.Denom, nft)
 	if err != nil {
 		return err.Result()
@@ -82,7 +115,7 @@ func HandleMsgEditNFTMetadata(ctx sdk.Context, msg types.MsgEditNFTMetadata, k k
 			types.EventTypeEditNFTMetadata,
 			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
 			sdk.NewAttribute(types.AttributeKeyNFTID, msg.ID),
-			sdk.NewAttribute(types.AttributeKeyNFTTokenURI, msg.TokenURI),
+			sdk.NewAttribute(types.AttributeKeyNFTTokenURI, nft.GetTokenURI()),
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -97,8 +130,19 @@ func HandleMsgEditNFTMetadata(ctx sdk.Context, msg types.MsgEditNFTMetadata, k k
 func HandleMsgMintNFT(ctx sdk.Context, msg types.MsgMintNFT, k keeper.Keeper,
 ) sdk.Result {
 
+	if !k.HasDenom(ctx, msg.Denom) && !k.HasCollection(ctx, msg.Denom) {
+		// the first minter of a new collection becomes the creator of its denom
+		k.SetDenom(ctx, types.NewDenom(msg.Denom, msg.Sender, types.NewSchema(), nil, types.DefaultEditPolicy))
+	}
+
+	err := k.ValidateMinter(ctx, msg.Denom, msg.Sender)
+	if err != nil {
+		return err.Result()
+	}
+
 	nft := types.NewBaseNFT(msg.ID, msg.Recipient, msg.TokenURI)
-	err := k.MintNFT(ctx, msg.Denom, &nft)
+	nft.EditAttributes(msg.Attributes.Map())
+	err = k.MintNFT(ctx, msg.Denom, &nft)
 	if err != nil {
 		return err.Result()
 	}
@@ -151,6 +195,129 @@ func HandleMsgBurnNFT(ctx sdk.Context, msg types.MsgBurnNFT, k keeper.Keeper,
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
 
+// HandleMsgIssueDenom handles MsgIssueDenom
+func HandleMsgIssueDenom(ctx sdk.Context, msg types.MsgIssueDenom, k keeper.Keeper,
+) sdk.Result {
+
+	denom := types.NewDenom(msg.Denom, msg.Sender, msg.Schema, msg.Minters, msg.EditPolicy)
+	err := k.IssueDenom(ctx, denom)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeIssueDenom,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Sender.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// HandleMsgTransferDenom handles MsgTransferDenom
+func HandleMsgTransferDenom(ctx sdk.Context, msg types.MsgTransferDenom, k keeper.Keeper,
+) sdk.Result {
+
+	err := k.TransferDenom(ctx, msg.Denom, msg.Sender, msg.Recipient)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTransferDenom,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// HandleMsgApproveNFT handles MsgApproveNFT
+func HandleMsgApproveNFT(ctx sdk.Context, msg types.MsgApproveNFT, k keeper.Keeper,
+) sdk.Result {
+
+	err := k.ApproveNFT(ctx, msg.Denom, msg.ID, msg.Sender, msg.Approved)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeApproveNFT,
+			sdk.NewAttribute(types.AttributeKeyApproved, msg.Approved.String()),
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyNFTID, msg.ID),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// HandleMsgSetApprovalForAll handles MsgSetApprovalForAll
+func HandleMsgSetApprovalForAll(ctx sdk.Context, msg types.MsgSetApprovalForAll, k keeper.Keeper,
+) sdk.Result {
+
+	err := k.SetApprovalForAll(ctx, msg.Sender, msg.Denom, msg.Operator, msg.Approved)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetApprovalForAll,
+			sdk.NewAttribute(types.AttributeKeyOperator, msg.Operator.String()),
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyApproved, fmt.Sprintf("%t", msg.Approved)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// HandleMsgRevokeApproval handles MsgRevokeApproval
+func HandleMsgRevokeApproval(ctx sdk.Context, msg types.MsgRevokeApproval, k keeper.Keeper,
+) sdk.Result {
+
+	err := k.RevokeApproval(ctx, msg.Denom, msg.ID, msg.Sender)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRevokeApproval,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyNFTID, msg.ID),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
 // EndBlocker is run at the end of the block
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
 	return nil
@@ -90,7 +90,12 @@ func TestTransferNFTMsg(t *testing.T) {
 	app.NFTKeeper.MintNFT(ctx, denom2, &nft)
 	require.True(t, CheckInvariants(app.NFTKeeper, ctx))
 
+	// handle should fail when nft is transferred by someone else than the owner
 	transferNftMsg = types.NewMsgTransferNFT(address2, address3, denom2, id)
+	res = h(ctx, transferNftMsg)
+	require.False(t, res.IsOK(), "%v", res)
+
+	transferNftMsg = types.NewMsgTransferNFT(address, address3, denom2, id)
 
 	// handle should succeed when nft exists and is transferred by owner
 	res = h(ctx, transferNftMsg)
@@ -109,13 +114,13 @@ func TestEditNFTMetadataMsg(t *testing.T) {
 	app.NFTKeeper.MintNFT(ctx, denom, &nft)
 
 	// Define MsgTransferNft
-	failingEditNFTMetadata := types.NewMsgEditNFTMetadata(address, id, denom2, tokenURI2)
+	failingEditNFTMetadata := types.NewMsgEditNFTMetadata(address, id, denom2, tokenURI2, nil)
 
 	res := h(ctx, failingEditNFTMetadata)
 	require.False(t, res.IsOK(), "%v", res)
 
 	// Define MsgTransferNft
-	editNFTMetadata := types.NewMsgEditNFTMetadata(address, id, denom, tokenURI2)
+	editNFTMetadata := types.NewMsgEditNFTMetadata(address, id, denom, tokenURI2, nil)
 
 	res = h(ctx, editNFTMetadata)
 	require.True(t, res.IsOK(), "%v", res)
@@ -152,7 +157,7 @@ func TestMintNFTMsg(t *testing.T) {
 	h := nft.GenericHandler(app.NFTKeeper)
 
 	// Define MsgMintNFT
-	mintNFT := types.NewMsgMintNFT(address, address, id, denom, tokenURI)
+	mintNFT := types.NewMsgMintNFT(address, address, id, denom, tokenURI, nil)
 
 	// minting a token should succeed
 	res := h(ctx, mintNFT)
@@ -250,3 +255,292 @@ func TestBurnNFTMsg(t *testing.T) {
 
 	require.True(t, CheckInvariants(app.NFTKeeper, ctx))
 }
+
+func TestIssueDenomMsg(t *testing.T) {
+	app, ctx := createTestApp(false)
+	h := nft.GenericHandler(app.NFTKeeper)
+
+	schema := types.NewSchema(
+		types.NewAttributeDefinition("color", types.AttributeTypeString, false, true),
+		types.NewAttributeDefinition("level", types.AttributeTypeInt, true, false),
+	)
+
+	// issuing a denom should succeed
+	issueDenom := types.NewMsgIssueDenom(address, denom, schema, nil, types.EditPolicyOwner)
+	res := h(ctx, issueDenom)
+	require.True(t, res.IsOK(), "%v", res)
+
+	d, found := app.NFTKeeper.GetDenom(ctx, denom)
+	require.True(t, found)
+	require.True(t, d.Creator.Equals(address))
+	require.Equal(t, schema, d.Schema)
+
+	// issuing the same denom should fail
+	res = h(ctx, issueDenom)
+	require.False(t, res.IsOK(), "%v", res)
+
+	// minting without the required attribute should fail
+	res = h(ctx, types.NewMsgMintNFT(address, address, id, denom, tokenURI, nil))
+	require.False(t, res.IsOK(), "%v", res)
+
+	attrs := types.NewAttributes(types.NewAttribute("color", "red"), types.NewAttribute("level", "1"))
+	res = h(ctx, types.NewMsgMintNFT(address, address, id, denom, tokenURI, attrs))
+	require.True(t, res.IsOK(), "%v", res)
+
+	// editing an immutable attribute should fail
+	res = h(ctx, types.NewMsgEditNFTMetadata(address, id, denom, tokenURI,
+		types.NewAttributes(types.NewAttribute("color", "blue"))))
+	require.False(t, res.IsOK(), "%v", res)
+
+	// editing a mutable attribute should succeed
+	res = h(ctx, types.NewMsgEditNFTMetadata(address, id, denom, tokenURI2,
+		types.NewAttributes(types.NewAttribute("level", "2"))))
+	require.True(t, res.IsOK(), "%v", res)
+
+	nftAfterwards, err := app.NFTKeeper.GetNFT(ctx, denom, id)
+	require.NoError(t, err)
+	require.Equal(t, tokenURI2, nftAfterwards.GetTokenURI())
+	require.Equal(t, map[string]string{"color": "red", "level": "2"}, nftAfterwards.GetAttributes())
+
+	require.True(t, CheckInvariants(app.NFTKeeper, ctx))
+}
+
+func TestEditNFTMetadataKeepsTokenURI(t *testing.T) {
+	app, ctx := createTestApp(false)
+	h := nft.GenericHandler(app.NFTKeeper)
+
+	res := h(ctx, types.NewMsgIssueDenom(address, denom, types.NewSchema(
+		types.NewAttributeDefinition("level", types.AttributeTypeInt, true, false),
+	), nil, types.EditPolicyOwner))
+	require.True(t, res.IsOK(), "%v", res)
+
+	attrs := types.NewAttributes(types.NewAttribute("level", "1"))
+	res = h(ctx, types.NewMsgMintNFT(address, address, id, denom, tokenURI, attrs))
+	require.True(t, res.IsOK(), "%v", res)
+
+	// an edit of the attributes only keeps the token URI
+	res = h(ctx, types.NewMsgEditNFTMetadata(address, id, denom, "",
+		types.NewAttributes(types.NewAttribute("level", "2"))))
+	require.True(t, res.IsOK(), "%v", res)
+
+	nftAfterwards, err := app.NFTKeeper.GetNFT(ctx, denom, id)
+	require.NoError(t, err)
+	require.Equal(t, tokenURI, nftAfterwards.GetTokenURI())
+	require.Equal(t, map[string]string{"level": "2"}, nftAfterwards.GetAttributes())
+
+	// the token URI is only removed when explicitly cleared
+	clearTokenURI := types.NewMsgEditNFTMetadata(address, id, denom, "", nil)
+	clearTokenURI.ClearTokenURI = true
+	res = h(ctx, clearTokenURI)
+	require.True(t, res.IsOK(), "%v", res)
+
+	nftAfterwards, err = app.NFTKeeper.GetNFT(ctx, denom, id)
+	require.NoError(t, err)
+	require.Empty(t, nftAfterwards.GetTokenURI())
+	require.Equal(t, map[string]string{"level": "2"}, nftAfterwards.GetAttributes())
+}
+
+func TestMintNFTPermissions(t *testing.T) {
+	app, ctx := createTestApp(false)
+	h := nft.GenericHandler(app.NFTKeeper)
+
+	// the first minter of a new collection becomes the creator of its denom
+	res := h(ctx, types.NewMsgMintNFT(address, address2, id, denom, tokenURI, nil))
+	require.True(t, res.IsOK(), "%v", res)
+
+	d, found := app.NFTKeeper.GetDenom(ctx, denom)
+	require.True(t, found)
+	require.True(t, d.Creator.Equals(address))
+	require.Equal(t, types.DefaultEditPolicy, d.EditPolicy)
+
+	// other accounts can't mint into the collection afterwards
+	res = h(ctx, types.NewMsgMintNFT(address2, address2, id2, denom, tokenURI, nil))
+	require.False(t, res.IsOK(), "%v", res)
+
+	// a denom issued with an allow-list accepts its minters only
+	res = h(ctx, types.NewMsgIssueDenom(address, denom2, types.NewSchema(), []sdk.AccAddress{address2}, types.EditPolicyOwner))
+	require.True(t, res.IsOK(), "%v", res)
+
+	res = h(ctx, types.NewMsgMintNFT(address2, address3, id, denom2, tokenURI, nil))
+	require.True(t, res.IsOK(), "%v", res)
+
+	res = h(ctx, types.NewMsgMintNFT(address3, address3, id2, denom2, tokenURI, nil))
+	require.False(t, res.IsOK(), "%v", res)
+
+	// collections created before denoms could be issued are closed to minting
+	legacyNFT := types.NewBaseNFT(id, address, tokenURI)
+	require.NoError(t, app.NFTKeeper.MintNFT(ctx, denom3, &legacyNFT))
+
+	res = h(ctx, types.NewMsgMintNFT(address3, address3, id2, denom3, tokenURI, nil))
+	require.False(t, res.IsOK(), "%v", res)
+	require.False(t, app.NFTKeeper.HasDenom(ctx, denom3))
+
+	// until the holder of all of their NFTs claims their denom
+	res = h(ctx, types.NewMsgIssueDenom(address3, denom3, types.NewSchema(), nil, types.EditPolicyOwner))
+	require.False(t, res.IsOK(), "%v", res)
+	res = h(ctx, types.NewMsgIssueDenom(address, denom3, types.NewSchema(), nil, types.EditPolicyOwner))
+	require.True(t, res.IsOK(), "%v", res)
+
+	res = h(ctx, types.NewMsgMintNFT(address, address3, id2, denom3, tokenURI, nil))
+	require.True(t, res.IsOK(), "%v", res)
+
+	require.True(t, CheckInvariants(app.NFTKeeper, ctx))
+}
+
+func TestEditNFTMetadataPolicy(t *testing.T) {
+	app, ctx := createTestApp(false)
+	h := nft.GenericHandler(app.NFTKeeper)
+
+	res := h(ctx, types.NewMsgIssueDenom(address, denom, types.NewSchema(), nil, types.EditPolicyOwner))
+	require.True(t, res.IsOK(), "%v", res)
+	res = h(ctx, types.NewMsgIssueDenom(address, denom2, types.NewSchema(), nil, types.EditPolicyCreator))
+	require.True(t, res.IsOK(), "%v", res)
+
+	res = h(ctx, types.NewMsgMintNFT(address, address2, id, denom, tokenURI, nil))
+	require.True(t, res.IsOK(), "%v", res)
+	res = h(ctx, types.NewMsgMintNFT(address, address2, id, denom2, tokenURI, nil))
+	require.True(t, res.IsOK(), "%v", res)
+
+	// owner policy: only the owner of the NFT can edit it
+	res = h(ctx, types.NewMsgEditNFTMetadata(address, id, denom, tokenURI2, nil))
+	require.False(t, res.IsOK(), "%v", res)
+	res = h(ctx, types.NewMsgEditNFTMetadata(address2, id, denom, tokenURI2, nil))
+	require.True(t, res.IsOK(), "%v", res)
+
+	// creator policy: only the creator of the denom can edit its NFTs
+	res = h(ctx, types.NewMsgEditNFTMetadata(address2, id, denom2, tokenURI2, nil))
+	require.False(t, res.IsOK(), "%v", res)
+	res = h(ctx, types.NewMsgEditNFTMetadata(address, id, denom2, tokenURI2, nil))
+	require.True(t, res.IsOK(), "%v", res)
+}
+
+func TestTransferDenomMsg(t *testing.T) {
+	app, ctx := createTestApp(false)
+	h := nft.GenericHandler(app.NFTKeeper)
+
+	transferDenom := types.NewMsgTransferDenom(address, address2, denom)
+
+	// transferring a denom that was never issued should fail
+	res := h(ctx, transferDenom)
+	require.False(t, res.IsOK(), "%v", res)
+
+	res = h(ctx, types.NewMsgIssueDenom(address, denom, types.NewSchema(), nil, types.EditPolicyCreator))
+	require.True(t, res.IsOK(), "%v", res)
+
+	// only the creator can transfer the denom
+	res = h(ctx, types.NewMsgTransferDenom(address2, address3, denom))
+	require.False(t, res.IsOK(), "%v", res)
+
+	res = h(ctx, transferDenom)
+	require.True(t, res.IsOK(), "%v", res)
+
+	// the new creator holds the minting and editing rights
+	res = h(ctx, types.NewMsgMintNFT(address, address, id, denom, tokenURI, nil))
+	require.False(t, res.IsOK(), "%v", res)
+	res = h(ctx, types.NewMsgMintNFT(address2, address, id, denom, tokenURI, nil))
+	require.True(t, res.IsOK(), "%v", res)
+	res = h(ctx, types.NewMsgEditNFTMetadata(address2, id, denom, tokenURI2, nil))
+	require.True(t, res.IsOK(), "%v", res)
+}
+
+func TestApproveNFTMsg(t *testing.T) {
+	app, ctx := createTestApp(false)
+	h := nft.GenericHandler(app.NFTKeeper)
+
+	approveNFT := types.NewMsgApproveNFT(address, address2, denom, id)
+
+	// approving an NFT that doesn't exist should fail
+	res := h(ctx, approveNFT)
+	require.False(t, res.IsOK(), "%v", res)
+
+	nft := types.NewBaseNFT(id, address, tokenURI)
+	require.NoError(t, app.NFTKeeper.MintNFT(ctx, denom, &nft))
+
+	// only the owner or an operator can approve an NFT
+	res = h(ctx, types.NewMsgApproveNFT(address2, address2, denom, id))
+	require.False(t, res.IsOK(), "%v", res)
+
+	res = h(ctx, approveNFT)
+	require.True(t, res.IsOK(), "%v", res)
+
+	approval, found := app.NFTKeeper.GetApproval(ctx, denom, id)
+	require.True(t, found)
+	require.Equal(t, address2, approval.Approved)
+
+	// the approved address can transfer the NFT, which clears the approval
+	res = h(ctx, types.NewMsgTransferNFT(address2, address3, denom, id))
+	require.True(t, res.IsOK(), "%v", res)
+	require.True(t, CheckInvariants(app.NFTKeeper, ctx))
+
+	_, found = app.NFTKeeper.GetApproval(ctx, denom, id)
+	require.False(t, found)
+
+	// the previous approved address can't transfer the NFT anymore
+	res = h(ctx, types.NewMsgTransferNFT(address2, address, denom, id))
+	require.False(t, res.IsOK(), "%v", res)
+}
+
+func TestSetApprovalForAllMsg(t *testing.T) {
+	app, ctx := createTestApp(false)
+	h := nft.GenericHandler(app.NFTKeeper)
+
+	setApprovalForAll := types.NewMsgSetApprovalForAll(address, address2, denom, true)
+
+	// the collection must exist
+	res := h(ctx, setApprovalForAll)
+	require.False(t, res.IsOK(), "%v", res)
+
+	nft1 := types.NewBaseNFT(id, address, tokenURI)
+	require.NoError(t, app.NFTKeeper.MintNFT(ctx, denom, &nft1))
+	nft2 := types.NewBaseNFT(id2, address, tokenURI)
+	require.NoError(t, app.NFTKeeper.MintNFT(ctx, denom, &nft2))
+
+	res = h(ctx, setApprovalForAll)
+	require.True(t, res.IsOK(), "%v", res)
+	require.True(t, app.NFTKeeper.IsOperator(ctx, address, denom, address2))
+
+	// an operator can approve and transfer any NFT of the owner
+	res = h(ctx, types.NewMsgApproveNFT(address2, address3, denom, id))
+	require.True(t, res.IsOK(), "%v", res)
+	res = h(ctx, types.NewMsgTransferNFT(address2, address3, denom, id2))
+	require.True(t, res.IsOK(), "%v", res)
+	require.True(t, CheckInvariants(app.NFTKeeper, ctx))
+
+	// the operator is not an operator of the new owner
+	res = h(ctx, types.NewMsgTransferNFT(address2, address, denom, id2))
+	require.False(t, res.IsOK(), "%v", res)
+
+	res = h(ctx, types.NewMsgSetApprovalForAll(address, address2, denom, false))
+	require.True(t, res.IsOK(), "%v", res)
+	require.False(t, app.NFTKeeper.IsOperator(ctx, address, denom, address2))
+
+	res = h(ctx, types.NewMsgTransferNFT(address2, address3, denom, id))
+	require.False(t, res.IsOK(), "%v", res)
+}
+
+func TestRevokeApprovalMsg(t *testing.T) {
+	app, ctx := createTestApp(false)
+	h := nft.GenericHandler(app.NFTKeeper)
+
+	nft := types.NewBaseNFT(id, address, tokenURI)
+	require.NoError(t, app.NFTKeeper.MintNFT(ctx, denom, &nft))
+
+	revokeApproval := types.NewMsgRevokeApproval(address, denom, id)
+
+	// revoking a missing approval should fail
+	res := h(ctx, revokeApproval)
+	require.False(t, res.IsOK(), "%v", res)
+
+	res = h(ctx, types.NewMsgApproveNFT(address, address2, denom, id))
+	require.True(t, res.IsOK(), "%v", res)
+
+	// the approved address can't revoke its own approval
+	res = h(ctx, types.NewMsgRevokeApproval(address2, denom, id))
+	require.False(t, res.IsOK(), "%v", res)
+
+	res = h(ctx, revokeApproval)
+	require.True(t, res.IsOK(), "%v", res)
+
+	res = h(ctx, types.NewMsgTransferNFT(address2, address3, denom, id))
+	require.False(t, res.IsOK(), "%v", res)
+}
@@ -0,0 +1,191 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft/exported"
+	"github.com/cosmos/cosmos-sdk/x/nft/internal/types"
+)
+
+// GetApproval returns the approval of an NFT
+func (k Keeper) GetApproval(ctx sdk.Context, denom, id string) (approval types.Approval, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetApprovalKey(denom, id))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &approval)
+	return approval, true
+}
+
+// SetApproval sets the approved address of an NFT
+func (k Keeper) SetApproval(ctx sdk.Context, approval types.Approval) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(approval)
+	store.Set(types.GetApprovalKey(approval.Denom, approval.ID), bz)
+}
+
+// IterateApprovals iterates over all the NFT approvals and performs a function
+func (k Keeper) IterateApprovals(ctx sdk.Context, handler func(approval types.Approval) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ApprovalsKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var approval types.Approval
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &approval)
+		if handler(approval) {
+			break
+		}
+	}
+}
+
+// GetApprovals returns all the NFT approvals
+func (k Keeper) GetApprovals(ctx sdk.Context) (approvals []types.Approval) {
+	k.IterateApprovals(ctx,
+		func(approval types.Approval) (stop bool) {
+			approvals = append(approvals, approval)
+			return false
+		},
+	)
+	return
+}
+
+// ApproveNFT approves an address to transfer an NFT, replacing any previously
+// approved address. Only the owner of the NFT or one of its operators can
+// approve it.
+func (k Keeper) ApproveNFT(ctx sdk.Context, denom, id string, sender, approved sdk.AccAddress) sdk.Error {
+	nft, err := k.GetNFT(ctx, denom, id)
+	if err != nil {
+		return err
+	}
+
+	if !nft.GetOwner().Equals(sender) && !k.IsOperator(ctx, nft.GetOwner(), denom, sender) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("%s is neither the owner nor an operator of NFT #%s in collection %s", sender, id, denom))
+	}
+	if nft.GetOwner().Equals(approved) {
+		return types.ErrInvalidApproval(types.DefaultCodespace, "the owner of an NFT can't be its approved address")
+	}
+
+	k.SetApproval(ctx, types.NewApproval(denom, id, approved))
+	return nil
+}
+
+// RevokeApproval clears the approved address of an NFT. Only the owner of the
+// NFT or one of its operators can revoke it.
+func (k Keeper) RevokeApproval(ctx sdk.Context, denom, id string, sender sdk.AccAddress) sdk.Error {
+	nft, err := k.GetNFT(ctx, denom, id)
+	if err != nil {
+		return err
+	}
+
+	if !nft.GetOwner().Equals(sender) && !k.IsOperator(ctx, nft.GetOwner(), denom, sender) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("%s is neither the owner nor an operator of NFT #%s in collection %s", sender, id, denom))
+	}
+	if _, found := k.GetApproval(ctx, denom, id); !found {
+		return types.ErrUnknownApproval(types.DefaultCodespace, fmt.Sprintf("NFT #%s in collection %s has no approved address", id, denom))
+	}
+
+	k.deleteApproval(ctx, denom, id)
+	return nil
+}
+
+// IsOperator returns whether an address is an operator of the NFTs of a
+// collection held by an owner
+func (k Keeper) IsOperator(ctx sdk.Context, owner sdk.AccAddress, denom string, operator sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetOperatorKey(owner, denom, operator))
+}
+
+// SetApprovalForAll adds or removes an operator of the NFTs of a collection
+// held by an owner. Operators also apply to the NFTs the owner receives later.
+func (k Keeper) SetApprovalForAll(ctx sdk.Context, owner sdk.AccAddress, denom string,
+	operator sdk.AccAddress, approved bool) sdk.Error {
+
+	if !k.HasCollection(ctx, denom) {
+		return types.ErrUnknownCollection(types.DefaultCodespace, fmt.Sprintf("collection of %s doesn't exist", denom))
+	}
+	if owner.Equals(operator) {
+		return types.ErrInvalidApproval(types.DefaultCodespace, "an owner can't be its own operator")
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if approved {
+		store.Set(types.GetOperatorKey(owner, denom, operator), []byte(denom))
+	} else {
+		store.Delete(types.GetOperatorKey(owner, denom, operator))
+	}
+	return nil
+}
+
+// IterateOperators iterates over the operators of an owner and performs a
+// function. If a denom is provided only the operators of that collection are
+// iterated.
+func (k Keeper) IterateOperators(ctx sdk.Context, owner sdk.AccAddress, denom string,
+	handler func(operator types.OperatorApproval) (stop bool)) {
+
+	prefix := types.GetOwnerOperatorsKey(owner)
+	if denom != "" {
+		prefix = types.GetOperatorsKey(owner, denom)
+	}
+	k.iterateOperators(ctx, prefix, handler)
+}
+
+// IterateAllOperators iterates over the operators of all owners and performs a
+// function
+func (k Keeper) IterateAllOperators(ctx sdk.Context, handler func(operator types.OperatorApproval) (stop bool)) {
+	k.iterateOperators(ctx, types.OperatorsKeyPrefix, handler)
+}
+
+// GetOperators returns the operators of an owner, optionally filtered by denom
+func (k Keeper) GetOperators(ctx sdk.Context, owner sdk.AccAddress, denom string) (operators types.OperatorApprovals) {
+	k.IterateOperators(ctx, owner, denom,
+		func(operator types.OperatorApproval) (stop bool) {
+			operators = append(operators, operator)
+			return false
+		},
+	)
+	return
+}
+
+// GetAllOperators returns the operators of all owners
+func (k Keeper) GetAllOperators(ctx sdk.Context) (operators types.OperatorApprovals) {
+	k.IterateAllOperators(ctx,
+		func(operator types.OperatorApproval) (stop bool) {
+			operators = append(operators, operator)
+			return false
+		},
+	)
+	return
+}
+
+// IsApprovedOrOwner returns whether an address can transfer an NFT, that is if
+// it is the owner, the approved address or an operator of the NFT
+func (k Keeper) IsApprovedOrOwner(ctx sdk.Context, denom string, nft exported.NFT, spender sdk.AccAddress) bool {
+	owner := nft.GetOwner()
+	if owner.Equals(spender) {
+		return true
+	}
+	if approval, found := k.GetApproval(ctx, denom, nft.GetID()); found && approval.Approved.Equals(spender) {
+		return true
+	}
+	return k.IsOperator(ctx, owner, denom, spender)
+}
+
+func (k Keeper) iterateOperators(ctx sdk.Context, prefix []byte, handler func(operator types.OperatorApproval) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		owner, _, operator := types.SplitOperatorKey(iterator.Key())
+		if handler(types.NewOperatorApproval(owner, string(iterator.Value()), operator)) {
+			break
+		}
+	}
+}
+
+// deleteApproval clears the approved address of an NFT
+func (k Keeper) deleteApproval(ctx sdk.Context, denom, id string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetApprovalKey(denom, id))
+}
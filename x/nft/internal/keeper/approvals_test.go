@@ -0,0 +1,133 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/nft/internal/types"
+)
+
+func TestApproveNFT(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	// approving an NFT that doesn't exist should fail
+	err := app.NFTKeeper.ApproveNFT(ctx, denom, id, address, address2)
+	require.Error(t, err)
+
+	nft := types.NewBaseNFT(id, address, tokenURI)
+	err = app.NFTKeeper.MintNFT(ctx, denom, &nft)
+	require.NoError(t, err)
+
+	// only the owner can approve its NFT
+	err = app.NFTKeeper.ApproveNFT(ctx, denom, id, address2, address3)
+	require.Error(t, err)
+
+	// the owner can't be the approved address
+	err = app.NFTKeeper.ApproveNFT(ctx, denom, id, address, address)
+	require.Error(t, err)
+
+	err = app.NFTKeeper.ApproveNFT(ctx, denom, id, address, address2)
+	require.NoError(t, err)
+
+	approval, found := app.NFTKeeper.GetApproval(ctx, denom, id)
+	require.True(t, found)
+	require.Equal(t, types.NewApproval(denom, id, address2), approval)
+
+	returnedNFT, err := app.NFTKeeper.GetNFT(ctx, denom, id)
+	require.NoError(t, err)
+	require.True(t, app.NFTKeeper.IsApprovedOrOwner(ctx, denom, returnedNFT, address))
+	require.True(t, app.NFTKeeper.IsApprovedOrOwner(ctx, denom, returnedNFT, address2))
+	require.False(t, app.NFTKeeper.IsApprovedOrOwner(ctx, denom, returnedNFT, address3))
+
+	// approving another address replaces the previous approval
+	err = app.NFTKeeper.ApproveNFT(ctx, denom, id, address, address3)
+	require.NoError(t, err)
+	require.False(t, app.NFTKeeper.IsApprovedOrOwner(ctx, denom, returnedNFT, address2))
+	require.True(t, app.NFTKeeper.IsApprovedOrOwner(ctx, denom, returnedNFT, address3))
+	require.Len(t, app.NFTKeeper.GetApprovals(ctx), 1)
+
+	// the approval is cleared when the NFT changes hands
+	returnedNFT.SetOwner(address2)
+	err = app.NFTKeeper.UpdateNFT(ctx, denom, returnedNFT)
+	require.NoError(t, err)
+
+	_, found = app.NFTKeeper.GetApproval(ctx, denom, id)
+	require.False(t, found)
+
+	// and when the NFT is burnt
+	err = app.NFTKeeper.ApproveNFT(ctx, denom, id, address2, address3)
+	require.NoError(t, err)
+
+	err = app.NFTKeeper.DeleteNFT(ctx, denom, id)
+	require.NoError(t, err)
+	require.Empty(t, app.NFTKeeper.GetApprovals(ctx))
+}
+
+func TestRevokeApproval(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	nft := types.NewBaseNFT(id, address, tokenURI)
+	err := app.NFTKeeper.MintNFT(ctx, denom, &nft)
+	require.NoError(t, err)
+
+	err = app.NFTKeeper.RevokeApproval(ctx, denom, id, address)
+	require.Error(t, err)
+
+	err = app.NFTKeeper.ApproveNFT(ctx, denom, id, address, address2)
+	require.NoError(t, err)
+
+	err = app.NFTKeeper.RevokeApproval(ctx, denom, id, address2)
+	require.Error(t, err)
+
+	err = app.NFTKeeper.RevokeApproval(ctx, denom, id, address)
+	require.NoError(t, err)
+
+	_, found := app.NFTKeeper.GetApproval(ctx, denom, id)
+	require.False(t, found)
+}
+
+func TestSetApprovalForAll(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	// the collection must exist
+	err := app.NFTKeeper.SetApprovalForAll(ctx, address, denom, address2, true)
+	require.Error(t, err)
+
+	nft := types.NewBaseNFT(id, address, tokenURI)
+	err = app.NFTKeeper.MintNFT(ctx, denom, &nft)
+	require.NoError(t, err)
+	nft2 := types.NewBaseNFT(id, address, tokenURI)
+	err = app.NFTKeeper.MintNFT(ctx, denom2, &nft2)
+	require.NoError(t, err)
+
+	// an owner can't be its own operator
+	err = app.NFTKeeper.SetApprovalForAll(ctx, address, denom, address, true)
+	require.Error(t, err)
+
+	err = app.NFTKeeper.SetApprovalForAll(ctx, address, denom, address2, true)
+	require.NoError(t, err)
+	err = app.NFTKeeper.SetApprovalForAll(ctx, address, denom2, address3, true)
+	require.NoError(t, err)
+
+	require.True(t, app.NFTKeeper.IsOperator(ctx, address, denom, address2))
+	require.False(t, app.NFTKeeper.IsOperator(ctx, address, denom2, address2))
+	require.False(t, app.NFTKeeper.IsOperator(ctx, address2, denom, address))
+
+	// operators can approve the NFTs of the owner
+	err = app.NFTKeeper.ApproveNFT(ctx, denom, id, address2, address3)
+	require.NoError(t, err)
+	err = app.NFTKeeper.ApproveNFT(ctx, denom2, id, address2, address3)
+	require.Error(t, err)
+
+	require.Equal(t, types.OperatorApprovals{types.NewOperatorApproval(address, denom, address2)},
+		app.NFTKeeper.GetOperators(ctx, address, denom))
+	require.Len(t, app.NFTKeeper.GetOperators(ctx, address, ""), 2)
+	require.Empty(t, app.NFTKeeper.GetOperators(ctx, address2, ""))
+	require.Len(t, app.NFTKeeper.GetAllOperators(ctx), 2)
+
+	err = app.NFTKeeper.SetApprovalForAll(ctx, address, denom, address2, false)
+	require.NoError(t, err)
+	require.False(t, app.NFTKeeper.IsOperator(ctx, address, denom, address2))
+	require.Len(t, app.NFTKeeper.GetAllOperators(ctx), 1)
+}
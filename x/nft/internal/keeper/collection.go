@@ -1,42 +1,106 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft/exported"
 	"github.com/cosmos/cosmos-sdk/x/nft/internal/types"
 )
 
-// IterateCollections iterates over collections and performs a function
-func (k Keeper) IterateCollections(ctx sdk.Context, handler func(collection types.Collection) (stop bool)) {
+// HasCollection returns whether a collection exists
+func (k Keeper) HasCollection(ctx sdk.Context, denom string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetCollectionKey(denom))
+}
+
+// setCollectionDenom registers the denom of a collection
+func (k Keeper) setCollectionDenom(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetCollectionKey(denom), []byte(denom))
+}
+
+// IterateCollectionDenoms iterates over the denoms of the collections and
+// performs a function
+func (k Keeper) IterateCollectionDenoms(ctx sdk.Context, handler func(denom string) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
 	iterator := sdk.KVStorePrefixIterator(store, types.CollectionsKeyPrefix)
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
-		var collection types.Collection
-		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &collection)
-		if handler(collection) {
+		if handler(string(iterator.Value())) {
 			break
 		}
 	}
 }
 
-// SetCollection sets the entire collection of a single denom
+// IterateCollections iterates over collections and performs a function. Each
+// collection is loaded with all its NFTs, so prefer IterateNFTs or the
+// paginated getters for large collections.
+func (k Keeper) IterateCollections(ctx sdk.Context, handler func(collection types.Collection) (stop bool)) {
+	k.IterateCollectionDenoms(ctx,
+		func(denom string) (stop bool) {
+			collection, _ := k.GetCollection(ctx, denom)
+			return handler(collection)
+		},
+	)
+}
+
+// SetCollection sets the entire collection of a single denom, storing each of
+// its NFTs and indexing them by owner
 func (k Keeper) SetCollection(ctx sdk.Context, denom string, collection types.Collection) {
-	store := ctx.KVStore(k.storeKey)
-	collectionKey := types.GetCollectionKey(denom)
-	bz := k.cdc.MustMarshalBinaryLengthPrefixed(collection)
-	store.Set(collectionKey, bz)
+	if !k.HasCollection(ctx, denom) {
+		k.setCollectionDenom(ctx, denom)
+	}
+
+	supply := k.GetSupply(ctx, denom)
+	for _, nft := range collection.NFTs {
+		if !k.IsNFT(ctx, denom, nft.GetID()) {
+			supply++
+		} else {
+			k.deleteOwnerIndex(ctx, denom, nft.GetID())
+		}
+		k.setNFT(ctx, denom, nft)
+	}
+	k.setSupply(ctx, denom, supply)
 }
 
-// GetCollection returns a collection of NFTs
+// GetCollection returns a collection with all its NFTs. Prefer
+// GetCollectionPage for large collections.
 func (k Keeper) GetCollection(ctx sdk.Context, denom string) (collection types.Collection, found bool) {
-	store := ctx.KVStore(k.storeKey)
-	collectionKey := types.GetCollectionKey(denom)
-	bz := store.Get(collectionKey)
-	if bz == nil {
+	if !k.HasCollection(ctx, denom) {
 		return
 	}
-	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &collection)
-	return collection, true
+
+	nfts := types.NewNFTs()
+	k.IterateNFTs(ctx, denom,
+		func(nft exported.NFT) (stop bool) {
+			nfts = append(nfts, nft)
+			return false
+		},
+	)
+	return types.NewCollection(denom, nfts), true
+}
+
+// GetCollectionPage returns a page of the NFTs of a collection along with
+// the start key of the next page. If a start key is provided the page starts
+// at that key, otherwise the page number is used.
+func (k Keeper) GetCollectionPage(ctx sdk.Context, denom string, page, limit int, startKey []byte,
+) (collection types.Collection, nextKey []byte, err sdk.Error) {
+
+	if !k.HasCollection(ctx, denom) {
+		return collection, nil, types.ErrUnknownCollection(types.DefaultCodespace, fmt.Sprintf("collection of %s doesn't exist", denom))
+	}
+
+	nfts := types.NewNFTs()
+	store := ctx.KVStore(k.storeKey)
+	nextKey = paginate(store, types.GetNFTsKey(denom), page, limit, startKey,
+		func(_, value []byte) {
+			var nft exported.NFT
+			k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &nft)
+			nfts = append(nfts, nft)
+		},
+	)
+	return types.NewCollection(denom, nfts), nextKey, nil
 }
 
 // GetCollections returns all the NFTs collections
@@ -52,11 +116,27 @@ func (k Keeper) GetCollections(ctx sdk.Context) (collections []types.Collection)
 
 // GetDenoms returns all the NFT denoms
 func (k Keeper) GetDenoms(ctx sdk.Context) (denoms []string) {
-	k.IterateCollections(ctx,
-		func(collection types.Collection) (stop bool) {
-			denoms = append(denoms, collection.Denom)
+	k.IterateCollectionDenoms(ctx,
+		func(denom string) (stop bool) {
+			denoms = append(denoms, denom)
 			return false
 		},
 	)
 	return
 }
+
+// GetSupply returns the total amount of NFTs of a collection
+func (k Keeper) GetSupply(ctx sdk.Context, denom string) (supply int) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetSupplyKey(denom))
+	if bz == nil {
+		return 0
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &supply)
+	return supply
+}
+
+func (k Keeper) setSupply(ctx sdk.Context, denom string, supply int) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetSupplyKey(denom), k.cdc.MustMarshalBinaryLengthPrefixed(supply))
+}
@@ -0,0 +1,206 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft/exported"
+	"github.com/cosmos/cosmos-sdk/x/nft/internal/types"
+)
+
+// IssueDenom registers a new denom along with its creator and schema. The
+// denom of a collection minted before denoms could be issued can be claimed by
+// the account holding all of its NFTs, provided they match the schema.
+func (k Keeper) IssueDenom(ctx sdk.Context, denom types.Denom) sdk.Error {
+	if k.HasDenom(ctx, denom.Name) {
+		return types.ErrDenomExists(types.DefaultCodespace, fmt.Sprintf("denom %s has already been issued", denom.Name))
+	}
+	if k.HasCollection(ctx, denom.Name) {
+		if err := k.validateClaim(ctx, denom); err != nil {
+			return err
+		}
+	}
+
+	k.SetDenom(ctx, denom)
+	return nil
+}
+
+// validateClaim checks that the creator of a denom holds every NFT of the
+// existing collection and that those NFTs follow the schema of the denom.
+func (k Keeper) validateClaim(ctx sdk.Context, denom types.Denom) (err sdk.Error) {
+	k.IterateNFTs(ctx, denom.Name, func(nft exported.NFT) (stop bool) {
+		if !nft.GetOwner().Equals(denom.Creator) {
+			err = types.ErrDenomExists(types.DefaultCodespace, fmt.Sprintf(
+				"collection %s already exists and %s doesn't hold all of its NFTs", denom.Name, denom.Creator,
+			))
+			return true
+		}
+		if schemaErr := denom.Schema.ValidateAttributes(types.NewAttributesFromMap(nft.GetAttributes())); schemaErr != nil {
+			err = types.ErrInvalidAttributes(types.DefaultCodespace, fmt.Sprintf(
+				"NFT #%s of collection %s: %s", nft.GetID(), denom.Name, schemaErr,
+			))
+			return true
+		}
+		return false
+	})
+	return err
+}
+
+// HasDenom returns whether a denom has been issued
+func (k Keeper) HasDenom(ctx sdk.Context, denom string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetDenomKey(denom))
+}
+
+// SetDenom sets an issued denom
+func (k Keeper) SetDenom(ctx sdk.Context, denom types.Denom) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(denom)
+	store.Set(types.GetDenomKey(denom.Name), bz)
+}
+
+// GetDenom returns an issued denom
+func (k Keeper) GetDenom(ctx sdk.Context, denom string) (d types.Denom, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetDenomKey(denom))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &d)
+	return d, true
+}
+
+// IterateDenoms iterates over the issued denoms and performs a function
+func (k Keeper) IterateDenoms(ctx sdk.Context, handler func(denom types.Denom) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DenomsKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var denom types.Denom
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &denom)
+		if handler(denom) {
+			break
+		}
+	}
+}
+
+// GetIssuedDenoms returns all the issued denoms
+func (k Keeper) GetIssuedDenoms(ctx sdk.Context) (denoms []types.Denom) {
+	k.IterateDenoms(ctx,
+		func(denom types.Denom) (stop bool) {
+			denoms = append(denoms, denom)
+			return false
+		},
+	)
+	return
+}
+
+// TransferDenom transfers the ownership of an issued denom. Only the current
+// creator of the denom can transfer it.
+func (k Keeper) TransferDenom(ctx sdk.Context, denom string, sender, recipient sdk.AccAddress) sdk.Error {
+	d, found := k.GetDenom(ctx, denom)
+	if !found {
+		return types.ErrUnknownDenom(types.DefaultCodespace, fmt.Sprintf("denom %s has not been issued", denom))
+	}
+	if !d.Creator.Equals(sender) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("%s is not the creator of denom %s", sender, denom))
+	}
+
+	d.Creator = recipient
+	k.SetDenom(ctx, d)
+	return nil
+}
+
+// ValidateMinter checks that an account is allowed to mint NFTs of a denom.
+// Collections created before denoms could be issued have no owner and are
+// closed to minting until their denom is claimed through IssueDenom.
+func (k Keeper) ValidateMinter(ctx sdk.Context, denom string, minter sdk.AccAddress) sdk.Error {
+	d, found := k.GetDenom(ctx, denom)
+	if !found {
+		if k.HasCollection(ctx, denom) {
+			return sdk.ErrUnauthorized(fmt.Sprintf("denom %s must be issued before minting into its collection", denom))
+		}
+		return nil
+	}
+	if !d.IsMinter(minter) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("%s is not allowed to mint NFTs of denom %s", minter, denom))
+	}
+	return nil
+}
+
+// ValidateEditor checks that an account is allowed to edit the metadata of an
+// NFT according to the edit policy of its denom. NFTs of collections created
+// before denoms could be issued can only be edited by their owner.
+func (k Keeper) ValidateEditor(ctx sdk.Context, denom string, editor, nftOwner sdk.AccAddress) sdk.Error {
+	d, found := k.GetDenom(ctx, denom)
+	if !found {
+		d.EditPolicy = types.DefaultEditPolicy
+	}
+	if !d.CanEdit(editor, nftOwner) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("%s is not allowed to edit NFTs of denom %s", editor, denom))
+	}
+	return nil
+}
+
+// ValidateMintAttributes checks the attributes of a new NFT against the schema
+// of its denom. NFTs of denoms that were never issued can't hold attributes.
+func (k Keeper) ValidateMintAttributes(ctx sdk.Context, denom string, attributes types.Attributes) sdk.Error {
+	d, found := k.GetDenom(ctx, denom)
+	if !found {
+		if attributes.Empty() {
+			return nil
+		}
+		return types.ErrUnknownDenom(types.DefaultCodespace, fmt.Sprintf("denom %s has no attribute schema", denom))
+	}
+
+	if err := d.Schema.ValidateAttributes(attributes); err != nil {
+		return types.ErrInvalidAttributes(types.DefaultCodespace, err.Error())
+	}
+	return nil
+}
+
+// ValidateEditAttributes checks a set of attribute changes against the schema
+// of the denom
+func (k Keeper) ValidateEditAttributes(ctx sdk.Context, denom string, attributes types.Attributes) sdk.Error {
+	if attributes.Empty() {
+		return nil
+	}
+
+	d, found := k.GetDenom(ctx, denom)
+	if !found {
+		return types.ErrUnknownDenom(types.DefaultCodespace, fmt.Sprintf("denom %s has no attribute schema", denom))
+	}
+
+	if err := d.Schema.ValidateEdit(attributes); err != nil {
+		return types.ErrInvalidAttributes(types.DefaultCodespace, err.Error())
+	}
+	return nil
+}
+
+// GetNFTsByAttribute returns a page of the NFTs of a collection whose
+// attribute matches the given value, along with the start key of the next
+// page. Pages are built the same way as by GetCollectionPage.
+func (k Keeper) GetNFTsByAttribute(ctx sdk.Context, denom, key, value string, page, limit int, startKey []byte,
+) (nfts types.NFTs, nextKey []byte, err sdk.Error) {
+
+	if !k.HasCollection(ctx, denom) {
+		return nil, nil, types.ErrUnknownCollection(types.DefaultCodespace, fmt.Sprintf("collection of %s doesn't exist", denom))
+	}
+
+	nfts = types.NewNFTs()
+	store := ctx.KVStore(k.storeKey)
+	nextKey = paginateFiltered(store, types.GetNFTsKey(denom), page, limit, startKey,
+		func(_, bz []byte) bool {
+			var nft exported.NFT
+			k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &nft)
+			v, ok := nft.GetAttribute(key)
+			return ok && v == value
+		},
+		func(_, bz []byte) {
+			var nft exported.NFT
+			k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &nft)
+			nfts = append(nfts, nft)
+		},
+	)
+	return nfts, nextKey, nil
+}
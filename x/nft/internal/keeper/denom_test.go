@@ -0,0 +1,211 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keep "github.com/cosmos/cosmos-sdk/x/nft/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/nft/internal/types"
+)
+
+func testSchema() types.Schema {
+	return types.NewSchema(
+		types.NewAttributeDefinition("color", types.AttributeTypeString, false, true),
+		types.NewAttributeDefinition("level", types.AttributeTypeInt, true, false),
+	)
+}
+
+func TestIssueDenom(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	require.False(t, app.NFTKeeper.HasDenom(ctx, denom))
+
+	d := types.NewDenom(denom, address, testSchema(), nil, types.DefaultEditPolicy)
+	err := app.NFTKeeper.IssueDenom(ctx, d)
+	require.NoError(t, err)
+	require.True(t, app.NFTKeeper.HasDenom(ctx, denom))
+
+	returnedDenom, found := app.NFTKeeper.GetDenom(ctx, denom)
+	require.True(t, found)
+	require.Equal(t, d.String(), returnedDenom.String())
+
+	// issuing the same denom twice should fail
+	err = app.NFTKeeper.IssueDenom(ctx, types.NewDenom(denom, address2, types.NewSchema(), nil, types.DefaultEditPolicy))
+	require.Error(t, err)
+
+	// a collection minted before denoms could be issued is closed to minting
+	nft := types.NewBaseNFT(id, address, tokenURI)
+	err = app.NFTKeeper.MintNFT(ctx, denom2, &nft)
+	require.NoError(t, err)
+	require.Error(t, app.NFTKeeper.ValidateMinter(ctx, denom2, address))
+
+	// its denom can't be claimed with a schema its NFTs don't follow
+	err = app.NFTKeeper.IssueDenom(ctx, types.NewDenom(denom2, address, testSchema(), nil, types.DefaultEditPolicy))
+	require.Error(t, err)
+
+	// nor by an account which doesn't hold all of its NFTs
+	err = app.NFTKeeper.IssueDenom(ctx, types.NewDenom(denom2, address2, types.NewSchema(), nil, types.DefaultEditPolicy))
+	require.Error(t, err)
+
+	denoms := app.NFTKeeper.GetIssuedDenoms(ctx)
+	require.Len(t, denoms, 1)
+	require.Equal(t, denom, denoms[0].Name)
+
+	// the holder of all of its NFTs can claim it
+	err = app.NFTKeeper.IssueDenom(ctx, types.NewDenom(denom2, address, types.NewSchema(), nil, types.DefaultEditPolicy))
+	require.NoError(t, err)
+	require.NoError(t, app.NFTKeeper.ValidateMinter(ctx, denom2, address))
+	require.Error(t, app.NFTKeeper.ValidateMinter(ctx, denom2, address2))
+	require.Len(t, app.NFTKeeper.GetIssuedDenoms(ctx), 2)
+}
+
+func TestTransferDenom(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	err := app.NFTKeeper.TransferDenom(ctx, denom, address, address2)
+	require.Error(t, err)
+
+	err = app.NFTKeeper.IssueDenom(ctx, types.NewDenom(denom, address, testSchema(), nil, types.DefaultEditPolicy))
+	require.NoError(t, err)
+
+	// only the creator can transfer the denom
+	err = app.NFTKeeper.TransferDenom(ctx, denom, address2, address3)
+	require.Error(t, err)
+
+	err = app.NFTKeeper.TransferDenom(ctx, denom, address, address2)
+	require.NoError(t, err)
+
+	d, found := app.NFTKeeper.GetDenom(ctx, denom)
+	require.True(t, found)
+	require.Equal(t, address2, d.Creator)
+	require.Equal(t, testSchema(), d.Schema)
+}
+
+func TestValidateMinterAndEditor(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	// new collections are open to any minter, whose first mint issues their
+	// denom, and NFTs without an issued denom can only be edited by their owner
+	require.NoError(t, app.NFTKeeper.ValidateMinter(ctx, denom, address3))
+	require.NoError(t, app.NFTKeeper.ValidateEditor(ctx, denom, address3, address3))
+	require.Error(t, app.NFTKeeper.ValidateEditor(ctx, denom, address, address3))
+
+	err := app.NFTKeeper.IssueDenom(ctx, types.NewDenom(denom, address, testSchema(), []sdk.AccAddress{address2}, types.EditPolicyCreator))
+	require.NoError(t, err)
+
+	require.NoError(t, app.NFTKeeper.ValidateMinter(ctx, denom, address))
+	require.NoError(t, app.NFTKeeper.ValidateMinter(ctx, denom, address2))
+	require.Error(t, app.NFTKeeper.ValidateMinter(ctx, denom, address3))
+
+	require.NoError(t, app.NFTKeeper.ValidateEditor(ctx, denom, address, address3))
+	require.Error(t, app.NFTKeeper.ValidateEditor(ctx, denom, address3, address3))
+}
+
+func TestMintNFTWithAttributes(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	// attributes can't be set on NFTs of denoms that were never issued
+	nft := types.NewBaseNFT(id, address, tokenURI)
+	nft.EditAttributes(map[string]string{"color": "red"})
+	err := app.NFTKeeper.MintNFT(ctx, denom, &nft)
+	require.Error(t, err)
+
+	err = app.NFTKeeper.IssueDenom(ctx, types.NewDenom(denom, address, testSchema(), nil, types.DefaultEditPolicy))
+	require.NoError(t, err)
+
+	err = app.NFTKeeper.MintNFT(ctx, denom, &nft)
+	require.NoError(t, err)
+
+	// the required attribute is missing
+	nft2 := types.NewBaseNFT(id2, address, tokenURI)
+	err = app.NFTKeeper.MintNFT(ctx, denom, &nft2)
+	require.Error(t, err)
+
+	// the attribute value doesn't match the schema type
+	nft2.EditAttributes(map[string]string{"color": "blue", "level": "high"})
+	err = app.NFTKeeper.MintNFT(ctx, denom, &nft2)
+	require.Error(t, err)
+
+	nft2.EditAttributes(map[string]string{"level": "2"})
+	err = app.NFTKeeper.MintNFT(ctx, denom, &nft2)
+	require.NoError(t, err)
+
+	receivedNFT, err := app.NFTKeeper.GetNFT(ctx, denom, id2)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"color": "blue", "level": "2"}, receivedNFT.GetAttributes())
+}
+
+func TestGetNFTsByAttribute(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	err := app.NFTKeeper.IssueDenom(ctx, types.NewDenom(denom, address, testSchema(), nil, types.DefaultEditPolicy))
+	require.NoError(t, err)
+
+	for i, color := range []string{"red", "blue", "red"} {
+		nft := types.NewBaseNFT([]string{id, id2, id3}[i], address, tokenURI)
+		nft.EditAttributes(map[string]string{"color": color})
+		err = app.NFTKeeper.MintNFT(ctx, denom, &nft)
+		require.NoError(t, err)
+	}
+
+	nfts, nextKey, err := app.NFTKeeper.GetNFTsByAttribute(ctx, denom, "color", "red", 0, 0, nil)
+	require.NoError(t, err)
+	require.Len(t, nfts, 2)
+	require.Equal(t, id, nfts[0].GetID())
+	require.Equal(t, id3, nfts[1].GetID())
+	require.Nil(t, nextKey)
+
+	// pages only count the matching NFTs
+	nfts, nextKey, err = app.NFTKeeper.GetNFTsByAttribute(ctx, denom, "color", "red", 1, 1, nil)
+	require.NoError(t, err)
+	require.Len(t, nfts, 1)
+	require.Equal(t, id, nfts[0].GetID())
+	require.Equal(t, []byte(id3), nextKey)
+
+	nfts, nextKey, err = app.NFTKeeper.GetNFTsByAttribute(ctx, denom, "color", "red", 0, 1, nextKey)
+	require.NoError(t, err)
+	require.Len(t, nfts, 1)
+	require.Equal(t, id3, nfts[0].GetID())
+	require.Nil(t, nextKey)
+
+	nfts, _, err = app.NFTKeeper.GetNFTsByAttribute(ctx, denom, "color", "red", 2, 1, nil)
+	require.NoError(t, err)
+	require.Len(t, nfts, 1)
+	require.Equal(t, id3, nfts[0].GetID())
+
+	nfts, _, err = app.NFTKeeper.GetNFTsByAttribute(ctx, denom, "color", "green", 0, 0, nil)
+	require.NoError(t, err)
+	require.Empty(t, nfts)
+
+	_, _, err = app.NFTKeeper.GetNFTsByAttribute(ctx, denom2, "color", "red", 0, 0, nil)
+	require.Error(t, err)
+
+	// the querier route returns a page of the matching NFTs as a collection
+	querier := keep.NewQuerier(app.NFTKeeper)
+	bz, errRes := app.Codec().MarshalJSON(types.NewQueryAttributePageParams(denom, "color", "red", 1, 1, nil))
+	require.Nil(t, errRes)
+
+	res, err := querier(ctx, []string{keep.QueryNFTsByAttr}, abci.RequestQuery{Data: bz})
+	require.NoError(t, err)
+
+	var out types.QueryCollectionResponse
+	require.NoError(t, app.Codec().UnmarshalJSON(res, &out))
+	require.Equal(t, denom, out.Collection.Denom)
+	require.Len(t, out.Collection.NFTs, 1)
+	require.Equal(t, id, out.Collection.NFTs[0].GetID())
+	require.Equal(t, []byte(id3), []byte(out.NextKey))
+
+	// the denom querier route returns the schema
+	bz, errRes = app.Codec().MarshalJSON(types.NewQueryCollectionParams(denom))
+	require.Nil(t, errRes)
+
+	res, err = querier(ctx, []string{keep.QueryDenom}, abci.RequestQuery{Data: bz})
+	require.NoError(t, err)
+
+	var d types.Denom
+	require.NoError(t, app.Codec().UnmarshalJSON(res, &d))
+	require.Equal(t, testSchema(), d.Schema)
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft/exported"
 	"github.com/cosmos/cosmos-sdk/x/nft/internal/types"
 )
 
@@ -24,7 +25,8 @@ func AllInvariants(k Keeper) sdk.Invariant {
 	}
 }
 
-// SupplyInvariant checks that the total amount of nfts on collections matches the total amount owned by addresses
+// SupplyInvariant checks that the total supply of each collection matches both
+// the amount of NFTs stored on the collection and the amount owned by addresses
 func SupplyInvariant(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
 		collectionsSupply := make(map[string]int)
@@ -32,23 +34,30 @@ func SupplyInvariant(k Keeper) sdk.Invariant {
 		var msg string
 		count := 0
 
-		k.IterateCollections(ctx, func(collection types.Collection) bool {
-			collectionsSupply[collection.Denom] = collection.Supply()
+		k.IterateCollectionDenoms(ctx, func(denom string) bool {
+			k.IterateNFTs(ctx, denom, func(_ exported.NFT) bool {
+				collectionsSupply[denom]++
+				return false
+			})
 			return false
 		})
 
-		for _, owner := range k.GetOwners(ctx) {
+		k.IterateOwners(ctx, func(owner types.Owner) bool {
 			for _, idCollection := range owner.IDCollections {
 				ownersCollectionsSupply[idCollection.Denom] += idCollection.Supply()
 			}
-		}
+			return false
+		})
 
-		for denom, supply := range collectionsSupply {
-			if supply != ownersCollectionsSupply[denom] {
+		for _, denom := range k.GetDenoms(ctx) {
+			supply := k.GetSupply(ctx, denom)
+			if supply != collectionsSupply[denom] || supply != ownersCollectionsSupply[denom] {
 				count++
 				msg += fmt.Sprintf("total %s NFTs supply invariance:\n"+
 					"\ttotal %s NFTs supply: %d\n"+
-					"\tsum of %s NFTs by owner: %d\n", denom, denom, supply, denom, ownersCollectionsSupply[denom])
+					"\tsum of %s NFTs on collection: %d\n"+
+					"\tsum of %s NFTs by owner: %d\n", denom, denom, supply,
+					denom, collectionsSupply[denom], denom, ownersCollectionsSupply[denom])
 			}
 		}
 		broken := count != 0
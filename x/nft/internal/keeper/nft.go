@@ -10,99 +10,105 @@ import (
 
 // IsNFT returns whether an NFT exists
 func (k Keeper) IsNFT(ctx sdk.Context, denom, id string) (exists bool) {
-	_, err := k.GetNFT(ctx, denom, id)
-	return err == nil
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetNFTKey(denom, id))
 }
 
 // GetNFT gets the entire NFT metadata struct for a uint64
 func (k Keeper) GetNFT(ctx sdk.Context, denom, id string) (nft exported.NFT, err sdk.Error) {
-	collection, found := k.GetCollection(ctx, denom)
-	if !found {
+	if !k.HasCollection(ctx, denom) {
 		return nil, types.ErrUnknownCollection(types.DefaultCodespace, fmt.Sprintf("collection of %s doesn't exist", denom))
 	}
-	nft, err = collection.GetNFT(id)
 
-	if err != nil {
-		return nil, err
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetNFTKey(denom, id))
+	if bz == nil {
+		return nil, types.ErrUnknownNFT(types.DefaultCodespace,
+			fmt.Sprintf("NFT #%s doesn't exist in collection %s", id, denom),
+		)
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &nft)
+	return nft, nil
+}
+
+// IterateNFTs iterates over the NFTs of a collection and performs a function
+func (k Keeper) IterateNFTs(ctx sdk.Context, denom string, handler func(nft exported.NFT) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetNFTsKey(denom))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var nft exported.NFT
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &nft)
+		if handler(nft) {
+			break
+		}
 	}
-	return nft, err
 }
 
 // UpdateNFT updates an already existing NFTs
 func (k Keeper) UpdateNFT(ctx sdk.Context, denom string, nft exported.NFT) (err sdk.Error) {
-	collection, found := k.GetCollection(ctx, denom)
-	if !found {
-		return types.ErrUnknownCollection(types.DefaultCodespace,
-			fmt.Sprintf("collection #%s doesn't exist", denom),
-		)
-	}
-	oldNFT, err := collection.GetNFT(nft.GetID())
+	oldNFT, err := k.GetNFT(ctx, denom, nft.GetID())
 	if err != nil {
 		return err
 	}
-	// if the owner changed then update the owners KVStore too
+	// if the owner changed then update the owners index too and clear the
+	// approval granted by the previous owner
 	if !oldNFT.GetOwner().Equals(nft.GetOwner()) {
-		err = k.SwapOwners(ctx, denom, nft.GetID(), oldNFT.GetOwner(), nft.GetOwner())
-		if err != nil {
-			return err
-		}
+		k.deleteOwnerIndex(ctx, denom, oldNFT.GetID())
+		k.deleteApproval(ctx, denom, oldNFT.GetID())
 	}
-	collection, err = collection.UpdateNFT(nft)
-
-	if err != nil {
-		return err
-	}
-	k.SetCollection(ctx, denom, collection)
+	k.setNFT(ctx, denom, nft)
 	return nil
 }
 
 // MintNFT mints an NFT and manages that NFTs existence within Collections and Owners
 func (k Keeper) MintNFT(ctx sdk.Context, denom string, nft exported.NFT) (err sdk.Error) {
-	collection, found := k.GetCollection(ctx, denom)
-	if found {
-		collection, err = collection.AddNFT(nft)
-		if err != nil {
-			return err
-		}
-	} else {
-		collection = types.NewCollection(denom, types.NewNFTs(nft))
+	err = k.ValidateMintAttributes(ctx, denom, types.NewAttributesFromMap(nft.GetAttributes()))
+	if err != nil {
+		return err
+	}
+
+	if !k.HasCollection(ctx, denom) {
+		k.setCollectionDenom(ctx, denom)
+	} else if k.IsNFT(ctx, denom, nft.GetID()) {
+		return types.ErrNFTAlreadyExists(types.DefaultCodespace,
+			fmt.Sprintf("NFT #%s already exists in collection %s", nft.GetID(), denom),
+		)
 	}
-	k.SetCollection(ctx, denom, collection)
 
-	ownerIDCollection, _ := k.GetOwnerByDenom(ctx, nft.GetOwner(), denom)
-	ownerIDCollection = ownerIDCollection.AddID(nft.GetID())
-	k.SetOwnerByDenom(ctx, nft.GetOwner(), denom, ownerIDCollection.IDs)
+	k.setNFT(ctx, denom, nft)
+	k.setSupply(ctx, denom, k.GetSupply(ctx, denom)+1)
 	return
 }
 
 // DeleteNFT deletes an existing NFT from store
 func (k Keeper) DeleteNFT(ctx sdk.Context, denom, id string) (err sdk.Error) {
-	collection, found := k.GetCollection(ctx, denom)
-	if !found {
-		return types.ErrUnknownCollection(types.DefaultCodespace, fmt.Sprintf("collection of %s doesn't exist", denom))
-	}
-	nft, err := collection.GetNFT(id)
+	nft, err := k.GetNFT(ctx, denom, id)
 	if err != nil {
 		return err
 	}
-	ownerIDCollection, found := k.GetOwnerByDenom(ctx, nft.GetOwner(), denom)
-	if !found {
-		return types.ErrUnknownCollection(types.DefaultCodespace,
-			fmt.Sprintf("id collection #%s doesn't exist for owner %s", denom, nft.GetOwner()),
-		)
-	}
-	ownerIDCollection, err = ownerIDCollection.DeleteID(nft.GetID())
-	if err != nil {
-		return err
-	}
-	k.SetOwnerByDenom(ctx, nft.GetOwner(), denom, ownerIDCollection.IDs)
 
-	collection, err = collection.DeleteNFT(nft)
-	if err != nil {
-		return err
-	}
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetOwnerNFTKey(nft.GetOwner(), denom, id))
+	store.Delete(types.GetNFTKey(denom, id))
+	k.deleteApproval(ctx, denom, id)
+	k.setSupply(ctx, denom, k.GetSupply(ctx, denom)-1)
+	return
+}
 
-	k.SetCollection(ctx, denom, collection)
+// setNFT stores an NFT and indexes it by its owner
+func (k Keeper) setNFT(ctx sdk.Context, denom string, nft exported.NFT) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetNFTKey(denom, nft.GetID()), k.cdc.MustMarshalBinaryLengthPrefixed(nft))
+	store.Set(types.GetOwnerNFTKey(nft.GetOwner(), denom, nft.GetID()), []byte(denom))
+}
 
-	return
+// deleteOwnerIndex removes the owner index entry of a stored NFT
+func (k Keeper) deleteOwnerIndex(ctx sdk.Context, denom, id string) {
+	nft, err := k.GetNFT(ctx, denom, id)
+	if err != nil {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetOwnerNFTKey(nft.GetOwner(), denom, id))
 }
@@ -1,7 +1,7 @@
 package keeper
 
 import (
-	"fmt"
+	"bytes"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/nft/internal/types"
@@ -9,13 +9,9 @@ import (
 
 // GetOwners returns all the Owners ID Collections
 func (k Keeper) GetOwners(ctx sdk.Context) (owners []types.Owner) {
-	var foundOwners = make(map[string]bool)
 	k.IterateOwners(ctx,
 		func(owner types.Owner) (stop bool) {
-			if _, ok := foundOwners[owner.Address.String()]; !ok {
-				foundOwners[owner.Address.String()] = true
-				owners = append(owners, owner)
-			}
+			owners = append(owners, owner)
 			return false
 		},
 	)
@@ -24,68 +20,60 @@ func (k Keeper) GetOwners(ctx sdk.Context) (owners []types.Owner) {
 
 // GetOwner gets all the ID Collections owned by an address
 func (k Keeper) GetOwner(ctx sdk.Context, address sdk.AccAddress) (owner types.Owner) {
-	var idCollections []types.IDCollection
-	k.IterateIDCollections(ctx, types.GetOwnersKey(address),
-		func(_ sdk.AccAddress, idCollection types.IDCollection) (stop bool) {
-			idCollections = append(idCollections, idCollection)
-			return false
-		},
-	)
-	return types.NewOwner(address, idCollections...)
-}
-
-// GetOwnerByDenom gets the ID Collection owned by an address of a specific denom
-func (k Keeper) GetOwnerByDenom(ctx sdk.Context, owner sdk.AccAddress, denom string) (idCollection types.IDCollection, found bool) {
-
 	store := ctx.KVStore(k.storeKey)
-	b := store.Get(types.GetOwnerKey(owner, denom))
-	if b == nil {
-		return types.NewIDCollection(denom, []string{}), false
+	iterator := sdk.KVStorePrefixIterator(store, types.GetOwnersKey(address))
+	defer iterator.Close()
+
+	owner = types.NewOwner(address)
+	for ; iterator.Valid(); iterator.Next() {
+		owner = addOwnedID(owner, string(iterator.Value()), iterator.Key())
 	}
-	k.cdc.MustUnmarshalBinaryLengthPrefixed(b, &idCollection)
-	return idCollection, true
+	return owner
 }
 
-// SetOwnerByDenom sets a collection of NFT IDs owned by an address
-func (k Keeper) SetOwnerByDenom(ctx sdk.Context, owner sdk.AccAddress, denom string, ids []string) {
-	store := ctx.KVStore(k.storeKey)
-	key := types.GetOwnerKey(owner, denom)
-
-	var idCollection types.IDCollection
-	idCollection.Denom = denom
-	idCollection.IDs = ids
+// GetOwnerPage returns a page of the IDs owned by an address, optionally
+// filtered by denom, along with the start key of the next page. If a start
+// key is provided the page starts at that key, otherwise the page number is
+// used.
+func (k Keeper) GetOwnerPage(ctx sdk.Context, address sdk.AccAddress, denom string, page, limit int, startKey []byte,
+) (owner types.Owner, nextKey []byte) {
 
-	store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(idCollection))
-}
-
-// SetOwner sets an entire Owner
-func (k Keeper) SetOwner(ctx sdk.Context, owner types.Owner) {
-	for _, idCollection := range owner.IDCollections {
-		k.SetOwnerByDenom(ctx, owner.Address, idCollection.Denom, idCollection.IDs)
+	prefix := types.GetOwnersKey(address)
+	if denom != "" {
+		prefix = types.GetOwnerKey(address, denom)
 	}
-}
 
-// SetOwners sets all Owners
-func (k Keeper) SetOwners(ctx sdk.Context, owners []types.Owner) {
-	for _, owner := range owners {
-		k.SetOwner(ctx, owner)
-	}
+	owner = types.NewOwner(address)
+	store := ctx.KVStore(k.storeKey)
+	nextKey = paginate(store, prefix, page, limit, startKey,
+		func(key, value []byte) {
+			owner = addOwnedID(owner, string(value), key)
+		},
+	)
+	return owner, nextKey
 }
 
-// IterateIDCollections iterates over the IDCollections by Owner and performs a function
-func (k Keeper) IterateIDCollections(ctx sdk.Context, prefix []byte,
-	handler func(owner sdk.AccAddress, idCollection types.IDCollection) (stop bool)) {
+// GetOwnerByDenom gets the ID Collection owned by an address of a specific denom
+func (k Keeper) GetOwnerByDenom(ctx sdk.Context, owner sdk.AccAddress, denom string) (idCollection types.IDCollection, found bool) {
+	ids := []string{}
+	k.IterateOwnerIDs(ctx, owner, denom,
+		func(id string) (stop bool) {
+			ids = append(ids, id)
+			return false
+		},
+	)
+	return types.NewIDCollection(denom, ids), len(ids) > 0
+}
 
+// IterateOwnerIDs iterates over the IDs of the NFTs of a collection owned by
+// an address and performs a function
+func (k Keeper) IterateOwnerIDs(ctx sdk.Context, owner sdk.AccAddress, denom string, handler func(id string) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
-	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetOwnerKey(owner, denom))
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
-
-		var idCollection types.IDCollection
-		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &idCollection)
-
-		owner, _ := types.SplitOwnerKey(iterator.Key())
-		if handler(owner, idCollection) {
+		_, _, id := types.SplitOwnerKey(iterator.Key())
+		if handler(id) {
 			break
 		}
 	}
@@ -96,37 +84,34 @@ func (k Keeper) IterateOwners(ctx sdk.Context, handler func(owner types.Owner) (
 	store := ctx.KVStore(k.storeKey)
 	iterator := sdk.KVStorePrefixIterator(store, types.OwnersKeyPrefix)
 	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		var owner types.Owner
 
-		address, _ := types.SplitOwnerKey(iterator.Key())
-		owner = k.GetOwner(ctx, address)
+	var address sdk.AccAddress
+	for ; iterator.Valid(); iterator.Next() {
+		// owner index keys are sorted by address, so the IDs of each owner
+		// are visited contiguously
+		keyAddress, _, _ := types.SplitOwnerKey(iterator.Key())
+		if bytes.Equal(keyAddress, address) {
+			continue
+		}
+		address = append(sdk.AccAddress{}, keyAddress...)
 
-		if handler(owner) {
+		if handler(k.GetOwner(ctx, address)) {
 			break
 		}
 	}
 }
 
-// SwapOwners swaps the owners of a NFT ID
-func (k Keeper) SwapOwners(ctx sdk.Context, denom string, id string, oldAddress sdk.AccAddress, newAddress sdk.AccAddress) (err sdk.Error) {
-	oldOwnerIDCollection, found := k.GetOwnerByDenom(ctx, oldAddress, denom)
-	if !found {
-		return types.ErrUnknownCollection(types.DefaultCodespace,
-			fmt.Sprintf("id collection %s doesn't exist for owner %s", denom, oldAddress),
-		)
-	}
-	oldOwnerIDCollection, err = oldOwnerIDCollection.DeleteID(id)
-	if err != nil {
-		return err
-	}
-	k.SetOwnerByDenom(ctx, oldAddress, denom, oldOwnerIDCollection.IDs)
+// addOwnedID adds the NFT ID of an owner index key to the ID Collection of
+// its denom
+func addOwnedID(owner types.Owner, denom string, key []byte) types.Owner {
+	_, _, id := types.SplitOwnerKey(key)
 
-	newOwnerIDCollection, found := k.GetOwnerByDenom(ctx, newAddress, denom)
-	if !found {
-		newOwnerIDCollection = types.NewIDCollection(denom, []string{})
+	// entries are sorted by denom hash, so only the last ID Collection can match
+	last := len(owner.IDCollections) - 1
+	if last >= 0 && owner.IDCollections[last].Denom == denom {
+		owner.IDCollections[last] = owner.IDCollections[last].AddID(id)
+		return owner
 	}
-	newOwnerIDCollection = newOwnerIDCollection.AddID(id)
-	k.SetOwnerByDenom(ctx, newAddress, denom, newOwnerIDCollection.IDs)
-	return nil
+	owner.IDCollections = append(owner.IDCollections, types.NewIDCollection(denom, []string{id}))
+	return owner
 }
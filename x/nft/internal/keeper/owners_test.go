@@ -42,67 +42,76 @@ func TestGetOwners(t *testing.T) {
 	require.Equal(t, 3, len(owners))
 }
 
-func TestSetOwner(t *testing.T) {
+func TestOwnerIndex(t *testing.T) {
 	app, ctx := createTestApp(false)
 
 	nft := types.NewBaseNFT(id, address, tokenURI)
 	err := app.NFTKeeper.MintNFT(ctx, denom, &nft)
 	require.NoError(t, err)
 
-	idCollection := types.NewIDCollection(denom, []string{id, id2, id3})
-	owner := types.NewOwner(address, idCollection)
-
-	oldOwner := app.NFTKeeper.GetOwner(ctx, address)
-
-	app.NFTKeeper.SetOwner(ctx, owner)
-
-	newOwner := app.NFTKeeper.GetOwner(ctx, address)
-	require.NotEqual(t, oldOwner.String(), newOwner.String())
-	require.Equal(t, owner.String(), newOwner.String())
-}
-
-func TestSetOwners(t *testing.T) {
-	app, ctx := createTestApp(false)
-
-	nft := types.NewBaseNFT(id, address, tokenURI)
-	err := app.NFTKeeper.MintNFT(ctx, denom, &nft)
+	nft2 := types.NewBaseNFT(id2, address, tokenURI)
+	err = app.NFTKeeper.MintNFT(ctx, denom, &nft2)
 	require.NoError(t, err)
 
-	nft = types.NewBaseNFT(id2, address2, tokenURI)
-	err = app.NFTKeeper.MintNFT(ctx, denom, &nft)
-	require.NoError(t, err)
+	owner := app.NFTKeeper.GetOwner(ctx, address)
+	require.Equal(t, types.NewOwner(address, types.NewIDCollection(denom, []string{id, id2})).String(), owner.String())
 
-	idCollection := types.NewIDCollection(denom, []string{id, id2, id3})
-	owner := types.NewOwner(address, idCollection)
-	owner2 := types.NewOwner(address2, idCollection)
+	// transferring an NFT moves its owner index entry
+	nft.SetOwner(address2)
+	err = app.NFTKeeper.UpdateNFT(ctx, denom, &nft)
+	require.NoError(t, err)
 
-	oldOwner := app.NFTKeeper.GetOwner(ctx, address)
-	oldOwner2 := app.NFTKeeper.GetOwner(ctx, address2)
+	idCollection, found := app.NFTKeeper.GetOwnerByDenom(ctx, address, denom)
+	require.True(t, found)
+	require.Equal(t, []string{id2}, idCollection.IDs)
 
-	app.NFTKeeper.SetOwners(ctx, []types.Owner{owner, owner2})
+	idCollection, found = app.NFTKeeper.GetOwnerByDenom(ctx, address2, denom)
+	require.True(t, found)
+	require.Equal(t, []string{id}, idCollection.IDs)
 
-	newOwner := app.NFTKeeper.GetOwner(ctx, address)
-	require.NotEqual(t, oldOwner.String(), newOwner.String())
-	require.Equal(t, owner.String(), newOwner.String())
+	// burning an NFT removes its owner index entry
+	err = app.NFTKeeper.DeleteNFT(ctx, denom, id2)
+	require.NoError(t, err)
 
-	newOwner2 := app.NFTKeeper.GetOwner(ctx, address2)
-	require.NotEqual(t, oldOwner2.String(), newOwner2.String())
-	require.Equal(t, owner2.String(), newOwner2.String())
+	_, found = app.NFTKeeper.GetOwnerByDenom(ctx, address, denom)
+	require.False(t, found)
+	require.Empty(t, app.NFTKeeper.GetOwner(ctx, address).IDCollections)
 }
 
-func TestSwapOwners(t *testing.T) {
+func TestGetOwnerPage(t *testing.T) {
 	app, ctx := createTestApp(false)
 
-	nft := types.NewBaseNFT(id, address, tokenURI)
-	err := app.NFTKeeper.MintNFT(ctx, denom, &nft)
-	require.NoError(t, err)
-
-	err = app.NFTKeeper.SwapOwners(ctx, denom, id, address, address2)
-	require.NoError(t, err)
-
-	err = app.NFTKeeper.SwapOwners(ctx, denom, id, address, address2)
-	require.Error(t, err)
-
-	err = app.NFTKeeper.SwapOwners(ctx, denom2, id, address, address2)
-	require.Error(t, err)
+	for _, d := range []string{denom, denom2} {
+		for _, nftID := range []string{id, id2, id3} {
+			nft := types.NewBaseNFT(nftID, address, tokenURI)
+			err := app.NFTKeeper.MintNFT(ctx, d, &nft)
+			require.NoError(t, err)
+		}
+	}
+
+	// pages of a single collection
+	owner, nextKey := app.NFTKeeper.GetOwnerPage(ctx, address, denom, 1, 2, nil)
+	require.Equal(t, []string{id, id2}, owner.IDCollections[0].IDs)
+	require.Equal(t, []byte(id3), nextKey)
+
+	owner, nextKey = app.NFTKeeper.GetOwnerPage(ctx, address, denom, 0, 2, nextKey)
+	require.Equal(t, []string{id3}, owner.IDCollections[0].IDs)
+	require.Nil(t, nextKey)
+
+	owner, nextKey = app.NFTKeeper.GetOwnerPage(ctx, address, denom, 2, 2, nil)
+	require.Equal(t, []string{id3}, owner.IDCollections[0].IDs)
+	require.Nil(t, nextKey)
+
+	// pages across collections
+	var supply int
+	var startKey []byte
+	for {
+		owner, startKey = app.NFTKeeper.GetOwnerPage(ctx, address, "", 0, 4, startKey)
+		supply += owner.Supply()
+		if startKey == nil {
+			break
+		}
+	}
+	require.Equal(t, 6, supply)
+	require.Equal(t, app.NFTKeeper.GetOwner(ctx, address).Supply(), supply)
 }
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft/internal/types"
+)
+
+// paginate calls the handler on a page of the entries stored under a prefix
+// and returns the key of the first entry of the next page, relative to the
+// prefix, or nil if there are no more entries. Pages start at the given start
+// key, or at the given page number (starting from 1) if the key is empty.
+func paginate(store sdk.KVStore, prefix []byte, page, limit int, startKey []byte,
+	handler func(key, value []byte)) (nextKey []byte) {
+
+	return paginateFiltered(store, prefix, page, limit, startKey, nil, handler)
+}
+
+// paginateFiltered paginates like paginate over the entries accepted by the
+// filter only. All the entries are accepted if the filter is nil.
+func paginateFiltered(store sdk.KVStore, prefix []byte, page, limit int, startKey []byte,
+	filter func(key, value []byte) bool, handler func(key, value []byte)) (nextKey []byte) {
+
+	if limit <= 0 {
+		limit = types.DefaultQueryLimit
+	}
+
+	start := prefix
+	skip := 0
+	if len(startKey) > 0 {
+		start = append(append([]byte{}, prefix...), startKey...)
+	} else if page > 1 {
+		skip = (page - 1) * limit
+	}
+
+	iterator := store.Iterator(start, sdk.PrefixEndBytes(prefix))
+	defer iterator.Close()
+	for count := 0; iterator.Valid(); iterator.Next() {
+		if filter != nil && !filter(iterator.Key(), iterator.Value()) {
+			continue
+		}
+		if skip > 0 {
+			skip--
+			continue
+		}
+		if count == limit {
+			return append([]byte{}, iterator.Key()[len(prefix):]...)
+		}
+		handler(iterator.Key(), iterator.Value())
+		count++
+	}
+	return nil
+}
@@ -18,6 +18,10 @@ const (
 	QueryCollection   = "collection"
 	QueryDenoms       = "denoms"
 	QueryNFT          = "nft"
+	QueryDenom        = "denom"
+	QueryNFTsByAttr   = "nftsByAttribute"
+	QueryApproved     = "approved"
+	QueryOperators    = "operators"
 )
 
 // NewQuerier is the module level router for state queries
@@ -36,6 +40,14 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryDenoms(ctx, path[1:], req, k)
 		case QueryNFT:
 			return queryNFT(ctx, path[1:], req, k)
+		case QueryDenom:
+			return queryDenom(ctx, path[1:], req, k)
+		case QueryNFTsByAttr:
+			return queryNFTsByAttribute(ctx, path[1:], req, k)
+		case QueryApproved:
+			return queryApproved(ctx, path[1:], req, k)
+		case QueryOperators:
+			return queryOperators(ctx, path[1:], req, k)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown nft query endpoint")
 		}
@@ -50,13 +62,12 @@ func querySupply(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper
 		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
 	}
 
-	collection, found := k.GetCollection(ctx, params.Denom)
-	if !found {
+	if !k.HasCollection(ctx, params.Denom) {
 		return nil, types.ErrUnknownCollection(types.DefaultCodespace, fmt.Sprintf("unknown denom %s", params.Denom))
 	}
 
 	bz := make([]byte, 8)
-	binary.LittleEndian.PutUint64(bz, uint64(collection.Supply()))
+	binary.LittleEndian.PutUint64(bz, uint64(k.GetSupply(ctx, params.Denom)))
 	return bz, nil
 }
 
@@ -68,8 +79,8 @@ func queryOwner(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper)
 		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
 	}
 
-	owner := k.GetOwner(ctx, params.Owner)
-	bz, err := types.ModuleCdc.MarshalJSON(owner)
+	owner, nextKey := k.GetOwnerPage(ctx, params.Owner, "", params.Page, params.Limit, params.StartKey)
+	bz, err := types.ModuleCdc.MarshalJSON(types.NewQueryOwnerResponse(owner, nextKey))
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
 	}
@@ -85,13 +96,12 @@ func queryOwnerByDenom(ctx sdk.Context, path []string, req abci.RequestQuery, k
 		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
 	}
 
-	var owner types.Owner
-
-	idCollection, _ := k.GetOwnerByDenom(ctx, params.Owner, params.Denom)
-	owner.Address = params.Owner
-	owner.IDCollections = append(owner.IDCollections, idCollection)
+	owner, nextKey := k.GetOwnerPage(ctx, params.Owner, params.Denom, params.Page, params.Limit, params.StartKey)
+	if len(owner.IDCollections) == 0 {
+		owner.IDCollections = append(owner.IDCollections, types.NewIDCollection(params.Denom, []string{}))
+	}
 
-	bz, err := types.ModuleCdc.MarshalJSON(owner)
+	bz, err := types.ModuleCdc.MarshalJSON(types.NewQueryOwnerResponse(owner, nextKey))
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
 	}
@@ -107,14 +117,12 @@ func queryCollection(ctx sdk.Context, path []string, req abci.RequestQuery, k Ke
 		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
 	}
 
-	collection, found := k.GetCollection(ctx, params.Denom)
-	if !found {
-		return nil, types.ErrUnknownCollection(types.DefaultCodespace, fmt.Sprintf("unknown denom %s", params.Denom))
+	collection, nextKey, sdkErr := k.GetCollectionPage(ctx, params.Denom, params.Page, params.Limit, params.StartKey)
+	if sdkErr != nil {
+		return nil, sdkErr
 	}
 
-	// use Collections custom JSON to make the denom the key of the object
-	collections := types.NewCollections(collection)
-	bz, err := types.ModuleCdc.MarshalJSON(collections)
+	bz, err := types.ModuleCdc.MarshalJSON(types.NewQueryCollectionResponse(collection, nextKey))
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
 	}
@@ -153,3 +161,95 @@ func queryNFT(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) (
 
 	return bz, nil
 }
+
+func queryDenom(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryCollectionParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	denom, found := k.GetDenom(ctx, params.Denom)
+	if !found {
+		return nil, types.ErrUnknownDenom(types.DefaultCodespace, fmt.Sprintf("unknown denom %s", params.Denom))
+	}
+
+	bz, err := types.ModuleCdc.MarshalJSON(denom)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func queryNFTsByAttribute(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryAttributeParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	nfts, nextKey, sdkErr := k.GetNFTsByAttribute(
+		ctx, params.Denom, params.Key, params.Value, params.Page, params.Limit, params.StartKey,
+	)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	bz, err := types.ModuleCdc.MarshalJSON(types.NewQueryCollectionResponse(types.NewCollection(params.Denom, nfts), nextKey))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func queryApproved(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryNFTParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	if !k.IsNFT(ctx, params.Denom, params.TokenID) {
+		return nil, types.ErrUnknownNFT(types.DefaultCodespace, fmt.Sprintf("invalid NFT #%s from collection %s", params.TokenID, params.Denom))
+	}
+
+	approval, found := k.GetApproval(ctx, params.Denom, params.TokenID)
+	if !found {
+		return nil, types.ErrUnknownApproval(types.DefaultCodespace,
+			fmt.Sprintf("NFT #%s in collection %s has no approved address", params.TokenID, params.Denom),
+		)
+	}
+
+	bz, err := types.ModuleCdc.MarshalJSON(approval)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func queryOperators(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryOperatorsParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	operators := k.GetOperators(ctx, params.Owner, params.Denom)
+	if operators == nil {
+		operators = types.OperatorApprovals{}
+	}
+
+	bz, err := types.ModuleCdc.MarshalJSON(operators)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return bz, nil
+}
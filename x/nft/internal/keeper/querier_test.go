@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/nft/exported"
 	keep "github.com/cosmos/cosmos-sdk/x/nft/internal/keeper"
 	"github.com/cosmos/cosmos-sdk/x/nft/internal/types"
@@ -106,10 +107,53 @@ func TestQueryCollection(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
-	var collections types.Collections
-	types.ModuleCdc.MustUnmarshalJSON(res, &collections)
-	require.Len(t, collections, 1)
-	require.Len(t, collections[0].NFTs, 1)
+	var out types.QueryCollectionResponse
+	types.ModuleCdc.MustUnmarshalJSON(res, &out)
+	require.Len(t, out.Collection.NFTs, 1)
+	require.Empty(t, out.NextKey)
+}
+
+func TestQueryCollectionPage(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	for _, nftID := range []string{id, id2, id3} {
+		nft := types.NewBaseNFT(nftID, address, tokenURI)
+		err := app.NFTKeeper.MintNFT(ctx, denom, &nft)
+		require.NoError(t, err)
+	}
+
+	querier := keep.NewQuerier(app.NFTKeeper)
+	queryPage := func(page, limit int, startKey []byte) types.QueryCollectionResponse {
+		bz, errRes := app.Codec().MarshalJSON(types.NewQueryCollectionPageParams(denom, page, limit, startKey))
+		require.Nil(t, errRes)
+
+		res, err := querier(ctx, []string{keep.QueryCollection}, abci.RequestQuery{Data: bz})
+		require.NoError(t, err)
+
+		var out types.QueryCollectionResponse
+		app.Codec().MustUnmarshalJSON(res, &out)
+		return out
+	}
+
+	out := queryPage(1, 2, nil)
+	require.Len(t, out.Collection.NFTs, 2)
+	require.Equal(t, id, out.Collection.NFTs[0].GetID())
+	require.Equal(t, id2, out.Collection.NFTs[1].GetID())
+	require.Equal(t, []byte(id3), []byte(out.NextKey))
+
+	// the next key returned by a page is the cursor of the following one
+	out = queryPage(0, 2, out.NextKey)
+	require.Len(t, out.Collection.NFTs, 1)
+	require.Equal(t, id3, out.Collection.NFTs[0].GetID())
+	require.Empty(t, out.NextKey)
+
+	out = queryPage(2, 2, nil)
+	require.Len(t, out.Collection.NFTs, 1)
+	require.Equal(t, id3, out.Collection.NFTs[0].GetID())
+
+	out = queryPage(3, 2, nil)
+	require.Empty(t, out.Collection.NFTs)
+	require.Empty(t, out.NextKey)
 }
 
 func TestQueryOwner(t *testing.T) {
@@ -147,14 +191,14 @@ func TestQueryOwner(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
-	var out types.Owner
+	var out types.QueryOwnerResponse
 	app.Codec().MustUnmarshalJSON(res, &out)
 
 	// build the owner using only the first denom
 	idCollection1 := types.NewIDCollection(denom, []string{id})
 	owner := types.NewOwner(address, idCollection1)
 
-	require.Equal(t, out.String(), owner.String())
+	require.Equal(t, out.Owner.String(), owner.String())
 
 	// query the balance using no denom so that all denoms will be returns
 	params = types.NewQueryBalanceParams(address, "")
@@ -171,13 +215,15 @@ func TestQueryOwner(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
+	out = types.QueryOwnerResponse{}
 	app.Codec().MustUnmarshalJSON(res, &out)
 
-	// build the owner using both denoms TODO: add sorting to ensure the objects are the same
+	// build the owner using both denoms, sorted by the hash of the denom
 	idCollection2 := types.NewIDCollection(denom2, []string{id})
 	owner = types.NewOwner(address, idCollection2, idCollection1)
 
-	require.Equal(t, out.String(), owner.String())
+	require.Equal(t, out.Owner.String(), owner.String())
+	require.Empty(t, out.NextKey)
 }
 
 func TestQueryNFT(t *testing.T) {
@@ -226,6 +272,72 @@ func TestQueryNFT(t *testing.T) {
 	require.Equal(t, out.String(), nft.String())
 }
 
+func TestQueryApproved(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	nft := types.NewBaseNFT(id, address, tokenURI)
+	err := app.NFTKeeper.MintNFT(ctx, denom, &nft)
+	require.NoError(t, err)
+
+	querier := keep.NewQuerier(app.NFTKeeper)
+	query := abci.RequestQuery{Path: "/custom/nft/approved"}
+
+	// unknown NFT
+	bz, err2 := app.Codec().MarshalJSON(types.NewQueryNFTParams(denom, id2))
+	require.Nil(t, err2)
+	query.Data = bz
+	_, err = querier(ctx, []string{keep.QueryApproved}, query)
+	require.Error(t, err)
+
+	// no approval
+	bz, err2 = app.Codec().MarshalJSON(types.NewQueryNFTParams(denom, id))
+	require.Nil(t, err2)
+	query.Data = bz
+	_, err = querier(ctx, []string{keep.QueryApproved}, query)
+	require.Error(t, err)
+
+	err = app.NFTKeeper.ApproveNFT(ctx, denom, id, address, address2)
+	require.NoError(t, err)
+
+	res, err := querier(ctx, []string{keep.QueryApproved}, query)
+	require.NoError(t, err)
+
+	var out types.Approval
+	app.Codec().MustUnmarshalJSON(res, &out)
+	require.Equal(t, types.NewApproval(denom, id, address2), out)
+}
+
+func TestQueryOperators(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	nft := types.NewBaseNFT(id, address, tokenURI)
+	err := app.NFTKeeper.MintNFT(ctx, denom, &nft)
+	require.NoError(t, err)
+	err = app.NFTKeeper.MintNFT(ctx, denom2, &nft)
+	require.NoError(t, err)
+
+	err = app.NFTKeeper.SetApprovalForAll(ctx, address, denom, address2, true)
+	require.NoError(t, err)
+	err = app.NFTKeeper.SetApprovalForAll(ctx, address, denom2, address3, true)
+	require.NoError(t, err)
+
+	querier := keep.NewQuerier(app.NFTKeeper)
+	queryOperators := func(owner sdk.AccAddress, denom string) (out types.OperatorApprovals) {
+		bz, errRes := app.Codec().MarshalJSON(types.NewQueryOperatorsParams(owner, denom))
+		require.Nil(t, errRes)
+
+		res, err := querier(ctx, []string{keep.QueryOperators}, abci.RequestQuery{Data: bz})
+		require.NoError(t, err)
+
+		app.Codec().MustUnmarshalJSON(res, &out)
+		return out
+	}
+
+	require.Len(t, queryOperators(address, ""), 2)
+	require.Equal(t, types.OperatorApprovals{types.NewOperatorApproval(address, denom2, address3)}, queryOperators(address, denom2))
+	require.Empty(t, queryOperators(address2, ""))
+}
+
 func TestQueryDenoms(t *testing.T) {
 	app, ctx := createTestApp(false)
 
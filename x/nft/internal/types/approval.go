@@ -0,0 +1,111 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Approval is the address approved to transfer a single NFT on behalf of its
+// owner. An NFT has at most one approved address.
+type Approval struct {
+	Denom    string         `json:"denom" yaml:"denom"`
+	ID       string         `json:"id" yaml:"id"`
+	Approved sdk.AccAddress `json:"approved" yaml:"approved"`
+}
+
+// NewApproval creates a new Approval instance
+func NewApproval(denom, id string, approved sdk.AccAddress) Approval {
+	return Approval{
+		Denom:    strings.TrimSpace(denom),
+		ID:       strings.TrimSpace(id),
+		Approved: approved,
+	}
+}
+
+// Validate performs a basic validation of the approval fields
+func (approval Approval) Validate() error {
+	if strings.TrimSpace(approval.Denom) == "" {
+		return fmt.Errorf("approval denom cannot be blank")
+	}
+	if strings.TrimSpace(approval.ID) == "" {
+		return fmt.Errorf("approval NFT ID cannot be blank")
+	}
+	if approval.Approved.Empty() {
+		return fmt.Errorf("approved address of NFT #%s in collection %s cannot be empty", approval.ID, approval.Denom)
+	}
+	return nil
+}
+
+// String follows stringer interface
+func (approval Approval) String() string {
+	return fmt.Sprintf(`Denom:		%s
+ID:		%s
+Approved:	%s`,
+		approval.Denom,
+		approval.ID,
+		approval.Approved,
+	)
+}
+
+// OperatorApproval is an operator allowed to transfer and approve all the NFTs
+// of a collection held by an owner
+type OperatorApproval struct {
+	Owner    sdk.AccAddress `json:"owner" yaml:"owner"`
+	Denom    string         `json:"denom" yaml:"denom"`
+	Operator sdk.AccAddress `json:"operator" yaml:"operator"`
+}
+
+// NewOperatorApproval creates a new OperatorApproval instance
+func NewOperatorApproval(owner sdk.AccAddress, denom string, operator sdk.AccAddress) OperatorApproval {
+	return OperatorApproval{
+		Owner:    owner,
+		Denom:    strings.TrimSpace(denom),
+		Operator: operator,
+	}
+}
+
+// Validate performs a basic validation of the operator approval fields
+func (approval OperatorApproval) Validate() error {
+	if approval.Owner.Empty() {
+		return fmt.Errorf("operator owner cannot be empty")
+	}
+	if strings.TrimSpace(approval.Denom) == "" {
+		return fmt.Errorf("operator denom cannot be blank")
+	}
+	if approval.Operator.Empty() {
+		return fmt.Errorf("operator of %s in collection %s cannot be empty", approval.Owner, approval.Denom)
+	}
+	if approval.Owner.Equals(approval.Operator) {
+		return fmt.Errorf("%s can't be its own operator", approval.Owner)
+	}
+	return nil
+}
+
+// String follows stringer interface
+func (approval OperatorApproval) String() string {
+	return fmt.Sprintf(`Owner:		%s
+Denom:		%s
+Operator:	%s`,
+		approval.Owner,
+		approval.Denom,
+		approval.Operator,
+	)
+}
+
+// OperatorApprovals is an array of OperatorApproval
+type OperatorApprovals []OperatorApproval
+
+// String follows stringer interface
+func (approvals OperatorApprovals) String() string {
+	if len(approvals) == 0 {
+		return ""
+	}
+
+	out := make([]string, len(approvals))
+	for i, approval := range approvals {
+		out[i] = approval.String()
+	}
+	return strings.Join(out, "\n")
+}
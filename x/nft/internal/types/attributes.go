@@ -0,0 +1,153 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Attribute is a single on-chain key/value property of an NFT
+type Attribute struct {
+	Key   string `json:"key" yaml:"key"`
+	Value string `json:"value" yaml:"value"`
+}
+
+// NewAttribute creates a new Attribute instance
+func NewAttribute(key, value string) Attribute {
+	return Attribute{
+		Key:   strings.TrimSpace(key),
+		Value: strings.TrimSpace(value),
+	}
+}
+
+// String implements the Stringer interface
+func (attr Attribute) String() string {
+	return fmt.Sprintf("%s=%s", attr.Key, attr.Value)
+}
+
+// ----------------------------------------------------------------------------
+// Attributes
+
+// Attributes defines a set of NFT attributes sorted by key. Amino can't encode
+// maps, so the attributes are kept as a sorted slice with map-like helpers.
+type Attributes []Attribute
+
+// NewAttributes creates a new sorted set of attributes. If the same key is
+// provided more than once, the last value wins.
+func NewAttributes(attrs ...Attribute) Attributes {
+	set := Attributes{}
+	for _, attr := range attrs {
+		set = set.Set(attr.Key, attr.Value)
+	}
+	return set
+}
+
+// NewAttributesFromMap creates a new sorted set of attributes from a map
+func NewAttributesFromMap(m map[string]string) Attributes {
+	set := Attributes{}
+	for k, v := range m {
+		set = set.Set(k, v)
+	}
+	return set
+}
+
+// ParseAttributes parses a comma separated list of key=value pairs
+// (eg. "color=red,level=4") into a set of attributes.
+func ParseAttributes(attrsStr string) (Attributes, error) {
+	attrsStr = strings.TrimSpace(attrsStr)
+	if len(attrsStr) == 0 {
+		return Attributes{}, nil
+	}
+
+	set := Attributes{}
+	for _, pair := range strings.Split(attrsStr, ",") {
+		kv := strings.SplitN(pair, "=", 2)
+		if len(kv) != 2 || strings.TrimSpace(kv[0]) == "" {
+			return nil, fmt.Errorf("invalid attribute %q, expected key=value", pair)
+		}
+		set = set.Set(kv[0], kv[1])
+	}
+	return set, nil
+}
+
+// Get returns the value of the attribute with the given key
+func (attrs Attributes) Get(key string) (string, bool) {
+	i := attrs.find(key)
+	if i == -1 {
+		return "", false
+	}
+	return attrs[i].Value, true
+}
+
+// Set returns a new set with the given attribute added or replaced
+func (attrs Attributes) Set(key, value string) Attributes {
+	attr := NewAttribute(key, value)
+
+	res := make(Attributes, len(attrs))
+	copy(res, attrs)
+
+	i := sort.Search(len(res), func(i int) bool { return res[i].Key >= attr.Key })
+	if i < len(res) && res[i].Key == attr.Key {
+		res[i] = attr
+		return res
+	}
+
+	res = append(res, Attribute{})
+	copy(res[i+1:], res[i:])
+	res[i] = attr
+	return res
+}
+
+// Merge returns a new set with all the attributes of attrsB added to or
+// replacing the ones in attrs
+func (attrs Attributes) Merge(attrsB Attributes) Attributes {
+	res := attrs
+	for _, attr := range attrsB {
+		res = res.Set(attr.Key, attr.Value)
+	}
+	return res
+}
+
+// Map returns the attributes as a key/value map
+func (attrs Attributes) Map() map[string]string {
+	m := make(map[string]string, len(attrs))
+	for _, attr := range attrs {
+		m[attr.Key] = attr.Value
+	}
+	return m
+}
+
+// Empty returns true if there are no attributes
+func (attrs Attributes) Empty() bool {
+	return len(attrs) == 0
+}
+
+// Validate performs a basic validation of the attribute keys
+func (attrs Attributes) Validate() error {
+	for i, attr := range attrs {
+		if strings.TrimSpace(attr.Key) == "" {
+			return fmt.Errorf("attribute key cannot be blank")
+		}
+		if i > 0 && attrs[i-1].Key >= attr.Key {
+			return fmt.Errorf("attributes must be sorted and unique, got %s after %s", attr.Key, attrs[i-1].Key)
+		}
+	}
+	return nil
+}
+
+// String implements the Stringer interface
+func (attrs Attributes) String() string {
+	strs := make([]string, len(attrs))
+	for i, attr := range attrs {
+		strs[i] = attr.String()
+	}
+	return strings.Join(strs, ",")
+}
+
+func (attrs Attributes) find(key string) int {
+	i := sort.Search(len(attrs), func(i int) bool { return attrs[i].Key >= key })
+	if i < len(attrs) && attrs[i].Key == key {
+		return i
+	}
+	return -1
+}
@@ -18,6 +18,11 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgEditNFTMetadata{}, "cosmos-sdk/MsgEditNFTMetadata", nil)
 	cdc.RegisterConcrete(MsgMintNFT{}, "cosmos-sdk/MsgMintNFT", nil)
 	cdc.RegisterConcrete(MsgBurnNFT{}, "cosmos-sdk/MsgBurnNFT", nil)
+	cdc.RegisterConcrete(MsgIssueDenom{}, "cosmos-sdk/MsgIssueDenom", nil)
+	cdc.RegisterConcrete(MsgTransferDenom{}, "cosmos-sdk/MsgTransferDenom", nil)
+	cdc.RegisterConcrete(MsgApproveNFT{}, "cosmos-sdk/MsgApproveNFT", nil)
+	cdc.RegisterConcrete(MsgSetApprovalForAll{}, "cosmos-sdk/MsgSetApprovalForAll", nil)
+	cdc.RegisterConcrete(MsgRevokeApproval{}, "cosmos-sdk/MsgRevokeApproval", nil)
 }
 
 // ModuleCdc generic sealed codec to be used throughout this module
@@ -0,0 +1,121 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Edit policies of a denom, defining who can edit the metadata of its NFTs
+const (
+	EditPolicyOwner   = "owner"   // only the owner of the NFT
+	EditPolicyCreator = "creator" // only the creator of the denom
+
+	DefaultEditPolicy = EditPolicyOwner
+)
+
+// ValidEditPolicy returns true if the edit policy is supported
+func ValidEditPolicy(policy string) bool {
+	return policy == EditPolicyOwner || policy == EditPolicyCreator
+}
+
+// Denom defines the registered properties of an NFT collection
+type Denom struct {
+	Name       string           `json:"name" yaml:"name"`               // denomination of the collection
+	Creator    sdk.AccAddress   `json:"creator" yaml:"creator"`         // account that owns the collection
+	Schema     Schema           `json:"schema" yaml:"schema"`           // attributes that the NFTs of the collection can hold
+	Minters    []sdk.AccAddress `json:"minters" yaml:"minters"`         // accounts allowed to mint besides the creator
+	EditPolicy string           `json:"edit_policy" yaml:"edit_policy"` // who can edit the metadata of the NFTs
+}
+
+// NewDenom creates a new Denom instance
+func NewDenom(name string, creator sdk.AccAddress, schema Schema,
+	minters []sdk.AccAddress, editPolicy string) Denom {
+
+	return Denom{
+		Name:       strings.TrimSpace(name),
+		Creator:    creator,
+		Schema:     schema,
+		Minters:    minters,
+		EditPolicy: strings.TrimSpace(editPolicy),
+	}
+}
+
+// IsMinter returns whether an account is allowed to mint NFTs of the denom
+func (denom Denom) IsMinter(address sdk.AccAddress) bool {
+	if denom.Creator.Equals(address) {
+		return true
+	}
+	for _, minter := range denom.Minters {
+		if minter.Equals(address) {
+			return true
+		}
+	}
+	return false
+}
+
+// CanEdit returns whether an account is allowed to edit the metadata of an
+// NFT of the denom held by the given owner
+func (denom Denom) CanEdit(address, nftOwner sdk.AccAddress) bool {
+	switch denom.EditPolicy {
+	case EditPolicyCreator:
+		return denom.Creator.Equals(address)
+	default:
+		return nftOwner.Equals(address)
+	}
+}
+
+// Validate performs a basic validation of the denom fields
+func (denom Denom) Validate() error {
+	if strings.TrimSpace(denom.Name) == "" {
+		return fmt.Errorf("denom name cannot be blank")
+	}
+	if denom.Creator.Empty() {
+		return fmt.Errorf("denom %s creator cannot be empty", denom.Name)
+	}
+	if !ValidEditPolicy(denom.EditPolicy) {
+		return fmt.Errorf("invalid edit policy %q for denom %s", denom.EditPolicy, denom.Name)
+	}
+	if err := ValidateMinters(denom.Minters); err != nil {
+		return err
+	}
+	return denom.Schema.Validate()
+}
+
+// String implements the Stringer interface
+func (denom Denom) String() string {
+	minters := make([]string, len(denom.Minters))
+	for i, minter := range denom.Minters {
+		minters[i] = minter.String()
+	}
+
+	return fmt.Sprintf(`Denom:		%s
+Creator:	%s
+Minters:	%s
+Edit Policy:	%s
+Schema:
+%s`,
+		denom.Name,
+		denom.Creator,
+		strings.Join(minters, ", "),
+		denom.EditPolicy,
+		denom.Schema,
+	)
+}
+
+// ValidateMinters checks that a minters allow-list has no empty or duplicate
+// addresses
+func ValidateMinters(minters []sdk.AccAddress) error {
+	seen := make(map[string]bool)
+	for _, minter := range minters {
+		if minter.Empty() {
+			return fmt.Errorf("minter address cannot be empty")
+		}
+		if seen[minter.String()] {
+			return fmt.Errorf("duplicate minter %s", minter)
+		}
+		seen[minter.String()] = true
+	}
+	return nil
+}
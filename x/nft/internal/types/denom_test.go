@@ -0,0 +1,41 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestDenomValidate(t *testing.T) {
+	require.NoError(t, NewDenom(denom, address, testSchema(), nil, EditPolicyOwner).Validate())
+	require.NoError(t, NewDenom(denom, address, testSchema(), []sdk.AccAddress{address2}, EditPolicyCreator).Validate())
+	require.Error(t, NewDenom("", address, testSchema(), nil, EditPolicyOwner).Validate())
+	require.Error(t, NewDenom(denom, nil, testSchema(), nil, EditPolicyOwner).Validate())
+	require.Error(t, NewDenom(denom, address, NewSchema(NewAttributeDefinition("color", "color", false, false)), nil, EditPolicyOwner).Validate())
+	require.Error(t, NewDenom(denom, address, testSchema(), nil, "").Validate())
+	require.Error(t, NewDenom(denom, address, testSchema(), []sdk.AccAddress{address2, address2}, EditPolicyOwner).Validate())
+	require.Error(t, NewDenom(denom, address, testSchema(), []sdk.AccAddress{nil}, EditPolicyOwner).Validate())
+}
+
+func TestDenomIsMinter(t *testing.T) {
+	d := NewDenom(denom, address, testSchema(), []sdk.AccAddress{address2}, EditPolicyOwner)
+	require.True(t, d.IsMinter(address))
+	require.True(t, d.IsMinter(address2))
+	require.False(t, d.IsMinter(address3))
+
+	d = NewDenom(denom, address, testSchema(), nil, EditPolicyOwner)
+	require.True(t, d.IsMinter(address))
+	require.False(t, d.IsMinter(address2))
+}
+
+func TestDenomCanEdit(t *testing.T) {
+	d := NewDenom(denom, address, testSchema(), nil, EditPolicyOwner)
+	require.True(t, d.CanEdit(address2, address2))
+	require.False(t, d.CanEdit(address, address2))
+
+	d = NewDenom(denom, address, testSchema(), nil, EditPolicyCreator)
+	require.True(t, d.CanEdit(address, address2))
+	require.False(t, d.CanEdit(address2, address2))
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -17,6 +19,12 @@ const (
 	CodeUnknownNFT        CodeType = 653
 	CodeNFTAlreadyExists  CodeType = 654
 	CodeEmptyMetadata     CodeType = 655
+	CodeUnknownDenom      CodeType = 656
+	CodeDenomExists       CodeType = 657
+	CodeInvalidAttributes CodeType = 658
+	CodeInvalidEditPolicy CodeType = 659
+	CodeInvalidApproval   CodeType = 660
+	CodeUnknownApproval   CodeType = 661
 )
 
 // ErrInvalidCollection is an error
@@ -33,7 +41,10 @@ func ErrUnknownCollection(codespace sdk.CodespaceType, msg string) sdk.Error {
 }
 
 // ErrInvalidNFT is an error
-func ErrInvalidNFT(codespace sdk.CodespaceType) sdk.Error {
+func ErrInvalidNFT(codespace sdk.CodespaceType, msg string) sdk.Error {
+	if msg != "" {
+		return sdk.NewError(codespace, CodeInvalidNFT, msg)
+	}
 	return sdk.NewError(codespace, CodeInvalidNFT, "invalid NFT")
 }
 
@@ -60,3 +71,48 @@ func ErrEmptyMetadata(codespace sdk.CodespaceType, msg string) sdk.Error {
 	}
 	return sdk.NewError(codespace, CodeEmptyMetadata, "NFT metadata can't be empty")
 }
+
+// ErrUnknownDenom is an error when a denom has not been issued
+func ErrUnknownDenom(codespace sdk.CodespaceType, msg string) sdk.Error {
+	if msg != "" {
+		return sdk.NewError(codespace, CodeUnknownDenom, msg)
+	}
+	return sdk.NewError(codespace, CodeUnknownDenom, "unknown NFT denom")
+}
+
+// ErrDenomExists is an error when a denom is issued twice
+func ErrDenomExists(codespace sdk.CodespaceType, msg string) sdk.Error {
+	if msg != "" {
+		return sdk.NewError(codespace, CodeDenomExists, msg)
+	}
+	return sdk.NewError(codespace, CodeDenomExists, "NFT denom already exists")
+}
+
+// ErrInvalidAttributes is an error when NFT attributes don't match the denom schema
+func ErrInvalidAttributes(codespace sdk.CodespaceType, msg string) sdk.Error {
+	if msg != "" {
+		return sdk.NewError(codespace, CodeInvalidAttributes, msg)
+	}
+	return sdk.NewError(codespace, CodeInvalidAttributes, "invalid NFT attributes")
+}
+
+// ErrInvalidEditPolicy is an error when a denom edit policy is not supported
+func ErrInvalidEditPolicy(codespace sdk.CodespaceType, policy string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidEditPolicy, fmt.Sprintf("invalid edit policy %q, expected %s or %s", policy, EditPolicyOwner, EditPolicyCreator))
+}
+
+// ErrInvalidApproval is an error when an approval or operator is not valid
+func ErrInvalidApproval(codespace sdk.CodespaceType, msg string) sdk.Error {
+	if msg != "" {
+		return sdk.NewError(codespace, CodeInvalidApproval, msg)
+	}
+	return sdk.NewError(codespace, CodeInvalidApproval, "invalid NFT approval")
+}
+
+// ErrUnknownApproval is an error when an NFT has no approved address
+func ErrUnknownApproval(codespace sdk.CodespaceType, msg string) sdk.Error {
+	if msg != "" {
+		return sdk.NewError(codespace, CodeUnknownApproval, msg)
+	}
+	return sdk.NewError(codespace, CodeUnknownApproval, "unknown NFT approval")
+}
@@ -2,10 +2,15 @@ package types
 
 // NFT module event types
 var (
-	EventTypeTransfer        = "transfer_nft"
-	EventTypeEditNFTMetadata = "edit_nft_metadata"
-	EventTypeMintNFT         = "mint_nft"
-	EventTypeBurnNFT         = "burn_nft"
+	EventTypeTransfer          = "transfer_nft"
+	EventTypeEditNFTMetadata   = "edit_nft_metadata"
+	EventTypeMintNFT           = "mint_nft"
+	EventTypeBurnNFT           = "burn_nft"
+	EventTypeIssueDenom        = "issue_denom"
+	EventTypeTransferDenom     = "transfer_denom"
+	EventTypeApproveNFT        = "approve_nft"
+	EventTypeRevokeApproval    = "revoke_approval"
+	EventTypeSetApprovalForAll = "set_approval_for_all"
 
 	AttributeValueCategory = ModuleName
 
@@ -15,4 +20,7 @@ var (
 	AttributeKeyNFTID       = "nft-id"
 	AttributeKeyNFTTokenURI = "token-uri"
 	AttributeKeyDenom       = "denom"
+	AttributeKeyCreator     = "creator"
+	AttributeKeyApproved    = "approved"
+	AttributeKeyOperator    = "operator"
 )
@@ -1,26 +1,103 @@
 package types
 
-// GenesisState is the state that must be provided at genesis.
+import (
+	"fmt"
+	"strings"
+)
+
+// GenesisState is the state that must be provided at genesis. The owners of
+// the NFTs are indexed from the collections.
 type GenesisState struct {
-	Owners      []Owner     `json:"owners"`
-	Collections Collections `json:"collections"`
+	Collections Collections        `json:"collections"`
+	Denoms      []Denom            `json:"denoms"`
+	Approvals   []Approval         `json:"approvals"`
+	Operators   []OperatorApproval `json:"operators"`
 }
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(owners []Owner, collections Collections) GenesisState {
+func NewGenesisState(collections Collections, denoms []Denom,
+	approvals []Approval, operators []OperatorApproval) GenesisState {
+
 	return GenesisState{
-		Owners:      owners,
 		Collections: collections,
+		Denoms:      denoms,
+		Approvals:   approvals,
+		Operators:   operators,
 	}
 }
 
 // DefaultGenesisState returns a default genesis state
 func DefaultGenesisState() GenesisState {
-	return NewGenesisState([]Owner{}, NewCollections())
+	return NewGenesisState(NewCollections(), []Denom{}, []Approval{}, []OperatorApproval{})
 }
 
 // ValidateGenesis performs basic validation of nfts genesis data returning an
 // error for any failed validation criteria.
 func ValidateGenesis(data GenesisState) error {
+	seenDenoms := make(map[string]bool)
+	for _, denom := range data.Denoms {
+		if err := denom.Validate(); err != nil {
+			return err
+		}
+		if seenDenoms[denom.Name] {
+			return fmt.Errorf("duplicate denom %s", denom.Name)
+		}
+		seenDenoms[denom.Name] = true
+	}
+
+	seenCollections := make(map[string]bool)
+	owners := make(map[string]string)
+	for _, collection := range data.Collections {
+		if strings.TrimSpace(collection.Denom) == "" {
+			return fmt.Errorf("collection denom cannot be blank")
+		}
+		if seenCollections[collection.Denom] {
+			return fmt.Errorf("duplicate collection %s", collection.Denom)
+		}
+		seenCollections[collection.Denom] = true
+
+		seenNFTs := make(map[string]bool)
+		for _, nft := range collection.NFTs {
+			if seenNFTs[nft.GetID()] {
+				return fmt.Errorf("duplicate NFT #%s in collection %s", nft.GetID(), collection.Denom)
+			}
+			if nft.GetOwner().Empty() {
+				return fmt.Errorf("NFT #%s in collection %s has no owner", nft.GetID(), collection.Denom)
+			}
+			seenNFTs[nft.GetID()] = true
+			owners[collection.Denom+"/"+nft.GetID()] = nft.GetOwner().String()
+		}
+	}
+
+	seenApprovals := make(map[string]bool)
+	for _, approval := range data.Approvals {
+		if err := approval.Validate(); err != nil {
+			return err
+		}
+		key := approval.Denom + "/" + approval.ID
+		owner, ok := owners[key]
+		if !ok {
+			return fmt.Errorf("approval of unknown NFT #%s in collection %s", approval.ID, approval.Denom)
+		}
+		if owner == approval.Approved.String() {
+			return fmt.Errorf("owner of NFT #%s in collection %s can't be its approved address", approval.ID, approval.Denom)
+		}
+		if seenApprovals[key] {
+			return fmt.Errorf("duplicate approval of NFT #%s in collection %s", approval.ID, approval.Denom)
+		}
+		seenApprovals[key] = true
+	}
+
+	seenOperators := make(map[string]bool)
+	for _, operator := range data.Operators {
+		if err := operator.Validate(); err != nil {
+			return err
+		}
+		key := operator.Owner.String() + "/" + operator.Denom + "/" + operator.Operator.String()
+		if seenOperators[key] {
+			return fmt.Errorf("duplicate operator %s of %s in collection %s", operator.Operator, operator.Owner, operator.Denom)
+		}
+		seenOperators[key] = true
+	}
 	return nil
 }
@@ -24,51 +24,136 @@ const (
 
 // NFTs are stored as follow:
 //
-// - Colections: 0x00<denom_bytes_key> :<Collection>
+// - Colections: 0x00<denom_bytes_key>: <denom>
 //
-// - Owners: 0x01<address_bytes_key><denom_bytes_key>: <Owner>
+// - Owners: 0x01<address_bytes_key><denom_bytes_key><id_bytes>: <denom>
+//
+// - Denoms: 0x02<denom_bytes_key>: <Denom>
+//
+// - NFTs: 0x03<denom_bytes_key><id_bytes>: <NFT>
+//
+// - Supply: 0x04<denom_bytes_key>: <supply>
+//
+// - Approvals: 0x05<denom_bytes_key><id_bytes>: <Approval>
+//
+// - Operators: 0x06<owner_address_bytes_key><denom_bytes_key><operator_address_bytes_key>: <denom>
 var (
 	CollectionsKeyPrefix = []byte{0x00} // key for NFT collections
-	OwnersKeyPrefix      = []byte{0x01} // key for balance of NFTs held by an address
+	OwnersKeyPrefix      = []byte{0x01} // key for the index of NFTs held by an address
+	DenomsKeyPrefix      = []byte{0x02} // key for issued denoms and their schemas
+	NFTsKeyPrefix        = []byte{0x03} // key for NFTs
+	SupplyKeyPrefix      = []byte{0x04} // key for the total supply of NFT collections
+	ApprovalsKeyPrefix   = []byte{0x05} // key for the addresses approved to transfer an NFT
+	OperatorsKeyPrefix   = []byte{0x06} // key for the operators of the NFTs of an owner
 )
 
-// GetCollectionKey gets the key of a collection
-func GetCollectionKey(denom string) []byte {
+// length of the hash of a denom used on the store keys
+const denomHashLen = tmhash.Size
 
+// GetDenomHash returns the hashed denom bytes used on the store keys
+func GetDenomHash(denom string) []byte {
 	h := tmhash.New()
 	_, err := h.Write([]byte(denom))
 	if err != nil {
 		panic(err)
 	}
-	bs := h.Sum(nil)
+	return h.Sum(nil)
+}
 
-	return append(CollectionsKeyPrefix, bs...)
+// GetCollectionKey gets the key of a collection
+func GetCollectionKey(denom string) []byte {
+	return append(CollectionsKeyPrefix, GetDenomHash(denom)...)
 }
 
-// SplitOwnerKey gets an address and denom from an owner key
-func SplitOwnerKey(key []byte) (sdk.AccAddress, []byte) {
-	if len(key) != 53 {
+// GetNFTsKey gets the key prefix for all the NFTs of a collection
+func GetNFTsKey(denom string) []byte {
+	return append(NFTsKeyPrefix, GetDenomHash(denom)...)
+}
+
+// GetNFTKey gets the key of an NFT
+func GetNFTKey(denom, id string) []byte {
+	return append(GetNFTsKey(denom), []byte(id)...)
+}
+
+// SplitNFTKey gets the denom hash and the NFT ID from an NFT key
+func SplitNFTKey(key []byte) ([]byte, string) {
+	if len(key) < 1+denomHashLen {
+		panic(fmt.Sprintf("unexpected key length %d", len(key)))
+	}
+	return key[1 : 1+denomHashLen], string(key[1+denomHashLen:])
+}
+
+// GetSupplyKey gets the key of the total supply of a collection
+func GetSupplyKey(denom string) []byte {
+	return append(SupplyKeyPrefix, GetDenomHash(denom)...)
+}
+
+// SplitOwnerKey gets an address, denom hash and NFT ID from an owner key
+func SplitOwnerKey(key []byte) (sdk.AccAddress, []byte, string) {
+	if len(key) < 1+sdk.AddrLen+denomHashLen {
 		panic(fmt.Sprintf("unexpected key length %d", len(key)))
 	}
 	address := key[1 : sdk.AddrLen+1]
-	denomHashBz := key[sdk.AddrLen+1:]
-	return sdk.AccAddress(address), denomHashBz
+	denomHashBz := key[sdk.AddrLen+1 : sdk.AddrLen+1+denomHashLen]
+	id := key[sdk.AddrLen+1+denomHashLen:]
+	return sdk.AccAddress(address), denomHashBz, string(id)
 }
 
-// GetOwnersKey gets the key prefix for all the collections owned by an account address
+// GetOwnersKey gets the key prefix for all the NFTs owned by an account address
 func GetOwnersKey(address sdk.AccAddress) []byte {
 	return append(OwnersKeyPrefix, address.Bytes()...)
 }
 
-// GetOwnerKey gets the key of a collection owned by an account address
+// GetOwnerKey gets the key prefix for the NFTs of a collection owned by an
+// account address
 func GetOwnerKey(address sdk.AccAddress, denom string) []byte {
+	return append(GetOwnersKey(address), GetDenomHash(denom)...)
+}
 
-	h := tmhash.New()
-	_, err := h.Write([]byte(denom))
-	if err != nil {
-		panic(err)
-	}
-	bs := h.Sum(nil)
+// GetOwnerNFTKey gets the key of the owner index entry of an NFT
+func GetOwnerNFTKey(address sdk.AccAddress, denom, id string) []byte {
+	return append(GetOwnerKey(address, denom), []byte(id)...)
+}
+
+// GetDenomKey gets the key of an issued denom
+func GetDenomKey(denom string) []byte {
+	return append(DenomsKeyPrefix, GetDenomHash(denom)...)
+}
+
+// GetApprovalKey gets the key of the approved address of an NFT
+func GetApprovalKey(denom, id string) []byte {
+	return append(append(ApprovalsKeyPrefix, GetDenomHash(denom)...), []byte(id)...)
+}
 
-	return append(GetOwnersKey(address), bs...)
+// SplitApprovalKey gets the denom hash and the NFT ID from an approval key
+func SplitApprovalKey(key []byte) ([]byte, string) {
+	return SplitNFTKey(key)
+}
+
+// GetOwnerOperatorsKey gets the key prefix for all the operators of an owner
+func GetOwnerOperatorsKey(owner sdk.AccAddress) []byte {
+	return append(OperatorsKeyPrefix, owner.Bytes()...)
+}
+
+// GetOperatorsKey gets the key prefix for the operators of the NFTs of a
+// collection held by an owner
+func GetOperatorsKey(owner sdk.AccAddress, denom string) []byte {
+	return append(GetOwnerOperatorsKey(owner), GetDenomHash(denom)...)
+}
+
+// GetOperatorKey gets the key of an operator of the NFTs of a collection held
+// by an owner
+func GetOperatorKey(owner sdk.AccAddress, denom string, operator sdk.AccAddress) []byte {
+	return append(GetOperatorsKey(owner, denom), operator.Bytes()...)
+}
+
+// SplitOperatorKey gets the owner, denom hash and operator from an operator key
+func SplitOperatorKey(key []byte) (sdk.AccAddress, []byte, sdk.AccAddress) {
+	if len(key) != 1+2*sdk.AddrLen+denomHashLen {
+		panic(fmt.Sprintf("unexpected key length %d", len(key)))
+	}
+	owner := key[1 : sdk.AddrLen+1]
+	denomHashBz := key[sdk.AddrLen+1 : sdk.AddrLen+1+denomHashLen]
+	operator := key[sdk.AddrLen+1+denomHashLen:]
+	return sdk.AccAddress(owner), denomHashBz, sdk.AccAddress(operator)
 }
@@ -46,7 +46,7 @@ func (msg MsgTransferNFT) ValidateBasic() sdk.Error {
 		return sdk.ErrInvalidAddress("invalid recipient address")
 	}
 	if strings.TrimSpace(msg.ID) == "" {
-		return ErrInvalidNFT(DefaultCodespace)
+		return ErrInvalidNFT(DefaultCodespace, "")
 	}
 
 	return nil
@@ -67,23 +67,28 @@ func (msg MsgTransferNFT) GetSigners() []sdk.AccAddress {
 // MsgEditNFTMetadata
 /* --------------------------------------------------------------------------- */
 
-// MsgEditNFTMetadata edits an NFT's metadata
+// MsgEditNFTMetadata edits an NFT's metadata. The given attributes are added
+// to or replace the existing ones. The token URI is only replaced when one is
+// given, and is removed when ClearTokenURI is set.
 type MsgEditNFTMetadata struct {
-	Sender   sdk.AccAddress
-	ID       string
-	Denom    string
-	TokenURI string
+	Sender        sdk.AccAddress
+	ID            string
+	Denom         string
+	TokenURI      string
+	Attributes    Attributes `json:"Attributes,omitempty"`
+	ClearTokenURI bool       `json:"ClearTokenURI,omitempty"`
 }
 
 // NewMsgEditNFTMetadata is a constructor function for MsgSetName
 func NewMsgEditNFTMetadata(sender sdk.AccAddress, id,
-	denom, tokenURI string,
+	denom, tokenURI string, attributes Attributes,
 ) MsgEditNFTMetadata {
 	return MsgEditNFTMetadata{
-		Sender:   sender,
-		ID:       strings.TrimSpace(id),
-		Denom:    strings.TrimSpace(denom),
-		TokenURI: strings.TrimSpace(tokenURI),
+		Sender:     sender,
+		ID:         strings.TrimSpace(id),
+		Denom:      strings.TrimSpace(denom),
+		TokenURI:   strings.TrimSpace(tokenURI),
+		Attributes: attributes,
 	}
 }
 
@@ -99,10 +104,16 @@ func (msg MsgEditNFTMetadata) ValidateBasic() sdk.Error {
 		return sdk.ErrInvalidAddress("invalid sender address")
 	}
 	if strings.TrimSpace(msg.ID) == "" {
-		return ErrInvalidNFT(DefaultCodespace)
+		return ErrInvalidNFT(DefaultCodespace, "")
 	}
 	if strings.TrimSpace(msg.Denom) == "" {
-		return ErrInvalidNFT(DefaultCodespace)
+		return ErrInvalidNFT(DefaultCodespace, "")
+	}
+	if err := msg.Attributes.Validate(); err != nil {
+		return ErrInvalidAttributes(DefaultCodespace, err.Error())
+	}
+	if msg.ClearTokenURI && msg.TokenURI != "" {
+		return ErrInvalidNFT(DefaultCodespace, "a token URI can not be set and cleared at the same time")
 	}
 	return nil
 }
@@ -124,21 +135,23 @@ func (msg MsgEditNFTMetadata) GetSigners() []sdk.AccAddress {
 
 // MsgMintNFT defines a MintNFT message
 type MsgMintNFT struct {
-	Sender    sdk.AccAddress
-	Recipient sdk.AccAddress
-	ID        string
-	Denom     string
-	TokenURI  string
+	Sender     sdk.AccAddress
+	Recipient  sdk.AccAddress
+	ID         string
+	Denom      string
+	TokenURI   string
+	Attributes Attributes `json:"Attributes,omitempty"`
 }
 
 // NewMsgMintNFT is a constructor function for MsgMintNFT
-func NewMsgMintNFT(sender, recipient sdk.AccAddress, id, denom, tokenURI string) MsgMintNFT {
+func NewMsgMintNFT(sender, recipient sdk.AccAddress, id, denom, tokenURI string, attributes Attributes) MsgMintNFT {
 	return MsgMintNFT{
-		Sender:    sender,
-		Recipient: recipient,
-		ID:        strings.TrimSpace(id),
-		Denom:     strings.TrimSpace(denom),
-		TokenURI:  strings.TrimSpace(tokenURI),
+		Sender:     sender,
+		Recipient:  recipient,
+		ID:         strings.TrimSpace(id),
+		Denom:      strings.TrimSpace(denom),
+		TokenURI:   strings.TrimSpace(tokenURI),
+		Attributes: attributes,
 	}
 }
 
@@ -151,10 +164,10 @@ func (msg MsgMintNFT) Type() string { return "mint_nft" }
 // ValidateBasic Implements Msg.
 func (msg MsgMintNFT) ValidateBasic() sdk.Error {
 	if strings.TrimSpace(msg.Denom) == "" {
-		return ErrInvalidNFT(DefaultCodespace)
+		return ErrInvalidNFT(DefaultCodespace, "")
 	}
 	if strings.TrimSpace(msg.ID) == "" {
-		return ErrInvalidNFT(DefaultCodespace)
+		return ErrInvalidNFT(DefaultCodespace, "")
 	}
 	if msg.Sender.Empty() {
 		return sdk.ErrInvalidAddress("invalid sender address")
@@ -162,6 +175,9 @@ func (msg MsgMintNFT) ValidateBasic() sdk.Error {
 	if msg.Recipient.Empty() {
 		return sdk.ErrInvalidAddress("invalid recipient address")
 	}
+	if err := msg.Attributes.Validate(); err != nil {
+		return ErrInvalidAttributes(DefaultCodespace, err.Error())
+	}
 	return nil
 }
 
@@ -205,10 +221,10 @@ func (msg MsgBurnNFT) Type() string { return "burn_nft" }
 // ValidateBasic Implements Msg.
 func (msg MsgBurnNFT) ValidateBasic() sdk.Error {
 	if strings.TrimSpace(msg.ID) == "" {
-		return ErrInvalidNFT(DefaultCodespace)
+		return ErrInvalidNFT(DefaultCodespace, "")
 	}
 	if strings.TrimSpace(msg.Denom) == "" {
-		return ErrInvalidNFT(DefaultCodespace)
+		return ErrInvalidNFT(DefaultCodespace, "")
 	}
 	if msg.Sender.Empty() {
 		return sdk.ErrInvalidAddress("invalid sender address")
@@ -226,3 +242,284 @@ func (msg MsgBurnNFT) GetSignBytes() []byte {
 func (msg MsgBurnNFT) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Sender}
 }
+
+/* --------------------------------------------------------------------------- */
+// MsgIssueDenom
+/* --------------------------------------------------------------------------- */
+
+// MsgIssueDenom registers a new NFT denom owned by the sender along with the
+// schema of the attributes that its NFTs can hold, the accounts allowed to
+// mint besides the sender and the policy defining who can edit the NFTs.
+type MsgIssueDenom struct {
+	Sender     sdk.AccAddress
+	Denom      string
+	Schema     Schema
+	Minters    []sdk.AccAddress
+	EditPolicy string
+}
+
+// NewMsgIssueDenom is a constructor function for MsgIssueDenom
+func NewMsgIssueDenom(sender sdk.AccAddress, denom string, schema Schema,
+	minters []sdk.AccAddress, editPolicy string) MsgIssueDenom {
+
+	return MsgIssueDenom{
+		Sender:     sender,
+		Denom:      strings.TrimSpace(denom),
+		Schema:     schema,
+		Minters:    minters,
+		EditPolicy: strings.TrimSpace(editPolicy),
+	}
+}
+
+// Route Implements Msg
+func (msg MsgIssueDenom) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgIssueDenom) Type() string { return "issue_denom" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgIssueDenom) ValidateBasic() sdk.Error {
+	if strings.TrimSpace(msg.Denom) == "" {
+		return ErrInvalidCollection(DefaultCodespace)
+	}
+	if msg.Sender.Empty() {
+		return sdk.ErrInvalidAddress("invalid sender address")
+	}
+	if err := msg.Schema.Validate(); err != nil {
+		return ErrInvalidAttributes(DefaultCodespace, err.Error())
+	}
+	if err := ValidateMinters(msg.Minters); err != nil {
+		return sdk.ErrInvalidAddress(err.Error())
+	}
+	if !ValidEditPolicy(msg.EditPolicy) {
+		return ErrInvalidEditPolicy(DefaultCodespace, msg.EditPolicy)
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgIssueDenom) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgIssueDenom) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+/* --------------------------------------------------------------------------- */
+// MsgTransferDenom
+/* --------------------------------------------------------------------------- */
+
+// MsgTransferDenom transfers the ownership of an issued denom to a new creator
+type MsgTransferDenom struct {
+	Sender    sdk.AccAddress
+	Recipient sdk.AccAddress
+	Denom     string
+}
+
+// NewMsgTransferDenom is a constructor function for MsgTransferDenom
+func NewMsgTransferDenom(sender, recipient sdk.AccAddress, denom string) MsgTransferDenom {
+	return MsgTransferDenom{
+		Sender:    sender,
+		Recipient: recipient,
+		Denom:     strings.TrimSpace(denom),
+	}
+}
+
+// Route Implements Msg
+func (msg MsgTransferDenom) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgTransferDenom) Type() string { return "transfer_denom" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgTransferDenom) ValidateBasic() sdk.Error {
+	if strings.TrimSpace(msg.Denom) == "" {
+		return ErrInvalidCollection(DefaultCodespace)
+	}
+	if msg.Sender.Empty() {
+		return sdk.ErrInvalidAddress("invalid sender address")
+	}
+	if msg.Recipient.Empty() {
+		return sdk.ErrInvalidAddress("invalid recipient address")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgTransferDenom) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgTransferDenom) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+/* --------------------------------------------------------------------------- */
+// MsgApproveNFT
+/* --------------------------------------------------------------------------- */
+
+// MsgApproveNFT approves an address to transfer a single NFT on behalf of its
+// owner. The approval is cleared when the NFT is transferred.
+type MsgApproveNFT struct {
+	Sender   sdk.AccAddress
+	Approved sdk.AccAddress
+	Denom    string
+	ID       string
+}
+
+// NewMsgApproveNFT is a constructor function for MsgApproveNFT
+func NewMsgApproveNFT(sender, approved sdk.AccAddress, denom, id string) MsgApproveNFT {
+	return MsgApproveNFT{
+		Sender:   sender,
+		Approved: approved,
+		Denom:    strings.TrimSpace(denom),
+		ID:       strings.TrimSpace(id),
+	}
+}
+
+// Route Implements Msg
+func (msg MsgApproveNFT) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgApproveNFT) Type() string { return "approve_nft" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgApproveNFT) ValidateBasic() sdk.Error {
+	if strings.TrimSpace(msg.Denom) == "" {
+		return ErrInvalidCollection(DefaultCodespace)
+	}
+	if msg.Sender.Empty() {
+		return sdk.ErrInvalidAddress("invalid sender address")
+	}
+	if msg.Approved.Empty() {
+		return sdk.ErrInvalidAddress("invalid approved address")
+	}
+	if strings.TrimSpace(msg.ID) == "" {
+		return ErrInvalidNFT(DefaultCodespace, "")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgApproveNFT) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgApproveNFT) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+/* --------------------------------------------------------------------------- */
+// MsgSetApprovalForAll
+/* --------------------------------------------------------------------------- */
+
+// MsgSetApprovalForAll adds or removes an operator allowed to transfer and
+// approve all the NFTs of a collection held by the sender
+type MsgSetApprovalForAll struct {
+	Sender   sdk.AccAddress
+	Operator sdk.AccAddress
+	Denom    string
+	Approved bool
+}
+
+// NewMsgSetApprovalForAll is a constructor function for MsgSetApprovalForAll
+func NewMsgSetApprovalForAll(sender, operator sdk.AccAddress, denom string, approved bool) MsgSetApprovalForAll {
+	return MsgSetApprovalForAll{
+		Sender:   sender,
+		Operator: operator,
+		Denom:    strings.TrimSpace(denom),
+		Approved: approved,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgSetApprovalForAll) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgSetApprovalForAll) Type() string { return "set_approval_for_all" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgSetApprovalForAll) ValidateBasic() sdk.Error {
+	if strings.TrimSpace(msg.Denom) == "" {
+		return ErrInvalidCollection(DefaultCodespace)
+	}
+	if msg.Sender.Empty() {
+		return sdk.ErrInvalidAddress("invalid sender address")
+	}
+	if msg.Operator.Empty() {
+		return sdk.ErrInvalidAddress("invalid operator address")
+	}
+	if msg.Sender.Equals(msg.Operator) {
+		return ErrInvalidApproval(DefaultCodespace, "an owner can't be its own operator")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSetApprovalForAll) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSetApprovalForAll) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+/* --------------------------------------------------------------------------- */
+// MsgRevokeApproval
+/* --------------------------------------------------------------------------- */
+
+// MsgRevokeApproval clears the approved address of an NFT
+type MsgRevokeApproval struct {
+	Sender sdk.AccAddress
+	Denom  string
+	ID     string
+}
+
+// NewMsgRevokeApproval is a constructor function for MsgRevokeApproval
+func NewMsgRevokeApproval(sender sdk.AccAddress, denom, id string) MsgRevokeApproval {
+	return MsgRevokeApproval{
+		Sender: sender,
+		Denom:  strings.TrimSpace(denom),
+		ID:     strings.TrimSpace(id),
+	}
+}
+
+// Route Implements Msg
+func (msg MsgRevokeApproval) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgRevokeApproval) Type() string { return "revoke_approval" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgRevokeApproval) ValidateBasic() sdk.Error {
+	if strings.TrimSpace(msg.Denom) == "" {
+		return ErrInvalidCollection(DefaultCodespace)
+	}
+	if msg.Sender.Empty() {
+		return sdk.ErrInvalidAddress("invalid sender address")
+	}
+	if strings.TrimSpace(msg.ID) == "" {
+		return ErrInvalidNFT(DefaultCodespace, "")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgRevokeApproval) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgRevokeApproval) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
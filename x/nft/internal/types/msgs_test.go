@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // ---------------------------------------- Msgs ---------------------------------------------------
@@ -61,7 +63,7 @@ func TestNewMsgEditNFTMetadata(t *testing.T) {
 	newMsgEditNFTMetadata := NewMsgEditNFTMetadata(address,
 		fmt.Sprintf("     %s     ", id),
 		fmt.Sprintf("     %s     ", denom),
-		fmt.Sprintf("     %s     ", tokenURI))
+		fmt.Sprintf("     %s     ", tokenURI), nil)
 
 	require.Equal(t, newMsgEditNFTMetadata.Sender.String(), address.String())
 	require.Equal(t, newMsgEditNFTMetadata.ID, id)
@@ -71,26 +73,32 @@ func TestNewMsgEditNFTMetadata(t *testing.T) {
 
 func TestMsgEditNFTMetadataValidateBasicMethod(t *testing.T) {
 
-	newMsgEditNFTMetadata := NewMsgEditNFTMetadata(nil, id, denom, tokenURI)
+	newMsgEditNFTMetadata := NewMsgEditNFTMetadata(nil, id, denom, tokenURI, nil)
 
 	err := newMsgEditNFTMetadata.ValidateBasic()
 	require.Error(t, err)
 
-	newMsgEditNFTMetadata = NewMsgEditNFTMetadata(address, "", denom, tokenURI)
+	newMsgEditNFTMetadata = NewMsgEditNFTMetadata(address, "", denom, tokenURI, nil)
 	err = newMsgEditNFTMetadata.ValidateBasic()
 	require.Error(t, err)
 
-	newMsgEditNFTMetadata = NewMsgEditNFTMetadata(address, id, "", tokenURI)
+	newMsgEditNFTMetadata = NewMsgEditNFTMetadata(address, id, "", tokenURI, nil)
 	err = newMsgEditNFTMetadata.ValidateBasic()
 	require.Error(t, err)
 
-	newMsgEditNFTMetadata = NewMsgEditNFTMetadata(address, id, denom, tokenURI)
+	newMsgEditNFTMetadata = NewMsgEditNFTMetadata(address, id, denom, tokenURI, nil)
 	err = newMsgEditNFTMetadata.ValidateBasic()
 	require.NoError(t, err)
+
+	newMsgEditNFTMetadata.ClearTokenURI = true
+	err = newMsgEditNFTMetadata.ValidateBasic()
+	require.Error(t, err)
+	require.Equal(t, CodeInvalidNFT, err.Code())
+	require.Equal(t, DefaultCodespace, err.Codespace())
 }
 
 func TestMsgEditNFTMetadataGetSignBytesMethod(t *testing.T) {
-	newMsgEditNFTMetadata := NewMsgEditNFTMetadata(address, id, denom, tokenURI)
+	newMsgEditNFTMetadata := NewMsgEditNFTMetadata(address, id, denom, tokenURI, nil)
 	sortedBytes := newMsgEditNFTMetadata.GetSignBytes()
 	require.Equal(t, string(sortedBytes), fmt.Sprintf(`{"type":"cosmos-sdk/MsgEditNFTMetadata","value":{"Denom":"%s","ID":"%s","Sender":"%s","TokenURI":"%s"}}`,
 		denom, id, address.String(), tokenURI,
@@ -98,7 +106,7 @@ func TestMsgEditNFTMetadataGetSignBytesMethod(t *testing.T) {
 }
 
 func TestMsgEditNFTMetadataGetSignersMethod(t *testing.T) {
-	newMsgEditNFTMetadata := NewMsgEditNFTMetadata(address, id, denom, tokenURI)
+	newMsgEditNFTMetadata := NewMsgEditNFTMetadata(address, id, denom, tokenURI, nil)
 	signers := newMsgEditNFTMetadata.GetSigners()
 	require.Equal(t, 1, len(signers))
 	require.Equal(t, address.String(), signers[0].String())
@@ -108,7 +116,7 @@ func TestNewMsgMintNFT(t *testing.T) {
 	newMsgMintNFT := NewMsgMintNFT(address, address2,
 		fmt.Sprintf("     %s     ", id),
 		fmt.Sprintf("     %s     ", denom),
-		fmt.Sprintf("     %s     ", tokenURI))
+		fmt.Sprintf("     %s     ", tokenURI), nil)
 
 	require.Equal(t, newMsgMintNFT.Sender.String(), address.String())
 	require.Equal(t, newMsgMintNFT.Recipient.String(), address2.String())
@@ -119,29 +127,29 @@ func TestNewMsgMintNFT(t *testing.T) {
 
 func TestMsgMsgMintNFTValidateBasicMethod(t *testing.T) {
 
-	newMsgMintNFT := NewMsgMintNFT(nil, address2, id, denom, tokenURI)
+	newMsgMintNFT := NewMsgMintNFT(nil, address2, id, denom, tokenURI, nil)
 	err := newMsgMintNFT.ValidateBasic()
 	require.Error(t, err)
 
-	newMsgMintNFT = NewMsgMintNFT(address, nil, id, denom, tokenURI)
+	newMsgMintNFT = NewMsgMintNFT(address, nil, id, denom, tokenURI, nil)
 	err = newMsgMintNFT.ValidateBasic()
 	require.Error(t, err)
 
-	newMsgMintNFT = NewMsgMintNFT(address, address2, "", denom, tokenURI)
+	newMsgMintNFT = NewMsgMintNFT(address, address2, "", denom, tokenURI, nil)
 	err = newMsgMintNFT.ValidateBasic()
 	require.Error(t, err)
 
-	newMsgMintNFT = NewMsgMintNFT(address, address2, id, "", tokenURI)
+	newMsgMintNFT = NewMsgMintNFT(address, address2, id, "", tokenURI, nil)
 	err = newMsgMintNFT.ValidateBasic()
 	require.Error(t, err)
 
-	newMsgMintNFT = NewMsgMintNFT(address, address2, id, denom, tokenURI)
+	newMsgMintNFT = NewMsgMintNFT(address, address2, id, denom, tokenURI, nil)
 	err = newMsgMintNFT.ValidateBasic()
 	require.NoError(t, err)
 }
 
 func TestMsgMintNFTGetSignBytesMethod(t *testing.T) {
-	newMsgMintNFT := NewMsgMintNFT(address, address2, id, denom, tokenURI)
+	newMsgMintNFT := NewMsgMintNFT(address, address2, id, denom, tokenURI, nil)
 	sortedBytes := newMsgMintNFT.GetSignBytes()
 	require.Equal(t, string(sortedBytes), fmt.Sprintf(`{"type":"cosmos-sdk/MsgMintNFT","value":{"Denom":"%s","ID":"%s","Recipient":"%s","Sender":"%s","TokenURI":"%s"}}`,
 		denom, id, address2.String(), address.String(), tokenURI,
@@ -149,7 +157,7 @@ func TestMsgMintNFTGetSignBytesMethod(t *testing.T) {
 }
 
 func TestMsgMintNFTGetSignersMethod(t *testing.T) {
-	newMsgMintNFT := NewMsgMintNFT(address, address2, id, denom, tokenURI)
+	newMsgMintNFT := NewMsgMintNFT(address, address2, id, denom, tokenURI, nil)
 	signers := newMsgMintNFT.GetSigners()
 	require.Equal(t, 1, len(signers))
 	require.Equal(t, address.String(), signers[0].String())
@@ -198,3 +206,176 @@ func TestMsgBurnNFTGetSignersMethod(t *testing.T) {
 	require.Equal(t, 1, len(signers))
 	require.Equal(t, address.String(), signers[0].String())
 }
+
+func TestMsgMintNFTWithAttributes(t *testing.T) {
+	attrs := NewAttributes(NewAttribute("color", "red"))
+	newMsgMintNFT := NewMsgMintNFT(address, address2, id, denom, tokenURI, attrs)
+	require.NoError(t, newMsgMintNFT.ValidateBasic())
+
+	sortedBytes := newMsgMintNFT.GetSignBytes()
+	require.Equal(t, string(sortedBytes), fmt.Sprintf(`{"type":"cosmos-sdk/MsgMintNFT","value":{"Attributes":[{"key":"color","value":"red"}],"Denom":"%s","ID":"%s","Recipient":"%s","Sender":"%s","TokenURI":"%s"}}`,
+		denom, id, address2.String(), address.String(), tokenURI,
+	))
+
+	newMsgMintNFT = NewMsgMintNFT(address, address2, id, denom, tokenURI, Attributes{NewAttribute("", "red")})
+	require.Error(t, newMsgMintNFT.ValidateBasic())
+}
+
+func TestNewMsgIssueDenom(t *testing.T) {
+	newMsgIssueDenom := NewMsgIssueDenom(address, fmt.Sprintf("     %s     ", denom), testSchema(),
+		[]sdk.AccAddress{address2}, fmt.Sprintf("  %s  ", EditPolicyCreator))
+
+	require.Equal(t, newMsgIssueDenom.Sender.String(), address.String())
+	require.Equal(t, newMsgIssueDenom.Denom, denom)
+	require.Equal(t, newMsgIssueDenom.Schema, testSchema())
+	require.Equal(t, newMsgIssueDenom.Minters, []sdk.AccAddress{address2})
+	require.Equal(t, newMsgIssueDenom.EditPolicy, EditPolicyCreator)
+}
+
+func TestMsgIssueDenomValidateBasicMethod(t *testing.T) {
+	newMsgIssueDenom := NewMsgIssueDenom(nil, denom, testSchema(), nil, EditPolicyOwner)
+	err := newMsgIssueDenom.ValidateBasic()
+	require.Error(t, err)
+
+	newMsgIssueDenom = NewMsgIssueDenom(address, "", testSchema(), nil, EditPolicyOwner)
+	err = newMsgIssueDenom.ValidateBasic()
+	require.Error(t, err)
+
+	newMsgIssueDenom = NewMsgIssueDenom(address, denom, NewSchema(NewAttributeDefinition("color", "color", false, false)), nil, EditPolicyOwner)
+	err = newMsgIssueDenom.ValidateBasic()
+	require.Error(t, err)
+
+	newMsgIssueDenom = NewMsgIssueDenom(address, denom, NewSchema(), nil, EditPolicyOwner)
+	err = newMsgIssueDenom.ValidateBasic()
+	require.NoError(t, err)
+
+	newMsgIssueDenom = NewMsgIssueDenom(address, denom, testSchema(), nil, EditPolicyOwner)
+	err = newMsgIssueDenom.ValidateBasic()
+	require.NoError(t, err)
+
+	newMsgIssueDenom = NewMsgIssueDenom(address, denom, testSchema(), nil, "anyone")
+	err = newMsgIssueDenom.ValidateBasic()
+	require.Error(t, err)
+
+	newMsgIssueDenom = NewMsgIssueDenom(address, denom, testSchema(), []sdk.AccAddress{address2, address2}, EditPolicyOwner)
+	err = newMsgIssueDenom.ValidateBasic()
+	require.Error(t, err)
+
+	newMsgIssueDenom = NewMsgIssueDenom(address, denom, testSchema(), []sdk.AccAddress{address2, address3}, EditPolicyCreator)
+	err = newMsgIssueDenom.ValidateBasic()
+	require.NoError(t, err)
+}
+
+func TestMsgIssueDenomGetSignersMethod(t *testing.T) {
+	newMsgIssueDenom := NewMsgIssueDenom(address, denom, testSchema(), nil, EditPolicyOwner)
+	signers := newMsgIssueDenom.GetSigners()
+	require.Equal(t, 1, len(signers))
+	require.Equal(t, address.String(), signers[0].String())
+}
+
+func TestNewMsgTransferDenom(t *testing.T) {
+	newMsgTransferDenom := NewMsgTransferDenom(address, address2, fmt.Sprintf("     %s     ", denom))
+
+	require.Equal(t, newMsgTransferDenom.Sender.String(), address.String())
+	require.Equal(t, newMsgTransferDenom.Recipient.String(), address2.String())
+	require.Equal(t, newMsgTransferDenom.Denom, denom)
+	require.Equal(t, newMsgTransferDenom.Type(), "transfer_denom")
+}
+
+func TestMsgTransferDenomValidateBasicMethod(t *testing.T) {
+	newMsgTransferDenom := NewMsgTransferDenom(nil, address2, denom)
+	require.Error(t, newMsgTransferDenom.ValidateBasic())
+
+	newMsgTransferDenom = NewMsgTransferDenom(address, nil, denom)
+	require.Error(t, newMsgTransferDenom.ValidateBasic())
+
+	newMsgTransferDenom = NewMsgTransferDenom(address, address2, "")
+	require.Error(t, newMsgTransferDenom.ValidateBasic())
+
+	newMsgTransferDenom = NewMsgTransferDenom(address, address2, denom)
+	require.NoError(t, newMsgTransferDenom.ValidateBasic())
+}
+
+func TestMsgTransferDenomGetSignersMethod(t *testing.T) {
+	newMsgTransferDenom := NewMsgTransferDenom(address, address2, denom)
+	signers := newMsgTransferDenom.GetSigners()
+	require.Equal(t, 1, len(signers))
+	require.Equal(t, address.String(), signers[0].String())
+}
+
+func TestNewMsgApproveNFT(t *testing.T) {
+	newMsgApproveNFT := NewMsgApproveNFT(address, address2, fmt.Sprintf("     %s     ", denom), fmt.Sprintf("     %s     ", id))
+
+	require.Equal(t, newMsgApproveNFT.Sender.String(), address.String())
+	require.Equal(t, newMsgApproveNFT.Approved.String(), address2.String())
+	require.Equal(t, newMsgApproveNFT.Denom, denom)
+	require.Equal(t, newMsgApproveNFT.ID, id)
+	require.Equal(t, newMsgApproveNFT.Type(), "approve_nft")
+}
+
+func TestMsgApproveNFTValidateBasicMethod(t *testing.T) {
+	newMsgApproveNFT := NewMsgApproveNFT(nil, address2, denom, id)
+	require.Error(t, newMsgApproveNFT.ValidateBasic())
+
+	newMsgApproveNFT = NewMsgApproveNFT(address, nil, denom, id)
+	require.Error(t, newMsgApproveNFT.ValidateBasic())
+
+	newMsgApproveNFT = NewMsgApproveNFT(address, address2, "", id)
+	require.Error(t, newMsgApproveNFT.ValidateBasic())
+
+	newMsgApproveNFT = NewMsgApproveNFT(address, address2, denom, "")
+	require.Error(t, newMsgApproveNFT.ValidateBasic())
+
+	newMsgApproveNFT = NewMsgApproveNFT(address, address2, denom, id)
+	require.NoError(t, newMsgApproveNFT.ValidateBasic())
+}
+
+func TestNewMsgSetApprovalForAll(t *testing.T) {
+	newMsgSetApprovalForAll := NewMsgSetApprovalForAll(address, address2, fmt.Sprintf("     %s     ", denom), true)
+
+	require.Equal(t, newMsgSetApprovalForAll.Sender.String(), address.String())
+	require.Equal(t, newMsgSetApprovalForAll.Operator.String(), address2.String())
+	require.Equal(t, newMsgSetApprovalForAll.Denom, denom)
+	require.True(t, newMsgSetApprovalForAll.Approved)
+	require.Equal(t, newMsgSetApprovalForAll.Type(), "set_approval_for_all")
+}
+
+func TestMsgSetApprovalForAllValidateBasicMethod(t *testing.T) {
+	newMsgSetApprovalForAll := NewMsgSetApprovalForAll(nil, address2, denom, true)
+	require.Error(t, newMsgSetApprovalForAll.ValidateBasic())
+
+	newMsgSetApprovalForAll = NewMsgSetApprovalForAll(address, nil, denom, true)
+	require.Error(t, newMsgSetApprovalForAll.ValidateBasic())
+
+	newMsgSetApprovalForAll = NewMsgSetApprovalForAll(address, address2, "", true)
+	require.Error(t, newMsgSetApprovalForAll.ValidateBasic())
+
+	newMsgSetApprovalForAll = NewMsgSetApprovalForAll(address, address, denom, true)
+	require.Error(t, newMsgSetApprovalForAll.ValidateBasic())
+
+	newMsgSetApprovalForAll = NewMsgSetApprovalForAll(address, address2, denom, false)
+	require.NoError(t, newMsgSetApprovalForAll.ValidateBasic())
+}
+
+func TestNewMsgRevokeApproval(t *testing.T) {
+	newMsgRevokeApproval := NewMsgRevokeApproval(address, fmt.Sprintf("     %s     ", denom), fmt.Sprintf("     %s     ", id))
+
+	require.Equal(t, newMsgRevokeApproval.Sender.String(), address.String())
+	require.Equal(t, newMsgRevokeApproval.Denom, denom)
+	require.Equal(t, newMsgRevokeApproval.ID, id)
+	require.Equal(t, newMsgRevokeApproval.Type(), "revoke_approval")
+}
+
+func TestMsgRevokeApprovalValidateBasicMethod(t *testing.T) {
+	newMsgRevokeApproval := NewMsgRevokeApproval(nil, denom, id)
+	require.Error(t, newMsgRevokeApproval.ValidateBasic())
+
+	newMsgRevokeApproval = NewMsgRevokeApproval(address, "", id)
+	require.Error(t, newMsgRevokeApproval.ValidateBasic())
+
+	newMsgRevokeApproval = NewMsgRevokeApproval(address, denom, "")
+	require.Error(t, newMsgRevokeApproval.ValidateBasic())
+
+	newMsgRevokeApproval = NewMsgRevokeApproval(address, denom, id)
+	require.NoError(t, newMsgRevokeApproval.ValidateBasic())
+}
@@ -14,9 +14,10 @@ var _ exported.NFT = (*BaseNFT)(nil)
 
 // BaseNFT non fungible token definition
 type BaseNFT struct {
-	ID       string         `json:"id,omitempty" yaml:"id"`     // id of the token; not exported to clients
-	Owner    sdk.AccAddress `json:"owner" yaml:"owner"`         // account address that owns the NFT
-	TokenURI string         `json:"token_uri" yaml:"token_uri"` // optional extra properties available for querying
+	ID         string         `json:"id,omitempty" yaml:"id"`                 // id of the token; not exported to clients
+	Owner      sdk.AccAddress `json:"owner" yaml:"owner"`                     // account address that owns the NFT
+	TokenURI   string         `json:"token_uri" yaml:"token_uri"`             // optional extra properties available for querying
+	Attributes Attributes     `json:"attributes,omitempty" yaml:"attributes"` // on-chain properties declared by the collection schema
 }
 
 // NewBaseNFT creates a new NFT instance
@@ -47,14 +48,33 @@ func (bnft *BaseNFT) EditMetadata(tokenURI string) {
 	bnft.TokenURI = tokenURI
 }
 
+// GetAttribute returns the value of an on-chain attribute
+func (bnft BaseNFT) GetAttribute(key string) (string, bool) {
+	return bnft.Attributes.Get(key)
+}
+
+// GetAttributes returns a copy of the on-chain attributes
+func (bnft BaseNFT) GetAttributes() map[string]string {
+	return bnft.Attributes.Map()
+}
+
+// EditAttributes adds or replaces the given on-chain attributes
+func (bnft *BaseNFT) EditAttributes(attributes map[string]string) {
+	bnft.Attributes = bnft.Attributes.Merge(NewAttributesFromMap(attributes))
+}
+
 func (bnft BaseNFT) String() string {
-	return fmt.Sprintf(`ID:				%s
+	out := fmt.Sprintf(`ID:				%s
 Owner:			%s
 TokenURI:		%s`,
 		bnft.ID,
 		bnft.Owner,
 		bnft.TokenURI,
 	)
+	if !bnft.Attributes.Empty() {
+		out += fmt.Sprintf("\nAttributes:		%s", bnft.Attributes)
+	}
+	return out
 }
 
 // ----------------------------------------------------------------------------
@@ -153,6 +173,7 @@ func (nfts NFTs) MarshalJSON() ([]byte, error) {
 	for _, nft := range nfts {
 		id := nft.GetID()
 		bnft := NewBaseNFT(id, nft.GetOwner(), nft.GetTokenURI())
+		bnft.EditAttributes(nft.GetAttributes())
 		nftJSON[id] = bnft
 	}
 	return json.Marshal(nftJSON)
@@ -167,6 +188,7 @@ func (nfts *NFTs) UnmarshalJSON(b []byte) error {
 
 	for id, nft := range nftJSON {
 		bnft := NewBaseNFT(id, nft.GetOwner(), nft.GetTokenURI())
+		bnft.EditAttributes(nft.GetAttributes())
 		*nfts = append(*nfts, &bnft)
 	}
 	return nil
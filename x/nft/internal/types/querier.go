@@ -3,14 +3,29 @@ package types
 // DONTCOVER
 
 import (
+	"fmt"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// DefaultQueryLimit is the number of NFTs returned by a paginated query when
+// no limit is provided
+const DefaultQueryLimit = 100
+
 // QueryCollectionParams defines the params for queries:
 // - 'custom/nft/supply'
 // - 'custom/nft/collection'
+// - 'custom/nft/denom'
+//
+// The collection query is paginated. When a StartKey is provided the page
+// starts at that key and Page is ignored.
 type QueryCollectionParams struct {
-	Denom string
+	Denom    string
+	Page     int
+	Limit    int
+	StartKey cmn.HexBytes
 }
 
 // NewQueryCollectionParams creates a new instance of QuerySupplyParams
@@ -18,15 +33,30 @@ func NewQueryCollectionParams(denom string) QueryCollectionParams {
 	return QueryCollectionParams{Denom: denom}
 }
 
+// NewQueryCollectionPageParams creates a new instance of QueryCollectionParams
+// for a page of a collection
+func NewQueryCollectionPageParams(denom string, page, limit int, startKey cmn.HexBytes) QueryCollectionParams {
+	return QueryCollectionParams{
+		Denom:    denom,
+		Page:     page,
+		Limit:    limit,
+		StartKey: startKey,
+	}
+}
+
 // Bytes exports the Denom as bytes
 func (q QueryCollectionParams) Bytes() []byte {
 	return []byte(q.Denom)
 }
 
-// QueryBalanceParams params for query 'custom/nfts/balance'
+// QueryBalanceParams params for query 'custom/nfts/balance'. The query is
+// paginated the same way as the collection query.
 type QueryBalanceParams struct {
-	Owner sdk.AccAddress
-	Denom string // optional
+	Owner    sdk.AccAddress
+	Denom    string // optional
+	Page     int
+	Limit    int
+	StartKey cmn.HexBytes
 }
 
 // NewQueryBalanceParams creates a new instance of QuerySupplyParams
@@ -40,7 +70,21 @@ func NewQueryBalanceParams(owner sdk.AccAddress, denom ...string) QueryBalancePa
 	return QueryBalanceParams{Owner: owner}
 }
 
-// QueryNFTParams params for query 'custom/nfts/nft'
+// NewQueryBalancePageParams creates a new instance of QueryBalanceParams for a
+// page of the NFTs held by an owner. The denom is optional.
+func NewQueryBalancePageParams(owner sdk.AccAddress, denom string, page, limit int, startKey cmn.HexBytes) QueryBalanceParams {
+	return QueryBalanceParams{
+		Owner:    owner,
+		Denom:    denom,
+		Page:     page,
+		Limit:    limit,
+		StartKey: startKey,
+	}
+}
+
+// QueryNFTParams params for queries:
+// - 'custom/nfts/nft'
+// - 'custom/nfts/approved'
 type QueryNFTParams struct {
 	Denom   string
 	TokenID string
@@ -53,3 +97,93 @@ func NewQueryNFTParams(denom, id string) QueryNFTParams {
 		TokenID: id,
 	}
 }
+
+// QueryOperatorsParams params for query 'custom/nfts/operators'
+type QueryOperatorsParams struct {
+	Owner sdk.AccAddress
+	Denom string // optional
+}
+
+// NewQueryOperatorsParams creates a new instance of QueryOperatorsParams
+func NewQueryOperatorsParams(owner sdk.AccAddress, denom string) QueryOperatorsParams {
+	return QueryOperatorsParams{
+		Owner: owner,
+		Denom: denom,
+	}
+}
+
+// QueryAttributeParams params for query 'custom/nfts/nftsByAttribute'. The
+// query is paginated the same way as the collection query.
+type QueryAttributeParams struct {
+	Denom    string
+	Key      string
+	Value    string
+	Page     int
+	Limit    int
+	StartKey cmn.HexBytes
+}
+
+// NewQueryAttributeParams creates a new instance of QueryAttributeParams
+func NewQueryAttributeParams(denom, key, value string) QueryAttributeParams {
+	return QueryAttributeParams{
+		Denom: denom,
+		Key:   key,
+		Value: value,
+	}
+}
+
+// NewQueryAttributePageParams creates a new instance of QueryAttributeParams
+// for a page of the matching NFTs
+func NewQueryAttributePageParams(denom, key, value string, page, limit int, startKey cmn.HexBytes,
+) QueryAttributeParams {
+	return QueryAttributeParams{
+		Denom:    denom,
+		Key:      key,
+		Value:    value,
+		Page:     page,
+		Limit:    limit,
+		StartKey: startKey,
+	}
+}
+
+// QueryCollectionResponse is the result of a paginated collection query.
+// NextKey is the start key of the next page and is empty on the last page.
+type QueryCollectionResponse struct {
+	Collection Collection   `json:"collection" yaml:"collection"`
+	NextKey    cmn.HexBytes `json:"next_key" yaml:"next_key"`
+}
+
+// NewQueryCollectionResponse creates a new instance of QueryCollectionResponse
+func NewQueryCollectionResponse(collection Collection, nextKey cmn.HexBytes) QueryCollectionResponse {
+	return QueryCollectionResponse{
+		Collection: collection,
+		NextKey:    nextKey,
+	}
+}
+
+// String implements the Stringer interface
+func (res QueryCollectionResponse) String() string {
+	return fmt.Sprintf(`%s
+Next Key:	%s`, res.Collection, res.NextKey)
+}
+
+// QueryOwnerResponse is the result of a paginated owner query. NextKey is the
+// start key of the next page and is empty on the last page.
+type QueryOwnerResponse struct {
+	Owner   Owner        `json:"owner" yaml:"owner"`
+	NextKey cmn.HexBytes `json:"next_key" yaml:"next_key"`
+}
+
+// NewQueryOwnerResponse creates a new instance of QueryOwnerResponse
+func NewQueryOwnerResponse(owner Owner, nextKey cmn.HexBytes) QueryOwnerResponse {
+	return QueryOwnerResponse{
+		Owner:   owner,
+		NextKey: nextKey,
+	}
+}
+
+// String implements the Stringer interface
+func (res QueryOwnerResponse) String() string {
+	return fmt.Sprintf(`%s
+Next Key:	%s`, res.Owner, res.NextKey)
+}
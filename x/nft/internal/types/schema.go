@@ -0,0 +1,172 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Supported attribute value types
+const (
+	AttributeTypeString = "string"
+	AttributeTypeInt    = "int"
+	AttributeTypeBool   = "bool"
+	AttributeTypeDec    = "dec"
+)
+
+// ValidAttributeType returns true if the attribute type is supported
+func ValidAttributeType(ty string) bool {
+	switch ty {
+	case AttributeTypeString, AttributeTypeInt, AttributeTypeBool, AttributeTypeDec:
+		return true
+	default:
+		return false
+	}
+}
+
+// AttributeDefinition declares a single attribute of a collection's schema
+type AttributeDefinition struct {
+	Name     string `json:"name" yaml:"name"`         // key of the attribute
+	Type     string `json:"type" yaml:"type"`         // value type of the attribute
+	Mutable  bool   `json:"mutable" yaml:"mutable"`   // whether the attribute can be edited after minting
+	Required bool   `json:"required" yaml:"required"` // whether the attribute must be set on mint
+}
+
+// NewAttributeDefinition creates a new AttributeDefinition instance
+func NewAttributeDefinition(name, ty string, mutable, required bool) AttributeDefinition {
+	return AttributeDefinition{
+		Name:     strings.TrimSpace(name),
+		Type:     strings.TrimSpace(ty),
+		Mutable:  mutable,
+		Required: required,
+	}
+}
+
+// ValidateValue checks that the value is of the declared type
+func (ad AttributeDefinition) ValidateValue(value string) error {
+	var err error
+	switch ad.Type {
+	case AttributeTypeString:
+	case AttributeTypeInt:
+		if _, ok := sdk.NewIntFromString(value); !ok {
+			err = fmt.Errorf("%s is not an integer", value)
+		}
+	case AttributeTypeBool:
+		_, err = strconv.ParseBool(value)
+	case AttributeTypeDec:
+		_, err = sdk.NewDecFromStr(value)
+	default:
+		err = fmt.Errorf("unknown attribute type %s", ad.Type)
+	}
+
+	if err != nil {
+		return fmt.Errorf("invalid value for attribute %s of type %s: %s", ad.Name, ad.Type, err)
+	}
+	return nil
+}
+
+// String implements the Stringer interface
+func (ad AttributeDefinition) String() string {
+	return fmt.Sprintf("%s (%s, mutable: %t, required: %t)", ad.Name, ad.Type, ad.Mutable, ad.Required)
+}
+
+// ----------------------------------------------------------------------------
+// Schema
+
+// Schema declares the attributes that the NFTs of a collection can hold
+type Schema []AttributeDefinition
+
+// NewSchema creates a new Schema instance
+func NewSchema(defs ...AttributeDefinition) Schema {
+	if len(defs) == 0 {
+		return Schema{}
+	}
+	return Schema(defs)
+}
+
+// Find returns the definition of the attribute with the given name
+func (schema Schema) Find(name string) (AttributeDefinition, bool) {
+	for _, def := range schema {
+		if def.Name == name {
+			return def, true
+		}
+	}
+	return AttributeDefinition{}, false
+}
+
+// Validate performs a basic validation of the schema definitions
+func (schema Schema) Validate() error {
+	seen := make(map[string]bool)
+	for _, def := range schema {
+		if def.Name == "" || strings.TrimSpace(def.Name) != def.Name {
+			return fmt.Errorf("invalid attribute name %q", def.Name)
+		}
+		if strings.ContainsAny(def.Name, ",=") {
+			return fmt.Errorf("attribute name %s cannot contain ',' or '='", def.Name)
+		}
+		if !ValidAttributeType(def.Type) {
+			return fmt.Errorf("invalid type %q for attribute %s", def.Type, def.Name)
+		}
+		if seen[def.Name] {
+			return fmt.Errorf("duplicate attribute %s", def.Name)
+		}
+		seen[def.Name] = true
+	}
+	return nil
+}
+
+// ValidateAttributes checks that a full set of attributes of a newly minted
+// NFT matches the schema: every attribute is declared, has a value of the
+// declared type and all the required attributes are present.
+func (schema Schema) ValidateAttributes(attrs Attributes) error {
+	if err := schema.validateDeclared(attrs); err != nil {
+		return err
+	}
+	for _, def := range schema {
+		if _, found := attrs.Get(def.Name); def.Required && !found {
+			return fmt.Errorf("missing required attribute %s", def.Name)
+		}
+	}
+	return nil
+}
+
+// ValidateEdit checks that a set of attribute changes matches the schema and
+// only touches mutable attributes.
+func (schema Schema) ValidateEdit(attrs Attributes) error {
+	if err := schema.validateDeclared(attrs); err != nil {
+		return err
+	}
+	for _, attr := range attrs {
+		if def, _ := schema.Find(attr.Key); !def.Mutable {
+			return fmt.Errorf("attribute %s is immutable", attr.Key)
+		}
+	}
+	return nil
+}
+
+func (schema Schema) validateDeclared(attrs Attributes) error {
+	if err := attrs.Validate(); err != nil {
+		return err
+	}
+	for _, attr := range attrs {
+		def, found := schema.Find(attr.Key)
+		if !found {
+			return fmt.Errorf("attribute %s is not declared in the schema", attr.Key)
+		}
+		if err := def.ValidateValue(attr.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// String implements the Stringer interface
+func (schema Schema) String() string {
+	strs := make([]string, len(schema))
+	for i, def := range schema {
+		strs[i] = def.String()
+	}
+	return strings.Join(strs, "\n")
+}
@@ -0,0 +1,107 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testSchema() Schema {
+	return NewSchema(
+		NewAttributeDefinition("color", AttributeTypeString, false, true),
+		NewAttributeDefinition("level", AttributeTypeInt, true, false),
+		NewAttributeDefinition("shiny", AttributeTypeBool, true, false),
+		NewAttributeDefinition("weight", AttributeTypeDec, false, false),
+	)
+}
+
+func TestAttributes(t *testing.T) {
+	attrs := NewAttributes(NewAttribute("level", "1"), NewAttribute("color", "red"), NewAttribute("level", "2"))
+	require.NoError(t, attrs.Validate())
+	require.Equal(t, "color=red,level=2", attrs.String())
+
+	value, found := attrs.Get("level")
+	require.True(t, found)
+	require.Equal(t, "2", value)
+
+	_, found = attrs.Get("shiny")
+	require.False(t, found)
+
+	merged := attrs.Merge(NewAttributes(NewAttribute("shiny", "true"), NewAttribute("color", "blue")))
+	require.Equal(t, "color=blue,level=2,shiny=true", merged.String())
+	// the original set is left untouched
+	require.Equal(t, "color=red,level=2", attrs.String())
+
+	require.Equal(t, merged, NewAttributesFromMap(merged.Map()))
+
+	unsorted := Attributes{NewAttribute("level", "2"), NewAttribute("color", "red")}
+	require.Error(t, unsorted.Validate())
+	duplicated := Attributes{NewAttribute("color", "red"), NewAttribute("color", "blue")}
+	require.Error(t, duplicated.Validate())
+}
+
+func TestParseAttributes(t *testing.T) {
+	attrs, err := ParseAttributes("")
+	require.NoError(t, err)
+	require.True(t, attrs.Empty())
+
+	attrs, err = ParseAttributes("level=4, color = red")
+	require.NoError(t, err)
+	require.Equal(t, "color=red,level=4", attrs.String())
+
+	_, err = ParseAttributes("level")
+	require.Error(t, err)
+
+	_, err = ParseAttributes("=4")
+	require.Error(t, err)
+}
+
+func TestSchemaValidate(t *testing.T) {
+	require.NoError(t, testSchema().Validate())
+	require.NoError(t, NewSchema().Validate())
+
+	require.Error(t, NewSchema(NewAttributeDefinition("", AttributeTypeString, false, false)).Validate())
+	require.Error(t, NewSchema(NewAttributeDefinition("a=b", AttributeTypeString, false, false)).Validate())
+	require.Error(t, NewSchema(NewAttributeDefinition("color", "color", false, false)).Validate())
+	require.Error(t, NewSchema(
+		NewAttributeDefinition("color", AttributeTypeString, false, false),
+		NewAttributeDefinition("color", AttributeTypeInt, false, false),
+	).Validate())
+}
+
+func TestSchemaValidateAttributes(t *testing.T) {
+	schema := testSchema()
+
+	testCases := []struct {
+		attrs   Attributes
+		expPass bool
+	}{
+		{NewAttributes(NewAttribute("color", "red")), true},
+		{NewAttributes(NewAttribute("color", "red"), NewAttribute("level", "-3"), NewAttribute("shiny", "false"), NewAttribute("weight", "1.5")), true},
+		{NewAttributes(), false},                                                        // missing required attribute
+		{NewAttributes(NewAttribute("level", "1")), false},                              // missing required attribute
+		{NewAttributes(NewAttribute("color", "red"), NewAttribute("size", "1")), false}, // undeclared attribute
+		{NewAttributes(NewAttribute("color", "red"), NewAttribute("level", "1.5")), false},
+		{NewAttributes(NewAttribute("color", "red"), NewAttribute("shiny", "maybe")), false},
+		{NewAttributes(NewAttribute("color", "red"), NewAttribute("weight", "heavy")), false},
+	}
+
+	for i, tc := range testCases {
+		err := schema.ValidateAttributes(tc.attrs)
+		if tc.expPass {
+			require.NoError(t, err, "tc #%d", i)
+		} else {
+			require.Error(t, err, "tc #%d", i)
+		}
+	}
+}
+
+func TestSchemaValidateEdit(t *testing.T) {
+	schema := testSchema()
+
+	require.NoError(t, schema.ValidateEdit(NewAttributes()))
+	require.NoError(t, schema.ValidateEdit(NewAttributes(NewAttribute("level", "5"), NewAttribute("shiny", "true"))))
+	require.Error(t, schema.ValidateEdit(NewAttributes(NewAttribute("color", "blue"))))
+	require.Error(t, schema.ValidateEdit(NewAttributes(NewAttribute("level", "five"))))
+	require.Error(t, schema.ValidateEdit(NewAttributes(NewAttribute("size", "1"))))
+}
@@ -0,0 +1,20 @@
+package v037
+
+import (
+	"encoding/json"
+)
+
+// DONTCOVER
+
+// nolint
+const (
+	ModuleName = "nft"
+)
+
+// GenesisState is the NFT genesis state prior to per-token storage, where the
+// owners were exported alongside the collections.
+type GenesisState struct {
+	Owners      json.RawMessage `json:"owners"`
+	Collections json.RawMessage `json:"collections"`
+	Denoms      json.RawMessage `json:"denoms,omitempty"`
+}
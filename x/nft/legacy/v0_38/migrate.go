@@ -0,0 +1,25 @@
+package v038
+
+import (
+	"encoding/json"
+
+	v037nft "github.com/cosmos/cosmos-sdk/x/nft/legacy/v0_37"
+)
+
+// Migrate accepts exported genesis state from v0.37 and migrates it to v0.38
+// genesis state. The owners are dropped as they are rebuilt from the
+// collections when the NFTs are stored under their own keys, and no NFT
+// transfer approvals exist yet.
+func Migrate(oldGenState v037nft.GenesisState) GenesisState {
+	collections := oldGenState.Collections
+	if len(collections) == 0 || string(collections) == "null" {
+		collections = json.RawMessage(`[]`)
+	}
+
+	denoms := oldGenState.Denoms
+	if len(denoms) == 0 || string(denoms) == "null" {
+		denoms = json.RawMessage(`[]`)
+	}
+
+	return NewGenesisState(collections, denoms, json.RawMessage(`[]`), json.RawMessage(`[]`))
+}
@@ -0,0 +1,35 @@
+package v038
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	v037nft "github.com/cosmos/cosmos-sdk/x/nft/legacy/v0_37"
+)
+
+func TestMigrate(t *testing.T) {
+	cdc := codec.New()
+
+	rawCollections := `[{"denom":"crypto-kitties","nfts":{"1":{"type":"cosmos-sdk/BaseNFT","value":{"id":"1","owner":"cosmos1dfp05pasnts7a4lupn889vptjtrxzkk5f7027f","token_uri":"https://google.com/token-1"}}}}]`
+	rawOwners := `[{"address":"cosmos1dfp05pasnts7a4lupn889vptjtrxzkk5f7027f","idCollections":[{"denom":"crypto-kitties","ids":["1"]}]}]`
+
+	var oldGenState v037nft.GenesisState
+	cdc.MustUnmarshalJSON([]byte(`{"owners":`+rawOwners+`,"collections":`+rawCollections+`}`), &oldGenState)
+
+	genState := Migrate(oldGenState)
+	require.JSONEq(t, rawCollections, string(genState.Collections))
+	require.JSONEq(t, `[]`, string(genState.Denoms))
+	require.JSONEq(t, `[]`, string(genState.Approvals))
+	require.JSONEq(t, `[]`, string(genState.Operators))
+
+	bz := cdc.MustMarshalJSON(genState)
+
+	var out map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(bz, &out))
+	require.NotContains(t, out, "owners")
+	require.Contains(t, out, "collections")
+	require.Contains(t, out, "denoms")
+}
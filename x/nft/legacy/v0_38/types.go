@@ -0,0 +1,28 @@
+package v038
+
+import (
+	"encoding/json"
+)
+
+// DONTCOVER
+
+// nolint
+const (
+	ModuleName = "nft"
+)
+
+type GenesisState struct {
+	Collections json.RawMessage `json:"collections"`
+	Denoms      json.RawMessage `json:"denoms"`
+	Approvals   json.RawMessage `json:"approvals"`
+	Operators   json.RawMessage `json:"operators"`
+}
+
+func NewGenesisState(collections, denoms, approvals, operators json.RawMessage) GenesisState {
+	return GenesisState{
+		Collections: collections,
+		Denoms:      denoms,
+		Approvals:   approvals,
+		Operators:   operators,
+	}
+}
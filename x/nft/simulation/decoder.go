@@ -7,6 +7,7 @@ import (
 	cmn "github.com/tendermint/tendermint/libs/common"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/nft/exported"
 	"github.com/cosmos/cosmos-sdk/x/nft/internal/types"
 )
 
@@ -14,16 +15,41 @@ import (
 func DecodeStore(cdc *codec.Codec, kvA, kvB cmn.KVPair) string {
 	switch {
 	case bytes.Equal(kvA.Key[:1], types.CollectionsKeyPrefix):
-		var collectionA, collectionB types.Collection
-		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &collectionA)
-		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &collectionB)
-		return fmt.Sprintf("%v\n%v", collectionA, collectionB)
+		return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
 
 	case bytes.Equal(kvA.Key[:1], types.OwnersKeyPrefix):
-		var idCollectionA, idCollectionB types.IDCollection
-		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &idCollectionA)
-		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &idCollectionB)
-		return fmt.Sprintf("%v\n%v", idCollectionA, idCollectionB)
+		ownerA, _, idA := types.SplitOwnerKey(kvA.Key)
+		ownerB, _, idB := types.SplitOwnerKey(kvB.Key)
+		return fmt.Sprintf("%s %s #%s\n%s %s #%s", ownerA, kvA.Value, idA, ownerB, kvB.Value, idB)
+
+	case bytes.Equal(kvA.Key[:1], types.DenomsKeyPrefix):
+		var denomA, denomB types.Denom
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &denomA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &denomB)
+		return fmt.Sprintf("%v\n%v", denomA, denomB)
+
+	case bytes.Equal(kvA.Key[:1], types.NFTsKeyPrefix):
+		var nftA, nftB exported.NFT
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &nftA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &nftB)
+		return fmt.Sprintf("%v\n%v", nftA, nftB)
+
+	case bytes.Equal(kvA.Key[:1], types.SupplyKeyPrefix):
+		var supplyA, supplyB int
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &supplyA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &supplyB)
+		return fmt.Sprintf("%d\n%d", supplyA, supplyB)
+
+	case bytes.Equal(kvA.Key[:1], types.ApprovalsKeyPrefix):
+		var approvalA, approvalB types.Approval
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &approvalA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &approvalB)
+		return fmt.Sprintf("%v\n%v", approvalA, approvalB)
+
+	case bytes.Equal(kvA.Key[:1], types.OperatorsKeyPrefix):
+		ownerA, _, operatorA := types.SplitOperatorKey(kvA.Key)
+		ownerB, _, operatorB := types.SplitOperatorKey(kvB.Key)
+		return fmt.Sprintf("%s %s %s\n%s %s %s", ownerA, kvA.Value, operatorA, ownerB, kvB.Value, operatorB)
 
 	default:
 		panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
@@ -29,12 +29,17 @@ func makeTestCodec() (cdc *codec.Codec) {
 func TestDecodeStore(t *testing.T) {
 	cdc := makeTestCodec()
 	nft := types.NewBaseNFT("1", addr, "token URI")
-	collection := types.NewCollection("kitties", types.NFTs{&nft})
-	idCollection := types.NewIDCollection("kitties", []string{"1", "2", "3"})
+	denom := types.NewDenom("kitties", addr, types.NewSchema(), nil, types.DefaultEditPolicy)
+	approval := types.NewApproval("kitties", "1", addr)
 
 	kvPairs := cmn.KVPairs{
-		cmn.KVPair{Key: types.GetCollectionKey("kitties"), Value: cdc.MustMarshalBinaryLengthPrefixed(collection)},
-		cmn.KVPair{Key: types.GetOwnerKey(addr, "kitties"), Value: cdc.MustMarshalBinaryLengthPrefixed(idCollection)},
+		cmn.KVPair{Key: types.GetCollectionKey("kitties"), Value: []byte("kitties")},
+		cmn.KVPair{Key: types.GetOwnerNFTKey(addr, "kitties", "1"), Value: []byte("kitties")},
+		cmn.KVPair{Key: types.GetDenomKey("kitties"), Value: cdc.MustMarshalBinaryLengthPrefixed(denom)},
+		cmn.KVPair{Key: types.GetNFTKey("kitties", "1"), Value: cdc.MustMarshalBinaryLengthPrefixed(&nft)},
+		cmn.KVPair{Key: types.GetSupplyKey("kitties"), Value: cdc.MustMarshalBinaryLengthPrefixed(1)},
+		cmn.KVPair{Key: types.GetApprovalKey("kitties", "1"), Value: cdc.MustMarshalBinaryLengthPrefixed(approval)},
+		cmn.KVPair{Key: types.GetOperatorKey(addr, "kitties", addr), Value: []byte("kitties")},
 		cmn.KVPair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
 
@@ -42,8 +47,13 @@ func TestDecodeStore(t *testing.T) {
 		name        string
 		expectedLog string
 	}{
-		{"collections", fmt.Sprintf("%v\n%v", collection, collection)},
-		{"owners", fmt.Sprintf("%v\n%v", idCollection, idCollection)},
+		{"collections", "kitties\nkitties"},
+		{"owners", fmt.Sprintf("%s kitties #1\n%s kitties #1", addr, addr)},
+		{"denoms", fmt.Sprintf("%v\n%v", denom, denom)},
+		{"nfts", fmt.Sprintf("%v\n%v", &nft, &nft)},
+		{"supply", "1\n1"},
+		{"approvals", fmt.Sprintf("%v\n%v", approval, approval)},
+		{"operators", fmt.Sprintf("%s kitties %s\n%s kitties %s", addr, addr, addr, addr)},
 		{"other", ""},
 	}
 
@@ -17,8 +17,6 @@ const (
 // RandomizedGenState generates a random GenesisState for nft
 func RandomizedGenState(simState *module.SimulationState) {
 	collections := types.NewCollections(types.NewCollection(kities, types.NFTs{}), types.NewCollection(doggos, types.NFTs{}))
-	var ownerships []types.Owner
-
 	for _, acc := range simState.Accounts {
 		// 10% of accounts own an NFT
 		if simState.Rand.Intn(100) < 10 {
@@ -28,10 +26,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 				simulation.RandStringOfLength(simState.Rand, 45), // tokenURI
 			)
 
-			var (
-				idCollection types.IDCollection
-				err          error
-			)
+			var err error
 
 			// 50% doggos and 50% kitties
 			if simState.Rand.Intn(100) < 50 {
@@ -39,21 +34,16 @@ func RandomizedGenState(simState *module.SimulationState) {
 				if err != nil {
 					panic(err)
 				}
-				idCollection = types.NewIDCollection(kities, []string{baseNFT.ID})
 			} else {
 				collections[1], err = collections[1].AddNFT(&baseNFT)
 				if err != nil {
 					panic(err)
 				}
-				idCollection = types.NewIDCollection(doggos, []string{baseNFT.ID})
 			}
-
-			ownership := types.NewOwner(acc.Address, idCollection)
-			ownerships = append(ownerships, ownership)
 		}
 	}
 
-	nftGenesis := types.NewGenesisState(ownerships, collections)
+	nftGenesis := types.NewGenesisState(collections, []types.Denom{}, []types.Approval{}, []types.OperatorApproval{})
 
 	fmt.Printf("Selected randomly generated NFT genesis state:\n%s\n", codec.MustMarshalJSONIndent(simState.Cdc, nftGenesis))
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(nftGenesis)
@@ -63,6 +63,7 @@ func SimulateMsgEditNFTMetadata(k keeper.Keeper) simulation.Operation {
 			nftID,
 			denom,
 			simulation.RandStringOfLength(r, 45), // tokenURI
+			nil,                                  // attributes
 		)
 
 		if msg.ValidateBasic() != nil {
@@ -92,6 +93,7 @@ func SimulateMsgMintNFT(k keeper.Keeper) simulation.Operation {
 			simulation.RandStringOfLength(r, 10),  // nft ID
 			simulation.RandStringOfLength(r, 10),  // denom
 			simulation.RandStringOfLength(r, 45),  // tokenURI
+			nil,                                   // attributes
 		)
 
 		if msg.ValidateBasic() != nil {
@@ -42,6 +42,7 @@ type (
 		Changes     ParamChangesJSON `json:"changes" yaml:"changes"`
 		Proposer    sdk.AccAddress   `json:"proposer" yaml:"proposer"`
 		Deposit     sdk.Coins        `json:"deposit" yaml:"deposit"`
+		Class       string           `json:"class" yaml:"class"`
 	}
 )
 
@@ -17,6 +17,8 @@ type s struct {
 	I int
 }
 
+func validateNoOp(_ interface{}) error { return nil }
+
 func createTestCodec() *codec.Codec {
 	cdc := codec.New()
 	sdk.RegisterCodec(cdc)
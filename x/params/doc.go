@@ -35,11 +35,19 @@ recommended to use the same name with the module's.
 
 	func ParamKeyTable() params.KeyTable {
 		return params.NewKeyTable(
-			KeyParameter1, MyStruct{},
-			KeyParameter2, MyStruct{},
+			params.NewParamSetPair(KeyParameter1, MyStruct{}, validateMyStruct),
+			params.NewParamSetPair(KeyParameter2, MyStruct{}, validateMyStruct),
 		)
 	}
 
+	func validateMyStruct(i interface{}) error {
+		if _, ok := i.(MyStruct); !ok {
+			return fmt.Errorf("invalid parameter type: %T", i)
+		}
+		// validate the value...
+		return nil
+	}
+
 	func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, ps params.Subspace) Keeper {
 		return Keeper {
 			cdc: cdc,
@@ -58,13 +66,17 @@ Now we can access to the paramstore using Paramstore Keys
 	k.ps.Get(ctx, KeyParameter1, &param)
 	k.ps.Set(ctx, KeyParameter2, param)
 
+Every parameter is registered with a validation function. Set and SetParamSet
+panic on a value that fails validation, while Update (used by parameter change
+proposals) returns an error, so invalid values never reach the store.
+
 If you want to store an unknown number of parameters, or want to store a mapping,
 you can use subkeys. Subkeys can be used with a main key, where the subkeys are
 inheriting the key properties.
 
 	func ParamKeyTable() params.KeyTable {
 		return params.NewKeyTable(
-			KeyParamMain, MyStruct{},
+			params.NewParamSetPair(KeyParamMain, MyStruct{}, validateMyStruct),
 		)
 	}
 
@@ -84,11 +96,11 @@ be able to be passed to SetParamSet.
 	}
 
 	// Implements params.ParamSet
-	// KeyValuePairs must return the list of (ParamKey, PointerToTheField)
-	func (p *MyParams) KeyValuePairs() params.KeyValuePairs {
-		return params.KeyFieldPairs {
-			{KeyParameter1, &p.Parameter1},
-			{KeyParameter2, &p.Parameter2},
+	// ParamSetPairs must return the list of (ParamKey, PointerToTheField, ValidatorFn)
+	func (p *MyParams) ParamSetPairs() params.ParamSetPairs {
+		return params.ParamSetPairs{
+			params.NewParamSetPair(KeyParameter1, &p.Parameter1, validateParameter1),
+			params.NewParamSetPair(KeyParameter2, &p.Parameter2, validateParameter2),
 		}
 	}
 
@@ -1,6 +1,7 @@
 package params
 
 import (
+	"fmt"
 	"reflect"
 	"testing"
 
@@ -25,15 +26,15 @@ func TestKeeper(t *testing.T) {
 	}
 
 	table := NewKeyTable(
-		[]byte("key1"), int64(0),
-		[]byte("key2"), int64(0),
-		[]byte("key3"), int64(0),
-		[]byte("key4"), int64(0),
-		[]byte("key5"), int64(0),
-		[]byte("key6"), int64(0),
-		[]byte("key7"), int64(0),
-		[]byte("extra1"), bool(false),
-		[]byte("extra2"), string(""),
+		NewParamSetPair([]byte("key1"), int64(0), validateNoOp),
+		NewParamSetPair([]byte("key2"), int64(0), validateNoOp),
+		NewParamSetPair([]byte("key3"), int64(0), validateNoOp),
+		NewParamSetPair([]byte("key4"), int64(0), validateNoOp),
+		NewParamSetPair([]byte("key5"), int64(0), validateNoOp),
+		NewParamSetPair([]byte("key6"), int64(0), validateNoOp),
+		NewParamSetPair([]byte("key7"), int64(0), validateNoOp),
+		NewParamSetPair([]byte("extra1"), bool(false), validateNoOp),
+		NewParamSetPair([]byte("extra2"), string(""), validateNoOp),
 	)
 
 	cdc, ctx, skey, _, keeper := testComponents()
@@ -127,18 +128,18 @@ func TestSubspace(t *testing.T) {
 	}
 
 	table := NewKeyTable(
-		[]byte("string"), string(""),
-		[]byte("bool"), bool(false),
-		[]byte("int16"), int16(0),
-		[]byte("int32"), int32(0),
-		[]byte("int64"), int64(0),
-		[]byte("uint16"), uint16(0),
-		[]byte("uint32"), uint32(0),
-		[]byte("uint64"), uint64(0),
-		[]byte("int"), sdk.Int{},
-		[]byte("uint"), sdk.Uint{},
-		[]byte("dec"), sdk.Dec{},
-		[]byte("struct"), s{},
+		NewParamSetPair([]byte("string"), string(""), validateNoOp),
+		NewParamSetPair([]byte("bool"), bool(false), validateNoOp),
+		NewParamSetPair([]byte("int16"), int16(0), validateNoOp),
+		NewParamSetPair([]byte("int32"), int32(0), validateNoOp),
+		NewParamSetPair([]byte("int64"), int64(0), validateNoOp),
+		NewParamSetPair([]byte("uint16"), uint16(0), validateNoOp),
+		NewParamSetPair([]byte("uint32"), uint32(0), validateNoOp),
+		NewParamSetPair([]byte("uint64"), uint64(0), validateNoOp),
+		NewParamSetPair([]byte("int"), sdk.Int{}, validateNoOp),
+		NewParamSetPair([]byte("uint"), sdk.Uint{}, validateNoOp),
+		NewParamSetPair([]byte("dec"), sdk.Dec{}, validateNoOp),
+		NewParamSetPair([]byte("struct"), s{}, validateNoOp),
 	)
 
 	store := prefix.NewStore(ctx.KVStore(key), []byte("test/"))
@@ -190,7 +191,7 @@ func TestJSONUpdate(t *testing.T) {
 
 	key := []byte("key")
 
-	space := keeper.Subspace("test").WithKeyTable(NewKeyTable(key, paramJSON{}))
+	space := keeper.Subspace("test").WithKeyTable(NewKeyTable(NewParamSetPair(key, paramJSON{}, validateNoOp)))
 
 	var param paramJSON
 
@@ -210,3 +211,39 @@ func TestJSONUpdate(t *testing.T) {
 	space.Get(ctx, key, &param)
 	require.Equal(t, paramJSON{40964096, "goodbyeworld"}, param)
 }
+
+func TestValidation(t *testing.T) {
+	_, ctx, _, _, keeper := testComponents()
+
+	key := []byte("key")
+	validatePositive := func(i interface{}) error {
+		v, ok := i.(int64)
+		if !ok {
+			return fmt.Errorf("invalid parameter type: %T", i)
+		}
+		if v <= 0 {
+			return fmt.Errorf("value must be positive: %d", v)
+		}
+		return nil
+	}
+
+	space := keeper.Subspace("test").WithKeyTable(NewKeyTable(NewParamSetPair(key, int64(0), validatePositive)))
+
+	require.NoError(t, space.Validate(ctx, key, int64(1)))
+	require.Error(t, space.Validate(ctx, key, int64(0)))
+	require.Error(t, space.Validate(ctx, []byte("unknown"), int64(1)))
+
+	require.Panics(t, func() { space.Set(ctx, key, int64(-1)) })
+	require.False(t, space.Has(ctx, key))
+
+	require.NotPanics(t, func() { space.Set(ctx, key, int64(10)) })
+	require.Error(t, space.Update(ctx, key, []byte(`"-5"`)))
+
+	var param int64
+	space.Get(ctx, key, &param)
+	require.Equal(t, int64(10), param)
+
+	require.NoError(t, space.Update(ctx, key, []byte(`"5"`)))
+	space.Get(ctx, key, &param)
+	require.Equal(t, int64(5), param)
+}
@@ -1,6 +1,7 @@
 package params_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -41,10 +42,28 @@ type testParams struct {
 	SlashingRate  testParamsSlashingRate `json:"slashing_rate" yaml:"slashing_rate"`
 }
 
+func validateMaxValidators(i interface{}) error {
+	v, ok := i.(uint16)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("max validators must be positive: %d", v)
+	}
+	return nil
+}
+
+func validateSlashingRate(i interface{}) error {
+	if _, ok := i.(testParamsSlashingRate); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
 func (tp *testParams) ParamSetPairs() subspace.ParamSetPairs {
 	return subspace.ParamSetPairs{
-		{Key: []byte(keyMaxValidators), Value: &tp.MaxValidators},
-		{Key: []byte(keySlashingRate), Value: &tp.SlashingRate},
+		{Key: []byte(keyMaxValidators), Value: &tp.MaxValidators, ValidatorFn: validateMaxValidators},
+		{Key: []byte(keySlashingRate), Value: &tp.SlashingRate, ValidatorFn: validateSlashingRate},
 	}
 }
 
@@ -106,6 +125,19 @@ func TestProposalHandlerFailed(t *testing.T) {
 	require.False(t, ss.Has(input.ctx, []byte(keyMaxValidators)))
 }
 
+func TestProposalHandlerInvalidValue(t *testing.T) {
+	input := newTestInput(t)
+	ss := input.keeper.Subspace(testSubspace).WithKeyTable(
+		params.NewKeyTable().RegisterParamSet(&testParams{}),
+	)
+
+	tp := testProposal(params.NewParamChange(testSubspace, keyMaxValidators, "0"))
+	hdlr := params.NewParamChangeProposalHandler(input.keeper)
+	require.Error(t, hdlr(input.ctx, tp))
+
+	require.False(t, ss.Has(input.ctx, []byte(keyMaxValidators)))
+}
+
 func TestProposalHandlerUpdateOmitempty(t *testing.T) {
 	input := newTestInput(t)
 	ss := input.keeper.Subspace(testSubspace).WithKeyTable(
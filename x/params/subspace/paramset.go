@@ -1,14 +1,22 @@
 package subspace
 
-// ParamSetPair is used for associating paramsubspace key and field of param structs
-type ParamSetPair struct {
-	Key   []byte
-	Value interface{}
-}
+type (
+	// ValueValidatorFn defines a function type that validates the value of a
+	// parameter. It receives the dereferenced parameter value.
+	ValueValidatorFn func(value interface{}) error
+
+	// ParamSetPair is used for associating paramsubspace key and field of param
+	// structs, along with the function used to validate values of the field.
+	ParamSetPair struct {
+		Key         []byte
+		Value       interface{}
+		ValidatorFn ValueValidatorFn
+	}
+)
 
 // NewParamSetPair creates a new ParamSetPair instance
-func NewParamSetPair(key []byte, value interface{}) ParamSetPair {
-	return ParamSetPair{key, value}
+func NewParamSetPair(key []byte, value interface{}, vfn ValueValidatorFn) ParamSetPair {
+	return ParamSetPair{key, value, vfn}
 }
 
 // ParamSetPairs Slice of KeyFieldPair
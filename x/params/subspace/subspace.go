@@ -142,7 +142,24 @@ func (s Subspace) Modified(ctx sdk.Context, key []byte) bool {
 	return tstore.Has(key)
 }
 
-func (s Subspace) checkType(store sdk.KVStore, key []byte, param interface{}) {
+// Validate attempts to validate a parameter value by its key. If the key is not
+// registered or if the validation of the value fails, an error is returned.
+// Pointer values are dereferenced before they are passed to the validator.
+func (s Subspace) Validate(ctx sdk.Context, key []byte, value interface{}) error {
+	attr, ok := s.table.m[string(key)]
+	if !ok {
+		return fmt.Errorf("parameter %s not registered", string(key))
+	}
+
+	v := reflect.Indirect(reflect.ValueOf(value)).Interface()
+	if err := attr.vfn(v); err != nil {
+		return fmt.Errorf("value from ParamSetPair is invalid: %s", err)
+	}
+
+	return nil
+}
+
+func (s Subspace) checkType(key []byte, param interface{}) {
 	attr, ok := s.table.m[string(key)]
 	if !ok {
 		panic(fmt.Sprintf("parameter %s not registered", string(key)))
@@ -159,12 +176,16 @@ func (s Subspace) checkType(store sdk.KVStore, key []byte, param interface{}) {
 	}
 }
 
-// Set stores the parameter. It returns error if stored parameter has different type from input.
+// Set stores the parameter. It panics if the stored parameter has a different
+// type from the input or if the value fails its registered validation.
 // It also set to the transient store to record change.
 func (s Subspace) Set(ctx sdk.Context, key []byte, param interface{}) {
 	store := s.kvStore(ctx)
 
-	s.checkType(store, key, param)
+	s.checkType(key, param)
+	if err := s.Validate(ctx, key, param); err != nil {
+		panic(err.Error())
+	}
 
 	bz, err := s.cdc.MarshalJSON(param)
 	if err != nil {
@@ -178,8 +199,8 @@ func (s Subspace) Set(ctx sdk.Context, key []byte, param interface{}) {
 }
 
 // Update stores raw parameter bytes. It returns error if the stored parameter
-// has a different type from the input. It also sets to the transient store to
-// record change.
+// has a different type from the input or if the resulting value fails its
+// registered validation. It also sets to the transient store to record change.
 func (s Subspace) Update(ctx sdk.Context, key []byte, param []byte) error {
 	attr, ok := s.table.m[string(key)]
 	if !ok {
@@ -194,6 +215,10 @@ func (s Subspace) Update(ctx sdk.Context, key []byte, param []byte) error {
 		return err
 	}
 
+	if err := s.Validate(ctx, key, dest); err != nil {
+		return err
+	}
+
 	s.Set(ctx, key, dest)
 
 	// TODO: Remove; seems redundant as Set already does this.
@@ -204,11 +229,14 @@ func (s Subspace) Update(ctx sdk.Context, key []byte, param []byte) error {
 }
 
 // SetWithSubkey set a parameter with a key and subkey
-// Checks parameter type only over the key
+// Checks parameter type and validity only over the key
 func (s Subspace) SetWithSubkey(ctx sdk.Context, key []byte, subkey []byte, param interface{}) {
 	store := s.kvStore(ctx)
 
-	s.checkType(store, key, param)
+	s.checkType(key, param)
+	if err := s.Validate(ctx, key, param); err != nil {
+		panic(err.Error())
+	}
 
 	newkey := concatKeys(key, subkey)
 
@@ -240,6 +268,10 @@ func (s Subspace) UpdateWithSubkey(ctx sdk.Context, key []byte, subkey []byte, p
 		return err
 	}
 
+	if err := s.Validate(ctx, key, dest); err != nil {
+		return err
+	}
+
 	s.SetWithSubkey(ctx, key, subkey, dest)
 	tStore := s.transientStore(ctx)
 	tStore.Set(concatkey, []byte{})
@@ -254,7 +286,8 @@ func (s Subspace) GetParamSet(ctx sdk.Context, ps ParamSet) {
 	}
 }
 
-// Set from ParamSet
+// Set from ParamSet. It panics if any of the values fails the validation
+// function of its ParamSetPair.
 func (s Subspace) SetParamSet(ctx sdk.Context, ps ParamSet) {
 	for _, pair := range ps.ParamSetPairs() {
 		// pair.Field is a pointer to the field, so indirecting the ptr.
@@ -262,6 +295,11 @@ func (s Subspace) SetParamSet(ctx sdk.Context, ps ParamSet) {
 		// since SetStruct is meant to be used in InitGenesis
 		// so this method will not be called frequently
 		v := reflect.Indirect(reflect.ValueOf(pair.Value)).Interface()
+
+		if err := pair.ValidatorFn(v); err != nil {
+			panic(fmt.Sprintf("value from ParamSetPair is invalid: %s", err))
+		}
+
 		s.Set(ctx, pair.Key, v)
 	}
 }
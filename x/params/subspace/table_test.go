@@ -1,6 +1,7 @@
 package subspace
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -11,24 +12,39 @@ type testparams struct {
 	b bool
 }
 
+func validateI(i interface{}) error {
+	if _, ok := i.(int64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateB(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
 func (tp *testparams) ParamSetPairs() ParamSetPairs {
 	return ParamSetPairs{
-		{[]byte("i"), &tp.i},
-		{[]byte("b"), &tp.b},
+		{[]byte("i"), &tp.i, validateI},
+		{[]byte("b"), &tp.b, validateB},
 	}
 }
 
 func TestKeyTable(t *testing.T) {
 	table := NewKeyTable()
 
-	require.Panics(t, func() { table.RegisterType([]byte(""), nil) })
-	require.Panics(t, func() { table.RegisterType([]byte("!@#$%"), nil) })
-	require.Panics(t, func() { table.RegisterType([]byte("hello,"), nil) })
-	require.Panics(t, func() { table.RegisterType([]byte("hello"), nil) })
+	require.Panics(t, func() { table.RegisterType(ParamSetPair{[]byte(""), nil, validateB}) })
+	require.Panics(t, func() { table.RegisterType(ParamSetPair{[]byte("!@#$%"), nil, validateB}) })
+	require.Panics(t, func() { table.RegisterType(ParamSetPair{[]byte("hello,"), nil, validateB}) })
+	require.Panics(t, func() { table.RegisterType(ParamSetPair{[]byte("hello"), nil, validateB}) })
+	require.Panics(t, func() { table.RegisterType(ParamSetPair{[]byte("hello"), bool(false), nil}) })
 
-	require.NotPanics(t, func() { table.RegisterType([]byte("hello"), bool(false)) })
-	require.NotPanics(t, func() { table.RegisterType([]byte("world"), int64(0)) })
-	require.Panics(t, func() { table.RegisterType([]byte("hello"), bool(false)) })
+	require.NotPanics(t, func() { table.RegisterType(ParamSetPair{[]byte("hello"), bool(false), validateB}) })
+	require.NotPanics(t, func() { table.RegisterType(ParamSetPair{[]byte("world"), int64(0), validateI}) })
+	require.Panics(t, func() { table.RegisterType(ParamSetPair{[]byte("hello"), bool(false), validateB}) })
 
 	require.NotPanics(t, func() { table.RegisterParamSet(&testparams{}) })
 	require.Panics(t, func() { table.RegisterParamSet(&testparams{}) })
@@ -39,3 +39,32 @@ func TestGetSetValidatorMissedBlockBitArray(t *testing.T) {
 	missed = keeper.GetValidatorMissedBlockBitArray(ctx, sdk.ConsAddress(Addrs[0]), 0)
 	require.True(t, missed) // now should be missed
 }
+
+func TestTombstoned(t *testing.T) {
+	ctx, _, _, _, keeper := CreateTestInput(t, types.DefaultParams())
+	consAddr := sdk.ConsAddress(Addrs[0])
+	require.Panics(t, func() { keeper.Tombstone(ctx, consAddr) })
+	require.False(t, keeper.IsTombstoned(ctx, consAddr))
+
+	newInfo := types.NewValidatorSigningInfo(consAddr, int64(4), int64(3), time.Unix(0, 0), false, int64(10))
+	keeper.SetValidatorSigningInfo(ctx, consAddr, newInfo)
+	require.True(t, keeper.HasValidatorSigningInfo(ctx, consAddr))
+	require.False(t, keeper.IsTombstoned(ctx, consAddr))
+
+	keeper.Tombstone(ctx, consAddr)
+	require.True(t, keeper.IsTombstoned(ctx, consAddr))
+}
+
+func TestJailUntil(t *testing.T) {
+	ctx, _, _, _, keeper := CreateTestInput(t, types.DefaultParams())
+	consAddr := sdk.ConsAddress(Addrs[0])
+	require.Panics(t, func() { keeper.JailUntil(ctx, consAddr, time.Now()) })
+
+	newInfo := types.NewValidatorSigningInfo(consAddr, int64(4), int64(3), time.Unix(0, 0), false, int64(10))
+	keeper.SetValidatorSigningInfo(ctx, consAddr, newInfo)
+	keeper.JailUntil(ctx, consAddr, time.Unix(253402300799, 0).UTC())
+
+	info, ok := keeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, ok)
+	require.Equal(t, time.Unix(253402300799, 0).UTC(), info.JailedUntil)
+}
@@ -0,0 +1,87 @@
+// nolint
+// autogenerated code using github.com/rigelrozanski/multitool
+// aliases generated for the following subdirectories:
+// ALIASGEN: github.com/cosmos/cosmos-sdk/x/transfer/internal/keeper
+// ALIASGEN: github.com/cosmos/cosmos-sdk/x/transfer/internal/types
+package transfer
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/transfer/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/transfer/internal/types"
+)
+
+const (
+	ModuleName             = types.ModuleName
+	StoreKey               = types.StoreKey
+	RouterKey              = types.RouterKey
+	QuerierRoute           = types.QuerierRoute
+	PortID                 = types.PortID
+	Version                = types.Version
+	DefaultCodespace       = types.DefaultCodespace
+	CodeInvalidPacketData  = types.CodeInvalidPacketData
+	CodeInvalidDenomTrace  = types.CodeInvalidDenomTrace
+	CodeChannelNotFound    = types.CodeChannelNotFound
+	CodeInvalidChannel     = types.CodeInvalidChannel
+	CodeInsufficientEscrow = types.CodeInsufficientEscrow
+	TypeMsgTransfer        = types.TypeMsgTransfer
+	QueryDenomTrace        = types.QueryDenomTrace
+	QueryDenomTraces       = types.QueryDenomTraces
+	VoucherDenomPrefix     = types.VoucherDenomPrefix
+)
+
+var (
+	// functions aliases
+	NewKeeper                  = keeper.NewKeeper
+	NewQuerier                 = keeper.NewQuerier
+	RegisterCodec              = types.RegisterCodec
+	ErrInvalidPacketData       = types.ErrInvalidPacketData
+	ErrInvalidDenomTrace       = types.ErrInvalidDenomTrace
+	ErrChannelNotFound         = types.ErrChannelNotFound
+	ErrInvalidChannel          = types.ErrInvalidChannel
+	ErrInsufficientEscrow      = types.ErrInsufficientEscrow
+	NewChannelEscrow           = types.NewChannelEscrow
+	NewGenesisState            = types.NewGenesisState
+	DefaultGenesisState        = types.DefaultGenesisState
+	ValidateGenesis            = types.ValidateGenesis
+	DenomTraceKey              = types.DenomTraceKey
+	ChannelEscrowKey           = types.ChannelEscrowKey
+	NewMsgTransfer             = types.NewMsgTransfer
+	NewFungibleTokenPacketData = types.NewFungibleTokenPacketData
+	NewSuccessAcknowledgement  = types.NewSuccessAcknowledgement
+	NewErrorAcknowledgement    = types.NewErrorAcknowledgement
+	NewQueryDenomTraceParams   = types.NewQueryDenomTraceParams
+	NewDenomTrace              = types.NewDenomTrace
+	ParseDenomTrace            = types.ParseDenomTrace
+	SourcePrefix               = types.SourcePrefix
+	ValidateFullDenom          = types.ValidateFullDenom
+
+	// variable aliases
+	ModuleCdc                = types.ModuleCdc
+	DenomTraceKeyPrefix      = types.DenomTraceKeyPrefix
+	ChannelEscrowKeyPrefix   = types.ChannelEscrowKeyPrefix
+	EventTypeTransfer        = types.EventTypeTransfer
+	EventTypeReceive         = types.EventTypeReceive
+	EventTypeRefund          = types.EventTypeRefund
+	EventTypeAcknowledgement = types.EventTypeAcknowledgement
+	AttributeValueCategory   = types.AttributeValueCategory
+	AttributeKeyChannelID    = types.AttributeKeyChannelID
+	AttributeKeySequence     = types.AttributeKeySequence
+	AttributeKeySender       = types.AttributeKeySender
+	AttributeKeyReceiver     = types.AttributeKeyReceiver
+	AttributeKeyDenom        = types.AttributeKeyDenom
+	AttributeKeyAmount       = types.AttributeKeyAmount
+	AttributeKeySuccess      = types.AttributeKeySuccess
+	AttributeKeyError        = types.AttributeKeyError
+)
+
+type (
+	Keeper                             = keeper.Keeper
+	ChannelEscrow                      = types.ChannelEscrow
+	GenesisState                       = types.GenesisState
+	MsgTransfer                        = types.MsgTransfer
+	FungibleTokenPacketData            = types.FungibleTokenPacketData
+	FungibleTokenPacketAcknowledgement = types.FungibleTokenPacketAcknowledgement
+	QueryDenomTraceParams              = types.QueryDenomTraceParams
+	DenomTrace                         = types.DenomTrace
+	DenomTraces                        = types.DenomTraces
+)
@@ -0,0 +1,92 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/transfer/internal/types"
+)
+
+// GetQueryCmd returns the cli query commands for the transfer module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	transferQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the transfer module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	transferQueryCmd.AddCommand(client.GetCommands(
+		GetCmdQueryDenomTrace(cdc),
+		GetCmdQueryDenomTraces(cdc),
+	)...)
+
+	return transferQueryCmd
+}
+
+// GetCmdQueryDenomTrace returns the command to query the denomination trace of
+// a voucher
+func GetCmdQueryDenomTrace(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "denom-trace [denom]",
+		Short: "Query the denomination trace of a voucher",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the channels a voucher has been transferred over and the denomination of
+its coins on the chain they originate from.
+
+Example:
+$ %s query %s denom-trace ibc2f6e9a3bc8d1f
+`, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := cdc.MarshalJSON(types.NewQueryDenomTraceParams(args[0]))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDenomTrace)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var trace types.DenomTrace
+			cdc.MustUnmarshalJSON(res, &trace)
+			return cliCtx.PrintOutput(trace)
+		},
+	}
+}
+
+// GetCmdQueryDenomTraces returns the command to query the denomination traces
+// of all the vouchers
+func GetCmdQueryDenomTraces(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "denom-traces",
+		Short: "Query the denomination traces of all the vouchers",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDenomTraces)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var traces types.DenomTraces
+			cdc.MustUnmarshalJSON(res, &traces)
+			return cliCtx.PrintOutput(traces)
+		},
+	}
+}
@@ -0,0 +1,79 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/transfer/internal/types"
+)
+
+// flags of the transfer command
+const (
+	FlagTimeoutHeight = "timeout-height"
+)
+
+// GetTxCmd returns the transaction commands for the transfer module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	transferTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "IBC fungible token transfer transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	transferTxCmd.AddCommand(client.PostCommands(
+		GetCmdTransfer(cdc),
+	)...)
+
+	return transferTxCmd
+}
+
+// GetCmdTransfer returns the command to transfer coins over a channel
+func GetCmdTransfer(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer [from_key_or_address] [channel_id] [receiver] [amount]",
+		Short: "Transfer coins to a receiver on a counterparty chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Transfer coins over a channel of the transfer port to a receiver on the
+counterparty chain. The coins are refunded if the counterparty chain has not received
+them before reaching the --timeout-height.
+
+Example:
+$ %s tx %s transfer mykey gaiachannel cosmos1lwjm... 100stake --timeout-height 1000
+`, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithFrom(args[0]).WithCodec(cdc)
+
+			token, err := sdk.ParseCoin(args[3])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransfer(args[1], token, cliCtx.GetFromAddress(), args[2],
+				viper.GetUint64(FlagTimeoutHeight))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Uint64(FlagTimeoutHeight, 0, "Height of the counterparty chain at which the transfer times out")
+	return cmd
+}
@@ -0,0 +1,57 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/transfer/internal/types"
+)
+
+func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc(
+		"/transfer/channels/{channelID}/transfers",
+		transferHandlerFn(cliCtx),
+	).Methods("POST")
+}
+
+// TransferReq defines the properties of a transfer request's body. The coins
+// are sent by the sender of the request.
+type TransferReq struct {
+	BaseReq       rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Token         sdk.Coin     `json:"token" yaml:"token"`
+	Receiver      string       `json:"receiver" yaml:"receiver"`
+	TimeoutHeight uint64       `json:"timeout_height" yaml:"timeout_height"`
+}
+
+func transferHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req TransferReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		sender, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgTransfer(mux.Vars(r)["channelID"], req.Token, sender, req.Receiver, req.TimeoutHeight)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
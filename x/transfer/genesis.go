@@ -0,0 +1,41 @@
+package transfer
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/transfer/internal/types"
+)
+
+// InitGenesis sets the denomination traces and channel escrows of the genesis
+// state.
+func InitGenesis(ctx sdk.Context, k Keeper, supplyKeeper types.SupplyKeeper, data GenesisState) {
+	// check if the escrow account exists
+	if moduleAcc := supplyKeeper.GetModuleAccount(ctx, ModuleName); moduleAcc == nil {
+		panic(fmt.Sprintf("%s module account has not been set", ModuleName))
+	}
+
+	for _, trace := range data.DenomTraces {
+		k.SetDenomTrace(ctx, trace)
+	}
+	for _, escrow := range data.ChannelEscrows {
+		k.SetChannelEscrow(ctx, escrow.ChannelID, escrow.Amount)
+	}
+}
+
+// ExportGenesis returns a GenesisState with the denomination traces and channel
+// escrows of the transfer module.
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	data := DefaultGenesisState()
+
+	k.IterateDenomTraces(ctx, func(trace DenomTrace) bool {
+		data.DenomTraces = append(data.DenomTraces, trace)
+		return false
+	})
+	k.IterateChannelEscrows(ctx, func(escrow ChannelEscrow) bool {
+		data.ChannelEscrows = append(data.ChannelEscrows, escrow)
+		return false
+	})
+
+	return data
+}
@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/transfer/internal/types"
+)
+
+// Keeper defines the transfer module's keeper. It sends and receives coins over
+// the channels of the transfer port, escrowing the coins of this chain and
+// minting vouchers for the coins of counterparty chains in the transfer module
+// account.
+type Keeper struct {
+	cdc           *codec.Codec
+	storeKey      sdk.StoreKey
+	channelKeeper types.ChannelKeeper
+	supplyKeeper  types.SupplyKeeper
+	codespace     sdk.CodespaceType
+}
+
+// NewKeeper creates a new transfer Keeper instance
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, codespace sdk.CodespaceType,
+	channelKeeper types.ChannelKeeper, supplyKeeper types.SupplyKeeper) Keeper {
+
+	// ensure transfer module account is set
+	if addr := supplyKeeper.GetModuleAddress(types.ModuleName); addr == nil {
+		panic("the transfer module account has not been set")
+	}
+
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      key,
+		channelKeeper: channelKeeper,
+		supplyKeeper:  supplyKeeper,
+		codespace:     codespace,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// Codespace returns the transfer keeper's codespace.
+func (k Keeper) Codespace() sdk.CodespaceType {
+	return k.codespace
+}
+
+// GetDenomTrace returns the denomination trace of a voucher denomination.
+func (k Keeper) GetDenomTrace(ctx sdk.Context, denom string) (trace types.DenomTrace, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.DenomTraceKey(denom))
+	if bz == nil {
+		return trace, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &trace)
+	return trace, true
+}
+
+// SetDenomTrace sets the denomination trace of the vouchers of the trace.
+func (k Keeper) SetDenomTrace(ctx sdk.Context, trace types.DenomTrace) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(trace)
+	store.Set(types.DenomTraceKey(trace.Denom()), bz)
+}
+
+// IterateDenomTraces iterates over the denomination traces of the vouchers,
+// ordered by voucher denomination, until cb returns true.
+func (k Keeper) IterateDenomTraces(ctx sdk.Context, cb func(types.DenomTrace) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.DenomTraceKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var trace types.DenomTrace
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &trace)
+		if cb(trace) {
+			break
+		}
+	}
+}
+
+// GetChannelEscrow returns the coins of this chain escrowed for a channel of the
+// transfer port, which the counterparty chain can return over the channel.
+func (k Keeper) GetChannelEscrow(ctx sdk.Context, channelID string) (escrow sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ChannelEscrowKey(channelID))
+	if bz == nil {
+		return sdk.NewCoins()
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &escrow)
+	return escrow
+}
+
+// SetChannelEscrow sets the coins escrowed for a channel of the transfer port.
+func (k Keeper) SetChannelEscrow(ctx sdk.Context, channelID string, escrow sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	if escrow.IsZero() {
+		store.Delete(types.ChannelEscrowKey(channelID))
+		return
+	}
+
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(escrow)
+	store.Set(types.ChannelEscrowKey(channelID), bz)
+}
+
+// IterateChannelEscrows iterates over the coins escrowed for the channels of
+// the transfer port, ordered by channel, until cb returns true.
+func (k Keeper) IterateChannelEscrows(ctx sdk.Context, cb func(types.ChannelEscrow) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ChannelEscrowKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var escrow sdk.Coins
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &escrow)
+
+		channelID := string(iter.Key()[len(types.ChannelEscrowKeyPrefix):])
+		if cb(types.NewChannelEscrow(channelID, escrow)) {
+			break
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/transfer/internal/types"
+)
+
+// NewQuerier creates a querier for transfer cli and REST endpoints
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryDenomTrace:
+			return queryDenomTrace(ctx, req, k)
+
+		case types.QueryDenomTraces:
+			return queryDenomTraces(ctx, k)
+
+		default:
+			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("unknown transfer query endpoint: %s", path[0]))
+		}
+	}
+}
+
+func queryDenomTrace(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryDenomTraceParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	trace, found := k.GetDenomTrace(ctx, params.Denom)
+	if !found {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("voucher %s has no denomination trace", params.Denom))
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, trace)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+	return bz, nil
+}
+
+func queryDenomTraces(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	traces := types.DenomTraces{}
+	k.IterateDenomTraces(ctx, func(trace types.DenomTrace) bool {
+		traces = append(traces, trace)
+		return false
+	})
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, traces)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+	return bz, nil
+}
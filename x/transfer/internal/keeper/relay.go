@@ -0,0 +1,231 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc"
+	"github.com/cosmos/cosmos-sdk/x/transfer/internal/types"
+)
+
+// SendTransfer sends coins over a channel of the transfer port to a receiver
+// on the counterparty chain. Vouchers returning to the chain their coins
+// originate from over the channel they were received on are burned, and any
+// other coins are escrowed in the transfer module account.
+func (k Keeper) SendTransfer(ctx sdk.Context, sourceChannel string, token sdk.Coin, sender sdk.AccAddress,
+	receiver string, timeoutHeight uint64) sdk.Error {
+
+	channel, found := k.channelKeeper.GetChannel(ctx, types.PortID, sourceChannel)
+	if !found {
+		return types.ErrChannelNotFound(k.codespace, sourceChannel)
+	}
+
+	fullDenom := token.Denom
+	if trace, found := k.GetDenomTrace(ctx, token.Denom); found {
+		fullDenom = trace.FullDenom()
+	}
+
+	coins := sdk.NewCoins(token)
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	if strings.HasPrefix(fullDenom, types.SourcePrefix(types.PortID, sourceChannel)) {
+		if err := k.supplyKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+			return err
+		}
+	} else {
+		k.SetChannelEscrow(ctx, sourceChannel, k.GetChannelEscrow(ctx, sourceChannel).Add(coins))
+	}
+
+	sequence := k.channelKeeper.GetNextSequenceSend(ctx, types.PortID, sourceChannel)
+	data := types.NewFungibleTokenPacketData(fullDenom, token.Amount, sender.String(), receiver)
+	packet := ibc.NewPacket(data.GetBytes(), sequence, types.PortID, sourceChannel,
+		channel.Counterparty.PortID, channel.Counterparty.ChannelID, timeoutHeight)
+
+	if err := k.channelKeeper.SendPacket(ctx, packet); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTransfer,
+			sdk.NewAttribute(types.AttributeKeyChannelID, sourceChannel),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyReceiver, receiver),
+			sdk.NewAttribute(types.AttributeKeyDenom, fullDenom),
+			sdk.NewAttribute(types.AttributeKeyAmount, token.Amount.String()),
+		),
+	)
+	return nil
+}
+
+// OnRecvPacket receives the coins of a packet from the counterparty chain.
+// Coins returning to this chain over the channel they were sent on are
+// released from escrow, and any other coins are minted as vouchers whose
+// denomination trace records the channel. The coins are only received if the
+// whole packet can be received, otherwise the packet is acknowledged with an
+// error so that the coins are refunded on the counterparty chain.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet ibc.Packet) ([]byte, sdk.Error) {
+	var data types.FungibleTokenPacketData
+	if err := types.ModuleCdc.UnmarshalJSON(packet.Data, &data); err != nil {
+		return types.NewErrorAcknowledgement(fmt.Sprintf("cannot decode packet data: %s", err)).GetBytes(), nil
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.receive(cacheCtx, packet, data); err != nil {
+		return types.NewErrorAcknowledgement(err.ABCILog()).GetBytes(), nil
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return types.NewSuccessAcknowledgement().GetBytes(), nil
+}
+
+func (k Keeper) receive(ctx sdk.Context, packet ibc.Packet, data types.FungibleTokenPacketData) sdk.Error {
+	if err := data.ValidateBasic(); err != nil {
+		return types.ErrInvalidPacketData(k.codespace, err.Error())
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return sdk.ErrInvalidAddress(err.Error())
+	}
+
+	var coins sdk.Coins
+	sourcePrefix := types.SourcePrefix(packet.SourcePort, packet.SourceChannel)
+	if strings.HasPrefix(data.Denom, sourcePrefix) {
+		// the coins were sent from this chain over the channel and return
+		trace := types.ParseDenomTrace(strings.TrimPrefix(data.Denom, sourcePrefix))
+		coins = sdk.NewCoins(sdk.NewCoin(trace.Denom(), data.Amount))
+
+		if err := k.releaseEscrow(ctx, packet.DestinationChannel, coins); err != nil {
+			return err
+		}
+	} else {
+		// the coins are received from the counterparty chain as vouchers
+		trace := types.ParseDenomTrace(types.SourcePrefix(packet.DestinationPort, packet.DestinationChannel) + data.Denom)
+		if err := trace.Validate(); err != nil {
+			return types.ErrInvalidDenomTrace(k.codespace, err.Error())
+		}
+
+		denom := trace.Denom()
+		if existing, found := k.GetDenomTrace(ctx, denom); found && existing != trace {
+			return types.ErrInvalidDenomTrace(k.codespace,
+				fmt.Sprintf("voucher %s of %s is already used by %s", denom, trace.FullDenom(), existing.FullDenom()))
+		}
+		k.SetDenomTrace(ctx, trace)
+
+		coins = sdk.NewCoins(sdk.NewCoin(denom, data.Amount))
+		if err := k.supplyKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+			return err
+		}
+	}
+
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, coins); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReceive,
+			sdk.NewAttribute(types.AttributeKeyChannelID, packet.DestinationChannel),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.Sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeySender, data.Sender),
+			sdk.NewAttribute(types.AttributeKeyReceiver, data.Receiver),
+			sdk.NewAttribute(types.AttributeKeyDenom, coins[0].Denom),
+			sdk.NewAttribute(types.AttributeKeyAmount, data.Amount.String()),
+		),
+	)
+	return nil
+}
+
+// OnAcknowledgementPacket refunds the coins of a sent packet to its sender if
+// the counterparty chain acknowledged the packet with an error.
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet ibc.Packet, acknowledgement []byte) sdk.Error {
+	var ack types.FungibleTokenPacketAcknowledgement
+	if err := types.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return types.ErrInvalidPacketData(k.codespace, fmt.Sprintf("cannot decode acknowledgement: %s", err))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAcknowledgement,
+			sdk.NewAttribute(types.AttributeKeyChannelID, packet.SourceChannel),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.Sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(ack.Success)),
+			sdk.NewAttribute(types.AttributeKeyError, ack.Error),
+		),
+	)
+
+	if ack.Success {
+		return nil
+	}
+	return k.refund(ctx, packet)
+}
+
+// OnTimeoutPacket refunds the coins of a sent packet to its sender once the
+// packet has timed out.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet ibc.Packet) sdk.Error {
+	return k.refund(ctx, packet)
+}
+
+// refund returns the coins of a sent packet to its sender, by minting the
+// vouchers burned when the packet was sent or by releasing the escrowed coins.
+func (k Keeper) refund(ctx sdk.Context, packet ibc.Packet) sdk.Error {
+	var data types.FungibleTokenPacketData
+	if err := types.ModuleCdc.UnmarshalJSON(packet.Data, &data); err != nil {
+		return types.ErrInvalidPacketData(k.codespace, err.Error())
+	}
+
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return sdk.ErrInvalidAddress(err.Error())
+	}
+
+	trace := types.ParseDenomTrace(data.Denom)
+	coins := sdk.NewCoins(sdk.NewCoin(trace.Denom(), data.Amount))
+
+	if strings.HasPrefix(data.Denom, types.SourcePrefix(packet.SourcePort, packet.SourceChannel)) {
+		if err := k.supplyKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+			return err
+		}
+	} else {
+		if err := k.releaseEscrow(ctx, packet.SourceChannel, coins); err != nil {
+			return err
+		}
+	}
+
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, coins); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRefund,
+			sdk.NewAttribute(types.AttributeKeyChannelID, packet.SourceChannel),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.Sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeySender, data.Sender),
+			sdk.NewAttribute(types.AttributeKeyDenom, coins[0].Denom),
+			sdk.NewAttribute(types.AttributeKeyAmount, data.Amount.String()),
+		),
+	)
+	return nil
+}
+
+// releaseEscrow subtracts coins from the escrow of a channel, so that they can
+// be sent from the transfer module account. It fails if the coins exceed the
+// escrow of the channel.
+func (k Keeper) releaseEscrow(ctx sdk.Context, channelID string, coins sdk.Coins) sdk.Error {
+	escrow := k.GetChannelEscrow(ctx, channelID)
+	remaining, hasNeg := escrow.SafeSub(coins)
+	if hasNeg {
+		return types.ErrInsufficientEscrow(k.codespace, channelID, escrow, coins)
+	}
+
+	k.SetChannelEscrow(ctx, channelID, remaining)
+	return nil
+}
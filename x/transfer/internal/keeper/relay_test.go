@@ -0,0 +1,240 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/ibc"
+	"github.com/cosmos/cosmos-sdk/x/transfer/internal/types"
+)
+
+var (
+	addrA = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	addrB = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	// voucherB is the denomination of the vouchers of atoms on chain B
+	voucherB = types.NewDenomTrace("transfer/chanba", "atom").Denom()
+)
+
+func atoms(amt int64) sdk.Coin {
+	return sdk.NewInt64Coin("atom", amt)
+}
+
+func vouchers(amt int64) sdk.Coin {
+	return sdk.NewInt64Coin(voucherB, amt)
+}
+
+// setupEndpoints creates two chains with funded accounts and opens a transfer
+// channel between them
+func setupEndpoints(t *testing.T) (a, b *simapp.TestEndpoint) {
+	accA := auth.NewBaseAccountWithAddress(addrA)
+	require.NoError(t, accA.SetCoins(sdk.NewCoins(atoms(100))))
+	accB := auth.NewBaseAccountWithAddress(addrB)
+
+	a = simapp.NewTestEndpoint(simapp.NewTestChain(t, "chaina", &accA), "clientb", "connab", types.PortID, "chanab")
+	b = simapp.NewTestEndpoint(simapp.NewTestChain(t, "chainb", &accB), "clienta", "connba", types.PortID, "chanba")
+	simapp.Connect(a, b, ibc.OrderUnordered, types.Version)
+	return a, b
+}
+
+// transfer sends coins over the channel of an endpoint, commits the packet and
+// returns it
+func transfer(t *testing.T, src, dst *simapp.TestEndpoint, token sdk.Coin, sender sdk.AccAddress,
+	receiver string, timeoutHeight uint64) ibc.Packet {
+
+	ctx := src.Chain.Context()
+	sequence := src.Chain.App.IBCKeeper.GetNextSequenceSend(ctx, src.PortID, src.ChannelID)
+	fullDenom := token.Denom
+	if trace, found := src.Chain.App.TransferKeeper.GetDenomTrace(ctx, token.Denom); found {
+		fullDenom = trace.FullDenom()
+	}
+
+	src.Chain.DeliverOK(types.NewMsgTransfer(src.ChannelID, token, sender, receiver, timeoutHeight))
+	src.Chain.NextBlock()
+
+	data := types.NewFungibleTokenPacketData(fullDenom, token.Amount, sender.String(), receiver)
+	return ibc.NewPacket(data.GetBytes(), sequence, src.PortID, src.ChannelID,
+		dst.PortID, dst.ChannelID, timeoutHeight)
+}
+
+// receive relays a packet to its destination and commits the returned
+// acknowledgement
+func receive(t *testing.T, src, dst *simapp.TestEndpoint, packet ibc.Packet) types.FungibleTokenPacketAcknowledgement {
+	res := simapp.RelayPacket(src, dst, packet)
+	require.True(t, res.IsOK(), res.Log)
+	dst.Chain.NextBlock()
+
+	for _, event := range res.Events {
+		if event.Type != ibc.EventTypeRecvPacket {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == ibc.AttributeKeyAck {
+				var ack types.FungibleTokenPacketAcknowledgement
+				types.ModuleCdc.MustUnmarshalJSON(attr.Value, &ack)
+				return ack
+			}
+		}
+	}
+
+	t.Fatal("no acknowledgement of the received packet")
+	return types.FungibleTokenPacketAcknowledgement{}
+}
+
+func balance(chain *simapp.TestChain, addr sdk.AccAddress) sdk.Coins {
+	return chain.App.AccountKeeper.GetAccount(chain.Context(), addr).GetCoins()
+}
+
+func escrow(chain *simapp.TestChain) sdk.Coins {
+	moduleAcc := chain.App.SupplyKeeper.GetModuleAccount(chain.Context(), types.ModuleName)
+	return moduleAcc.GetCoins()
+}
+
+func totalSupply(chain *simapp.TestChain, denom string) sdk.Int {
+	return chain.App.SupplyKeeper.GetSupply(chain.Context()).GetTotal().AmountOf(denom)
+}
+
+func TestTransferRoundTrip(t *testing.T) {
+	a, b := setupEndpoints(t)
+
+	// atoms are escrowed on chain A
+	packet := transfer(t, a, b, atoms(40), addrA, addrB.String(), 1000)
+	require.Equal(t, sdk.NewCoins(atoms(60)), balance(a.Chain, addrA))
+	require.Equal(t, sdk.NewCoins(atoms(40)), escrow(a.Chain))
+	require.Equal(t, sdk.NewCoins(atoms(40)), a.Chain.App.TransferKeeper.GetChannelEscrow(a.Chain.Context(), a.ChannelID))
+
+	// vouchers are minted on chain B
+	ack := receive(t, a, b, packet)
+	require.True(t, ack.Success, ack.Error)
+	require.Equal(t, sdk.NewCoins(vouchers(40)), balance(b.Chain, addrB))
+	require.Equal(t, sdk.NewInt(40), totalSupply(b.Chain, voucherB))
+
+	trace, found := b.Chain.App.TransferKeeper.GetDenomTrace(b.Chain.Context(), voucherB)
+	require.True(t, found)
+	require.Equal(t, types.NewDenomTrace("transfer/chanba", "atom"), trace)
+
+	// a packet cannot be received twice
+	res := simapp.RelayPacket(a, b, packet)
+	require.False(t, res.IsOK())
+
+	res = simapp.RelayAcknowledgement(a, b, packet, ack.GetBytes())
+	require.True(t, res.IsOK(), res.Log)
+	a.Chain.NextBlock()
+	require.Equal(t, sdk.NewCoins(atoms(60)), balance(a.Chain, addrA))
+
+	// returning vouchers are burned on chain B and atoms released on chain A
+	packet = transfer(t, b, a, vouchers(15), addrB, addrA.String(), 1000)
+	require.Equal(t, sdk.NewCoins(vouchers(25)), balance(b.Chain, addrB))
+	require.Equal(t, sdk.NewInt(25), totalSupply(b.Chain, voucherB))
+	require.True(t, escrow(b.Chain).IsZero())
+
+	ack = receive(t, b, a, packet)
+	require.True(t, ack.Success, ack.Error)
+	require.Equal(t, sdk.NewCoins(atoms(75)), balance(a.Chain, addrA))
+	require.Equal(t, sdk.NewCoins(atoms(25)), escrow(a.Chain))
+	require.Equal(t, sdk.NewCoins(atoms(25)), a.Chain.App.TransferKeeper.GetChannelEscrow(a.Chain.Context(), a.ChannelID))
+}
+
+func TestTransferTimeout(t *testing.T) {
+	a, b := setupEndpoints(t)
+
+	timeoutHeight := uint64(b.Chain.Header.Height + 2)
+	packet := transfer(t, a, b, atoms(40), addrA, addrB.String(), timeoutHeight)
+	require.Equal(t, sdk.NewCoins(atoms(60)), balance(a.Chain, addrA))
+
+	// the packet cannot time out before chain B reaches the timeout height
+	res := simapp.RelayTimeout(a, b, packet)
+	require.False(t, res.IsOK())
+
+	b.Chain.NextBlock()
+	b.Chain.NextBlock()
+
+	// the packet cannot be received at the timeout height
+	res = simapp.RelayPacket(a, b, packet)
+	require.False(t, res.IsOK())
+
+	// the escrowed atoms are refunded
+	res = simapp.RelayTimeout(a, b, packet)
+	require.True(t, res.IsOK(), res.Log)
+	a.Chain.NextBlock()
+	require.Equal(t, sdk.NewCoins(atoms(100)), balance(a.Chain, addrA))
+	require.True(t, escrow(a.Chain).IsZero())
+
+	_, found := a.Chain.App.IBCKeeper.GetPacketCommitment(a.Chain.Context(), a.PortID, a.ChannelID, packet.Sequence)
+	require.False(t, found)
+}
+
+func TestTransferVoucherTimeout(t *testing.T) {
+	a, b := setupEndpoints(t)
+
+	packet := transfer(t, a, b, atoms(40), addrA, addrB.String(), 1000)
+	receive(t, a, b, packet)
+
+	// burned vouchers are minted again on timeout
+	timeoutHeight := uint64(a.Chain.Header.Height + 1)
+	packet = transfer(t, b, a, vouchers(15), addrB, addrA.String(), timeoutHeight)
+	require.Equal(t, sdk.NewCoins(vouchers(25)), balance(b.Chain, addrB))
+	a.Chain.NextBlock()
+
+	res := simapp.RelayTimeout(b, a, packet)
+	require.True(t, res.IsOK(), res.Log)
+	b.Chain.NextBlock()
+	require.Equal(t, sdk.NewCoins(vouchers(40)), balance(b.Chain, addrB))
+	require.Equal(t, sdk.NewInt(40), totalSupply(b.Chain, voucherB))
+}
+
+func TestTransferErrorAcknowledgement(t *testing.T) {
+	a, b := setupEndpoints(t)
+
+	// the packet is received with an error as the receiver is invalid
+	packet := transfer(t, a, b, atoms(40), addrA, "invalid", 1000)
+	ack := receive(t, a, b, packet)
+	require.False(t, ack.Success)
+	require.NotEmpty(t, ack.Error)
+	require.True(t, totalSupply(b.Chain, voucherB).IsZero())
+
+	_, found := b.Chain.App.TransferKeeper.GetDenomTrace(b.Chain.Context(), voucherB)
+	require.False(t, found)
+
+	// the escrowed atoms are refunded
+	res := simapp.RelayAcknowledgement(a, b, packet, ack.GetBytes())
+	require.True(t, res.IsOK(), res.Log)
+	a.Chain.NextBlock()
+	require.Equal(t, sdk.NewCoins(atoms(100)), balance(a.Chain, addrA))
+	require.True(t, escrow(a.Chain).IsZero())
+}
+
+func TestSendTransferErrors(t *testing.T) {
+	a, b := setupEndpoints(t)
+
+	// unknown channel
+	res := a.Chain.Deliver(types.NewMsgTransfer("unknown", atoms(10), addrA, addrB.String(), 1000))
+	require.False(t, res.IsOK())
+
+	// insufficient funds
+	res = a.Chain.Deliver(types.NewMsgTransfer(a.ChannelID, atoms(200), addrA, addrB.String(), 1000))
+	require.False(t, res.IsOK())
+
+	// timeout height already reached by chain B
+	res = a.Chain.Deliver(types.NewMsgTransfer(a.ChannelID, atoms(10), addrA, addrB.String(), 1))
+	require.False(t, res.IsOK())
+	require.Equal(t, sdk.NewCoins(atoms(100)), balance(a.Chain, addrA))
+
+	// chain B cannot release more atoms than were escrowed for the channel
+	packet := transfer(t, a, b, atoms(40), addrA, addrB.String(), 1000)
+	receive(t, a, b, packet)
+
+	ctx := a.Chain.Context()
+	a.Chain.App.TransferKeeper.SetChannelEscrow(ctx, a.ChannelID, sdk.NewCoins(atoms(10)))
+	a.Chain.NextBlock()
+
+	packet = transfer(t, b, a, vouchers(15), addrB, addrA.String(), 1000)
+	ack := receive(t, b, a, packet)
+	require.False(t, ack.Success)
+	require.Equal(t, sdk.NewCoins(atoms(60)), balance(a.Chain, addrA))
+}
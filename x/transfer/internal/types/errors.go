@@ -0,0 +1,49 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Codes for transfer errors
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeInvalidPacketData  sdk.CodeType = 101
+	CodeInvalidDenomTrace  sdk.CodeType = 102
+	CodeChannelNotFound    sdk.CodeType = 103
+	CodeInvalidChannel     sdk.CodeType = 104
+	CodeInsufficientEscrow sdk.CodeType = 105
+)
+
+// ErrInvalidPacketData is returned when the data of a transfer packet is
+// invalid
+func ErrInvalidPacketData(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidPacketData, fmt.Sprintf("invalid packet data: %s", msg))
+}
+
+// ErrInvalidDenomTrace is returned when a denomination trace is invalid, or
+// when its voucher denomination is already used by a different trace
+func ErrInvalidDenomTrace(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDenomTrace, fmt.Sprintf("invalid denomination trace: %s", msg))
+}
+
+// ErrChannelNotFound is returned when a channel of the transfer port does not
+// exist
+func ErrChannelNotFound(codespace sdk.CodespaceType, channelID string) sdk.Error {
+	return sdk.NewError(codespace, CodeChannelNotFound, fmt.Sprintf("channel %s of port %s not found", channelID, PortID))
+}
+
+// ErrInvalidChannel is returned when a channel of the transfer port is opened
+// with a different ordering, port or version than the transfer module uses
+func ErrInvalidChannel(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidChannel, fmt.Sprintf("invalid transfer channel: %s", msg))
+}
+
+// ErrInsufficientEscrow is returned when a channel returns more coins than have
+// been escrowed for it
+func ErrInsufficientEscrow(codespace sdk.CodespaceType, channelID string, escrow, amt sdk.Coins) sdk.Error {
+	return sdk.NewError(codespace, CodeInsufficientEscrow,
+		fmt.Sprintf("channel %s returns %s but only %s is escrowed", channelID, amt, escrow))
+}
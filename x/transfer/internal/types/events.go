@@ -0,0 +1,20 @@
+package types
+
+// transfer module event types
+var (
+	EventTypeTransfer        = "ibc_transfer"
+	EventTypeReceive         = "ibc_receive"
+	EventTypeRefund          = "ibc_refund"
+	EventTypeAcknowledgement = "ibc_acknowledgement"
+
+	AttributeValueCategory = ModuleName
+
+	AttributeKeyChannelID = "channel_id"
+	AttributeKeySequence  = "sequence"
+	AttributeKeySender    = "sender"
+	AttributeKeyReceiver  = "receiver"
+	AttributeKeyDenom     = "denom"
+	AttributeKeyAmount    = "amount"
+	AttributeKeySuccess   = "success"
+	AttributeKeyError     = "error"
+)
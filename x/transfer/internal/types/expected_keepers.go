@@ -0,0 +1,27 @@
+package types // noalias
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc"
+	"github.com/cosmos/cosmos-sdk/x/supply/exported"
+)
+
+// ChannelKeeper defines the expected ibc keeper, which sends the packets of
+// the channels of the transfer port
+type ChannelKeeper interface {
+	GetChannel(ctx sdk.Context, portID, channelID string) (channel ibc.Channel, found bool)
+	GetNextSequenceSend(ctx sdk.Context, portID, channelID string) uint64
+	SendPacket(ctx sdk.Context, packet ibc.Packet) sdk.Error
+}
+
+// SupplyKeeper defines the expected supply keeper, which escrows, mints and
+// burns the transferred coins through the transfer module account
+type SupplyKeeper interface {
+	GetModuleAddress(name string) sdk.AccAddress
+	GetModuleAccount(ctx sdk.Context, name string) exported.ModuleAccountI
+
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) sdk.Error
+	MintCoins(ctx sdk.Context, name string, amt sdk.Coins) sdk.Error
+	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) sdk.Error
+}
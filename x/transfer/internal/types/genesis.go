@@ -0,0 +1,79 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc"
+)
+
+// ChannelEscrow is the amount of coins escrowed for a channel of the transfer
+// port
+type ChannelEscrow struct {
+	ChannelID string    `json:"channel_id" yaml:"channel_id"`
+	Amount    sdk.Coins `json:"amount" yaml:"amount"`
+}
+
+// NewChannelEscrow creates a new ChannelEscrow instance
+func NewChannelEscrow(channelID string, amount sdk.Coins) ChannelEscrow {
+	return ChannelEscrow{
+		ChannelID: channelID,
+		Amount:    amount,
+	}
+}
+
+// GenesisState contains the denomination traces of the vouchers and the coins
+// escrowed for each channel of the transfer module
+type GenesisState struct {
+	DenomTraces    []DenomTrace    `json:"denom_traces" yaml:"denom_traces"`
+	ChannelEscrows []ChannelEscrow `json:"channel_escrows" yaml:"channel_escrows"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(denomTraces []DenomTrace, channelEscrows []ChannelEscrow) GenesisState {
+	return GenesisState{
+		DenomTraces:    denomTraces,
+		ChannelEscrows: channelEscrows,
+	}
+}
+
+// DefaultGenesisState returns a default genesis state without any voucher
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState([]DenomTrace{}, []ChannelEscrow{})
+}
+
+// ValidateGenesis checks that the denomination traces are valid vouchers with
+// distinct denominations and that the escrowed coins are valid
+func ValidateGenesis(data GenesisState) error {
+	denoms := make(map[string]bool, len(data.DenomTraces))
+	for _, trace := range data.DenomTraces {
+		if err := trace.Validate(); err != nil {
+			return err
+		}
+		if trace.Path == "" {
+			return fmt.Errorf("denomination trace of %s has no path", trace.BaseDenom)
+		}
+
+		denom := trace.Denom()
+		if denoms[denom] {
+			return fmt.Errorf("duplicate denomination trace for voucher %s", denom)
+		}
+		denoms[denom] = true
+	}
+
+	channels := make(map[string]bool, len(data.ChannelEscrows))
+	for _, escrow := range data.ChannelEscrows {
+		if err := ibc.ValidateIdentifier(escrow.ChannelID); err != nil {
+			return err
+		}
+		if channels[escrow.ChannelID] {
+			return fmt.Errorf("duplicate escrow for channel %s", escrow.ChannelID)
+		}
+		channels[escrow.ChannelID] = true
+
+		if !escrow.Amount.IsValid() {
+			return fmt.Errorf("invalid escrow amount %s for channel %s", escrow.Amount, escrow.ChannelID)
+		}
+	}
+	return nil
+}
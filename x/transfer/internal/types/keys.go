@@ -0,0 +1,43 @@
+package types
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "transfer"
+
+	// StoreKey is the store key string for the transfer module
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the transfer module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the transfer module
+	QuerierRoute = ModuleName
+
+	// PortID is the ibc port the transfer module is bound to
+	PortID = "transfer"
+
+	// Version is the version of the channels of the transfer module, which is
+	// the same at both ends of a channel
+	Version = "ics20-1"
+)
+
+var (
+	// DenomTraceKeyPrefix is the prefix of the denomination traces of the
+	// vouchers, which are keyed by voucher denomination.
+	DenomTraceKeyPrefix = []byte{0x00}
+
+	// ChannelEscrowKeyPrefix is the prefix of the coins escrowed for the
+	// channels of the transfer port, which are keyed by channel.
+	ChannelEscrowKeyPrefix = []byte{0x01}
+)
+
+// DenomTraceKey returns the store key of the denomination trace of a voucher
+// denomination.
+func DenomTraceKey(denom string) []byte {
+	return append(DenomTraceKeyPrefix, []byte(denom)...)
+}
+
+// ChannelEscrowKey returns the store key of the coins escrowed for a channel.
+func ChannelEscrowKey(channelID string) []byte {
+	return append(ChannelEscrowKeyPrefix, []byte(channelID)...)
+}
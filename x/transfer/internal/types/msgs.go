@@ -0,0 +1,73 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc"
+)
+
+// TypeMsgTransfer is the type of MsgTransfer
+const TypeMsgTransfer = "transfer"
+
+var _ sdk.Msg = MsgTransfer{}
+
+// MsgTransfer sends coins over a channel of the transfer port to a receiver on
+// the counterparty chain. The packet times out if it is not received before
+// the counterparty chain reaches the timeout height.
+type MsgTransfer struct {
+	SourceChannel string         `json:"source_channel" yaml:"source_channel"`
+	Token         sdk.Coin       `json:"token" yaml:"token"`
+	Sender        sdk.AccAddress `json:"sender" yaml:"sender"`
+	Receiver      string         `json:"receiver" yaml:"receiver"`
+	TimeoutHeight uint64         `json:"timeout_height" yaml:"timeout_height"`
+}
+
+// NewMsgTransfer creates a new MsgTransfer instance
+func NewMsgTransfer(sourceChannel string, token sdk.Coin, sender sdk.AccAddress, receiver string,
+	timeoutHeight uint64) MsgTransfer {
+
+	return MsgTransfer{
+		SourceChannel: sourceChannel,
+		Token:         token,
+		Sender:        sender,
+		Receiver:      receiver,
+		TimeoutHeight: timeoutHeight,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgTransfer) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgTransfer) Type() string { return TypeMsgTransfer }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgTransfer) ValidateBasic() sdk.Error {
+	if err := ibc.ValidateIdentifier(msg.SourceChannel); err != nil {
+		return sdk.ErrUnknownRequest(err.Error())
+	}
+	if !msg.Token.IsValid() || !msg.Token.IsPositive() {
+		return sdk.ErrInvalidCoins(msg.Token.String())
+	}
+	if msg.Sender.Empty() {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+	if strings.TrimSpace(msg.Receiver) == "" {
+		return sdk.ErrInvalidAddress("missing receiver address")
+	}
+	if msg.TimeoutHeight == 0 {
+		return sdk.ErrUnknownRequest("timeout height cannot be zero")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgTransfer) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
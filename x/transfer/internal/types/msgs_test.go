@@ -0,0 +1,25 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMsgTransferValidation(t *testing.T) {
+	sender := sdk.AccAddress([]byte("sender"))
+	token := sdk.NewInt64Coin("atom", 10)
+
+	require.NoError(t, NewMsgTransfer("chanab", token, sender, "receiver", 100).ValidateBasic())
+	require.Error(t, NewMsgTransfer("c", token, sender, "receiver", 100).ValidateBasic())
+	require.Error(t, NewMsgTransfer("chanab", sdk.NewInt64Coin("atom", 0), sender, "receiver", 100).ValidateBasic())
+	require.Error(t, NewMsgTransfer("chanab", token, nil, "receiver", 100).ValidateBasic())
+	require.Error(t, NewMsgTransfer("chanab", token, sender, "", 100).ValidateBasic())
+	require.Error(t, NewMsgTransfer("chanab", token, sender, "receiver", 0).ValidateBasic())
+
+	msg := NewMsgTransfer("chanab", token, sender, "receiver", 100)
+	require.Equal(t, []sdk.AccAddress{sender}, msg.GetSigners())
+	require.NotPanics(t, func() { msg.GetSignBytes() })
+}
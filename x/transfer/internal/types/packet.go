@@ -0,0 +1,88 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FungibleTokenPacketData is the data of the packets of the transfer module,
+// as defined by ICS 20. The denomination is the full denomination of the coins
+// on the sending chain, and the receiver is an address on the receiving chain.
+type FungibleTokenPacketData struct {
+	Denom    string  `json:"denom" yaml:"denom"`
+	Amount   sdk.Int `json:"amount" yaml:"amount"`
+	Sender   string  `json:"sender" yaml:"sender"`
+	Receiver string  `json:"receiver" yaml:"receiver"`
+}
+
+// NewFungibleTokenPacketData creates a new FungibleTokenPacketData instance
+func NewFungibleTokenPacketData(denom string, amount sdk.Int, sender, receiver string) FungibleTokenPacketData {
+	return FungibleTokenPacketData{
+		Denom:    denom,
+		Amount:   amount,
+		Sender:   sender,
+		Receiver: receiver,
+	}
+}
+
+// String implements the Stringer interface
+func (data FungibleTokenPacketData) String() string {
+	return fmt.Sprintf(`Fungible Token Packet Data:
+  Denom:    %s
+  Amount:   %s
+  Sender:   %s
+  Receiver: %s`, data.Denom, data.Amount, data.Sender, data.Receiver)
+}
+
+// ValidateBasic checks the denomination, amount, sender and receiver of the
+// packet data
+func (data FungibleTokenPacketData) ValidateBasic() error {
+	if err := ValidateFullDenom(data.Denom); err != nil {
+		return err
+	}
+	// the amount is unset if it is missing from the decoded packet data
+	if data.Amount == (sdk.Int{}) || !data.Amount.IsPositive() {
+		return fmt.Errorf("amount must be positive")
+	}
+	if strings.TrimSpace(data.Sender) == "" {
+		return errors.New("sender cannot be blank")
+	}
+	if strings.TrimSpace(data.Receiver) == "" {
+		return errors.New("receiver cannot be blank")
+	}
+	return nil
+}
+
+// GetBytes returns the sorted JSON encoding of the packet data, which is sent
+// in the packets
+func (data FungibleTokenPacketData) GetBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(data))
+}
+
+// FungibleTokenPacketAcknowledgement is the acknowledgement of the packets of
+// the transfer module. The coins are refunded to the sender if the receiving
+// chain acknowledges the packet with an error.
+type FungibleTokenPacketAcknowledgement struct {
+	Success bool   `json:"success" yaml:"success"`
+	Error   string `json:"error,omitempty" yaml:"error"`
+}
+
+// NewSuccessAcknowledgement returns the acknowledgement of a packet whose
+// coins have been received
+func NewSuccessAcknowledgement() FungibleTokenPacketAcknowledgement {
+	return FungibleTokenPacketAcknowledgement{Success: true}
+}
+
+// NewErrorAcknowledgement returns the acknowledgement of a packet whose coins
+// could not be received
+func NewErrorAcknowledgement(err string) FungibleTokenPacketAcknowledgement {
+	return FungibleTokenPacketAcknowledgement{Error: err}
+}
+
+// GetBytes returns the sorted JSON encoding of the acknowledgement
+func (ack FungibleTokenPacketAcknowledgement) GetBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(ack))
+}
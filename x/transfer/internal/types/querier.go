@@ -0,0 +1,20 @@
+package types
+
+// query endpoints supported by the transfer Querier
+const (
+	QueryDenomTrace  = "denom_trace"
+	QueryDenomTraces = "denom_traces"
+)
+
+// QueryDenomTraceParams defines the params for querying the denomination trace
+// of a voucher
+type QueryDenomTraceParams struct {
+	Denom string `json:"denom" yaml:"denom"`
+}
+
+// NewQueryDenomTraceParams creates a new QueryDenomTraceParams
+func NewQueryDenomTraceParams(denom string) QueryDenomTraceParams {
+	return QueryDenomTraceParams{
+		Denom: denom,
+	}
+}
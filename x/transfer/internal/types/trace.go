@@ -0,0 +1,124 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc"
+)
+
+// VoucherDenomPrefix is the prefix of the denominations of vouchers
+const VoucherDenomPrefix = "ibc"
+
+// DenomTrace is the trace of a denomination transferred over channels. The path
+// is the sequence of the port and channel identifiers prefixed by each chain
+// which received the coins, the latest first, and the base denomination is the
+// denomination on the chain the coins originate from. A coin with a trace is
+// represented by a voucher, whose denomination is derived from the trace.
+type DenomTrace struct {
+	Path      string `json:"path" yaml:"path"`
+	BaseDenom string `json:"base_denom" yaml:"base_denom"`
+}
+
+// NewDenomTrace creates a new DenomTrace instance
+func NewDenomTrace(path, baseDenom string) DenomTrace {
+	return DenomTrace{
+		Path:      path,
+		BaseDenom: baseDenom,
+	}
+}
+
+// ParseDenomTrace parses the full denomination of a transferred coin, i.e. the
+// base denomination prefixed by its path.
+func ParseDenomTrace(fullDenom string) DenomTrace {
+	i := strings.LastIndex(fullDenom, "/")
+	if i < 0 {
+		return NewDenomTrace("", fullDenom)
+	}
+	return NewDenomTrace(fullDenom[:i], fullDenom[i+1:])
+}
+
+// FullDenom returns the base denomination prefixed by the path of the trace
+func (dt DenomTrace) FullDenom() string {
+	if dt.Path == "" {
+		return dt.BaseDenom
+	}
+	return dt.Path + "/" + dt.BaseDenom
+}
+
+// Denom returns the local denomination of the coins of the trace: the voucher
+// denomination, made of the hex encoded SHA-256 hash of the full denomination,
+// if the trace has a path, or else the base denomination.
+func (dt DenomTrace) Denom() string {
+	if dt.Path == "" {
+		return dt.BaseDenom
+	}
+
+	hash := sha256.Sum256([]byte(dt.FullDenom()))
+	return VoucherDenomPrefix + hex.EncodeToString(hash[:])
+}
+
+// String implements the Stringer interface
+func (dt DenomTrace) String() string {
+	return fmt.Sprintf(`Denomination Trace:
+  Path:       %s
+  Base Denom: %s
+  Denom:      %s`, dt.Path, dt.BaseDenom, dt.Denom())
+}
+
+// Validate checks that the path of the trace is made of port and channel
+// identifiers, and that the base denomination is a valid coin denomination
+func (dt DenomTrace) Validate() error {
+	if err := sdk.ValidateDenom(dt.BaseDenom); err != nil {
+		return err
+	}
+	if dt.Path == "" {
+		return nil
+	}
+
+	ids := strings.Split(dt.Path, "/")
+	if len(ids)%2 != 0 {
+		return fmt.Errorf("path %s is not made of port and channel pairs", dt.Path)
+	}
+	for _, id := range ids {
+		if err := ibc.ValidateIdentifier(id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DenomTraces is a list of denomination traces
+type DenomTraces []DenomTrace
+
+// String implements the Stringer interface
+func (dts DenomTraces) String() string {
+	if len(dts) == 0 {
+		return "[]"
+	}
+
+	out := make([]string, len(dts))
+	for i, dt := range dts {
+		out[i] = dt.String()
+	}
+	return strings.Join(out, "\n")
+}
+
+// SourcePrefix returns the prefix added to the denominations of the coins sent
+// from a port and channel by the chain receiving them
+func SourcePrefix(portID, channelID string) string {
+	return portID + "/" + channelID + "/"
+}
+
+// ValidateFullDenom checks that a full denomination is a base denomination
+// prefixed by a valid path
+func ValidateFullDenom(fullDenom string) error {
+	if strings.TrimSpace(fullDenom) == "" {
+		return errors.New("denomination cannot be blank")
+	}
+	return ParseDenomTrace(fullDenom).Validate()
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestDenomTrace(t *testing.T) {
+	trace := ParseDenomTrace("transfer/chanba/transfer/chancb/atom")
+	require.Equal(t, NewDenomTrace("transfer/chanba/transfer/chancb", "atom"), trace)
+	require.Equal(t, "transfer/chanba/transfer/chancb/atom", trace.FullDenom())
+	require.NoError(t, trace.Validate())
+
+	// the voucher denomination is a valid coin denomination
+	denom := trace.Denom()
+	require.Len(t, denom, len(VoucherDenomPrefix)+2*sha256.Size)
+	require.NoError(t, sdk.ValidateDenom(denom))
+	require.NotEqual(t, denom, NewDenomTrace("transfer/chanba", "atom").Denom())
+
+	// coins without a path keep their denomination
+	trace = ParseDenomTrace("atom")
+	require.Equal(t, NewDenomTrace("", "atom"), trace)
+	require.Equal(t, "atom", trace.Denom())
+	require.NoError(t, trace.Validate())
+
+	for _, invalid := range []string{"", "transfer/atom", "transfer/chanba/Atom", "transfer/c/atom", "transfer//atom"} {
+		require.Error(t, ValidateFullDenom(invalid), invalid)
+	}
+}
+
+func TestFungibleTokenPacketDataValidation(t *testing.T) {
+	require.NoError(t, NewFungibleTokenPacketData("transfer/chanba/atom", sdk.NewInt(10), "sender", "receiver").ValidateBasic())
+	require.Error(t, NewFungibleTokenPacketData("transfer/atom", sdk.NewInt(10), "sender", "receiver").ValidateBasic())
+	require.Error(t, NewFungibleTokenPacketData("atom", sdk.ZeroInt(), "sender", "receiver").ValidateBasic())
+	require.Error(t, NewFungibleTokenPacketData("atom", sdk.Int{}, "sender", "receiver").ValidateBasic())
+	require.Error(t, NewFungibleTokenPacketData("atom", sdk.NewInt(10), "", "receiver").ValidateBasic())
+	require.Error(t, NewFungibleTokenPacketData("atom", sdk.NewInt(10), "sender", " ").ValidateBasic())
+
+	// the amount is missing from packet data without one
+	var data FungibleTokenPacketData
+	require.NoError(t, ModuleCdc.UnmarshalJSON([]byte(`{"denom":"atom","sender":"sender","receiver":"receiver"}`), &data))
+	require.Error(t, data.ValidateBasic())
+}
+
+func TestValidateGenesis(t *testing.T) {
+	require.NoError(t, ValidateGenesis(DefaultGenesisState()))
+
+	trace := NewDenomTrace("transfer/chanba", "atom")
+	escrow := ChannelEscrow{ChannelID: "chanab", Amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 10))}
+	require.NoError(t, ValidateGenesis(NewGenesisState([]DenomTrace{trace}, []ChannelEscrow{escrow})))
+
+	require.Error(t, ValidateGenesis(NewGenesisState([]DenomTrace{NewDenomTrace("", "atom")}, nil)))
+	require.Error(t, ValidateGenesis(NewGenesisState([]DenomTrace{trace, trace}, nil)))
+	require.Error(t, ValidateGenesis(NewGenesisState(nil, []ChannelEscrow{escrow, escrow})))
+	require.Error(t, ValidateGenesis(NewGenesisState(nil, []ChannelEscrow{{ChannelID: "c", Amount: escrow.Amount}})))
+	require.Error(t, ValidateGenesis(NewGenesisState(nil,
+		[]ChannelEscrow{{ChannelID: "chanab", Amount: sdk.Coins{sdk.Coin{Denom: "atom", Amount: sdk.NewInt(-1)}}}})))
+}
@@ -0,0 +1,198 @@
+package transfer
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/gorilla/mux"
+	"github.com/spf13/cobra"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/ibc"
+	"github.com/cosmos/cosmos-sdk/x/transfer/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/transfer/client/rest"
+	"github.com/cosmos/cosmos-sdk/x/transfer/internal/types"
+)
+
+var (
+	_ module.AppModule      = AppModule{}
+	_ module.AppModuleBasic = AppModuleBasic{}
+	_ ibc.Module            = AppModule{}
+)
+
+// AppModuleBasic defines the basic application module used by the transfer module.
+type AppModuleBasic struct{}
+
+// Name returns the transfer module's name.
+func (AppModuleBasic) Name() string {
+	return ModuleName
+}
+
+// RegisterCodec registers the transfer module's types for the given codec.
+func (AppModuleBasic) RegisterCodec(cdc *codec.Codec) {
+	RegisterCodec(cdc)
+}
+
+// DefaultGenesis returns default genesis state as raw bytes for the transfer
+// module.
+func (AppModuleBasic) DefaultGenesis() json.RawMessage {
+	return ModuleCdc.MustMarshalJSON(DefaultGenesisState())
+}
+
+// ValidateGenesis performs genesis state validation for the transfer module.
+func (AppModuleBasic) ValidateGenesis(bz json.RawMessage) error {
+	var data GenesisState
+	if err := ModuleCdc.UnmarshalJSON(bz, &data); err != nil {
+		return err
+	}
+	return ValidateGenesis(data)
+}
+
+// RegisterRESTRoutes registers the REST routes for the transfer module.
+func (AppModuleBasic) RegisterRESTRoutes(ctx context.CLIContext, rtr *mux.Router) {
+	rest.RegisterRoutes(ctx, rtr)
+}
+
+// GetTxCmd returns the root tx command for the transfer module.
+func (AppModuleBasic) GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	return cli.GetTxCmd(cdc)
+}
+
+// GetQueryCmd returns the root query command for the transfer module.
+func (AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	return cli.GetQueryCmd(cdc)
+}
+
+//____________________________________________________________________________
+
+// AppModule implements an application module for the transfer module.
+type AppModule struct {
+	AppModuleBasic
+
+	keeper       Keeper
+	supplyKeeper types.SupplyKeeper
+}
+
+// NewAppModule creates a new AppModule object
+func NewAppModule(keeper Keeper, supplyKeeper types.SupplyKeeper) AppModule {
+	return AppModule{
+		AppModuleBasic: AppModuleBasic{},
+		keeper:         keeper,
+		supplyKeeper:   supplyKeeper,
+	}
+}
+
+// Name returns the transfer module's name.
+func (AppModule) Name() string {
+	return ModuleName
+}
+
+// RegisterInvariants performs a no-op.
+func (AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+
+// Route returns the message routing key for the transfer module.
+func (AppModule) Route() string {
+	return RouterKey
+}
+
+// NewHandler returns an sdk.Handler for the transfer module.
+func (am AppModule) NewHandler() sdk.Handler {
+	return NewHandler(am.keeper)
+}
+
+// QuerierRoute returns the transfer module's querier route name.
+func (AppModule) QuerierRoute() string {
+	return QuerierRoute
+}
+
+// NewQuerierHandler returns the transfer module sdk.Querier.
+func (am AppModule) NewQuerierHandler() sdk.Querier {
+	return NewQuerier(am.keeper)
+}
+
+// InitGenesis performs genesis initialization for the transfer module. It
+// returns no validator updates.
+func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
+	var genesisState GenesisState
+	ModuleCdc.MustUnmarshalJSON(data, &genesisState)
+	InitGenesis(ctx, am.keeper, am.supplyKeeper, genesisState)
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis returns the exported genesis state as raw bytes for the
+// transfer module.
+func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
+	gs := ExportGenesis(ctx, am.keeper)
+	return ModuleCdc.MustMarshalJSON(gs)
+}
+
+// BeginBlock performs a no-op.
+func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
+
+// EndBlock performs a no-op. It returns no validator updates.
+func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	return []abci.ValidatorUpdate{}
+}
+
+//____________________________________________________________________________
+
+// OnChanOpenInit checks that a channel of the transfer port is unordered, is
+// connected to the transfer port of the counterparty chain and uses the
+// transfer version.
+func (am AppModule) OnChanOpenInit(ctx sdk.Context, order ibc.Order, connectionHops []string,
+	portID, channelID string, counterparty ibc.ChannelCounterparty, version string) sdk.Error {
+
+	return validateChannel(order, counterparty, version)
+}
+
+// OnChanOpenTry checks the channel like OnChanOpenInit.
+func (am AppModule) OnChanOpenTry(ctx sdk.Context, order ibc.Order, connectionHops []string,
+	portID, channelID string, counterparty ibc.ChannelCounterparty, version string) sdk.Error {
+
+	return validateChannel(order, counterparty, version)
+}
+
+// OnChanOpenAck performs a no-op.
+func (AppModule) OnChanOpenAck(_ sdk.Context, _, _ string) sdk.Error {
+	return nil
+}
+
+// OnChanOpenConfirm performs a no-op.
+func (AppModule) OnChanOpenConfirm(_ sdk.Context, _, _ string) sdk.Error {
+	return nil
+}
+
+// OnRecvPacket receives the coins of a packet from the counterparty chain.
+func (am AppModule) OnRecvPacket(ctx sdk.Context, packet ibc.Packet) ([]byte, sdk.Error) {
+	return am.keeper.OnRecvPacket(ctx, packet)
+}
+
+// OnAcknowledgementPacket refunds the coins of a packet if the counterparty
+// chain could not receive them.
+func (am AppModule) OnAcknowledgementPacket(ctx sdk.Context, packet ibc.Packet, acknowledgement []byte) sdk.Error {
+	return am.keeper.OnAcknowledgementPacket(ctx, packet, acknowledgement)
+}
+
+// OnTimeoutPacket refunds the coins of a packet which has timed out.
+func (am AppModule) OnTimeoutPacket(ctx sdk.Context, packet ibc.Packet) sdk.Error {
+	return am.keeper.OnTimeoutPacket(ctx, packet)
+}
+
+func validateChannel(order ibc.Order, counterparty ibc.ChannelCounterparty, version string) sdk.Error {
+	if order != ibc.OrderUnordered {
+		return ErrInvalidChannel(DefaultCodespace, fmt.Sprintf("expected %s channel, got %s", ibc.OrderUnordered, order))
+	}
+	if counterparty.PortID != PortID {
+		return ErrInvalidChannel(DefaultCodespace,
+			fmt.Sprintf("expected counterparty port %s, got %s", PortID, counterparty.PortID))
+	}
+	if version != Version {
+		return ErrInvalidChannel(DefaultCodespace, fmt.Sprintf("expected version %s, got %s", Version, version))
+	}
+	return nil
+}
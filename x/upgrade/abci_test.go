@@ -0,0 +1,189 @@
+package upgrade_test
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
+)
+
+type testInput struct {
+	keeper  upgrade.Keeper
+	handler gov.Handler
+	ctx     sdk.Context
+	home    string
+}
+
+func setupTest(t *testing.T, height int64) testInput {
+	home, err := ioutil.TempDir("", "upgrade_test")
+	require.NoError(t, err)
+
+	db := dbm.NewMemDB()
+	key := sdk.NewKVStoreKey(upgrade.StoreKey)
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	cdc := codec.New()
+	upgrade.RegisterCodec(cdc)
+
+	keeper := upgrade.NewKeeper(cdc, key, home)
+	ctx := sdk.NewContext(ms, abci.Header{Height: height, Time: time.Now()}, false, log.NewNopLogger())
+
+	return testInput{
+		keeper:  keeper,
+		handler: upgrade.NewSoftwareUpgradeProposalHandler(keeper),
+		ctx:     ctx,
+		home:    home,
+	}
+}
+
+func nextBlock(ctx sdk.Context, blocks int64, dur time.Duration) sdk.Context {
+	return ctx.WithBlockHeight(ctx.BlockHeight() + blocks).WithBlockTime(ctx.BlockTime().Add(dur))
+}
+
+func beginBlock(in testInput, ctx sdk.Context) {
+	upgrade.BeginBlocker(in.keeper, ctx, abci.RequestBeginBlock{Header: ctx.BlockHeader()})
+}
+
+func TestRequireName(t *testing.T) {
+	in := setupTest(t, 10)
+	defer os.RemoveAll(in.home)
+
+	err := in.handler(in.ctx, upgrade.NewSoftwareUpgradeProposal("test", "test", upgrade.Plan{}))
+	require.NotNil(t, err)
+	require.Equal(t, upgrade.CodeInvalidPlan, err.Code())
+}
+
+func TestRequireFutureTimeOrHeight(t *testing.T) {
+	in := setupTest(t, 10)
+	defer os.RemoveAll(in.home)
+
+	plan := upgrade.NewPlan("test", in.ctx.BlockTime(), 0, "")
+	err := in.handler(in.ctx, upgrade.NewSoftwareUpgradeProposal("test", "test", plan))
+	require.NotNil(t, err)
+	require.Equal(t, upgrade.CodeUpgradeExpired, err.Code())
+
+	plan = upgrade.NewPlan("test", time.Time{}, in.ctx.BlockHeight(), "")
+	err = in.handler(in.ctx, upgrade.NewSoftwareUpgradeProposal("test", "test", plan))
+	require.NotNil(t, err)
+	require.Equal(t, upgrade.CodeUpgradeExpired, err.Code())
+}
+
+func TestRejectTimeAndHeight(t *testing.T) {
+	in := setupTest(t, 10)
+	defer os.RemoveAll(in.home)
+
+	plan := upgrade.NewPlan("test", in.ctx.BlockTime().Add(time.Hour), in.ctx.BlockHeight()+1, "")
+	err := in.handler(in.ctx, upgrade.NewSoftwareUpgradeProposal("test", "test", plan))
+	require.NotNil(t, err)
+	require.Equal(t, upgrade.CodeInvalidPlan, err.Code())
+}
+
+func TestHaltAtHeightWithoutHandler(t *testing.T) {
+	in := setupTest(t, 10)
+	defer os.RemoveAll(in.home)
+
+	plan := upgrade.NewPlan("test", time.Time{}, in.ctx.BlockHeight()+1, "v2")
+	require.Nil(t, in.handler(in.ctx, upgrade.NewSoftwareUpgradeProposal("test", "test", plan)))
+
+	// the block before the upgrade height passes
+	require.NotPanics(t, func() { beginBlock(in, in.ctx) })
+
+	// the upgrade height halts the chain and records the upgrade on disk
+	newCtx := nextBlock(in.ctx, 1, time.Second)
+	require.Panics(t, func() { beginBlock(in, newCtx) })
+
+	info, err := in.keeper.ReadUpgradeInfoFromDisk()
+	require.NoError(t, err)
+	require.Equal(t, "test", info.Name)
+	require.Equal(t, newCtx.BlockHeight(), info.Height)
+}
+
+func TestHaltAtTimeWithoutHandler(t *testing.T) {
+	in := setupTest(t, 10)
+	defer os.RemoveAll(in.home)
+
+	plan := upgrade.NewPlan("test", in.ctx.BlockTime().Add(time.Hour), 0, "")
+	require.Nil(t, in.handler(in.ctx, upgrade.NewSoftwareUpgradeProposal("test", "test", plan)))
+
+	require.NotPanics(t, func() { beginBlock(in, nextBlock(in.ctx, 1, time.Minute)) })
+	require.Panics(t, func() { beginBlock(in, nextBlock(in.ctx, 1, time.Hour)) })
+}
+
+func TestApplyUpgradeWithHandler(t *testing.T) {
+	in := setupTest(t, 10)
+	defer os.RemoveAll(in.home)
+
+	plan := upgrade.NewPlan("test", time.Time{}, in.ctx.BlockHeight()+1, "")
+	require.Nil(t, in.handler(in.ctx, upgrade.NewSoftwareUpgradeProposal("test", "test", plan)))
+
+	var applied bool
+	in.keeper.SetUpgradeHandler("test", func(ctx sdk.Context, plan upgrade.Plan) { applied = true })
+
+	// the handler must not be registered before the upgrade height
+	require.Panics(t, func() { beginBlock(in, in.ctx) })
+
+	newCtx := nextBlock(in.ctx, 1, time.Second)
+	require.NotPanics(t, func() { beginBlock(in, newCtx) })
+	require.True(t, applied)
+
+	_, found := in.keeper.GetUpgradePlan(newCtx)
+	require.False(t, found)
+	require.Equal(t, newCtx.BlockHeight(), in.keeper.GetDoneHeight(newCtx, "test"))
+
+	// a completed upgrade name cannot be scheduled again
+	plan = upgrade.NewPlan("test", time.Time{}, newCtx.BlockHeight()+10, "")
+	err := in.handler(newCtx, upgrade.NewSoftwareUpgradeProposal("test", "test", plan))
+	require.NotNil(t, err)
+	require.Equal(t, upgrade.CodeUpgradeDone, err.Code())
+}
+
+func TestCanOverwriteScheduledUpgrade(t *testing.T) {
+	in := setupTest(t, 10)
+	defer os.RemoveAll(in.home)
+
+	plan := upgrade.NewPlan("test", time.Time{}, in.ctx.BlockHeight()+1, "")
+	require.Nil(t, in.handler(in.ctx, upgrade.NewSoftwareUpgradeProposal("test", "test", plan)))
+
+	plan = upgrade.NewPlan("test2", time.Time{}, in.ctx.BlockHeight()+2, "")
+	require.Nil(t, in.handler(in.ctx, upgrade.NewSoftwareUpgradeProposal("test2", "test2", plan)))
+
+	current, found := in.keeper.GetUpgradePlan(in.ctx)
+	require.True(t, found)
+	require.Equal(t, plan, current)
+
+	require.NotPanics(t, func() { beginBlock(in, nextBlock(in.ctx, 1, time.Second)) })
+	require.Panics(t, func() { beginBlock(in, nextBlock(in.ctx, 2, time.Second)) })
+}
+
+func TestCancelUpgrade(t *testing.T) {
+	in := setupTest(t, 10)
+	defer os.RemoveAll(in.home)
+
+	plan := upgrade.NewPlan("test", time.Time{}, in.ctx.BlockHeight()+1, "")
+	require.Nil(t, in.handler(in.ctx, upgrade.NewSoftwareUpgradeProposal("test", "test", plan)))
+	require.Nil(t, in.handler(in.ctx, upgrade.NewCancelSoftwareUpgradeProposal("cancel", "cancel")))
+
+	_, found := in.keeper.GetUpgradePlan(in.ctx)
+	require.False(t, found)
+	require.NotPanics(t, func() { beginBlock(in, nextBlock(in.ctx, 1, time.Second)) })
+}
+
+func TestNoSpuriousUpgrades(t *testing.T) {
+	in := setupTest(t, 10)
+	defer os.RemoveAll(in.home)
+
+	require.NotPanics(t, func() { beginBlock(in, in.ctx) })
+}
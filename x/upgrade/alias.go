@@ -0,0 +1,59 @@
+// nolint
+// autogenerated code using github.com/rigelrozanski/multitool
+// aliases generated for the following subdirectories:
+// ALIASGEN: github.com/cosmos/cosmos-sdk/x/upgrade/internal/types
+// ALIASGEN: github.com/cosmos/cosmos-sdk/x/upgrade/internal/keeper
+package upgrade
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/upgrade/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/internal/types"
+)
+
+const (
+	ModuleName                        = types.ModuleName
+	RouterKey                         = types.RouterKey
+	StoreKey                          = types.StoreKey
+	QuerierKey                        = types.QuerierKey
+	PlanByte                          = types.PlanByte
+	DoneByte                          = types.DoneByte
+	ProposalTypeSoftwareUpgrade       = types.ProposalTypeSoftwareUpgrade
+	ProposalTypeCancelSoftwareUpgrade = types.ProposalTypeCancelSoftwareUpgrade
+	QueryCurrent                      = types.QueryCurrent
+	QueryApplied                      = types.QueryApplied
+	DefaultCodespace                  = types.DefaultCodespace
+	CodeInvalidPlan                   = types.CodeInvalidPlan
+	CodeUpgradeExpired                = types.CodeUpgradeExpired
+	CodeUpgradeDone                   = types.CodeUpgradeDone
+	UpgradeInfoFileName               = types.UpgradeInfoFileName
+)
+
+var (
+	// functions aliases
+	RegisterCodec                    = types.RegisterCodec
+	PlanKey                          = types.PlanKey
+	DoneKey                          = types.DoneKey
+	NewPlan                          = types.NewPlan
+	NewSoftwareUpgradeProposal       = types.NewSoftwareUpgradeProposal
+	NewCancelSoftwareUpgradeProposal = types.NewCancelSoftwareUpgradeProposal
+	NewQueryAppliedParams            = types.NewQueryAppliedParams
+	UpgradeStoreLoader               = types.UpgradeStoreLoader
+	ErrInvalidPlan                   = types.ErrInvalidPlan
+	ErrUpgradeExpired                = types.ErrUpgradeExpired
+	ErrUpgradeDone                   = types.ErrUpgradeDone
+	NewKeeper                        = keeper.NewKeeper
+	NewQuerier                       = keeper.NewQuerier
+
+	// variable aliases
+	ModuleCdc = types.ModuleCdc
+)
+
+type (
+	UpgradeHandler                = types.UpgradeHandler
+	Plan                          = types.Plan
+	SoftwareUpgradeProposal       = types.SoftwareUpgradeProposal
+	CancelSoftwareUpgradeProposal = types.CancelSoftwareUpgradeProposal
+	QueryAppliedParams            = types.QueryAppliedParams
+	UpgradeInfo                   = types.UpgradeInfo
+	Keeper                        = keeper.Keeper
+)
@@ -0,0 +1,105 @@
+package rest
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/internal/types"
+)
+
+type (
+	// PlanRequest defines a proposal for a new upgrade plan.
+	PlanRequest struct {
+		BaseReq       rest.BaseReq   `json:"base_req" yaml:"base_req"`
+		Title         string         `json:"title" yaml:"title"`
+		Description   string         `json:"description" yaml:"description"`
+		Deposit       sdk.Coins      `json:"deposit" yaml:"deposit"`
+		UpgradeName   string         `json:"name" yaml:"name"`
+		UpgradeHeight int64          `json:"upgrade_height" yaml:"upgrade_height"`
+		UpgradeTime   time.Time      `json:"upgrade_time" yaml:"upgrade_time"`
+		UpgradeInfo   string         `json:"upgrade_info" yaml:"upgrade_info"`
+		Proposer      sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Class         string         `json:"class" yaml:"class"`
+	}
+
+	// CancelRequest defines a proposal to cancel a current plan.
+	CancelRequest struct {
+		BaseReq     rest.BaseReq   `json:"base_req" yaml:"base_req"`
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Class       string         `json:"class" yaml:"class"`
+	}
+)
+
+// ProposalRESTHandler returns a ProposalRESTHandler that exposes the software upgrade REST handler with a given sub-route.
+func ProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "upgrade",
+		Handler:  postPlanHandler(cliCtx),
+	}
+}
+
+// CancelProposalRESTHandler returns a ProposalRESTHandler that exposes the cancel software upgrade REST handler with a given sub-route.
+func CancelProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "cancel_upgrade",
+		Handler:  cancelPlanHandler(cliCtx),
+	}
+}
+
+func postPlanHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req PlanRequest
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		plan := types.NewPlan(req.UpgradeName, req.UpgradeTime, req.UpgradeHeight, req.UpgradeInfo)
+		content := types.NewSoftwareUpgradeProposal(req.Title, req.Description, plan)
+
+		msg := govtypes.NewMsgSubmitProposalWithClass(content, req.Deposit, req.Proposer, req.Class)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func cancelPlanHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CancelRequest
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewCancelSoftwareUpgradeProposal(req.Title, req.Description)
+
+		msg := govtypes.NewMsgSubmitProposalWithClass(content, req.Deposit, req.Proposer, req.Class)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
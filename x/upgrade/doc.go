@@ -0,0 +1,123 @@
+/*
+Package upgrade provides a Cosmos SDK module that can be used for smoothly upgrading a live Cosmos chain to a
+new software version. It accomplishes this by providing a BeginBlocker hook that prevents the blockchain state
+machine from proceeding once a pre-defined upgrade block time or height has been reached.
+
+Without software support for upgrades, upgrading a live chain is risky because all of the validators need to pause
+their state machines at exactly the same point in the process. If this is not done correctly, there can be state
+inconsistencies which are hard to recover from.
+
+General Workflow
+
+Let's assume we are running v1 of our software in our testnet and want to upgrade to v2.
+How would this look in practice? First of all, we want to finalize the v2 release candidate
+and there install a specially named upgrade handler (eg. "testnet-v2"). An upgrade
+handler should be defined in a new version of the software to define what migrations
+to run to migrate from the older version of the software. Naturally, this is app-specific rather
+than module specific, and must be defined in `app.go`, even if it imports logic from various
+modules to perform the actions. You can register them with `upgradeKeeper.SetUpgradeHandler`
+during the app initialization (before starting the abci server), and they serve not only to
+perform a migration, but also to identify if this is the old or new version (eg. presence of
+a handler registered for the named upgrade).
+
+Once the release candidate along with an appropriate upgrade handler is frozen,
+we can have a governance vote to approve this upgrade at some future block time
+or block height (e.g. 200000). This is known as an upgrade.Plan. The v1 code will not know of this
+handler, but will continue to run until block 200000, when the plan kicks in at BeginBlock. It will check
+for existence of the handler, and finding it missing, know that it is running the obsolete software,
+and gracefully exit.
+
+Generally the application binary will restart on exit, but then will execute this BeginBlocker
+again and exit, causing a restart loop. Either the operator can manually install the new software,
+or an external watcher daemon can switch binaries once it sees the upgrade log message.
+
+When the binary restarts with the upgraded version (here v2), it will detect we have registered the
+"testnet-v2" upgrade handler in the code, and realize it is the new version. It then will run the upgrade handler
+and *migrate the database in-place*. Once finished, it marks the upgrade as done, and continues processing
+the rest of the block as normal. Once 2/3 of the voting power has upgraded, the blockchain will immediately
+resume the consensus mechanism.
+
+Integrating With An App
+
+Setup an upgrade Keeper for the app, register the upgrade AppModule with the module manager and
+make sure its BeginBlock runs before every other module's:
+	app.upgradeKeeper = upgrade.NewKeeper(cdc, keys[upgrade.StoreKey], homePath)
+	app.mm.SetOrderBeginBlockers(upgrade.ModuleName, ...)
+
+The app must then route upgrade proposals from its governance module to the upgrade keeper:
+	govRouter.AddRoute(upgrade.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper))
+
+A passed SoftwareUpgradeProposal calls ScheduleUpgrade to schedule an upgrade, and a passed
+CancelSoftwareUpgradeProposal calls ClearUpgradePlan to cancel a pending upgrade.
+
+Performing Upgrades
+
+Upgrades can be scheduled at either a predefined block height or time. Once this block height or time is reached, the
+existing software will cease to process ABCI messages and a new version with code that handles the upgrade must be deployed.
+All upgrades are coordinated by a unique upgrade name that cannot be reused on the same blockchain. In order for the upgrade
+module to know that the upgrade has been safely applied, a handler with the name of the upgrade must be installed.
+Here is an example handler for an upgrade named "my-fancy-upgrade":
+	app.upgradeKeeper.SetUpgradeHandler("my-fancy-upgrade", func(ctx sdk.Context, plan upgrade.Plan) {
+		// Perform any migrations of the state store needed for this upgrade
+	})
+
+This upgrade handler performs the dual function of alerting the upgrade module that the named upgrade has been applied,
+as well as providing the opportunity for the upgraded software to perform any necessary state migrations. Both the halt
+(with the old binary) and applying the migration (with the new binary) are enforced in the state machine. Actually
+switching the binaries is an ops task and not handled inside the sdk / abci app.
+
+Store Migrations
+
+Upgrades that add, rename or delete stores need to change the set of mounted stores exactly at the upgrade height.
+When the old binary halts, it writes the name and height of the upgrade to data/upgrade-info.json under the node's
+home directory. The new binary reads it with ReadUpgradeInfoFromDisk and installs a store loader that applies the
+StoreUpgrades only when the multistore is loaded at the version right before the upgrade height:
+	upgradeInfo, err := app.upgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		panic(err)
+	}
+
+	if upgradeInfo.Name == "my-fancy-upgrade" {
+		storeUpgrades := store.StoreUpgrades{
+			Renamed: []store.StoreRename{{OldKey: "foo", NewKey: "bar"}},
+			Deleted: []string{"baz"},
+		}
+
+		// configure store loader that checks if version == upgradeHeight and applies store upgrades
+		app.SetStoreLoader(upgrade.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
+	}
+
+Halt Behavior
+
+Before halting the ABCI state machine in the BeginBlocker method, the upgrade module will log an error
+that looks like:
+	UPGRADE "<Name>" NEEDED at height <NNNN>: <Info>
+where Name are Info are the values of the respective fields on the upgrade Plan.
+
+Note that the first time the upgrade is scheduled the software will not halt, only once the upgrade time
+or height is reached.
+
+Plan.Info
+
+Plan.Info is not interpreted by the state machine. It can hold anything operators or a watcher daemon
+need to find the new binary, such as a git commit or a download URL.
+
+Cancelling Upgrades
+
+There are two ways to cancel a planned upgrade - with on-chain governance or off-chain social consensus.
+For the first one, there is a CancelSoftwareUpgrade proposal type, which can be voted on and will
+remove the scheduled upgrade plan. Of course this requires that the upgrade was known to be a bad idea
+well before the upgrade itself, to allow time for a vote. If you want to allow such a possibility, you
+should set the upgrade height to be 2 * (votingperiod + depositperiod) + (safety delta) from the beginning of
+the first upgrade proposal. Safety delta is the time available from the success of an upgrade proposal
+and the realization it was a bad idea (due to external testing). You can also start a CancelSoftwareUpgrade
+proposal while the original SoftwareUpgrade proposal is still being voted upon, as long as the voting
+period ends after the SoftwareUpgrade proposal.
+
+However, let's assume that we don't realize the upgrade has a bug until shortly before it will occur
+(or while we try it out - hitting some panic in the migration). It would seem the blockchain is stuck,
+but we need to allow an escape for social consensus to overrule the planned upgrade. In that case, the
+validators can simply restart the old binary with a state export at the halt height and start a new
+chain from it, without the scheduled plan.
+*/
+package upgrade
@@ -0,0 +1,191 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/internal/types"
+)
+
+// Keeper of the upgrade store
+type Keeper struct {
+	homePath        string
+	storeKey        sdk.StoreKey
+	cdc             *codec.Codec
+	upgradeHandlers map[string]types.UpgradeHandler
+}
+
+// NewKeeper constructs an upgrade Keeper. The homePath is the node's home
+// directory, under whose data directory the upgrade info is written when the
+// node halts for an upgrade. An empty homePath disables writing this file.
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, homePath string) Keeper {
+	return Keeper{
+		homePath:        homePath,
+		storeKey:        storeKey,
+		cdc:             cdc,
+		upgradeHandlers: map[string]types.UpgradeHandler{},
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// SetUpgradeHandler sets an UpgradeHandler for the upgrade specified by name. This handler will be called when the upgrade
+// with this name is applied. In order for an upgrade with the given name to proceed, a handler for this upgrade
+// must be set even if it is a no-op function.
+func (k Keeper) SetUpgradeHandler(name string, upgradeHandler types.UpgradeHandler) {
+	k.upgradeHandlers[name] = upgradeHandler
+}
+
+// HasHandler returns true iff there is a handler registered for this name
+func (k Keeper) HasHandler(name string) bool {
+	_, ok := k.upgradeHandlers[name]
+	return ok
+}
+
+// ScheduleUpgrade schedules an upgrade based on the specified plan.
+// If there is another Plan already scheduled, it will overwrite it
+// (implicitly cancelling the current plan)
+func (k Keeper) ScheduleUpgrade(ctx sdk.Context, plan types.Plan) sdk.Error {
+	if err := plan.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if !plan.Time.IsZero() {
+		if !plan.Time.After(ctx.BlockHeader().Time) {
+			return types.ErrUpgradeExpired(types.DefaultCodespace, "time")
+		}
+	} else if plan.Height <= ctx.BlockHeight() {
+		return types.ErrUpgradeExpired(types.DefaultCodespace, "height")
+	}
+
+	if k.GetDoneHeight(ctx, plan.Name) != 0 {
+		return types.ErrUpgradeDone(types.DefaultCodespace, plan.Name)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(plan)
+	store.Set(types.PlanKey(), bz)
+
+	return nil
+}
+
+// GetDoneHeight returns the height at which the given upgrade was executed
+func (k Keeper) GetDoneHeight(ctx sdk.Context, name string) int64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.DoneKey(name))
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return int64(binary.BigEndian.Uint64(bz))
+}
+
+// ClearUpgradePlan clears any schedule upgrade
+func (k Keeper) ClearUpgradePlan(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PlanKey())
+}
+
+// GetUpgradePlan returns the currently scheduled Plan if any, setting havePlan to true if there is a scheduled
+// upgrade or false if there is none
+func (k Keeper) GetUpgradePlan(ctx sdk.Context) (plan types.Plan, havePlan bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PlanKey())
+	if bz == nil {
+		return plan, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &plan)
+	return plan, true
+}
+
+// setDone marks this upgrade name as being done so the name can't be reused accidentally
+func (k Keeper) setDone(ctx sdk.Context, name string) {
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(ctx.BlockHeight()))
+	store.Set(types.DoneKey(name), bz)
+}
+
+// ApplyUpgrade will execute the handler associated with the Plan and mark the plan as done.
+func (k Keeper) ApplyUpgrade(ctx sdk.Context, plan types.Plan) {
+	handler := k.upgradeHandlers[plan.Name]
+	if handler == nil {
+		panic("ApplyUpgrade should never be called without first checking HasHandler")
+	}
+
+	handler(ctx, plan)
+
+	k.ClearUpgradePlan(ctx)
+	k.setDone(ctx, plan.Name)
+}
+
+// DumpUpgradeInfoToDisk writes the name and height of the upgrade the node is
+// halting for to the upgrade info file under the node's data directory. The new
+// binary reads it back with ReadUpgradeInfoFromDisk to apply store migrations.
+func (k Keeper) DumpUpgradeInfoToDisk(height int64, name string) error {
+	if k.homePath == "" {
+		return nil
+	}
+
+	upgradeInfoFilePath, err := k.GetUpgradeInfoPath()
+	if err != nil {
+		return err
+	}
+
+	info, err := json.Marshal(types.UpgradeInfo{Name: name, Height: height})
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(upgradeInfoFilePath, info, 0600)
+}
+
+// GetUpgradeInfoPath returns the upgrade info file path, creating the data
+// directory if it does not exist yet.
+func (k Keeper) GetUpgradeInfoPath() (string, error) {
+	upgradeInfoFileDir := filepath.Join(k.homePath, "data")
+	if err := os.MkdirAll(upgradeInfoFileDir, os.ModePerm); err != nil {
+		return "", fmt.Errorf("could not create directory %q: %v", upgradeInfoFileDir, err)
+	}
+
+	return filepath.Join(upgradeInfoFileDir, types.UpgradeInfoFileName), nil
+}
+
+// ReadUpgradeInfoFromDisk returns the upgrade info written by the halted
+// binary. An empty UpgradeInfo is returned if no file exists.
+func (k Keeper) ReadUpgradeInfoFromDisk() (types.UpgradeInfo, error) {
+	var upgradeInfo types.UpgradeInfo
+	if k.homePath == "" {
+		return upgradeInfo, nil
+	}
+
+	upgradeInfoPath, err := k.GetUpgradeInfoPath()
+	if err != nil {
+		return upgradeInfo, err
+	}
+
+	data, err := ioutil.ReadFile(upgradeInfoPath)
+	if os.IsNotExist(err) {
+		return upgradeInfo, nil
+	} else if err != nil {
+		return upgradeInfo, err
+	}
+
+	if err := json.Unmarshal(data, &upgradeInfo); err != nil {
+		return upgradeInfo, err
+	}
+
+	return upgradeInfo, nil
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultCodespace for the upgrade module
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeInvalidPlan    sdk.CodeType = 101
+	CodeUpgradeExpired sdk.CodeType = 102
+	CodeUpgradeDone    sdk.CodeType = 103
+)
+
+// ErrInvalidPlan returns an error for a plan that fails stateless validation
+func ErrInvalidPlan(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidPlan, fmt.Sprintf("invalid upgrade plan: %s", msg))
+}
+
+// ErrUpgradeExpired returns an error for a plan whose trigger has already passed
+func ErrUpgradeExpired(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeUpgradeExpired, fmt.Sprintf("upgrade cannot be scheduled in the past: %s", msg))
+}
+
+// ErrUpgradeDone returns an error for a plan whose name has already been applied
+func ErrUpgradeDone(codespace sdk.CodespaceType, name string) sdk.Error {
+	return sdk.NewError(codespace, CodeUpgradeDone, fmt.Sprintf("upgrade with name %s has already been completed", name))
+}
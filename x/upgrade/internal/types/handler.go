@@ -0,0 +1,8 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UpgradeHandler specifies the type of function that is called when an upgrade is applied
+type UpgradeHandler func(ctx sdk.Context, plan Plan)
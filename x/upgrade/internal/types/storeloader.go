@@ -0,0 +1,36 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	store "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UpgradeInfoFileName is the file, relative to the node's data directory, in
+// which the upgrade module records the upgrade the node halted for.
+const UpgradeInfoFileName = "upgrade-info.json"
+
+// UpgradeInfo is the information that the halted binary writes to disk, so that
+// the new binary knows which upgrade, and at which height, it has to apply.
+type UpgradeInfo struct {
+	Name   string `json:"name" yaml:"name"`
+	Height int64  `json:"height" yaml:"height"`
+}
+
+// UpgradeStoreLoader is used to prepare baseapp with a fixed StoreLoader
+// pattern. The store upgrades are only applied when the multistore is loaded
+// at the version right before the upgrade height, so restarting a node after
+// the upgrade has been committed performs the default load.
+func UpgradeStoreLoader(upgradeHeight int64, storeUpgrades *store.StoreUpgrades) baseapp.StoreLoader {
+	return func(ms sdk.CommitMultiStore) error {
+		if upgradeHeight == ms.LastCommitID().Version+1 {
+			// Check if the current commit version and upgrade height matches
+			if len(storeUpgrades.Renamed) > 0 || len(storeUpgrades.Deleted) > 0 {
+				return ms.LoadLatestVersionAndUpgrade(storeUpgrades)
+			}
+		}
+
+		// Otherwise load default store loader
+		return baseapp.DefaultStoreLoader(ms)
+	}
+}
@@ -0,0 +1,96 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	store "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func initStore(t *testing.T, db dbm.DB, storeKey string, k, v []byte) {
+	rs := rootmulti.NewStore(db)
+	rs.SetPruning(store.PruneSyncable)
+	key := sdk.NewKVStoreKey(storeKey)
+	rs.MountStoreWithDB(key, store.StoreTypeIAVL, nil)
+	require.NoError(t, rs.LoadLatestVersion())
+	require.Equal(t, int64(0), rs.LastCommitID().Version)
+
+	// write some data in substore
+	kv, _ := rs.GetStore(key).(store.KVStore)
+	require.NotNil(t, kv)
+	kv.Set(k, v)
+	commitID := rs.Commit()
+	require.Equal(t, int64(1), commitID.Version)
+}
+
+func checkStore(t *testing.T, db dbm.DB, ver int64, storeKey string, k, v []byte) {
+	rs := rootmulti.NewStore(db)
+	rs.SetPruning(store.PruneSyncable)
+	key := sdk.NewKVStoreKey(storeKey)
+	rs.MountStoreWithDB(key, store.StoreTypeIAVL, nil)
+	require.NoError(t, rs.LoadLatestVersion())
+	require.Equal(t, ver, rs.LastCommitID().Version)
+
+	// query data in substore
+	kv, _ := rs.GetStore(key).(store.KVStore)
+	require.NotNil(t, kv)
+	require.Equal(t, v, kv.Get(k))
+}
+
+func TestUpgradeStoreLoader(t *testing.T) {
+	renames := &store.StoreUpgrades{
+		Renamed: []store.StoreRename{{OldKey: "bnk", NewKey: "banker"}},
+	}
+
+	cases := map[string]struct {
+		upgradeHeight int64
+		origStoreKey  string
+		loadStoreKey  string
+	}{
+		"upgrade at next height renames the store": {
+			upgradeHeight: 2,
+			origStoreKey:  "bnk",
+			loadStoreKey:  "banker",
+		},
+		"upgrade at a later height keeps the store": {
+			upgradeHeight: 5,
+			origStoreKey:  "bnk",
+			loadStoreKey:  "bnk",
+		},
+	}
+
+	k := []byte("key")
+	v := []byte("value")
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			db := dbm.NewMemDB()
+			initStore(t, db, tc.origStoreKey, k, v)
+
+			// load the app with the upgrade store loader
+			opt := func(app *baseapp.BaseApp) {
+				app.SetStoreLoader(UpgradeStoreLoader(tc.upgradeHeight, renames))
+			}
+			app := baseapp.NewBaseApp(t.Name(), log.NewNopLogger(), db, nil, opt)
+			capKey := sdk.NewKVStoreKey(baseapp.MainStoreKey)
+			app.MountStores(capKey)
+			app.MountStores(sdk.NewKVStoreKey(tc.loadStoreKey))
+			require.NoError(t, app.LoadLatestVersion(capKey))
+
+			// commit a block to persist the (possibly renamed) store
+			app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 2}})
+			res := app.Commit()
+			require.NotNil(t, res.Data)
+
+			checkStore(t, db, 2, tc.loadStoreKey, k, v)
+		})
+	}
+}